@@ -2,13 +2,16 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/cli"
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
 	"github.com/dtg01100/rclone-mount-sync/internal/tui"
 )
@@ -18,6 +21,7 @@ var version = "dev"
 type Config struct {
 	ShowVersion bool
 	SkipChecks  bool
+	Quiet       bool
 	ConfigDir   string
 }
 
@@ -30,10 +34,28 @@ type PreflightChecker interface {
 
 type defaultPreflightChecker struct {
 	client *rclone.Client
+
+	// Remotes used by the config, each checked for a working connection
+	// once the rclone binary is found
+	remotes       []string
+	remoteTimeout time.Duration
+
+	// Whether the config has mounts, which makes FUSE a critical check
+	hasMounts bool
+
+	// Extra flags used by the config, checked against the rclone version
+	flags []string
 }
 
 func (d *defaultPreflightChecker) PreflightChecks() []rclone.CheckResult {
-	return rclone.PreflightChecks(d.client)
+	results := rclone.PreflightChecks(d.client)
+	if len(d.remotes) > 0 && results[0].Passed {
+		results = append(results, rclone.CheckRemoteConnections(d.client, d.remotes, d.remoteTimeout)...)
+	}
+	if len(d.flags) > 0 && results[0].Passed {
+		results = append(results, rclone.CheckFlagVersions(d.client, d.flags))
+	}
+	return append(results, rclone.CheckFUSE(d.hasMounts))
 }
 
 func (d *defaultPreflightChecker) HasCriticalFailure(results []rclone.CheckResult) bool {
@@ -64,6 +86,7 @@ func parseFlags(args []string) (*Config, error) {
 
 	showVersion := fs.Bool("version", false, "Print version and exit")
 	skipChecks := fs.Bool("skip-checks", false, "Skip pre-flight validation checks")
+	quiet := fs.Bool("quiet", false, "Only print pre-flight output if a critical check fails")
 	configDir := fs.String("config", "", "Custom config directory (overrides XDG_CONFIG_HOME)")
 
 	if err := fs.Parse(args); err != nil {
@@ -73,6 +96,7 @@ func parseFlags(args []string) (*Config, error) {
 	return &Config{
 		ShowVersion: *showVersion,
 		SkipChecks:  *skipChecks,
+		Quiet:       *quiet,
 		ConfigDir:   *configDir,
 	}, nil
 }
@@ -125,6 +149,18 @@ func runPreflightChecksTo(w io.Writer, checker PreflightChecker) error {
 	return nil
 }
 
+// runQuietPreflightChecks runs the pre-flight checks without printing
+// anything unless a critical check fails, in which case the full report is
+// written to errW.
+func runQuietPreflightChecks(errW io.Writer, checker PreflightChecker) error {
+	var buf bytes.Buffer
+	err := runPreflightChecksTo(&buf, checker)
+	if err != nil {
+		_, _ = buf.WriteTo(errW)
+	}
+	return err
+}
+
 func runPreflightChecks() error {
 	client := rclone.NewClient()
 	checker := &defaultPreflightChecker{client: client}
@@ -171,8 +207,19 @@ func runMainWithDeps(args []string, deps *AppDeps) int {
 	if !cfg.SkipChecks {
 		client := deps.NewClient()
 		checker := &defaultPreflightChecker{client: client}
+		if appConfig, err := config.Load(); err == nil {
+			checker.remotes = appConfig.ReferencedRemotes()
+			checker.remoteTimeout = appConfig.Settings.RemoteCheckDuration()
+			checker.hasMounts = len(appConfig.Mounts) > 0
+			checker.flags = appConfig.ReferencedFlags()
+		}
 
-		if err := runPreflightChecksTo(deps.Stdout, checker); err != nil {
+		if cfg.Quiet {
+			err = runQuietPreflightChecks(deps.Stderr, checker)
+		} else {
+			err = runPreflightChecksTo(deps.Stdout, checker)
+		}
+		if err != nil {
 			return 1
 		}
 	}
@@ -192,6 +239,16 @@ func runMain(args []string, stdout, stderr io.Writer) int {
 	return runMainWithDeps(args, DefaultAppDeps(stdout, stderr))
 }
 
+// hasCommand reports whether any of args is one of the CLI commands.
+func hasCommand(args []string, commands map[string]bool) bool {
+	for _, arg := range args {
+		if commands[arg] {
+			return true
+		}
+	}
+	return false
+}
+
 func main() {
 	args := os.Args[1:]
 
@@ -215,7 +272,9 @@ func main() {
 		"remote":     true,
 		"reconcile":  true,
 		"doctor":     true,
+		"status":     true,
 		"cleanup":    true,
+		"fetch":      true,
 		"help":       true,
 		"completion": true,
 	}
@@ -225,7 +284,7 @@ func main() {
 	if cliCommands[firstArg] {
 		cli.SetVersion(version)
 		if err := cli.Execute(); err != nil {
-			os.Exit(1)
+			os.Exit(cli.ExitCode(err))
 		}
 		os.Exit(0)
 	}
@@ -241,6 +300,12 @@ func main() {
 		os.Exit(runMain(args, os.Stdout, os.Stderr))
 	}
 
+	// --quiet is shared with the CLI; it only means the TUI when no
+	// command follows it
+	if firstArg == "--quiet" && !hasCommand(args, cliCommands) {
+		os.Exit(runMain(args, os.Stdout, os.Stderr))
+	}
+
 	// Unknown non-flag args route to CLI for help
 	cli.SetVersion(version)
 	if err := cli.Execute(); err != nil {
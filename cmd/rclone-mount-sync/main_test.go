@@ -19,6 +19,7 @@ func TestParseFlags(t *testing.T) {
 		wantVersion   bool
 		wantSkip      bool
 		wantConfigDir string
+		wantQuiet     bool
 		wantErr       bool
 	}{
 		{
@@ -75,6 +76,11 @@ func TestParseFlags(t *testing.T) {
 			wantConfigDir: "/path/to/config",
 			wantErr:       false,
 		},
+		{
+			name:      "quiet flag",
+			args:      []string{"--quiet"},
+			wantQuiet: true,
+		},
 		{
 			name:        "short flags not supported",
 			args:        []string{"-v"},
@@ -108,6 +114,9 @@ func TestParseFlags(t *testing.T) {
 			if cfg.ConfigDir != tt.wantConfigDir {
 				t.Errorf("ConfigDir = %q, want %q", cfg.ConfigDir, tt.wantConfigDir)
 			}
+			if cfg.Quiet != tt.wantQuiet {
+				t.Errorf("Quiet = %v, want %v", cfg.Quiet, tt.wantQuiet)
+			}
 		})
 	}
 }
@@ -243,6 +252,36 @@ func TestRunPreflightChecksTo_NonCriticalFailure(t *testing.T) {
 	}
 }
 
+func TestRunQuietPreflightChecks(t *testing.T) {
+	passing := &mockPreflightChecker{
+		results:          []rclone.CheckResult{{Name: "Test Check", Passed: true, Message: "OK"}},
+		allPassed:        true,
+		formatResultsStr: "[PASS] Test Check\n  OK\n",
+	}
+
+	var buf bytes.Buffer
+	if err := runQuietPreflightChecks(&buf, passing); err != nil {
+		t.Fatalf("runQuietPreflightChecks() unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("quiet pre-flight should print nothing on success, got %q", buf.String())
+	}
+
+	failing := &mockPreflightChecker{
+		results:          []rclone.CheckResult{{Name: "Critical Check", Passed: false, IsCritical: true}},
+		hasCritical:      true,
+		formatResultsStr: "[FAIL] Critical Check\n",
+	}
+
+	buf.Reset()
+	if err := runQuietPreflightChecks(&buf, failing); err == nil {
+		t.Fatal("runQuietPreflightChecks() expected error for critical failure")
+	}
+	if !strings.Contains(buf.String(), "[FAIL] Critical Check") {
+		t.Errorf("quiet pre-flight should print the report on failure, got %q", buf.String())
+	}
+}
+
 func TestHandleConfigDir(t *testing.T) {
 	tests := []struct {
 		name      string
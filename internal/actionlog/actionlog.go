@@ -0,0 +1,62 @@
+// Package actionlog keeps an audit trail of the changes rclone-mount-sync
+// makes itself: config saves and imports, unit file writes and service
+// actions. It is separate from the rclone and service logs and is off unless
+// enabled in the settings.
+package actionlog
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileName is the name of the action log inside the config directory.
+const FileName = "actions.log"
+
+var (
+	mu   sync.Mutex
+	path string // Empty while recording is off
+)
+
+// Configure turns recording on, appending to the file at p, or off when p
+// is empty.
+func Configure(p string) {
+	mu.Lock()
+	defer mu.Unlock()
+	path = p
+}
+
+// Path returns the file actions are recorded to, or "" when recording is off.
+func Path() string {
+	mu.Lock()
+	defer mu.Unlock()
+	return path
+}
+
+// Record appends a timestamped record of action on target, with the error
+// if the action failed. Recording is best effort: a log that can't be
+// written never fails the action itself.
+func Record(action, target string, err error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if path == "" {
+		return
+	}
+
+	if mkErr := os.MkdirAll(filepath.Dir(path), 0755); mkErr != nil {
+		return
+	}
+	f, openErr := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if openErr != nil {
+		return
+	}
+	defer f.Close()
+
+	logger := slog.New(slog.NewTextHandler(f, nil))
+	if err != nil {
+		logger.Error(action, "target", target, "error", err.Error())
+		return
+	}
+	logger.Info(action, "target", target)
+}
@@ -0,0 +1,51 @@
+package actionlog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", FileName)
+	t.Cleanup(func() { Configure("") })
+
+	Record("start", "rclone-mount-a.service", nil)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("nothing should be written while recording is off")
+	}
+
+	Configure(path)
+	if Path() != path {
+		t.Errorf("Path() = %q, want %q", Path(), path)
+	}
+	Record("start", "rclone-mount-a.service", nil)
+	Record("stop", "rclone-mount-b.service", errors.New("unit not loaded"))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read action log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d records, want 2:\n%s", len(lines), data)
+	}
+	for _, want := range []string{"time=", "level=INFO", "msg=start", "target=rclone-mount-a.service"} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("record %q should contain %q", lines[0], want)
+		}
+	}
+	for _, want := range []string{"level=ERROR", "msg=stop", `error="unit not loaded"`} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("record %q should contain %q", lines[1], want)
+		}
+	}
+
+	Configure("")
+	Record("start", "rclone-mount-c.service", nil)
+	if data2, _ := os.ReadFile(path); len(data2) != len(data) {
+		t.Error("nothing should be appended after recording is turned off")
+	}
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+var cleanupCmd = &cobra.Command{
+	Use:   "cleanup",
+	Short: "Clean up orphaned systemd units",
+	Long: `Remove what is left behind by mounts and sync jobs that are no longer in
+the config.
+
+This can happen if mounts/sync jobs were deleted improperly or if unit files
+were manually removed. The command will:
+1. Reset the failed state of rclone units that no longer have unit files
+2. Stop, disable and remove unit files with no mount or sync job in the config
+3. Remove the rclone VFS cache directories of those mounts, unless a
+   configured mount uses the same remote
+4. Remove their mount point directories if they are empty and not mounted
+
+Everything is listed and confirmed first, unless --assume-yes is given.
+With --dry-run the list is printed and nothing is removed. A failed removal
+does not stop the others; failures are summarized at the end.`,
+	RunE: runCleanup,
+}
+
+var cleanupDryRun bool
+
+func init() {
+	rootCmd.AddCommand(cleanupCmd)
+
+	cleanupCmd.Flags().BoolVar(&cleanupDryRun, "dry-run", false, "list what would be removed without removing anything")
+}
+
+// Kinds of cleanup items.
+const (
+	cleanupFailedUnit = "failed unit"
+	cleanupUnitFile   = "unit file"
+	cleanupCache      = "cache directory"
+	cleanupMountPoint = "mount point"
+)
+
+// cleanupItem is one thing cleanup removes.
+type cleanupItem struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target"` // Unit name or directory
+	Error  string `json:"error,omitempty"`
+
+	remove func() error
+}
+
+// listFailedUnits returns the names of the failed units of the scope. It is
+// injectable for testing.
+var listFailedUnits = func(scope systemd.Scope) ([]string, error) {
+	output, err := exec.Command("systemctl", scope.Args("list-units", "--state=failed", "--no-legend", "--plain")...).Output()
+	if err != nil {
+		return nil, err
+	}
+	var units []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			units = append(units, fields[0])
+		}
+	}
+	return units, nil
+}
+
+// rcloneCacheDir returns rclone's default cache directory. It is injectable
+// for testing.
+var rcloneCacheDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "rclone"), nil
+}
+
+// planCleanup lists what cleanup removes, in the order it removes it. Unit
+// files come before the directories of their mounts, so a mount is stopped
+// before its cache is removed.
+func planCleanup(cfg *config.Config, gen *systemd.Generator, mgr systemd.ServiceManager) ([]cleanupItem, error) {
+	var items []cleanupItem
+
+	failed, err := listFailedUnits(gen.Scope())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed units: %w", err)
+	}
+	for _, unit := range failed {
+		if !strings.HasPrefix(unit, "rclone-mount-") && !strings.HasPrefix(unit, "rclone-sync-") {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(gen.GetSystemdDir(), unit)); os.IsNotExist(err) {
+			items = append(items, cleanupItem{Kind: cleanupFailedUnit, Target: unit, remove: func() error { return mgr.ResetFailed(unit) }})
+		}
+	}
+
+	validMounts := make(map[string]bool)
+	usedRemotes := make(map[string]bool)
+	for _, m := range cfg.Mounts {
+		validMounts[m.ID] = true
+		usedRemotes[strings.TrimSuffix(m.Remote, ":")] = true
+	}
+	validSyncs := make(map[string]bool)
+	for _, j := range cfg.SyncJobs {
+		validSyncs[j.ID] = true
+	}
+
+	reconciler := systemd.NewReconciler(gen, mgr)
+	orphans, err := reconciler.ScanForOrphans(validMounts, validSyncs)
+	if err != nil {
+		return nil, err
+	}
+	var dirs []cleanupItem
+	cacheDir, cacheErr := rcloneCacheDir()
+	active, _ := rclone.ListActiveMounts()
+	for _, orphan := range orphans.OrphanedUnits {
+		items = append(items, cleanupItem{Kind: cleanupUnitFile, Target: orphan.Name, remove: func() error { return reconciler.RemoveOrphan(orphan) }})
+		if orphan.Type != "mount" {
+			continue
+		}
+		imported, err := reconciler.Import(orphan)
+		if err != nil || imported.Mount == nil || imported.Mount.Remote == "" {
+			continue
+		}
+		mount := imported.Mount
+
+		// The VFS cache of remote:path is kept under vfs/remote/path
+		remote := strings.TrimSuffix(mount.Remote, ":")
+		if cacheErr == nil && !usedRemotes[remote] {
+			for _, sub := range []string{"vfs", "vfsMeta"} {
+				dir := filepath.Join(cacheDir, sub, remote, mount.RemotePath)
+				if _, err := os.Stat(dir); err == nil {
+					dirs = append(dirs, cleanupItem{Kind: cleanupCache, Target: dir, remove: func() error { return os.RemoveAll(dir) }})
+				}
+			}
+		}
+
+		if mount.MountPoint == "" {
+			continue
+		}
+		dir := filepath.Clean(utils.ExpandHome(mount.MountPoint))
+		if entries, err := os.ReadDir(dir); err == nil && len(entries) == 0 && !isMountedAt(active, dir) {
+			// os.Remove only removes an empty directory
+			dirs = append(dirs, cleanupItem{Kind: cleanupMountPoint, Target: dir, remove: func() error { return os.Remove(dir) }})
+		}
+	}
+	return append(items, dirs...), nil
+}
+
+// isMountedAt reports whether one of the active rclone mounts is at dir.
+func isMountedAt(active []rclone.ActiveMount, dir string) bool {
+	for _, m := range active {
+		if filepath.Clean(m.MountPoint) == dir {
+			return true
+		}
+	}
+	return false
+}
+
+func runCleanup(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	generator, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	items, err := planCleanup(cfg, generator, loadManager())
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		if outputJSON {
+			return printJSON([]cleanupItem{})
+		}
+		printInfo("Nothing to clean up.\n")
+		return nil
+	}
+
+	if cleanupDryRun {
+		if outputJSON {
+			return printJSON(items)
+		}
+		for _, item := range items {
+			fmt.Printf("Would remove %s: %s\n", item.Kind, item.Target)
+		}
+		printInfo("\n%d item(s) would be removed.\n", len(items))
+		return nil
+	}
+
+	if !outputJSON {
+		for _, item := range items {
+			printInfo("  %s: %s\n", item.Kind, item.Target)
+		}
+	}
+	if err := confirm(fmt.Sprintf("Remove %d item(s)", len(items))); err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range items {
+		item := &items[i]
+		if err := item.remove(); err != nil {
+			item.Error = err.Error()
+			errs = append(errs, fmt.Errorf("%s %s: %w", item.Kind, item.Target, err))
+			if !outputJSON {
+				fmt.Fprintf(os.Stderr, "Warning: failed to remove %s %s: %v\n", item.Kind, item.Target, err)
+			}
+			continue
+		}
+		if !outputJSON {
+			printInfo("Removed %s: %s\n", item.Kind, item.Target)
+		}
+	}
+
+	if outputJSON {
+		if err := printJSON(items); err != nil {
+			return err
+		}
+	} else {
+		printInfo("\nCleaned up %d of %d item(s).\n", len(items)-len(errs), len(items))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d removal(s) failed: %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// cleanupFixture is what a cleanup test leaves behind to be cleaned up.
+type cleanupFixture struct {
+	unitFile   string
+	cacheDir   string
+	mountPoint string
+	keptCache  string // Cache of an old mount of a remote still in use
+}
+
+// useCleanupFixture sets up a failed unit with no unit file, the unit, VFS
+// cache and empty mount point of a mount no longer in the config, and the
+// unit and cache of an old mount of a remote a configured mount uses.
+func useCleanupFixture(t *testing.T, mgr *systemd.MockManager) cleanupFixture {
+	t.Helper()
+	cfg := &config.Config{Mounts: []models.MountConfig{{ID: "m1", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive"}}}
+	useReportLoaders(t, cfg, mgr)
+
+	oldListFailedUnits, oldCacheDir, oldDryRun := listFailedUnits, rcloneCacheDir, cleanupDryRun
+	t.Cleanup(func() { listFailedUnits, rcloneCacheDir, cleanupDryRun = oldListFailedUnits, oldCacheDir, oldDryRun })
+	listFailedUnits = func(systemd.Scope) ([]string, error) {
+		return []string{"rclone-sync-f0f0f0f0.service", "unrelated.service"}, nil
+	}
+	cache := t.TempDir()
+	rcloneCacheDir = func() (string, error) { return cache, nil }
+
+	gone := models.MountConfig{ID: "a1b2c3d4", Name: "old", Remote: "oldremote", RemotePath: "/Photos", MountPoint: filepath.Join(t.TempDir(), "old")}
+	gen, _ := loadGenerator()
+	unitFile, err := gen.WriteMountService(&gone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared := models.MountConfig{ID: "b2c3d4e5", Name: "old gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: filepath.Join(t.TempDir(), "missing")}
+	if _, err := gen.WriteMountService(&shared); err != nil {
+		t.Fatal(err)
+	}
+	fixture := cleanupFixture{
+		unitFile:   unitFile,
+		cacheDir:   filepath.Join(cache, "vfs", "oldremote", "Photos"),
+		mountPoint: gone.MountPoint,
+		keptCache:  filepath.Join(cache, "vfs", "gdrive"),
+	}
+	for _, dir := range []string{fixture.cacheDir, fixture.mountPoint, fixture.keptCache} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return fixture
+}
+
+// cleanupLines returns the targets of the output lines starting with prefix.
+func cleanupLines(out, prefix string) []string {
+	var lines []string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, prefix) {
+			lines = append(lines, strings.TrimPrefix(line, prefix))
+		}
+	}
+	return lines
+}
+
+func TestCleanupDryRunMatchesRealRun(t *testing.T) {
+	oldAssumeYes := assumeYes
+	defer func() { assumeYes = oldAssumeYes }()
+	mgr := &systemd.MockManager{}
+	fixture := useCleanupFixture(t, mgr)
+
+	cleanupDryRun = true
+	out := captureStdout(t, func() {
+		if err := runCleanup(nil, nil); err != nil {
+			t.Errorf("runCleanup() --dry-run error = %v", err)
+		}
+	})
+	wouldRemove := cleanupLines(out, "Would remove ")
+	want := []string{
+		"failed unit: rclone-sync-f0f0f0f0.service",
+		"unit file: rclone-mount-a1b2c3d4.service",
+		"unit file: rclone-mount-b2c3d4e5.service",
+		"cache directory: " + fixture.cacheDir,
+		"mount point: " + fixture.mountPoint,
+	}
+	if strings.Join(wouldRemove, "\n") != strings.Join(want, "\n") {
+		t.Errorf("dry run lists\n%s\nwant\n%s", strings.Join(wouldRemove, "\n"), strings.Join(want, "\n"))
+	}
+	for _, path := range []string{fixture.unitFile, fixture.cacheDir, fixture.mountPoint} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("dry run removed %s", path)
+		}
+	}
+	if mgr.Called("ResetFailed", "") || mgr.Called("DaemonReload", "") {
+		t.Errorf("dry run changed systemd: %v", mgr.Calls)
+	}
+
+	cleanupDryRun, assumeYes = false, true
+	out = captureStdout(t, func() {
+		if err := runCleanup(nil, nil); err != nil {
+			t.Errorf("runCleanup() error = %v", err)
+		}
+	})
+	if removed := cleanupLines(out, "Removed "); strings.Join(removed, "\n") != strings.Join(want, "\n") {
+		t.Errorf("real run removed\n%s\nwant the dry run's list", strings.Join(removed, "\n"))
+	}
+	for _, path := range []string{fixture.unitFile, fixture.cacheDir, fixture.mountPoint} {
+		if _, err := os.Stat(path); err == nil {
+			t.Errorf("%s was not removed", path)
+		}
+	}
+	if _, err := os.Stat(fixture.keptCache); err != nil {
+		t.Error("the cache of a remote still in use should be kept")
+	}
+	if !mgr.Called("ResetFailed", "rclone-sync-f0f0f0f0.service") {
+		t.Error("the failed unit should be reset")
+	}
+}
+
+func TestCleanupContinuesPastErrors(t *testing.T) {
+	oldAssumeYes := assumeYes
+	defer func() { assumeYes = oldAssumeYes }()
+	assumeYes = true
+	fixture := useCleanupFixture(t, &systemd.MockManager{ResetFailedErr: errors.New("permission denied")})
+
+	var err error
+	captureStdout(t, func() { err = runCleanup(nil, nil) })
+	if err == nil || !strings.Contains(err.Error(), "1 removal(s) failed") {
+		t.Errorf("runCleanup() error = %v, want the failure summarized", err)
+	}
+	if _, err := os.Stat(fixture.mountPoint); err == nil {
+		t.Error("later items should still be removed after a failure")
+	}
+}
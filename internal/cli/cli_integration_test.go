@@ -65,6 +65,9 @@ func TestMountCreateAndDeleteFlow(t *testing.T) {
 	_ = os.WriteFile(filepath.Join(tmp, "rclone-mount-abc12345.service"), []byte("[Unit]\n"), 0644)
 
 	// Run delete
+	oldAssumeYes := assumeYes
+	defer func() { assumeYes = oldAssumeYes }()
+	assumeYes = true
 	if err := runMountDelete(nil, []string{mountCreateName}); err != nil {
 		t.Fatalf("runMountDelete failed: %v", err)
 	}
@@ -0,0 +1,278 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/report"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Work with the configuration as a whole",
+}
+
+var configReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Write a Markdown or HTML report of the full setup",
+	Long: `Write a human-readable report of all mounts and sync jobs for documenting
+and reviewing a setup.
+
+The report lists each mount and sync job with its current service status, and
+each sync job with its schedule in plain words and the outcome of its last
+run. It is written to stdout unless --out is given.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigReport,
+}
+
+var configSetAutostartCmd = &cobra.Command{
+	Use:   "set-autostart --all <on|off>",
+	Short: "Turn auto-start on or off for every mount and sync job",
+	Long: `Turn auto-start on or off for every mount and sync job at once, for example
+to recover after a boot where everything started and failed.
+
+off sets auto_start and enabled to false on every item and disables each
+mount's service and each scheduled sync job's timer. Running services are
+left alone and nothing is deleted. on reverses it. You are asked to confirm
+first unless --assume-yes is given; the command fails if any unit could not
+be changed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigSetAutostart,
+}
+
+var configApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Make the installed units match the config",
+	Long: `Make the systemd units match the config, for use from automation.
+
+The command writes every unit whose file is missing or differs from what the
+config generates, removes the units of mounts and sync jobs that are no longer
+in the config, reloads systemd, and enables each mount's service and each
+scheduled sync job's timer exactly when the item is enabled. Running services
+are not restarted.
+
+The planned changes are listed first. --dry-run stops there; otherwise you
+are asked to confirm unless --assume-yes is given. Running it again when
+nothing changed does nothing.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigApply,
+}
+
+var setAutostartAll bool
+
+var applyDryRun bool
+
+var (
+	reportFormat string
+	reportOut    string
+)
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configReportCmd)
+	configCmd.AddCommand(configSetAutostartCmd)
+	configCmd.AddCommand(configApplyCmd)
+
+	configApplyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "list the changes without making them")
+
+	configSetAutostartCmd.Flags().BoolVar(&setAutostartAll, "all", false, "apply to every mount and sync job (required)")
+
+	configReportCmd.Flags().StringVar(&reportFormat, "format", report.FormatMarkdown, "report format: md or html")
+	configReportCmd.Flags().StringVarP(&reportOut, "out", "o", "", "write the report to this file instead of stdout")
+}
+
+func runConfigReport(cmd *cobra.Command, args []string) error {
+	if reportFormat != report.FormatMarkdown && reportFormat != report.FormatHTML {
+		return fmt.Errorf("unknown report format %q (use %s or %s)", reportFormat, report.FormatMarkdown, report.FormatHTML)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	gen, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	// One batched listing gives the status of every service; without it the
+	// report is still written, with the status shown as unknown
+	statuses, err := loadManager().ListServices()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to read service status: %v\n", err)
+		statuses = nil
+	}
+
+	r := report.Build(cfg, gen, statuses, time.Now())
+
+	if reportOut == "" {
+		return report.Write(os.Stdout, r, reportFormat)
+	}
+
+	f, err := os.Create(reportOut)
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	if err := report.Write(f, r, reportFormat); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	printInfo("Report written to %s\n", reportOut)
+	return nil
+}
+
+// autostartResult is the --json output of config set-autostart.
+type autostartResult struct {
+	AutoStart       bool                   `json:"auto_start"`
+	MountsChanged   int                    `json:"mounts_changed"`
+	SyncJobsChanged int                    `json:"sync_jobs_changed"`
+	Units           []systemd.ActionResult `json:"units"`
+}
+
+func runConfigSetAutostart(cmd *cobra.Command, args []string) error {
+	var on bool
+	switch args[0] {
+	case "on":
+		on = true
+	case "off":
+		on = false
+	default:
+		return fmt.Errorf("unknown state %q (use on or off)", args[0])
+	}
+	if !setAutostartAll {
+		return fmt.Errorf("set-autostart applies to every item; pass --all to confirm that")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	verb := "Disable"
+	if on {
+		verb = "Enable"
+	}
+	if err := confirm(fmt.Sprintf("%s auto-start for all %d mount(s) and %d sync job(s)", verb, len(cfg.Mounts), len(cfg.SyncJobs))); err != nil {
+		return err
+	}
+
+	gen, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	mounts, syncJobs := cfg.SetAllAutoStart(on)
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	action := systemd.BatchDisable
+	if on {
+		action = systemd.BatchEnable
+	}
+	results := systemd.RunBatch(loadManager(), action, systemd.AutoStartUnits(gen, cfg.Mounts, cfg.SyncJobs))
+	succeeded, failed := systemd.CountResults(results)
+
+	if outputJSON {
+		if err := printJSON(autostartResult{AutoStart: on, MountsChanged: mounts, SyncJobsChanged: syncJobs, Units: results}); err != nil {
+			return err
+		}
+	} else {
+		printInfo("%sd auto-start: %d mount(s) and %d sync job(s) changed, %d unit(s) %sd\n", verb, mounts, syncJobs, succeeded, action)
+		for _, r := range results {
+			if !r.OK() {
+				fmt.Fprintf(os.Stderr, "Warning: failed to %s %s: %s\n", action, r.Unit, r.Error)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s failed for %d of %d units", action, failed, len(results))
+	}
+	return nil
+}
+
+// applyResult is the --json output of config apply.
+type applyResult struct {
+	DryRun  bool                `json:"dry_run"`
+	Changes []applyChangeResult `json:"changes"`
+}
+
+type applyChangeResult struct {
+	Action string `json:"action"`
+	Unit   string `json:"unit"`
+	Error  string `json:"error,omitempty"`
+}
+
+func runConfigApply(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	gen, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	plan, err := systemd.PlanApply(gen, loadManager(), cfg.Mounts, cfg.SyncJobs)
+	if err != nil {
+		return fmt.Errorf("failed to compare units with the config: %w", err)
+	}
+
+	if len(plan.Changes) == 0 {
+		if outputJSON {
+			return printJSON(applyResult{DryRun: applyDryRun, Changes: []applyChangeResult{}})
+		}
+		printInfo("Units already match the config; nothing to do.\n")
+		return nil
+	}
+
+	if !outputJSON {
+		for _, c := range plan.Changes {
+			printInfo("  %-8s %s\n", c.Action, c.Unit)
+		}
+	}
+
+	var execErr error
+	if !applyDryRun {
+		if err := confirm(fmt.Sprintf("Apply %d change(s)", len(plan.Changes))); err != nil {
+			return err
+		}
+		execErr = plan.Execute()
+	}
+
+	if outputJSON {
+		result := applyResult{DryRun: applyDryRun}
+		for _, c := range plan.Changes {
+			change := applyChangeResult{Action: c.Action, Unit: c.Unit}
+			if c.Err != nil {
+				change.Error = c.Err.Error()
+			}
+			result.Changes = append(result.Changes, change)
+		}
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		verb := "Applied"
+		if applyDryRun {
+			verb = "Would apply"
+		}
+		printInfo("%s: %d written, %d removed, %d enabled, %d disabled\n", verb,
+			plan.Count(systemd.ApplyWrite), plan.Count(systemd.ApplyRemove),
+			plan.Count(systemd.ApplyEnable), plan.Count(systemd.ApplyDisable))
+	}
+
+	if execErr != nil {
+		return fmt.Errorf("some changes failed: %w", execErr)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// useReportLoaders points the config, generator and manager loaders at
+// test doubles for the report command.
+func useReportLoaders(t *testing.T, cfg *config.Config, mgr *systemd.MockManager) {
+	t.Helper()
+	oldLoadConfig, oldLoadGenerator, oldLoadManager := loadConfig, loadGenerator, loadManager
+	t.Cleanup(func() {
+		loadConfig, loadGenerator, loadManager = oldLoadConfig, oldLoadGenerator, oldLoadManager
+		reportFormat, reportOut = "md", ""
+	})
+
+	tmp := t.TempDir()
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	loadGenerator = func() (*systemd.Generator, error) { return systemd.NewTestGenerator(tmp), nil }
+	loadManager = func() systemd.ServiceManager { return mgr }
+}
+
+func TestConfigReportWritesFile(t *testing.T) {
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{{ID: "m1", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive"}},
+		SyncJobs: []models.SyncJobConfig{{
+			ID: "s1", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos",
+			Schedule: models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+		}},
+	}
+	mgr := &systemd.MockManager{ListServicesResult: []systemd.ServiceStatus{
+		{Name: "rclone-mount-m1", Active: true, State: "active"},
+	}}
+	useReportLoaders(t, cfg, mgr)
+
+	reportFormat = "html"
+	reportOut = filepath.Join(t.TempDir(), "setup.html")
+	captureStdout(t, func() {
+		if err := runConfigReport(nil, nil); err != nil {
+			t.Fatalf("runConfigReport() error = %v", err)
+		}
+	})
+
+	data, err := os.ReadFile(reportOut)
+	if err != nil {
+		t.Fatalf("report not written: %v", err)
+	}
+	for _, want := range []string{"<td>gdrive</td>", "<td>running</td>", "<td>Every day at midnight</td>", "<td>not installed</td>"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("report missing %q", want)
+		}
+	}
+	if !mgr.Called("ListServices", "") {
+		t.Error("report should take one batched status snapshot")
+	}
+}
+
+func TestConfigReportRejectsUnknownFormat(t *testing.T) {
+	useReportLoaders(t, &config.Config{}, &systemd.MockManager{})
+
+	reportFormat = "pdf"
+	if err := runConfigReport(nil, nil); err == nil || !strings.Contains(err.Error(), "unknown report format") {
+		t.Errorf("runConfigReport() error = %v, want unknown format", err)
+	}
+}
+
+func TestConfigSetAutostartOff(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{{ID: "abc12345", Name: "gdrive", AutoStart: true, Enabled: true}},
+		SyncJobs: []models.SyncJobConfig{
+			{ID: "def67890", Name: "photos", AutoStart: true, Enabled: true, Schedule: models.ScheduleConfig{Type: "timer"}},
+			{ID: "aaa11111", Name: "adhoc", Schedule: models.ScheduleConfig{Type: "manual"}},
+		},
+	}
+	mgr := &systemd.MockManager{}
+	useReportLoaders(t, cfg, mgr)
+	oldAll, oldAssumeYes := setAutostartAll, assumeYes
+	defer func() { setAutostartAll, assumeYes = oldAll, oldAssumeYes }()
+	setAutostartAll, assumeYes = true, true
+
+	var err error
+	out := captureStdout(t, func() { err = runConfigSetAutostart(nil, []string{"off"}) })
+	if err != nil {
+		t.Fatalf("runConfigSetAutostart() error = %v", err)
+	}
+	if cfg.Mounts[0].AutoStart || cfg.SyncJobs[0].AutoStart {
+		t.Error("every item should have auto-start turned off")
+	}
+	if !cfg.Mounts[0].Enabled || !cfg.SyncJobs[0].Enabled {
+		t.Error("turning auto-start off should leave the items enabled")
+	}
+	if !mgr.Called("Disable", "rclone-mount-abc12345.service") || !mgr.Called("Disable", "rclone-sync-def67890.timer") {
+		t.Errorf("mount service and sync timer should be disabled, calls: %v", mgr.Calls)
+	}
+	if mgr.Called("Disable", "rclone-sync-aaa11111.timer") || mgr.Called("Stop", "") {
+		t.Errorf("manual jobs have no timer and nothing should be stopped, calls: %v", mgr.Calls)
+	}
+	if !strings.Contains(out, "1 mount(s) and 1 sync job(s) changed, 2 unit(s) disabled") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestConfigSetAutostartRequiresAll(t *testing.T) {
+	oldAll := setAutostartAll
+	defer func() { setAutostartAll = oldAll }()
+	setAutostartAll = false
+
+	if err := runConfigSetAutostart(nil, []string{"off"}); err == nil || !strings.Contains(err.Error(), "--all") {
+		t.Errorf("runConfigSetAutostart() error = %v, want a request for --all", err)
+	}
+	setAutostartAll = true
+	if err := runConfigSetAutostart(nil, []string{"maybe"}); err == nil {
+		t.Error("runConfigSetAutostart() should reject states other than on and off")
+	}
+}
+
+func TestConfigApply(t *testing.T) {
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{{ID: "a1b2c3d4", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive", Enabled: true}},
+	}
+	mgr := &systemd.MockManager{}
+	useReportLoaders(t, cfg, mgr)
+	gen, _ := loadGenerator()
+	unitPath := filepath.Join(gen.GetSystemdDir(), "rclone-mount-a1b2c3d4.service")
+
+	oldDryRun, oldAssumeYes := applyDryRun, assumeYes
+	defer func() { applyDryRun, assumeYes = oldDryRun, oldAssumeYes }()
+
+	var err error
+	applyDryRun = true
+	out := captureStdout(t, func() { err = runConfigApply(nil, nil) })
+	if err != nil {
+		t.Fatalf("dry run error = %v", err)
+	}
+	if !strings.Contains(out, "write    rclone-mount-a1b2c3d4.service") || !strings.Contains(out, "Would apply: 1 written, 0 removed, 1 enabled, 0 disabled") {
+		t.Errorf("unexpected dry run output: %q", out)
+	}
+	if _, statErr := os.Stat(unitPath); !os.IsNotExist(statErr) || mgr.Called("Enable", "") {
+		t.Fatal("a dry run should not change anything")
+	}
+
+	applyDryRun, assumeYes = false, true
+	out = captureStdout(t, func() { err = runConfigApply(nil, nil) })
+	if err != nil {
+		t.Fatalf("runConfigApply() error = %v", err)
+	}
+	if _, statErr := os.Stat(unitPath); statErr != nil {
+		t.Errorf("unit file should be written: %v", statErr)
+	}
+	if !mgr.Called("DaemonReload", "") || !mgr.Called("Enable", "rclone-mount-a1b2c3d4.service") {
+		t.Errorf("expected a reload and the service enabled, calls: %v", mgr.Calls)
+	}
+	if !strings.Contains(out, "Applied: 1 written, 0 removed, 1 enabled, 0 disabled") {
+		t.Errorf("unexpected output: %q", out)
+	}
+
+	mgr.IsEnabledResult = true
+	out = captureStdout(t, func() { err = runConfigApply(nil, nil) })
+	if err != nil || !strings.Contains(out, "nothing to do") {
+		t.Errorf("second apply should be a no-op, err = %v, output: %q", err, out)
+	}
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the environment for problems",
+	Long: `Run the pre-flight checks and additional diagnostics.
+
+Besides the startup checks, doctor compares the rclone binary configured in
+the settings with the rclone on PATH that generated units fall back to, and
+warns if their versions differ. It also lists the source of each sync job and
+warns about any that are missing or inaccessible, since such a job silently
+transfers nothing. Each remote used by a mount or sync job is listed to
+confirm it is reachable and its credentials are accepted, waiting at most
+remote_check_timeout (10s by default) per remote. Mounts using options that
+rclone does not support on this platform, such as a config imported from
+another host, are reported too. It exits non-zero if a critical check fails.
+
+With --fix, doctor first creates missing mount point directories, reloads
+systemd when installed unit files changed since they were loaded, and
+enables installed mount services and sync timers the config enables. Unit
+files that are missing or differ from the config are written, and leftover
+units removed or disabled, only once confirmed (or with --assume-yes). Each fix is reported with the
+checks, and running it again changes nothing.`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+var doctorFix bool
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "fix common problems before checking: create missing mount points, reload stale units and enable units the config enables")
+}
+
+// doctorCheck is the JSON output of a single doctor check.
+type doctorCheck struct {
+	Name       string `json:"name"`
+	Passed     bool   `json:"passed"`
+	Critical   bool   `json:"critical"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// runDoctorChecks is injectable for testing so the systemd, remote and FUSE
+// checks don't depend on the host. FUSE is critical when mounts exist.
+var runDoctorChecks = func(client *rclone.Client, hasMounts bool) []rclone.CheckResult {
+	return append(rclone.PreflightChecks(client), rclone.CheckFUSE(hasMounts))
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	var fixes []rclone.CheckResult
+	if doctorFix {
+		if fixes, err = runDoctorFixes(cfg); err != nil {
+			return err
+		}
+	}
+
+	results := append(fixes, runDoctorChecks(loadRcloneClient(), len(cfg.Mounts) > 0)...)
+	results = append(results, rclone.CheckBinaryConsistency(cfg.Settings.RcloneBinaryPath))
+	results = append(results, rclone.CheckFlagVersions(loadRcloneClient(), cfg.ReferencedFlags()))
+	results = append(results, checkSyncSources(loadRcloneClient(), cfg.SyncJobs)...)
+	results = append(results, rclone.CheckRemoteConnections(loadRcloneClient(), cfg.ReferencedRemotes(), cfg.Settings.RemoteCheckDuration())...)
+	results = append(results, checkMountPlatform(cfg.Mounts, runtime.GOOS))
+	results = append(results, checkAllowOther(cfg.Mounts, rclone.FuseConfPath))
+
+	if outputJSON {
+		checks := make([]doctorCheck, len(results))
+		for i, r := range results {
+			checks[i] = doctorCheck{
+				Name:       r.Name,
+				Passed:     r.Passed,
+				Critical:   r.IsCritical,
+				Message:    r.Message,
+				Suggestion: r.Suggestion,
+			}
+		}
+		if err := printJSON(checks); err != nil {
+			return err
+		}
+	} else if !quiet || !rclone.AllPassed(results) {
+		fmt.Print(rclone.FormatResults(results))
+	}
+
+	if rclone.HasCriticalFailure(results) {
+		return fmt.Errorf("critical checks failed")
+	}
+	return nil
+}
+
+// checkSyncSources checks the source of every sync job. The checks run
+// concurrently since each may wait up to rclone.SourceCheckTimeout on the
+// network; the results keep the order of the jobs.
+func checkSyncSources(client *rclone.Client, jobs []models.SyncJobConfig) []rclone.CheckResult {
+	results := make([]rclone.CheckResult, len(jobs))
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func(i int, job models.SyncJobConfig) {
+			defer wg.Done()
+			results[i] = rclone.CheckSyncSource(context.Background(), client, job.Source)
+			results[i].Name = "Sync Source: " + job.Name
+		}(i, job)
+	}
+	wg.Wait()
+	return results
+}
+
+// checkMountPlatform reports mounts with options that rclone does not support
+// on goos. Their units would be rejected when regenerated, or fail at start.
+func checkMountPlatform(mounts []models.MountConfig, goos string) rclone.CheckResult {
+	result := rclone.CheckResult{Name: "Mount Platform Options"}
+
+	var problems []string
+	for _, m := range mounts {
+		if flags := models.UnsupportedMountOptions(m.MountOptions, goos); len(flags) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %s", m.Name, strings.Join(flags, ", ")))
+		}
+	}
+	if len(problems) == 0 {
+		result.Passed = true
+		result.Message = fmt.Sprintf("All mount options are supported on %s", goos)
+		return result
+	}
+
+	result.Message = fmt.Sprintf("Options not supported on %s: %s", goos, strings.Join(problems, "; "))
+	result.Suggestion = "Edit these mounts to turn the options off"
+	return result
+}
+
+// checkAllowOther reports mounts with --allow-other when the FUSE
+// configuration at fuseConf does not set user_allow_other, so fusermount
+// would refuse to mount them.
+func checkAllowOther(mounts []models.MountConfig, fuseConf string) rclone.CheckResult {
+	result := rclone.CheckResult{Name: "FUSE Allow Other"}
+
+	var names []string
+	for _, m := range mounts {
+		if m.MountOptions.AllowOther {
+			names = append(names, m.Name)
+		}
+	}
+	switch {
+	case len(names) == 0:
+		result.Passed = true
+		result.Message = "No mounts use --allow-other"
+	case rclone.FuseAllowsOther(fuseConf):
+		result.Passed = true
+		result.Message = fmt.Sprintf("user_allow_other is set in %s", fuseConf)
+	default:
+		result.Message = fmt.Sprintf("%s use --allow-other but user_allow_other is not set in %s", strings.Join(names, ", "), fuseConf)
+		result.Suggestion = fmt.Sprintf("Add the line 'user_allow_other' to %s, or turn Allow Other off for these mounts", fuseConf)
+	}
+	return result
+}
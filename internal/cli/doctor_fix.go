@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/pkg/utils"
+)
+
+// runDoctorFixes attempts to remedy common problems before doctor runs its
+// checks, returning one result per fix it made or could not make. Each fix
+// only acts when needed, so running it again does nothing. Units are
+// enabled without asking; rewriting or removing unit files that differ from
+// the config needs confirmation.
+func runDoctorFixes(cfg *config.Config) ([]rclone.CheckResult, error) {
+	gen, err := loadGenerator()
+	if err != nil {
+		return nil, err
+	}
+	mgr := loadManager()
+
+	results := fixMountPoints(cfg.Mounts)
+	if r, ok := fixStaleUnits(gen, mgr, cfg); ok {
+		results = append(results, r)
+	}
+
+	plan, err := systemd.PlanApply(gen, mgr, cfg.Mounts, cfg.SyncJobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare units with the config: %w", err)
+	}
+	return append(results, fixUnits(plan, gen.GetSystemdDir())...), nil
+}
+
+// fixMountPoints creates the missing mount point directories.
+func fixMountPoints(mounts []models.MountConfig) []rclone.CheckResult {
+	var results []rclone.CheckResult
+	for _, m := range mounts {
+		path := filepath.Clean(utils.ExpandHome(m.MountPoint))
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			continue
+		}
+		result := rclone.CheckResult{Name: "Fix: Mount Point " + m.Name}
+		if err := os.MkdirAll(path, 0755); err != nil {
+			result.Message = fmt.Sprintf("Could not create %s: %v", path, err)
+			result.Suggestion = "Create the directory by hand"
+		} else {
+			result.Passed = true
+			result.Message = "Created " + path
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// fixStaleUnits reloads systemd when an installed unit file changed since
+// it was loaded. ok is false when nothing needed reloading.
+func fixStaleUnits(gen *systemd.Generator, mgr systemd.ServiceManager, cfg *config.Config) (result rclone.CheckResult, ok bool) {
+	var units []string
+	for _, m := range cfg.Mounts {
+		units = append(units, gen.ServiceName(m.ID, "mount")+".service")
+	}
+	for _, j := range cfg.SyncJobs {
+		name := gen.ServiceName(j.ID, "sync")
+		units = append(units, name+".service", name+".timer")
+	}
+
+	var stale []string
+	for _, unit := range units {
+		if _, err := os.Stat(filepath.Join(gen.GetSystemdDir(), unit)); err != nil {
+			continue
+		}
+		if needs, err := mgr.NeedsDaemonReload(unit); err == nil && needs {
+			stale = append(stale, unit)
+		}
+	}
+	if len(stale) == 0 {
+		return rclone.CheckResult{}, false
+	}
+
+	result = rclone.CheckResult{Name: "Fix: Daemon Reload"}
+	if err := mgr.DaemonReload(); err != nil {
+		result.Message = fmt.Sprintf("Could not reload systemd: %v", err)
+		result.Suggestion = "Run: systemctl --user daemon-reload"
+		return result, true
+	}
+	result.Passed = true
+	result.Message = "Reloaded systemd for changed units: " + strings.Join(stale, ", ")
+	return result, true
+}
+
+// fixUnits enables the installed units the config wants enabled, and, once
+// confirmed, makes the remaining changes of plan, which write, remove or
+// disable units. Without confirmation only the units are enabled.
+func fixUnits(plan *systemd.ApplyPlan, systemdDir string) []rclone.CheckResult {
+	installed := func(unit string) bool {
+		_, err := os.Stat(filepath.Join(systemdDir, unit))
+		return err == nil
+	}
+	var safe, destructive []systemd.ApplyChange
+	for _, c := range plan.Changes {
+		if c.Action == systemd.ApplyEnable && installed(c.Unit) {
+			safe = append(safe, c)
+		} else {
+			destructive = append(destructive, c)
+		}
+	}
+
+	var results []rclone.CheckResult
+	if len(destructive) > 0 {
+		if !outputJSON {
+			for _, c := range destructive {
+				printInfo("  %-8s %s\n", c.Action, c.Unit)
+			}
+		}
+		if err := confirm(fmt.Sprintf("Apply %d change(s) that write, remove or disable units", len(destructive))); err != nil {
+			results = append(results, rclone.CheckResult{
+				Name:       "Fix: Unit Files",
+				Message:    fmt.Sprintf("Skipped %d change(s) that write, remove or disable units: %v", len(destructive), err),
+				Suggestion: "Review them with: rclone-mount-sync config apply --dry-run",
+			})
+			plan.Changes = safe
+		}
+	}
+	if len(plan.Changes) == 0 {
+		return results
+	}
+
+	if err := plan.Execute(); err != nil && !changeFailed(plan) {
+		// Only the reload failed, which stops Execute before units are
+		// enabled or disabled
+		return append(results, rclone.CheckResult{
+			Name:       "Fix: Unit Files",
+			Message:    err.Error(),
+			Suggestion: "Run: systemctl --user daemon-reload, then rerun doctor --fix",
+		})
+	}
+	for _, c := range plan.Changes {
+		result := rclone.CheckResult{Name: "Fix: Unit " + c.Unit}
+		if c.Err != nil {
+			result.Message = fmt.Sprintf("Could not %s %s: %v", c.Action, c.Unit, c.Err)
+		} else {
+			result.Passed = true
+			result.Message = fmt.Sprintf("%s %s", pastTense(c.Action), c.Unit)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// changeFailed reports whether a change of an executed plan failed.
+func changeFailed(plan *systemd.ApplyPlan) bool {
+	for _, c := range plan.Changes {
+		if c.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// pastTense returns the message verb of an apply action, e.g. "Enabled".
+func pastTense(action string) string {
+	switch action {
+	case systemd.ApplyWrite:
+		return "Wrote"
+	case systemd.ApplyRemove:
+		return "Removed"
+	case systemd.ApplyEnable:
+		return "Enabled"
+	case systemd.ApplyDisable:
+		return "Disabled"
+	}
+	return action
+}
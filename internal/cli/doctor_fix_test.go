@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// fixTestConfig returns a config with one enabled mount whose mount point
+// is in a temporary directory.
+func fixTestConfig(t *testing.T) *config.Config {
+	return &config.Config{Mounts: []models.MountConfig{{
+		ID: "m1", Name: "gdrive", Remote: "gdrive", RemotePath: "/",
+		MountPoint: filepath.Join(t.TempDir(), "gdrive"), Enabled: true,
+	}}}
+}
+
+// installMountUnit writes the unit the generator produces for the mount, so
+// the unit is installed and up to date.
+func installMountUnit(t *testing.T, cfg *config.Config) {
+	t.Helper()
+	gen, _ := loadGenerator()
+	if _, err := gen.WriteMountService(&cfg.Mounts[0]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func findFix(results []rclone.CheckResult, name string) *rclone.CheckResult {
+	for i := range results {
+		if strings.HasPrefix(results[i].Name, name) {
+			return &results[i]
+		}
+	}
+	return nil
+}
+
+func TestDoctorFixCreatesMountPoints(t *testing.T) {
+	cfg := fixTestConfig(t)
+	useReportLoaders(t, cfg, &systemd.MockManager{IsEnabledResult: true})
+	installMountUnit(t, cfg)
+
+	results, err := runDoctorFixes(cfg)
+	if err != nil {
+		t.Fatalf("runDoctorFixes() error = %v", err)
+	}
+	fix := findFix(results, "Fix: Mount Point gdrive")
+	if fix == nil || !fix.Passed {
+		t.Fatalf("expected the mount point to be created, got %+v", results)
+	}
+	if info, err := os.Stat(cfg.Mounts[0].MountPoint); err != nil || !info.IsDir() {
+		t.Errorf("mount point not created: %v", err)
+	}
+
+	// Nothing is left to fix the second time
+	if results, _ := runDoctorFixes(cfg); len(results) != 0 {
+		t.Errorf("second run should fix nothing, got %+v", results)
+	}
+}
+
+func TestDoctorFixReloadsStaleUnits(t *testing.T) {
+	cfg := fixTestConfig(t)
+	mgr := &systemd.MockManager{IsEnabledResult: true, NeedsDaemonReloadResult: true}
+	useReportLoaders(t, cfg, mgr)
+	installMountUnit(t, cfg)
+
+	results, err := runDoctorFixes(cfg)
+	if err != nil {
+		t.Fatalf("runDoctorFixes() error = %v", err)
+	}
+	fix := findFix(results, "Fix: Daemon Reload")
+	if fix == nil || !fix.Passed || !strings.Contains(fix.Message, "rclone-mount-m1.service") {
+		t.Errorf("expected a reload for the changed unit, got %+v", results)
+	}
+	if !mgr.Called("DaemonReload", "") {
+		t.Error("systemd should be reloaded")
+	}
+
+	mgr = &systemd.MockManager{IsEnabledResult: true}
+	useReportLoaders(t, cfg, mgr)
+	installMountUnit(t, cfg)
+	runDoctorFixes(cfg)
+	if mgr.Called("DaemonReload", "") {
+		t.Error("systemd should not be reloaded when no unit changed")
+	}
+}
+
+func TestDoctorFixEnablesUnits(t *testing.T) {
+	cfg := fixTestConfig(t)
+	mgr := &systemd.MockManager{}
+	useReportLoaders(t, cfg, mgr)
+	installMountUnit(t, cfg)
+
+	results, _ := runDoctorFixes(cfg)
+	if !mgr.Called("Enable", "rclone-mount-m1.service") {
+		t.Errorf("the disabled mount service should be enabled, got %+v", results)
+	}
+}
+
+func TestDoctorFixConfirmsUnitWrites(t *testing.T) {
+	oldIsInteractive, oldAssumeYes := isInteractive, assumeYes
+	defer func() { isInteractive, assumeYes = oldIsInteractive, oldAssumeYes }()
+	isInteractive = func() bool { return false }
+	assumeYes = false
+
+	// The unit is not installed, so fixing it means writing it
+	cfg := fixTestConfig(t)
+	mgr := &systemd.MockManager{}
+	useReportLoaders(t, cfg, mgr)
+	gen, _ := loadGenerator()
+	unitPath := filepath.Join(gen.GetSystemdDir(), "rclone-mount-m1.service")
+
+	var results []rclone.CheckResult
+	captureStdout(t, func() { results, _ = runDoctorFixes(cfg) })
+	fix := findFix(results, "Fix: Unit Files")
+	if fix == nil || fix.Passed || !strings.Contains(fix.Message, "Skipped 2 change(s)") {
+		t.Errorf("unconfirmed changes should be skipped, got %+v", results)
+	}
+	if _, err := os.Stat(unitPath); err == nil {
+		t.Error("the unit should not be written without confirmation")
+	}
+	if mgr.Called("Enable", "") {
+		t.Error("a unit that is not installed cannot be enabled on its own")
+	}
+
+	assumeYes = true
+	captureStdout(t, func() { results, _ = runDoctorFixes(cfg) })
+	if _, err := os.Stat(unitPath); err != nil {
+		t.Errorf("the unit should be written once confirmed: %v (results %+v)", err, results)
+	}
+	if !mgr.Called("Enable", "rclone-mount-m1.service") {
+		t.Error("the written unit should be enabled")
+	}
+}
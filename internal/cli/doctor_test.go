@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+// useDoctorChecks replaces the host-dependent doctor checks with results.
+func useDoctorChecks(t *testing.T, results ...rclone.CheckResult) {
+	t.Helper()
+	old := runDoctorChecks
+	runDoctorChecks = func(*rclone.Client, bool) []rclone.CheckResult { return results }
+	t.Cleanup(func() { runDoctorChecks = old })
+}
+
+func TestDoctorReportsVersionMismatch(t *testing.T) {
+	useDoctorChecks(t, rclone.CheckResult{Name: "Rclone Binary", Passed: true})
+
+	oldLoadConfig := loadConfig
+	defer func() { loadConfig = oldLoadConfig }()
+	cfg := &config.Config{}
+	cfg.Settings.RcloneBinaryPath = writeVersionScript(t, "rclone v1.60.0")
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	t.Setenv("PATH", pathWithVersionScript(t, "rclone v1.65.0"))
+
+	out := captureStdout(t, func() {
+		if err := runDoctor(nil, nil); err != nil {
+			t.Errorf("runDoctor() error = %v, a version mismatch is not critical", err)
+		}
+	})
+	if !strings.Contains(out, "v1.60.0") || !strings.Contains(out, "v1.65.0") {
+		t.Errorf("doctor output should report both versions, got %q", out)
+	}
+}
+
+func TestDoctorCriticalFailure(t *testing.T) {
+	useDoctorChecks(t, rclone.CheckResult{Name: "Rclone Binary", Passed: false, IsCritical: true})
+
+	oldLoadConfig := loadConfig
+	defer func() { loadConfig = oldLoadConfig }()
+	loadConfig = func() (*config.Config, error) { return &config.Config{}, nil }
+
+	captureStdout(t, func() {
+		if err := runDoctor(nil, nil); err == nil {
+			t.Error("runDoctor() should fail when a critical check fails")
+		}
+	})
+}
+
+// writeVersionScript creates a fake rclone printing version and returns its path.
+func writeVersionScript(t *testing.T, version string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rclone")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\necho \""+version+"\"\n"), 0755); err != nil {
+		t.Fatalf("failed to create mock rclone: %v", err)
+	}
+	return path
+}
+
+// pathWithVersionScript returns a PATH whose rclone prints version.
+func pathWithVersionScript(t *testing.T, version string) string {
+	t.Helper()
+	return filepath.Dir(writeVersionScript(t, version))
+}
+
+func TestDoctorWarnsAboutMissingSyncSource(t *testing.T) {
+	useDoctorChecks(t, rclone.CheckResult{Name: "Rclone Binary", Passed: true})
+	useMockRclone(t, "#!/bin/sh\nfor arg in \"$@\"; do\n\tcase \"$arg\" in\n\t\tgdrive:/gone) echo \"directory not found\" >&2; exit 3 ;;\n\tesac\ndone\n")
+
+	oldLoadConfig := loadConfig
+	defer func() { loadConfig = oldLoadConfig }()
+	cfg := &config.Config{SyncJobs: []models.SyncJobConfig{
+		{Name: "photos", Source: "gdrive:/Photos"},
+		{Name: "old", Source: "gdrive:/gone"},
+	}}
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+
+	out := captureStdout(t, func() {
+		if err := runDoctor(nil, nil); err != nil {
+			t.Errorf("runDoctor() error = %v, a missing source is not critical", err)
+		}
+	})
+	if !strings.Contains(out, "Sync Source: photos") || !strings.Contains(out, "Sync Source: old") {
+		t.Errorf("doctor output should list each sync source check, got %q", out)
+	}
+	if !strings.Contains(out, "Source gdrive:/gone does not exist") {
+		t.Errorf("doctor output should warn about the missing source, got %q", out)
+	}
+}
+
+func TestDoctorChecksRemoteConnections(t *testing.T) {
+	useDoctorChecks(t, rclone.CheckResult{Name: "Rclone Binary", Passed: true})
+	useMockRclone(t, "#!/bin/sh\nif [ \"$1\" = lsd ] && [ \"$2\" = expired: ]; then echo \"couldn't fetch token: invalid_grant\" >&2; exit 1; fi\n")
+
+	oldLoadConfig := loadConfig
+	defer func() { loadConfig = oldLoadConfig }()
+	cfg := &config.Config{
+		Mounts:   []models.MountConfig{{Name: "drive", Remote: "gdrive"}},
+		SyncJobs: []models.SyncJobConfig{{Name: "backup", Source: "/home/user/docs", Destination: "expired:backup"}},
+	}
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+
+	out := captureStdout(t, func() {
+		if err := runDoctor(nil, nil); err != nil {
+			t.Errorf("runDoctor() error = %v, a failed remote is not critical", err)
+		}
+	})
+	if !strings.Contains(out, "[✓ PASS] Remote Connection: gdrive") {
+		t.Errorf("doctor output should pass the working remote, got %q", out)
+	}
+	if !strings.Contains(out, "[⚠ FAIL (optional)] Remote Connection: expired") || !strings.Contains(out, "invalid_grant") {
+		t.Errorf("doctor output should report the failed remote and why, got %q", out)
+	}
+}
+
+func TestCheckMountPlatform(t *testing.T) {
+	mounts := []models.MountConfig{
+		{Name: "gdrive", MountOptions: models.MountOptions{AllowOther: true}},
+		{Name: "share", MountOptions: models.MountOptions{NetworkMode: true}},
+	}
+
+	result := checkMountPlatform(mounts, "linux")
+	if result.Passed || result.IsCritical {
+		t.Errorf("Passed = %v, IsCritical = %v, want a non-critical failure", result.Passed, result.IsCritical)
+	}
+	if !strings.Contains(result.Message, "share: --network-mode") || strings.Contains(result.Message, "gdrive") {
+		t.Errorf("Message = %q, want only share's --network-mode reported", result.Message)
+	}
+
+	if result := checkMountPlatform(mounts[:1], "linux"); !result.Passed {
+		t.Errorf("supported options should pass, got %q", result.Message)
+	}
+}
+
+func TestCheckAllowOther(t *testing.T) {
+	dir := t.TempDir()
+	fuseConf := filepath.Join(dir, "fuse.conf")
+	if err := os.WriteFile(fuseConf, []byte("# mount_max = 1000\n#user_allow_other\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mounts := []models.MountConfig{
+		{Name: "media", MountOptions: models.MountOptions{AllowOther: true, ReadOnly: true}},
+		{Name: "private"},
+	}
+
+	result := checkAllowOther(mounts, fuseConf)
+	if result.Passed || result.IsCritical {
+		t.Errorf("Passed = %v, IsCritical = %v, want a non-critical failure", result.Passed, result.IsCritical)
+	}
+	if !strings.Contains(result.Message, "media") || strings.Contains(result.Message, "private") {
+		t.Errorf("Message = %q, want only media reported", result.Message)
+	}
+
+	if result := checkAllowOther(mounts[1:], filepath.Join(dir, "missing")); !result.Passed {
+		t.Errorf("mounts without --allow-other should pass, got %q", result.Message)
+	}
+
+	if err := os.WriteFile(fuseConf, []byte("user_allow_other\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if result := checkAllowOther(mounts, fuseConf); !result.Passed {
+		t.Errorf("user_allow_other should pass, got %q", result.Message)
+	}
+}
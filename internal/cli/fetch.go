@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+)
+
+var fetchCmd = &cobra.Command{
+	Use:   "fetch <url-or-remote> <dest>",
+	Short: "Fetch a URL or remote path once",
+	Long: `Copy a single URL or rclone path to a destination in one run.
+
+URLs are downloaded with rclone copyurl; a destination ending in / keeps the
+file name from the URL. Remote paths are copied with rclone copy. Nothing is
+saved to the config and no systemd units are created.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runFetch,
+}
+
+func init() {
+	rootCmd.AddCommand(fetchCmd)
+}
+
+// fetchResult is the JSON output of the fetch command.
+type fetchResult struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Success     bool   `json:"success"`
+	Error       string `json:"error,omitempty"`
+}
+
+func runFetch(cmd *cobra.Command, args []string) error {
+	source, dest := args[0], args[1]
+
+	client := loadRcloneClient()
+	if !client.IsInstalled() {
+		return fmt.Errorf("rclone is not installed")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Progress only makes sense on a terminal, not in JSON or quiet output
+	var out io.Writer = os.Stdout
+	var extra []string
+	if outputJSON || quiet {
+		out = io.Discard
+	} else {
+		extra = append(extra, "--progress")
+	}
+
+	err := client.Fetch(ctx, source, dest, out, extra...)
+
+	if outputJSON {
+		result := fetchResult{Source: source, Destination: dest, Success: err == nil}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		if jsonErr := printJSON(result); jsonErr != nil {
+			return jsonErr
+		}
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("fetch failed: %w", err)
+	}
+	printInfo("Fetched %s to %s\n", source, dest)
+	return nil
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+// useMockRclone points loadRcloneClient at a shell script standing in for rclone.
+func useMockRclone(t *testing.T, script string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rclone")
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to create mock rclone: %v", err)
+	}
+
+	old := loadRcloneClient
+	loadRcloneClient = func() *rclone.Client { return rclone.NewClientWithPath(path) }
+	t.Cleanup(func() { loadRcloneClient = old })
+}
+
+func TestFetchSuccess(t *testing.T) {
+	useMockRclone(t, "#!/bin/sh\nexit 0\n")
+
+	if err := runFetch(nil, []string{"https://example.com/a.iso", "/tmp/"}); err != nil {
+		t.Fatalf("runFetch() error = %v", err)
+	}
+}
+
+func TestFetchFailureReportsExitStatus(t *testing.T) {
+	useMockRclone(t, "#!/bin/sh\nexit 4\n")
+
+	err := runFetch(nil, []string{"gdrive:/a.txt", "/tmp"})
+	if err == nil || !strings.Contains(err.Error(), "status 4") {
+		t.Errorf("runFetch() error = %v, want exit status 4", err)
+	}
+}
+
+func TestFetchJSON(t *testing.T) {
+	useMockRclone(t, "#!/bin/sh\nexit 1\n")
+	oldOutputJSON := outputJSON
+	defer func() { outputJSON = oldOutputJSON }()
+	outputJSON = true
+
+	if err := runFetch(nil, []string{"gdrive:/a.txt", "/tmp"}); err == nil {
+		t.Error("runFetch() should return the fetch error in JSON mode")
+	}
+}
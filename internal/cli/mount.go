@@ -1,11 +1,18 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +42,8 @@ var mountDeleteCmd = &cobra.Command{
 	Short: "Delete a mount",
 	Long: `Delete a mount configuration and its systemd service.
 
-This will stop and disable the service before removal.`,
+This will stop and disable the service before removal. You are asked to
+confirm first unless --assume-yes is given.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMountDelete,
 }
@@ -54,6 +62,37 @@ var mountStopCmd = &cobra.Command{
 	RunE:  runMountStop,
 }
 
+var mountCheckCmd = &cobra.Command{
+	Use:   "check <name-or-id>",
+	Short: "Check that a mount would work without mounting it",
+	Long: `Validate a mount's options the way unit generation does, then list the top
+of its remote path with the mount's config file and timeouts. This catches a
+missing remote, bad credentials or unsupported options before the mount fails
+at boot. Nothing is mounted and no unit is written.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMountCheck,
+}
+
+var mountAdoptCmd = &cobra.Command{
+	Use:   "adopt",
+	Short: "Adopt rclone mounts started outside this tool",
+	Long: `Find running rclone FUSE mounts that are not in the config, such as ones
+started by hand with rclone mount, and add them as managed mounts.
+
+The remote, mount point and the read-only and allow-other options are taken
+from the kernel's mount table; other options use the configured defaults.
+Each adopted mount gets an enabled systemd service, so it is mounted by the
+service from the next login. The running mount is left alone: unmount it and
+run "mount start" to hand it over now. You are asked to confirm first unless
+--assume-yes is given.`,
+	Args: cobra.NoArgs,
+	RunE: runMountAdopt,
+}
+
+// listActiveMounts is injectable for testing so adopt doesn't read the
+// host's mount table.
+var listActiveMounts = rclone.ListActiveMounts
+
 var (
 	mountCreateName       string
 	mountCreateRemote     string
@@ -70,6 +109,8 @@ func init() {
 	mountCmd.AddCommand(mountDeleteCmd)
 	mountCmd.AddCommand(mountStartCmd)
 	mountCmd.AddCommand(mountStopCmd)
+	mountCmd.AddCommand(mountAdoptCmd)
+	mountCmd.AddCommand(mountCheckCmd)
 
 	mountCreateCmd.Flags().StringVar(&mountCreateName, "name", "", "mount name (required)")
 	mountCreateCmd.Flags().StringVar(&mountCreateRemote, "remote", "", "rclone remote name (required)")
@@ -102,9 +143,8 @@ func runMountList(cmd *cobra.Command, args []string) error {
 	fmt.Fprintln(w, "ID\tNAME\tREMOTE\tMOUNT POINT\tENABLED\tAUTO-START")
 
 	for _, m := range cfg.Mounts {
-		remote := m.Remote + m.RemotePath
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%v\n",
-			m.ID, m.Name, remote, m.MountPoint, m.Enabled, m.AutoStart)
+			m.ID, m.Name, m.FullRemotePath(), m.MountPoint, m.Enabled, m.AutoStart)
 	}
 
 	return w.Flush()
@@ -124,9 +164,13 @@ func runMountCreate(cmd *cobra.Command, args []string) error {
 		Enabled:    mountCreateEnabled,
 		AutoStart:  mountCreateAutoStart,
 		MountOptions: models.MountOptions{
-			VFSCacheMode: cfg.Defaults.Mount.VFSCacheMode,
-			BufferSize:   cfg.Defaults.Mount.BufferSize,
-			LogLevel:     cfg.Defaults.Mount.LogLevel,
+			VFSCacheMode:    cfg.Defaults.Mount.VFSCacheMode,
+			VFSCacheMaxSize: cfg.Defaults.Mount.VFSCacheMaxSize,
+			VFSCacheMaxAge:  cfg.Defaults.Mount.VFSCacheMaxAge,
+			DirCacheTime:    cfg.Defaults.Mount.DirCacheTime,
+			AttrTimeout:     cfg.Defaults.Mount.AttrTimeout,
+			BufferSize:      cfg.Defaults.Mount.BufferSize,
+			LogLevel:        cfg.Defaults.Mount.LogLevel,
 		},
 	}
 
@@ -171,7 +215,7 @@ func runMountCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
-	fmt.Printf("Mount '%s' created successfully (ID: %s)\n", savedMount.Name, savedMount.ID)
+	printInfo("Mount '%s' created successfully (ID: %s)\n", savedMount.Name, savedMount.ID)
 	return nil
 }
 
@@ -188,6 +232,10 @@ func runMountDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("mount '%s' not found", idOrName)
 	}
 
+	if err := confirm(fmt.Sprintf("Delete mount '%s'", mount.Name)); err != nil {
+		return err
+	}
+
 	generator, err := loadGenerator()
 	if err != nil {
 		return err
@@ -224,7 +272,7 @@ func runMountDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
-	fmt.Printf("Mount '%s' deleted successfully\n", mount.Name)
+	printInfo("Mount '%s' deleted successfully\n", mount.Name)
 	return nil
 }
 
@@ -253,7 +301,7 @@ func runMountStart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to start mount: %w", err)
 	}
 
-	fmt.Printf("Mount '%s' started successfully\n", mount.Name)
+	printInfo("Mount '%s' started successfully\n", mount.Name)
 	return nil
 }
 
@@ -282,6 +330,193 @@ func runMountStop(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to stop mount: %w", err)
 	}
 
-	fmt.Printf("Mount '%s' stopped successfully\n", mount.Name)
+	printInfo("Mount '%s' stopped successfully\n", mount.Name)
+	return nil
+}
+
+// mountCheckResult is the --json output of mount check.
+type mountCheckResult struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+func runMountCheck(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	mount := findMountByIDOrName(cfg, idOrName)
+	if mount == nil {
+		return fmt.Errorf("mount '%s' not found", idOrName)
+	}
+
+	checkErr := checkMount(cfg, mount)
+	if outputJSON {
+		result := mountCheckResult{Name: mount.Name, OK: checkErr == nil}
+		if checkErr != nil {
+			result.Error = checkErr.Error()
+		}
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	}
+	if checkErr != nil {
+		return fmt.Errorf("mount '%s' check failed: %w", mount.Name, checkErr)
+	}
+
+	printInfo("Mount '%s' check passed: %s is reachable\n", mount.Name, mount.FullRemotePath())
+	return nil
+}
+
+// checkMount validates a mount's options and lists its remote path.
+func checkMount(cfg *config.Config, mount *models.MountConfig) error {
+	if err := systemd.ValidateExtraArgs(cfg.Defaults.Mount.ExtraFlags); err != nil {
+		return fmt.Errorf("invalid default mount flags: %w", err)
+	}
+	if err := systemd.ValidateMount(mount); err != nil {
+		return err
+	}
+	return loadRcloneClient().Probe(context.Background(), systemd.MountCheckArgs(mount)...)
+}
+
+func runMountAdopt(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	active, err := listActiveMounts()
+	if err != nil {
+		return fmt.Errorf("failed to read mount table: %w", err)
+	}
+	external := unmanagedMounts(cfg, active)
+	if len(external) == 0 {
+		printInfo("No rclone mounts found outside the config.\n")
+		return nil
+	}
+
+	if !outputJSON {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "REMOTE\tMOUNT POINT\tOPTIONS")
+		for _, m := range external {
+			fmt.Fprintf(w, "%s:%s\t%s\t%s\n", m.Remote, m.RemotePath, m.MountPoint, describeActiveMount(m))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if err := confirm(fmt.Sprintf("Adopt %d external rclone mount(s)", len(external))); err != nil {
+		return err
+	}
+
+	generator, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	var adopted []models.MountConfig
+	for _, m := range external {
+		mount := models.MountConfig{
+			Name:       uniqueMountName(cfg, m.Remote),
+			Remote:     m.Remote + ":",
+			RemotePath: m.RemotePath,
+			MountPoint: m.MountPoint,
+			Enabled:    true,
+			Adopted:    true,
+			MountOptions: models.MountOptions{
+				VFSCacheMode:       cfg.Defaults.Mount.VFSCacheMode,
+				VFSCacheMaxSize:    cfg.Defaults.Mount.VFSCacheMaxSize,
+				VFSCacheMaxAge:     cfg.Defaults.Mount.VFSCacheMaxAge,
+				DirCacheTime:       cfg.Defaults.Mount.DirCacheTime,
+				AttrTimeout:        cfg.Defaults.Mount.AttrTimeout,
+				BufferSize:         cfg.Defaults.Mount.BufferSize,
+				LogLevel:           cfg.Defaults.Mount.LogLevel,
+				ReadOnly:           m.ReadOnly,
+				AllowOther:         m.AllowOther,
+				DefaultPermissions: m.DefaultPermissions,
+			},
+		}
+		if err := cfg.AddMount(mount); err != nil {
+			return err
+		}
+		saved := cfg.GetMount(mount.Name)
+		if _, err := generator.WriteMountService(saved); err != nil {
+			return fmt.Errorf("failed to write systemd unit for %s: %w", m.MountPoint, err)
+		}
+		adopted = append(adopted, *saved)
+	}
+
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	manager := loadManager()
+	if err := manager.DaemonReload(); err != nil {
+		return fmt.Errorf("failed to reload systemd daemon: %w", err)
+	}
+	for _, m := range adopted {
+		serviceName := generator.ServiceName(m.ID, "mount") + ".service"
+		if err := manager.Enable(serviceName); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to enable %s: %v\n", serviceName, err)
+		}
+	}
+
+	if outputJSON {
+		return printJSON(adopted)
+	}
+	for _, m := range adopted {
+		printInfo("Adopted %s as mount '%s' (ID: %s)\n", m.MountPoint, m.Name, m.ID)
+	}
+	printInfo("The running mounts were left alone; unmount one and run 'mount start <name>' to hand it over to its service.\n")
 	return nil
 }
+
+// unmanagedMounts returns the active mounts whose mount point is not used by
+// a configured mount.
+func unmanagedMounts(cfg *config.Config, active []rclone.ActiveMount) []rclone.ActiveMount {
+	managed := make(map[string]bool, len(cfg.Mounts))
+	for _, m := range cfg.Mounts {
+		managed[filepath.Clean(utils.ExpandHome(m.MountPoint))] = true
+	}
+
+	var external []rclone.ActiveMount
+	for _, m := range active {
+		if !managed[filepath.Clean(m.MountPoint)] {
+			external = append(external, m)
+		}
+	}
+	return external
+}
+
+// uniqueMountName returns base, or base with a number appended if a mount
+// of that name already exists.
+func uniqueMountName(cfg *config.Config, base string) string {
+	name := base
+	for n := 2; cfg.GetMount(name) != nil; n++ {
+		name = fmt.Sprintf("%s-%d", base, n)
+	}
+	return name
+}
+
+// describeActiveMount lists the FUSE options taken over from a mount.
+func describeActiveMount(m rclone.ActiveMount) string {
+	var opts []string
+	if m.ReadOnly {
+		opts = append(opts, "read-only")
+	}
+	if m.AllowOther {
+		opts = append(opts, "allow-other")
+	}
+	if m.DefaultPermissions {
+		opts = append(opts, "default-permissions")
+	}
+	if len(opts) == 0 {
+		return "-"
+	}
+	return strings.Join(opts, ", ")
+}
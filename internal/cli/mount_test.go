@@ -2,10 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
 	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
 )
 
@@ -486,3 +490,103 @@ func TestMountCreateValidationMissingFields(t *testing.T) {
 		t.Fatal("expected runMountCreate to fail when remote is missing")
 	}
 }
+
+func TestMountAdopt(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	tmp := t.TempDir()
+	cfg := &config.Config{
+		Defaults: config.DefaultConfig{
+			Mount: config.MountDefaults{LogLevel: "INFO", VFSCacheMode: "full", BufferSize: "16M"},
+		},
+		Mounts: []models.MountConfig{
+			{ID: "abc12345", Name: "gdrive", Remote: "gdrive:", RemotePath: "/", MountPoint: "/home/user/mnt/gdrive", Enabled: true},
+		},
+	}
+
+	oldLoadConfig, oldLoadGenerator, oldLoadManager := loadConfig, loadGenerator, loadManager
+	oldList, oldAssumeYes := listActiveMounts, assumeYes
+	defer func() {
+		loadConfig, loadGenerator, loadManager = oldLoadConfig, oldLoadGenerator, oldLoadManager
+		listActiveMounts, assumeYes = oldList, oldAssumeYes
+	}()
+
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	loadGenerator = func() (*systemd.Generator, error) { return systemd.NewTestGenerator(tmp), nil }
+	mock := &systemd.MockManager{}
+	loadManager = func() systemd.ServiceManager { return mock }
+	assumeYes = true
+	listActiveMounts = func() ([]rclone.ActiveMount, error) {
+		return []rclone.ActiveMount{
+			{Remote: "gdrive", RemotePath: "/", MountPoint: "/home/user/mnt/gdrive"},
+			{Remote: "gdrive", RemotePath: "/Photos", MountPoint: "/home/user/mnt/photos", ReadOnly: true},
+		}, nil
+	}
+
+	var err error
+	captureStdout(t, func() { err = runMountAdopt(nil, nil) })
+	if err != nil {
+		t.Fatalf("runMountAdopt failed: %v", err)
+	}
+
+	adopted := cfg.GetMount("gdrive-2")
+	if adopted == nil {
+		t.Fatalf("expected the unmanaged mount to be adopted as gdrive-2, got %+v", cfg.Mounts)
+	}
+	if len(cfg.Mounts) != 2 {
+		t.Errorf("expected only the unmanaged mount to be added, got %d mounts", len(cfg.Mounts))
+	}
+	if !adopted.Adopted || adopted.Remote != "gdrive:" || adopted.RemotePath != "/Photos" || !adopted.MountOptions.ReadOnly {
+		t.Errorf("adopted mount = %+v", adopted)
+	}
+	unit := filepath.Join(tmp, "rclone-mount-"+adopted.ID+".service")
+	if _, err := os.Stat(unit); err != nil {
+		t.Errorf("expected unit %s to be written: %v", unit, err)
+	}
+	if !mock.Called("Enable", "") || mock.Called("Start", "") {
+		t.Errorf("expected the service to be enabled but not started, calls: %v", mock.Calls)
+	}
+}
+
+func TestMountAdoptNothingToAdopt(t *testing.T) {
+	cfg := &config.Config{}
+	oldLoadConfig, oldList := loadConfig, listActiveMounts
+	defer func() { loadConfig, listActiveMounts = oldLoadConfig, oldList }()
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	listActiveMounts = func() ([]rclone.ActiveMount, error) { return nil, nil }
+
+	var err error
+	out := captureStdout(t, func() { err = runMountAdopt(nil, nil) })
+	if err != nil {
+		t.Fatalf("runMountAdopt failed: %v", err)
+	}
+	if !strings.Contains(out, "No rclone mounts") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestMountCheck(t *testing.T) {
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{
+			{ID: "abc12345", Name: "gdrive", Remote: "gdrive:", RemotePath: "/", MountPoint: "/home/user/mnt/gdrive"},
+			{ID: "def67890", Name: "broken", Remote: "missing:", RemotePath: "/", MountPoint: "/home/user/mnt/broken"},
+		},
+	}
+	oldLoadConfig := loadConfig
+	defer func() { loadConfig = oldLoadConfig }()
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	useMockRclone(t, "#!/bin/sh\nif [ \"$2\" = missing:/ ]; then echo \"CRITICAL: didn't find section in config file\" >&2; exit 1; fi\n")
+
+	var err error
+	out := captureStdout(t, func() { err = runMountCheck(nil, []string{"gdrive"}) })
+	if err != nil {
+		t.Fatalf("runMountCheck failed: %v", err)
+	}
+	if !strings.Contains(out, "check passed") {
+		t.Errorf("unexpected output: %q", out)
+	}
+
+	err = runMountCheck(nil, []string{"broken"})
+	if err == nil || !strings.Contains(err.Error(), "didn't find section") {
+		t.Errorf("runMountCheck() error = %v, want the rclone error", err)
+	}
+}
@@ -0,0 +1,274 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/spf13/cobra"
+)
+
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Compare the installed units with the config",
+	Long: `Compare the unit files the config generates with the ones installed, and
+report three kinds of drift:
+
+  orphaned  unit files with no mount or sync job in the config
+  missing   mounts and sync jobs whose unit files are not installed
+  drifted   installed unit files whose contents differ from the config
+
+--generate writes the missing units and enables those the config enables.
+--prune removes the orphaned units, after confirmation (or with
+--assume-yes). Drifted units are only reported; config apply rewrites them.
+
+--watch keeps running and, each time config.yaml changes, reloads it,
+writes the missing and drifted units, runs daemon-reload and enables or
+disables units to match. Orphans are only removed with --prune, which is
+confirmed once at the start. Stop it with Ctrl+C.`,
+	Args: cobra.NoArgs,
+	RunE: runReconcile,
+}
+
+var (
+	reconcilePrune    bool
+	reconcileGenerate bool
+	reconcileWatch    bool
+)
+
+// reconcileWatchDebounce is how long config.yaml must be quiet before
+// --watch reconciles, so a save's write and rename cause one cycle.
+var reconcileWatchDebounce = 500 * time.Millisecond
+
+func init() {
+	rootCmd.AddCommand(reconcileCmd)
+
+	reconcileCmd.Flags().BoolVar(&reconcilePrune, "prune", false, "remove orphaned units after confirmation")
+	reconcileCmd.Flags().BoolVar(&reconcileGenerate, "generate", false, "write missing units")
+	reconcileCmd.Flags().BoolVar(&reconcileWatch, "watch", false, "reapply the config each time config.yaml changes")
+}
+
+// reconcileResult is the --json output of reconcile.
+type reconcileResult struct {
+	Orphaned []string `json:"orphaned"`
+	Missing  []string `json:"missing"`
+	Drifted  []string `json:"drifted"`
+	Pruned   []string `json:"pruned,omitempty"`
+	Written  []string `json:"written,omitempty"`
+}
+
+func runReconcile(cmd *cobra.Command, args []string) error {
+	if reconcileWatch {
+		return runReconcileWatch()
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	gen, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	plan, err := systemd.PlanApply(gen, loadManager(), cfg.Mounts, cfg.SyncJobs)
+	if err != nil {
+		return fmt.Errorf("failed to compare units with the config: %w", err)
+	}
+
+	// A write is for a missing unit or a drifted one, depending on whether
+	// the file exists
+	installed := func(unit string) bool {
+		_, err := os.Stat(filepath.Join(gen.GetSystemdDir(), unit))
+		return err == nil
+	}
+	result := reconcileResult{Orphaned: []string{}, Missing: []string{}, Drifted: []string{}}
+	missing := make(map[string]bool)
+	for _, c := range plan.Changes {
+		switch {
+		case c.Action == systemd.ApplyRemove:
+			result.Orphaned = append(result.Orphaned, c.Unit)
+		case c.Action == systemd.ApplyWrite && installed(c.Unit):
+			result.Drifted = append(result.Drifted, c.Unit)
+		case c.Action == systemd.ApplyWrite:
+			result.Missing = append(result.Missing, c.Unit)
+			missing[c.Unit] = true
+		}
+	}
+
+	if !outputJSON {
+		printReconcileBucket("Orphaned units (no config)", result.Orphaned)
+		printReconcileBucket("Missing units (not installed)", result.Missing)
+		printReconcileBucket("Drifted units (differ from config)", result.Drifted)
+		if len(result.Orphaned)+len(result.Missing)+len(result.Drifted) == 0 {
+			printInfo("Units match the config.\n")
+		}
+	}
+
+	// Select the changes the flags ask for: missing units are written and
+	// then enabled, orphans removed
+	var selected []systemd.ApplyChange
+	for _, c := range plan.Changes {
+		switch {
+		case reconcileGenerate && c.Action == systemd.ApplyWrite && missing[c.Unit],
+			reconcileGenerate && c.Action == systemd.ApplyEnable && missing[c.Unit],
+			reconcilePrune && c.Action == systemd.ApplyRemove:
+			selected = append(selected, c)
+		}
+	}
+	if reconcilePrune && len(result.Orphaned) > 0 {
+		if err := confirm(fmt.Sprintf("Remove %d orphaned unit(s)", len(result.Orphaned))); err != nil {
+			return err
+		}
+	}
+
+	var execErr error
+	if len(selected) > 0 {
+		plan.Changes = selected
+		execErr = plan.Execute()
+		for _, c := range plan.Changes {
+			if c.Err != nil {
+				continue
+			}
+			switch c.Action {
+			case systemd.ApplyRemove:
+				result.Pruned = append(result.Pruned, c.Unit)
+			case systemd.ApplyWrite:
+				result.Written = append(result.Written, c.Unit)
+			}
+		}
+	}
+
+	if outputJSON {
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else if len(selected) > 0 {
+		printInfo("Wrote %d missing unit(s), removed %d orphaned unit(s)\n", len(result.Written), len(result.Pruned))
+	}
+
+	if execErr != nil {
+		return fmt.Errorf("some changes failed: %w", execErr)
+	}
+	return nil
+}
+
+// printReconcileBucket prints the units of one kind of drift, if any.
+func printReconcileBucket(title string, units []string) {
+	if len(units) == 0 {
+		return
+	}
+	fmt.Printf("%s:\n", title)
+	for _, unit := range units {
+		fmt.Printf("  %s\n", unit)
+	}
+}
+
+// runReconcileWatch reconciles each time config.yaml changes, until
+// interrupted.
+func runReconcileWatch() error {
+	if outputJSON {
+		return fmt.Errorf("--watch does not support --json")
+	}
+	// Load the config first so a broken one fails now, and --config takes
+	// effect before the path is resolved
+	if _, err := loadConfig(); err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	path, err := config.ConfigPath()
+	if err != nil {
+		return err
+	}
+	if reconcilePrune {
+		if err := confirm("Remove orphaned units whenever the config changes"); err != nil {
+			return err
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	printInfo("Watching %s for changes (Ctrl+C to stop)\n", path)
+	return watchReconcile(ctx, path, func() error {
+		summary, err := reconcileApply(reconcilePrune)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s reconcile failed: %v\n", time.Now().Format(time.TimeOnly), err)
+			return err
+		}
+		printInfo("%s config changed: %s\n", time.Now().Format(time.TimeOnly), summary)
+		return nil
+	})
+}
+
+// watchReconcile calls cycle after each burst of changes to the config file
+// at path, until ctx is done. A failed cycle does not stop the watch.
+func watchReconcile(ctx context.Context, path string, cycle func() error) error {
+	w, err := config.NewWatcher(path, reconcileWatchDebounce)
+	if err != nil {
+		return fmt.Errorf("failed to watch %s: %w", path, err)
+	}
+	defer w.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-w.Changes():
+			if !ok {
+				return nil
+			}
+			_ = cycle()
+		}
+	}
+}
+
+// reconcileApply reloads the config and applies it to the installed units:
+// changed units are written, systemd reloaded and units enabled or
+// disabled. Orphaned units are removed only if prune is set. It returns a
+// summary of what changed.
+func reconcileApply(prune bool) (string, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to load config: %w", err)
+	}
+	gen, err := loadGenerator()
+	if err != nil {
+		return "", err
+	}
+
+	plan, err := systemd.PlanApply(gen, loadManager(), cfg.Mounts, cfg.SyncJobs)
+	if err != nil {
+		return "", fmt.Errorf("failed to compare units with the config: %w", err)
+	}
+	selected := plan.Changes[:0]
+	for _, c := range plan.Changes {
+		if c.Action != systemd.ApplyRemove || prune {
+			selected = append(selected, c)
+		}
+	}
+	plan.Changes = selected
+	if len(plan.Changes) == 0 {
+		return "units already match", nil
+	}
+
+	execErr := plan.Execute()
+	counts := make(map[string]int)
+	for _, c := range plan.Changes {
+		if c.Err == nil {
+			counts[c.Action]++
+		}
+	}
+	summary := fmt.Sprintf("wrote %d, removed %d, enabled %d, disabled %d unit(s)",
+		counts[systemd.ApplyWrite], counts[systemd.ApplyRemove], counts[systemd.ApplyEnable], counts[systemd.ApplyDisable])
+	if execErr != nil {
+		return "", fmt.Errorf("%s; some changes failed: %w", summary, execErr)
+	}
+	return summary, nil
+}
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// useReconcileUnits sets up a config with a mount and a manual sync job,
+// and a unit directory holding a drifted unit of the mount, no unit for the
+// sync job and a unit of a mount no longer in the config.
+func useReconcileUnits(t *testing.T, mgr *systemd.MockManager) string {
+	t.Helper()
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{{ID: "m1", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive", Enabled: true}},
+		SyncJobs: []models.SyncJobConfig{{
+			ID: "s1", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos",
+			Schedule: models.ScheduleConfig{Type: "manual"},
+		}},
+	}
+	useReportLoaders(t, cfg, mgr)
+	t.Cleanup(func() { reconcilePrune, reconcileGenerate = false, false })
+
+	gen, _ := loadGenerator()
+	dir := gen.GetSystemdDir()
+	for name, content := range map[string]string{
+		"rclone-mount-m1.service":       "[Service]\nExecStart=/usr/bin/rclone mount old: /mnt/old\n",
+		"rclone-mount-a1b2c3d4.service": "[Service]\nExecStart=/usr/bin/rclone mount gone: /mnt/gone\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestReconcileReportsDrift(t *testing.T) {
+	oldOutputJSON := outputJSON
+	defer func() { outputJSON = oldOutputJSON }()
+	outputJSON = true
+	dir := useReconcileUnits(t, &systemd.MockManager{})
+
+	out := captureStdout(t, func() {
+		if err := runReconcile(nil, nil); err != nil {
+			t.Errorf("runReconcile() error = %v", err)
+		}
+	})
+	var result reconcileResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(result.Orphaned) != 1 || result.Orphaned[0] != "rclone-mount-a1b2c3d4.service" {
+		t.Errorf("orphaned = %v", result.Orphaned)
+	}
+	if len(result.Missing) != 1 || result.Missing[0] != "rclone-sync-s1.service" {
+		t.Errorf("missing = %v", result.Missing)
+	}
+	if len(result.Drifted) != 1 || result.Drifted[0] != "rclone-mount-m1.service" {
+		t.Errorf("drifted = %v", result.Drifted)
+	}
+
+	// Reporting changes nothing
+	if _, err := os.Stat(filepath.Join(dir, "rclone-mount-a1b2c3d4.service")); err != nil {
+		t.Error("the orphan should not be removed without --prune")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "rclone-sync-s1.service")); err == nil {
+		t.Error("the missing unit should not be written without --generate")
+	}
+}
+
+func TestReconcileGenerateAndPrune(t *testing.T) {
+	oldAssumeYes := assumeYes
+	defer func() { assumeYes = oldAssumeYes }()
+	assumeYes = true
+
+	mgr := &systemd.MockManager{}
+	dir := useReconcileUnits(t, mgr)
+	reconcileGenerate, reconcilePrune = true, true
+
+	captureStdout(t, func() {
+		if err := runReconcile(nil, nil); err != nil {
+			t.Errorf("runReconcile() error = %v", err)
+		}
+	})
+	if _, err := os.Stat(filepath.Join(dir, "rclone-sync-s1.service")); err != nil {
+		t.Errorf("--generate should write the missing unit: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "rclone-mount-a1b2c3d4.service")); err == nil {
+		t.Error("--prune should remove the orphan")
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "rclone-mount-m1.service"))
+	if string(data) != "[Service]\nExecStart=/usr/bin/rclone mount old: /mnt/old\n" {
+		t.Error("a drifted unit should only be reported, not rewritten")
+	}
+	if mgr.Called("Enable", "rclone-mount-m1.service") {
+		t.Error("only generated units should be enabled")
+	}
+}
+
+func TestReconcilePruneNeedsConfirmation(t *testing.T) {
+	oldIsInteractive, oldAssumeYes := isInteractive, assumeYes
+	defer func() { isInteractive, assumeYes = oldIsInteractive, oldAssumeYes }()
+	isInteractive = func() bool { return false }
+	assumeYes = false
+
+	dir := useReconcileUnits(t, &systemd.MockManager{})
+	reconcilePrune = true
+
+	captureStdout(t, func() {
+		if err := runReconcile(nil, nil); err == nil {
+			t.Error("runReconcile() --prune should fail without confirmation")
+		}
+	})
+	if _, err := os.Stat(filepath.Join(dir, "rclone-mount-a1b2c3d4.service")); err != nil {
+		t.Error("the orphan should be kept without confirmation")
+	}
+}
+
+func TestWatchReconcileDebouncesChanges(t *testing.T) {
+	oldDebounce := reconcileWatchDebounce
+	defer func() { reconcileWatchDebounce = oldDebounce }()
+	reconcileWatchDebounce = 100 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("version: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var cycles atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watchReconcile(ctx, path, func() error {
+			cycles.Add(1)
+			return nil
+		})
+	}()
+	time.Sleep(50 * time.Millisecond) // Let the watcher start
+
+	// A save writes a temp file and renames it over the config; an editor
+	// may then write it again
+	tmp := path + ".tmp"
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile(tmp, []byte("version: 2\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(400 * time.Millisecond)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("watchReconcile() error = %v", err)
+	}
+	if n := cycles.Load(); n != 1 {
+		t.Errorf("reconciled %d times, want once after the burst", n)
+	}
+}
+
+func TestReconcileApplyKeepsOrphansWithoutPrune(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	dir := useReconcileUnits(t, mgr)
+
+	summary, err := reconcileApply(false)
+	if err != nil {
+		t.Fatalf("reconcileApply() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "rclone-sync-s1.service")); err != nil {
+		t.Error("the missing unit should be written")
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "rclone-mount-m1.service"))
+	if string(data) == "[Service]\nExecStart=/usr/bin/rclone mount old: /mnt/old\n" {
+		t.Error("the drifted unit should be rewritten")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "rclone-mount-a1b2c3d4.service")); err != nil {
+		t.Error("the orphan should be kept without prune")
+	}
+	if !mgr.Called("DaemonReload", "") {
+		t.Error("systemd should be reloaded after writing units")
+	}
+	if summary != "wrote 2, removed 0, enabled 1, disabled 0 unit(s)" {
+		t.Errorf("summary = %q", summary)
+	}
+}
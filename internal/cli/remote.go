@@ -0,0 +1,385 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/spf13/cobra"
+)
+
+var remoteCmd = &cobra.Command{
+	Use:   "remote",
+	Short: "Manage rclone remotes",
+	Long:  `List, create, rename and delete the rclone remotes that mounts and sync jobs use.`,
+}
+
+var remoteListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List rclone remotes",
+	Args:  cobra.NoArgs,
+	RunE:  runRemoteList,
+}
+
+var remoteCreateCmd = &cobra.Command{
+	Use:   "create [name] [type] [key=value...]",
+	Short: "Create an rclone remote",
+	Long: `Create an rclone remote with rclone config create.
+
+On a terminal, a missing name or type is asked for, and so are the common
+settings of drive, s3, dropbox and sftp remotes that are not given as
+key=value arguments. Secrets are typed in plain text; pass them as
+arguments from a script instead. Passwords typed at a prompt are stored
+obscured; as arguments they may be given plain or already obscured (see
+rclone obscure). Other types are created from the key=value arguments
+alone.
+
+Google Drive and Dropbox remotes are authorized with rclone authorize,
+which opens a browser; the login address is printed in case it cannot.
+Pass token=... to use a token obtained elsewhere.`,
+	Example: `  rclone-mount-sync remote create
+  rclone-mount-sync remote create backup s3 provider=AWS access_key_id=AKIA... secret_access_key=... region=eu-west-1
+  rclone-mount-sync remote create nas sftp host=nas.local user=alice key_file=~/.ssh/id_ed25519`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runRemoteCreate,
+}
+
+var remoteDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete an rclone remote",
+	Long: `Delete an rclone remote with rclone config delete.
+
+If mounts or sync jobs still use the remote they are listed, and the
+remote is only deleted with --assume-yes; they will fail until pointed at
+another remote.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRemoteDelete,
+}
+
+var remoteRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename an rclone remote",
+	Long: `Rename an rclone remote. rclone has no rename command, so the remote is
+copied to the new name and the old one deleted.
+
+Mounts and sync jobs using the remote are listed, and on confirmation are
+changed to use the new name and have their units rewritten. Running
+services pick up the change on their next restart.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runRemoteRename,
+}
+
+func init() {
+	remoteCmd.AddCommand(remoteListCmd)
+	remoteCmd.AddCommand(remoteCreateCmd)
+	remoteCmd.AddCommand(remoteDeleteCmd)
+	remoteCmd.AddCommand(remoteRenameCmd)
+	rootCmd.AddCommand(remoteCmd)
+}
+
+// remoteInfo is one remote in the JSON output.
+type remoteInfo struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func runRemoteList(cmd *cobra.Command, args []string) error {
+	client := loadRcloneClient()
+	if !client.IsInstalled() {
+		return fmt.Errorf("rclone is not installed")
+	}
+	remotes, err := client.ListRemotes(context.Background())
+	if err != nil {
+		return err
+	}
+	return printRemotes(remotes)
+}
+
+// printRemotes lists remotes as a table, or as JSON with --json.
+func printRemotes(remotes []rclone.Remote) error {
+	if outputJSON {
+		infos := make([]remoteInfo, len(remotes))
+		for i, r := range remotes {
+			infos[i] = remoteInfo{Name: r.Name, Type: r.Type}
+		}
+		return printJSON(infos)
+	}
+
+	if len(remotes) == 0 {
+		fmt.Println("No rclone remotes configured.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE")
+	for _, r := range remotes {
+		fmt.Fprintf(w, "%s\t%s\n", r.Name, r.Type)
+	}
+	return w.Flush()
+}
+
+// parseRemoteParams parses key=value arguments into remote settings.
+func parseRemoteParams(args []string) (map[string]string, error) {
+	params := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid setting %q, want key=value", arg)
+		}
+		params[key] = value
+	}
+	return params, nil
+}
+
+func runRemoteCreate(cmd *cobra.Command, args []string) error {
+	var name, backendType string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if len(args) > 1 {
+		backendType = args[1]
+	}
+	var params map[string]string
+	var err error
+	if len(args) > 2 {
+		if params, err = parseRemoteParams(args[2:]); err != nil {
+			return err
+		}
+	} else {
+		params = map[string]string{}
+	}
+
+	client := loadRcloneClient()
+	if !client.IsInstalled() {
+		return fmt.Errorf("rclone is not installed")
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	existing, err := client.ListRemotes(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Ask for anything missing on a terminal; a script must pass it all
+	if isInteractive() {
+		in := bufio.NewReader(confirmInput)
+		if name == "" {
+			name = prompt(in, "Remote name", "")
+		}
+		if backendType == "" {
+			types := make([]string, len(rclone.Backends))
+			for i, b := range rclone.Backends {
+				types[i] = b.Type
+			}
+			backendType = prompt(in, "Type ("+strings.Join(types, ", ")+", or any rclone type)", "")
+		}
+		if backend, ok := rclone.FindBackend(backendType); ok {
+			for _, field := range backend.Fields {
+				if _, given := params[field.Key]; given {
+					continue
+				}
+				label := field.Label
+				if field.Help != "" {
+					label += " (" + field.Help + ")"
+				}
+				params[field.Key] = prompt(in, label, field.Default)
+			}
+		}
+	}
+
+	if err := rclone.ValidateRemoteName(name); err != nil {
+		return err
+	}
+	if backendType == "" {
+		return fmt.Errorf("remote type is required")
+	}
+	for _, r := range existing {
+		if r.Name == name {
+			return fmt.Errorf("remote %q already exists", name)
+		}
+	}
+	backend, known := rclone.FindBackend(backendType)
+	if known {
+		for _, field := range backend.Fields {
+			if field.Required && params[field.Key] == "" {
+				return fmt.Errorf("%s remotes need %s=...", backendType, field.Key)
+			}
+		}
+	}
+
+	// Store passwords in the obscured form rclone expects, so rclone is
+	// not left to guess whether they already are
+	if known {
+		for _, field := range backend.Fields {
+			if field.Obscured && params[field.Key] != "" {
+				if params[field.Key], err = client.Obscure(params[field.Key]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if known && backend.OAuth && params["token"] == "" {
+		fmt.Fprintf(os.Stderr, "Authorizing %s access in your browser...\n", backend.Description)
+		token, err := client.Authorize(ctx, backendType, params, func(url string) {
+			fmt.Fprintf(os.Stderr, "If the browser does not open, go to: %s\n", url)
+		})
+		if err != nil {
+			return err
+		}
+		params["token"] = token
+	}
+
+	if err := client.CreateRemote(ctx, name, backendType, params, known); err != nil {
+		return err
+	}
+
+	// List the remotes again, so the new one shows it is usable
+	remotes, err := client.ListRemotes(ctx)
+	if err != nil {
+		return fmt.Errorf("remote %s created, but listing remotes failed: %w", name, err)
+	}
+	if outputJSON {
+		return printRemotes(remotes)
+	}
+	printInfo("Remote '%s' created (type %s)\n", name, backendType)
+	if !quiet {
+		return printRemotes(remotes)
+	}
+	return nil
+}
+
+// prompt asks for a value on stderr, returning def when the answer is empty.
+func prompt(in *bufio.Reader, label, def string) string {
+	if def != "" {
+		fmt.Fprintf(os.Stderr, "%s [%s]: ", label, def)
+	} else {
+		fmt.Fprintf(os.Stderr, "%s: ", label)
+	}
+	answer, _ := in.ReadString('\n')
+	if answer = strings.TrimSpace(answer); answer == "" {
+		return def
+	}
+	return answer
+}
+
+// remoteUsers returns the mounts and sync jobs using remote, one per line
+// for a warning, e.g. "  mount gdrive".
+func remoteUsers(cfg *config.Config, remote string) []string {
+	mounts, syncJobs := cfg.RemoteUsers(remote)
+	users := make([]string, 0, len(mounts)+len(syncJobs))
+	for _, name := range mounts {
+		users = append(users, "  mount "+name)
+	}
+	for _, name := range syncJobs {
+		users = append(users, "  sync job "+name)
+	}
+	return users
+}
+
+func runRemoteDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	client := loadRcloneClient()
+	if !client.IsInstalled() {
+		return fmt.Errorf("rclone is not installed")
+	}
+	ctx := context.Background()
+	if _, err := client.RemoteSettings(ctx, name); err != nil {
+		return err
+	}
+
+	if users := remoteUsers(cfg, name); len(users) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: remote '%s' is still used by:\n%s\n", name, strings.Join(users, "\n"))
+		if !assumeYes {
+			return fmt.Errorf("remote %s is in use, rerun with --assume-yes to delete it anyway", name)
+		}
+	} else if err := confirm(fmt.Sprintf("Delete remote '%s'", name)); err != nil {
+		return err
+	}
+
+	if err := client.DeleteRemote(ctx, name); err != nil {
+		return err
+	}
+	printInfo("Remote '%s' deleted\n", name)
+	return nil
+}
+
+func runRemoteRename(cmd *cobra.Command, args []string) error {
+	oldName, newName := args[0], args[1]
+	if err := rclone.ValidateRemoteName(newName); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	client := loadRcloneClient()
+	if !client.IsInstalled() {
+		return fmt.Errorf("rclone is not installed")
+	}
+
+	if err := client.RenameRemote(context.Background(), oldName, newName); err != nil {
+		return err
+	}
+	printInfo("Remote '%s' renamed to '%s'\n", oldName, newName)
+
+	users := remoteUsers(cfg, oldName)
+	if len(users) == 0 {
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "These still use '%s':\n%s\n", oldName, strings.Join(users, "\n"))
+	if err := confirm(fmt.Sprintf("Change them to use '%s'", newName)); err != nil {
+		return fmt.Errorf("left them unchanged (%w); edit them to use %s", err, newName)
+	}
+
+	mounts, syncJobs := cfg.RenameRemote(oldName, newName)
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	if err := rewriteUnits(cfg); err != nil {
+		return err
+	}
+	printInfo("Updated %d mount(s) and %d sync job(s)\n", mounts, syncJobs)
+	return nil
+}
+
+// rewriteUnits writes the unit files that differ from the config, leaving
+// orphans and enabled states alone.
+func rewriteUnits(cfg *config.Config) error {
+	gen, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	plan, err := systemd.PlanApply(gen, loadManager(), cfg.Mounts, cfg.SyncJobs)
+	if err != nil {
+		return fmt.Errorf("failed to compare units with the config: %w", err)
+	}
+	writes := plan.Changes[:0]
+	for _, c := range plan.Changes {
+		if c.Action == systemd.ApplyWrite {
+			writes = append(writes, c)
+		}
+	}
+	plan.Changes = writes
+	if len(plan.Changes) == 0 {
+		return nil
+	}
+	if err := plan.Execute(); err != nil {
+		return fmt.Errorf("config saved, but rewriting units failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// useMockRcloneRemotes stands in for rclone with a script that lists and
+// dumps the remotes in its state file, and records config create, config
+// delete, obscure and authorize calls. Obscure prints "obscured-" and the
+// length of the password it read.
+// It returns the file the calls are written to.
+func useMockRcloneRemotes(t *testing.T, existing ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	remotes := filepath.Join(dir, "remotes")
+	calls := filepath.Join(dir, "calls")
+	var list string
+	for _, r := range existing {
+		list += r + ":\n"
+	}
+	if err := os.WriteFile(remotes, []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+	useMockRclone(t, `#!/bin/sh
+case "$1" in
+listremotes) cat `+remotes+` ;;
+config)
+	case "$2" in
+	show) printf '[%s]\ntype = s3\n' "$3" ;;
+	dump) awk -F: 'BEGIN { printf "{" } { printf "%s\"%s\":{\"type\":\"s3\",\"provider\":\"AWS\"}", (NR > 1 ? "," : ""), $1 } END { print "}" }' `+remotes+` ;;
+	delete)
+		echo "$*" >> `+calls+`
+		grep -v "^$3:$" `+remotes+` > `+remotes+`.new; mv `+remotes+`.new `+remotes+` ;;
+	*)
+		echo "$*" >> `+calls+`
+		echo "$3:" >> `+remotes+` ;;
+	esac ;;
+obscure)
+	echo "$*" >> `+calls+`
+	read -r plain
+	echo "obscured-$(printf %s "$plain" | wc -c)" ;;
+authorize)
+	echo "$*" >> `+calls+`
+	echo "NOTICE: If your browser doesn't open automatically go to the following link: http://127.0.0.1:53682/auth?state=abc" >&2
+	echo "Paste the following into your remote machine --->"
+	echo '{"access_token":"ya29","expiry":"2026-10-16T12:00:00Z"}'
+	echo "<---End paste" ;;
+esac
+`)
+	return calls
+}
+
+// useRemotePrompts answers interactive prompts with input.
+func useRemotePrompts(t *testing.T, input string) {
+	t.Helper()
+	oldInteractive, oldInput := isInteractive, confirmInput
+	t.Cleanup(func() { isInteractive, confirmInput = oldInteractive, oldInput })
+	isInteractive = func() bool { return true }
+	confirmInput = strings.NewReader(input)
+}
+
+func TestParseRemoteParams(t *testing.T) {
+	params, err := parseRemoteParams([]string{"provider=AWS", "endpoint=", "acl=private=x"})
+	if err != nil {
+		t.Fatalf("parseRemoteParams() error = %v", err)
+	}
+	if params["provider"] != "AWS" || params["endpoint"] != "" || params["acl"] != "private=x" || len(params) != 3 {
+		t.Errorf("parseRemoteParams() = %v", params)
+	}
+
+	for _, bad := range []string{"provider", "=AWS"} {
+		if _, err := parseRemoteParams([]string{bad}); err == nil {
+			t.Errorf("parseRemoteParams(%q) should fail", bad)
+		}
+	}
+}
+
+func TestRemoteCreateFromArgs(t *testing.T) {
+	calls := useMockRcloneRemotes(t, "gdrive")
+	useRemotePrompts(t, "")
+	isInteractive = func() bool { return false }
+
+	out := captureStdout(t, func() {
+		err := runRemoteCreate(nil, []string{"backup", "s3", "provider=AWS", "access_key_id=AKIA", "secret_access_key=s3cr3t"})
+		if err != nil {
+			t.Fatalf("runRemoteCreate() error = %v", err)
+		}
+	})
+
+	got, _ := os.ReadFile(calls)
+	want := "config create backup s3 access_key_id=AKIA provider=AWS secret_access_key=s3cr3t --non-interactive --no-obscure\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+	if !strings.Contains(out, "Remote 'backup' created") || !strings.Contains(out, "gdrive") {
+		t.Errorf("output should confirm the remote and list the remotes again, got %q", out)
+	}
+}
+
+func TestRemoteCreateRejectsBadInput(t *testing.T) {
+	useMockRcloneRemotes(t, "gdrive")
+	useRemotePrompts(t, "")
+	isInteractive = func() bool { return false }
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"gdrive", "drive", "token=x"}, "already exists"},
+		{[]string{"-bad", "s3"}, "invalid remote name"},
+		{[]string{"backup"}, "type is required"},
+		{[]string{"backup", "s3", "provider=AWS"}, "access_key_id"},
+	}
+	for _, tt := range tests {
+		err := runRemoteCreate(nil, tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("runRemoteCreate(%v) error = %v, want %q", tt.args, err, tt.want)
+		}
+	}
+}
+
+func TestRemoteCreateInteractive(t *testing.T) {
+	calls := useMockRcloneRemotes(t)
+	// Name, type, then host, user, port (default), password, key file
+	useRemotePrompts(t, "nas\nsftp\nnas.local\nalice\n\nhunter2\n~/.ssh/id_ed25519\n")
+
+	captureStdout(t, func() {
+		if err := runRemoteCreate(nil, nil); err != nil {
+			t.Fatalf("runRemoteCreate() error = %v", err)
+		}
+	})
+
+	got, _ := os.ReadFile(calls)
+	want := "obscure -\n" +
+		"config create nas sftp host=nas.local key_file=~/.ssh/id_ed25519 pass=obscured-7 port=22 user=alice --non-interactive --no-obscure\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+	if strings.Contains(string(got), "hunter2") {
+		t.Error("the typed password should never reach rclone's arguments")
+	}
+}
+
+func TestRemoteCreateObscuresPasswordArgs(t *testing.T) {
+	calls := useMockRcloneRemotes(t)
+	useRemotePrompts(t, "")
+	isInteractive = func() bool { return false }
+
+	captureStdout(t, func() {
+		if err := runRemoteCreate(nil, []string{"nas", "sftp", "host=nas.local", "pass=hunter2"}); err != nil {
+			t.Fatalf("runRemoteCreate() error = %v", err)
+		}
+	})
+
+	got, _ := os.ReadFile(calls)
+	want := "obscure -\n" +
+		"config create nas sftp host=nas.local pass=obscured-7 --non-interactive --no-obscure\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRemoteCreateAuthorizesOAuthBackends(t *testing.T) {
+	calls := useMockRcloneRemotes(t)
+	useRemotePrompts(t, "")
+	isInteractive = func() bool { return false }
+
+	captureStdout(t, func() {
+		if err := runRemoteCreate(nil, []string{"photos", "drive", "scope=drive.readonly"}); err != nil {
+			t.Fatalf("runRemoteCreate() error = %v", err)
+		}
+	})
+
+	got, _ := os.ReadFile(calls)
+	want := "authorize drive\n" +
+		`config create photos drive scope=drive.readonly token={"access_token":"ya29","expiry":"2026-10-16T12:00:00Z"} --non-interactive --no-obscure` + "\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+}
+
+// useRemoteConfig points the config, generator and manager loaders at a
+// config whose mounts and sync jobs use gdrive, saved under a temporary
+// config home.
+func useRemoteConfig(t *testing.T) *config.Config {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{{ID: "m1", Name: "drive", Remote: "gdrive:", RemotePath: "/", MountPoint: "/mnt/drive"}},
+		SyncJobs: []models.SyncJobConfig{{
+			ID: "s1", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos",
+			Schedule: models.ScheduleConfig{Type: "manual"},
+		}},
+	}
+	useReportLoaders(t, cfg, &systemd.MockManager{})
+	oldAssumeYes := assumeYes
+	t.Cleanup(func() { assumeYes = oldAssumeYes })
+	return cfg
+}
+
+// captureStderr returns what fn writes to stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := os.Stderr
+	os.Stderr = w
+	defer func() { os.Stderr = old }()
+
+	fn()
+	w.Close()
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	return buf.String()
+}
+
+func TestRemoteDeleteInUse(t *testing.T) {
+	calls := useMockRcloneRemotes(t, "gdrive", "b2")
+	useRemoteConfig(t)
+	useRemotePrompts(t, "y\n")
+
+	var err error
+	stderr := captureStderr(t, func() { err = runRemoteDelete(nil, []string{"gdrive"}) })
+	if err == nil || !strings.Contains(err.Error(), "--assume-yes") {
+		t.Fatalf("runRemoteDelete() error = %v, want --assume-yes required", err)
+	}
+	if !strings.Contains(stderr, "mount drive") || !strings.Contains(stderr, "sync job photos") {
+		t.Errorf("warning should list the mount and sync job, got %q", stderr)
+	}
+	if got, _ := os.ReadFile(calls); len(got) != 0 {
+		t.Errorf("rclone ran %q, want nothing deleted", got)
+	}
+
+	assumeYes = true
+	captureStderr(t, func() {
+		captureStdout(t, func() { err = runRemoteDelete(nil, []string{"gdrive"}) })
+	})
+	if err != nil {
+		t.Fatalf("runRemoteDelete() with --assume-yes error = %v", err)
+	}
+	if got, _ := os.ReadFile(calls); string(got) != "config delete gdrive\n" {
+		t.Errorf("rclone ran %q, want config delete gdrive", got)
+	}
+}
+
+func TestRemoteDeleteUnused(t *testing.T) {
+	calls := useMockRcloneRemotes(t, "gdrive", "b2")
+	useRemoteConfig(t)
+	useRemotePrompts(t, "y\n")
+
+	out := captureStdout(t, func() {
+		if err := runRemoteDelete(nil, []string{"b2"}); err != nil {
+			t.Fatalf("runRemoteDelete() error = %v", err)
+		}
+	})
+	if got, _ := os.ReadFile(calls); string(got) != "config delete b2\n" {
+		t.Errorf("rclone ran %q, want config delete b2", got)
+	}
+	if !strings.Contains(out, "Remote 'b2' deleted") {
+		t.Errorf("output = %q", out)
+	}
+
+	if err := runRemoteDelete(nil, []string{"missing"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("runRemoteDelete(missing) error = %v, want not found", err)
+	}
+}
+
+func TestRemoteRenameUpdatesConfig(t *testing.T) {
+	calls := useMockRcloneRemotes(t, "gdrive")
+	cfg := useRemoteConfig(t)
+	useRemotePrompts(t, "y\n")
+
+	captureStderr(t, func() {
+		captureStdout(t, func() {
+			if err := runRemoteRename(nil, []string{"gdrive", "drive"}); err != nil {
+				t.Fatalf("runRemoteRename() error = %v", err)
+			}
+		})
+	})
+
+	got, _ := os.ReadFile(calls)
+	want := "config create drive s3 provider=AWS --non-interactive --no-obscure\nconfig delete gdrive\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+	if cfg.Mounts[0].Remote != "drive:" || cfg.SyncJobs[0].Source != "drive:/Photos" {
+		t.Errorf("config still uses gdrive: mount %q, sync source %q", cfg.Mounts[0].Remote, cfg.SyncJobs[0].Source)
+	}
+}
+
+func TestRemoteRenameDeclinedLeavesConfig(t *testing.T) {
+	useMockRcloneRemotes(t, "gdrive")
+	cfg := useRemoteConfig(t)
+	useRemotePrompts(t, "n\n")
+
+	var err error
+	captureStderr(t, func() {
+		captureStdout(t, func() { err = runRemoteRename(nil, []string{"gdrive", "drive"}) })
+	})
+	if err == nil || !strings.Contains(err.Error(), "unchanged") {
+		t.Errorf("runRemoteRename() error = %v, want the references reported unchanged", err)
+	}
+	if cfg.Mounts[0].Remote != "gdrive:" {
+		t.Errorf("mount remote = %q, want gdrive: kept", cfg.Mounts[0].Remote)
+	}
+}
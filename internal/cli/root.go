@@ -1,11 +1,12 @@
 package cli
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
-	"path/filepath"
 	"strings"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
@@ -18,6 +19,8 @@ import (
 var (
 	cfgFile     string
 	outputJSON  bool
+	quiet       bool
+	assumeYes   bool
 	showVersion bool
 	cliVersion  = "dev"
 )
@@ -33,8 +36,9 @@ and delete mount points and sync jobs.`,
 func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config directory (default is $XDG_CONFIG_HOME/rclone-mount-sync)")
 	rootCmd.PersistentFlags().BoolVarP(&outputJSON, "json", "j", false, "output in JSON format")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "suppress informational output; errors are still printed")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "assume-yes", "y", false, "confirm destructive actions such as delete and cleanup without prompting")
 	rootCmd.Flags().BoolVarP(&showVersion, "version", "v", false, "print version and exit")
-	rootCmd.AddCommand(cleanupCmd)
 }
 
 func Execute() error {
@@ -65,16 +69,49 @@ var loadConfig = func() (*config.Config, error) {
 	return config.Load()
 }
 
-// loadGenerator returns a new systemd generator instance.
+// loadGenerator returns a new systemd generator instance for the scope in
+// the config. This function is injectable for testing purposes.
+var loadGenerator = generatorFromConfig
+
+// newGenerator returns a new systemd generator instance for a scope.
 // This function is injectable for testing purposes.
-var loadGenerator = func() (*systemd.Generator, error) {
-	return systemd.NewGenerator()
+var newGenerator = systemd.NewGeneratorWithScope
+
+// generatorFromConfig returns a generator for the scope in the config that
+// adds the config's default extra flags to every mount and sync job, as the
+// installed units have them.
+func generatorFromConfig() (*systemd.Generator, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	scope, err := systemd.ParseScope(cfg.Settings.Scope)
+	if err != nil {
+		return nil, err
+	}
+	gen, err := newGenerator(scope)
+	if err != nil {
+		return nil, err
+	}
+	gen.SetDefaultExtraArgs(cfg.Defaults.Mount.ExtraFlags, cfg.Defaults.Sync.ExtraFlags)
+	return gen, nil
 }
 
-// loadManager returns a new systemd manager instance.
-// This function is injectable for testing purposes.
+// loadManager returns a new systemd manager instance for the scope in the
+// config. This function is injectable for testing purposes.
 var loadManager = func() systemd.ServiceManager {
-	return systemd.NewManager()
+	// A config that fails to load fails the command before it gets here
+	scope, _ := configScope()
+	return systemd.NewManagerWithScope(scope)
+}
+
+// configScope returns the systemd scope units are installed in.
+func configScope() (systemd.Scope, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return systemd.ScopeUser, fmt.Errorf("failed to load config: %w", err)
+	}
+	return systemd.ParseScope(cfg.Settings.Scope)
 }
 
 // loadRcloneClient returns a new rclone client instance.
@@ -89,6 +126,56 @@ func printJSON(v interface{}) error {
 	return encoder.Encode(v)
 }
 
+// printInfo prints an informational or success message unless --quiet is
+// set. Errors and --json output are never suppressed.
+func printInfo(format string, a ...interface{}) {
+	if quiet {
+		return
+	}
+	fmt.Printf(format, a...)
+}
+
+// confirmInput is where confirmation answers are read from.
+// It is injectable for testing purposes.
+var confirmInput io.Reader = os.Stdin
+
+// isInteractive reports whether confirmations can be asked on stdin.
+// This function is injectable for testing purposes.
+var isInteractive = func() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// confirm asks the user to confirm a destructive action. With --assume-yes
+// it succeeds without asking. Without a terminal to ask on it refuses, so
+// scripts must opt in with --assume-yes on every invocation.
+func confirm(prompt string) error {
+	if assumeYes {
+		return nil
+	}
+	if !isInteractive() {
+		return fmt.Errorf("%s: confirmation required, rerun with --assume-yes to proceed", prompt)
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", prompt)
+	answer, _ := bufio.NewReader(confirmInput).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+	return fmt.Errorf("aborted")
+}
+
+// ExitCode returns the status the process should exit with after err: the
+// exit status of a sync job run with sync run, or 1 for any other error.
+func ExitCode(err error) int {
+	var exitErr *systemd.ExitError
+	if errors.As(err, &exitErr) && exitErr.Code > 0 {
+		return exitErr.Code
+	}
+	return 1
+}
+
 func printError(err error) {
 	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 }
@@ -114,64 +201,3 @@ func findSyncJobByIDOrName(cfg *config.Config, idOrName string) *models.SyncJobC
 	}
 	return nil
 }
-
-var cleanupCmd = &cobra.Command{
-	Use:   "cleanup",
-	Short: "Clean up orphaned systemd units",
-	Long: `Remove failed rclone units from systemd that no longer have unit files.
-
-This can happen if mounts/sync jobs were deleted improperly or if unit files
-were manually removed. The command will:
-1. Find all failed rclone units
-2. Check if they have corresponding unit files
-3. Reset the failed state for units without files`,
-	RunE: runCleanup,
-}
-
-func runCleanup(cmd *cobra.Command, args []string) error {
-	manager := loadManager()
-	generator, err := loadGenerator()
-	if err != nil {
-		return err
-	}
-
-	cmd2 := exec.Command("systemctl", "--user", "list-units", "--state=failed", "--no-legend")
-	output, err := cmd2.Output()
-	if err != nil {
-		return fmt.Errorf("failed to list failed units: %w", err)
-	}
-
-	lines := strings.Split(string(output), "\n")
-	cleaned := 0
-
-	for _, line := range lines {
-		fields := strings.Fields(line)
-		if len(fields) < 2 {
-			continue
-		}
-
-		unitName := fields[1]
-
-		if !strings.HasPrefix(unitName, "rclone-mount-") && !strings.HasPrefix(unitName, "rclone-sync-") {
-			continue
-		}
-
-		unitPath := filepath.Join(generator.GetSystemdDir(), unitName)
-		if _, err := os.Stat(unitPath); os.IsNotExist(err) {
-			if err := manager.ResetFailed(unitName); err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to reset %s: %v\n", unitName, err)
-			} else {
-				fmt.Printf("Cleaned up orphaned unit: %s\n", unitName)
-				cleaned++
-			}
-		}
-	}
-
-	if cleaned == 0 {
-		fmt.Println("No orphaned units found.")
-	} else {
-		fmt.Printf("\nCleaned up %d orphaned unit(s).\n", cleaned)
-	}
-
-	return nil
-}
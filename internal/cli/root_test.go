@@ -3,10 +3,14 @@ package cli
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
 	"github.com/spf13/cobra"
 )
 
@@ -47,6 +51,53 @@ func TestPrintError(t *testing.T) {
 	printError(testErr)
 }
 
+// captureStdout returns what fn writes to os.Stdout.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	old := os.Stdout
+	os.Stdout = w
+	fn()
+	os.Stdout = old
+	w.Close()
+	out, _ := io.ReadAll(r)
+	return string(out)
+}
+
+func TestPrintInfoQuiet(t *testing.T) {
+	oldQuiet := quiet
+	defer func() { quiet = oldQuiet }()
+
+	quiet = false
+	if out := captureStdout(t, func() { printInfo("hello %s\n", "world") }); out != "hello world\n" {
+		t.Errorf("printInfo() output = %q, want %q", out, "hello world\n")
+	}
+
+	quiet = true
+	if out := captureStdout(t, func() { printInfo("hello %s\n", "world") }); out != "" {
+		t.Errorf("printInfo() with --quiet output = %q, want nothing", out)
+	}
+}
+
+func TestQuietKeepsJSONOutput(t *testing.T) {
+	oldQuiet, oldOutputJSON := quiet, outputJSON
+	defer func() { quiet, outputJSON = oldQuiet, oldOutputJSON }()
+	useMockRclone(t, "#!/bin/sh\nexit 0\n")
+	quiet, outputJSON = true, true
+
+	out := captureStdout(t, func() {
+		if err := runFetch(nil, []string{"gdrive:/a.txt", "/tmp"}); err != nil {
+			t.Errorf("runFetch() error = %v", err)
+		}
+	})
+	if !bytes.Contains([]byte(out), []byte(`"success": true`)) {
+		t.Errorf("JSON output should still print with --quiet, got %q", out)
+	}
+}
+
 func TestPrintJSON(t *testing.T) {
 	data := map[string]string{"key": "value", "name": "test"}
 	err := printJSON(data)
@@ -122,3 +173,67 @@ func TestFindSyncJobByIDOrName(t *testing.T) {
 		t.Error("expected nil for nonexistent sync job")
 	}
 }
+
+func TestConfirm(t *testing.T) {
+	oldAssumeYes, oldInput, oldInteractive := assumeYes, confirmInput, isInteractive
+	defer func() { assumeYes, confirmInput, isInteractive = oldAssumeYes, oldInput, oldInteractive }()
+
+	tests := []struct {
+		name        string
+		assumeYes   bool
+		interactive bool
+		answer      string
+		wantErr     string
+	}{
+		{name: "assume yes", assumeYes: true},
+		{name: "assume yes without terminal", assumeYes: true, interactive: false},
+		{name: "no terminal", interactive: false, wantErr: "--assume-yes"},
+		{name: "answered yes", interactive: true, answer: "y\n"},
+		{name: "answered YES", interactive: true, answer: "YES\n"},
+		{name: "answered no", interactive: true, answer: "n\n", wantErr: "aborted"},
+		{name: "empty answer", interactive: true, answer: "\n", wantErr: "aborted"},
+		{name: "end of input", interactive: true, answer: "", wantErr: "aborted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assumeYes = tt.assumeYes
+			interactive := tt.interactive
+			isInteractive = func() bool { return interactive }
+			confirmInput = strings.NewReader(tt.answer)
+
+			err := confirm("Delete mount 'x'")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("confirm() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("confirm() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMountDeleteRequiresConfirmation(t *testing.T) {
+	oldAssumeYes, oldInteractive, oldLoadConfig, oldLoadManager := assumeYes, isInteractive, loadConfig, loadManager
+	defer func() {
+		assumeYes, isInteractive, loadConfig, loadManager = oldAssumeYes, oldInteractive, oldLoadConfig, oldLoadManager
+	}()
+
+	cfg := &config.Config{Mounts: []models.MountConfig{{ID: "abc12345", Name: "gdrive"}}}
+	mock := &systemd.MockManager{}
+	assumeYes = false
+	isInteractive = func() bool { return false }
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	loadManager = func() systemd.ServiceManager { return mock }
+
+	err := runMountDelete(nil, []string{"gdrive"})
+	if err == nil || !strings.Contains(err.Error(), "--assume-yes") {
+		t.Fatalf("runMountDelete() error = %v, want a confirmation error", err)
+	}
+	if len(mock.Calls) != 0 || cfg.GetMount("gdrive") == nil {
+		t.Error("nothing should be stopped or removed without confirmation")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
 	"github.com/spf13/cobra"
 )
 
@@ -45,6 +46,26 @@ a shortened version (e.g., rclone-mount-abc123).`,
 	RunE: runServicesLogs,
 }
 
+var servicesStartAllCmd = &cobra.Command{
+	Use:   "start-all",
+	Short: "Start all stopped mount services",
+	Long: `Start every rclone mount service that is not running.
+
+Each unit is attempted even if others fail, transient failures are retried
+once, and a per-unit summary is printed.`,
+	RunE: runServicesStartAll,
+}
+
+var servicesRestartFailedCmd = &cobra.Command{
+	Use:   "restart-failed",
+	Short: "Restart all failed services",
+	Long: `Restart every rclone service in the failed state.
+
+Each unit is attempted even if others fail, transient failures are retried
+once, and a per-unit summary is printed.`,
+	RunE: runServicesRestartFailed,
+}
+
 var (
 	logsLines  int
 	logsFollow bool
@@ -55,11 +76,38 @@ func init() {
 	servicesCmd.AddCommand(servicesListCmd)
 	servicesCmd.AddCommand(servicesStatusCmd)
 	servicesCmd.AddCommand(servicesLogsCmd)
+	servicesCmd.AddCommand(servicesStartAllCmd)
+	servicesCmd.AddCommand(servicesRestartFailedCmd)
 
 	servicesLogsCmd.Flags().IntVarP(&logsLines, "lines", "n", 50, "number of lines to show")
 	servicesLogsCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "follow log output")
 }
 
+// serviceInfo is one service in the --json output of services list.
+type serviceInfo struct {
+	Name    string     `json:"name"`
+	Type    string     `json:"type"` // "mount" or "sync"
+	Status  string     `json:"status"`
+	Enabled bool       `json:"enabled"`
+	NextRun *time.Time `json:"next_run,omitempty"`
+}
+
+// newServiceInfo describes s, reading the next run of a sync service from
+// its timer.
+func newServiceInfo(manager systemd.ServiceManager, s systemd.ServiceStatus) serviceInfo {
+	info := serviceInfo{Name: s.Name, Status: s.State, Enabled: s.Enabled}
+	switch {
+	case strings.HasPrefix(s.Name, "rclone-mount-"):
+		info.Type = "mount"
+	case strings.HasPrefix(s.Name, "rclone-sync-"):
+		info.Type = "sync"
+		if next, err := manager.GetTimerNextRun(s.Name + ".timer"); err == nil && !next.IsZero() {
+			info.NextRun = &next
+		}
+	}
+	return info
+}
+
 func runServicesList(cmd *cobra.Command, args []string) error {
 	manager := loadManager()
 
@@ -69,7 +117,11 @@ func runServicesList(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		return printJSON(services)
+		infos := make([]serviceInfo, 0, len(services))
+		for _, s := range services {
+			infos = append(infos, newServiceInfo(manager, s))
+		}
+		return printJSON(infos)
 	}
 
 	if len(services) == 0 {
@@ -176,3 +228,53 @@ func runServicesLogs(cmd *cobra.Command, args []string) error {
 	fmt.Print(logs)
 	return nil
 }
+
+func runServicesStartAll(cmd *cobra.Command, args []string) error {
+	return runServicesBatch(systemd.BatchStart, systemd.InactiveMountUnits, "No stopped mount services.")
+}
+
+func runServicesRestartFailed(cmd *cobra.Command, args []string) error {
+	return runServicesBatch(systemd.BatchRestart, systemd.FailedUnits, "No failed services.")
+}
+
+// runServicesBatch applies action to the units chosen by selectUnits and
+// prints a per-unit summary. It fails if any unit failed.
+func runServicesBatch(action systemd.BatchAction, selectUnits func([]systemd.ServiceStatus) []string, noneMsg string) error {
+	manager := loadManager()
+
+	services, err := manager.ListServices()
+	if err != nil {
+		return fmt.Errorf("failed to list services: %w", err)
+	}
+
+	units := selectUnits(services)
+	results := systemd.RunBatch(manager, action, units)
+
+	if outputJSON {
+		if err := printJSON(results); err != nil {
+			return err
+		}
+	} else if quiet {
+		// Failures are still reported through the returned error
+	} else if len(units) == 0 {
+		fmt.Println(noneMsg)
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "UNIT\tRESULT\tATTEMPTS\tERROR")
+		for _, r := range results {
+			result := "ok"
+			if !r.OK() {
+				result = "failed"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", r.Unit, result, r.Attempts, r.Error)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if _, failed := systemd.CountResults(results); failed > 0 {
+		return fmt.Errorf("%s failed for %d of %d units", action, failed, len(results))
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -49,17 +50,47 @@ func TestServicesListWithServicesJSON(t *testing.T) {
 		outputJSON = oldOutputJSON
 	}()
 
+	next := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
 	mock := &systemd.MockManager{
 		ListServicesResult: []systemd.ServiceStatus{
-			{Name: "rclone-mount-abc.service", Enabled: true, Active: true, State: "active"},
+			{Name: "rclone-mount-abc", Enabled: true, Active: true, State: "active"},
+			{Name: "rclone-sync-xyz", State: "failed"},
 		},
+		GetTimerNextRunResult: next,
 	}
 	loadManager = func() systemd.ServiceManager { return mock }
 	outputJSON = true
 
-	err := runServicesList(nil, nil)
-	if err != nil {
-		t.Fatalf("runServicesList JSON failed: %v", err)
+	out := captureStdout(t, func() {
+		if err := runServicesList(nil, nil); err != nil {
+			t.Fatalf("runServicesList JSON failed: %v", err)
+		}
+	})
+
+	var services []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &services); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, out)
+	}
+	if len(services) != 2 {
+		t.Fatalf("got %d services, want 2", len(services))
+	}
+	mount, sync := services[0], services[1]
+	for _, key := range []string{"name", "type", "status", "enabled"} {
+		if _, ok := mount[key]; !ok {
+			t.Errorf("service missing key %q: %v", key, mount)
+		}
+	}
+	if mount["type"] != "mount" || mount["status"] != "active" || mount["enabled"] != true {
+		t.Errorf("mount = %v", mount)
+	}
+	if _, ok := mount["next_run"]; ok {
+		t.Errorf("a mount has no next run: %v", mount)
+	}
+	if sync["type"] != "sync" || sync["status"] != "failed" || sync["next_run"] != "2026-01-02T03:00:00Z" {
+		t.Errorf("sync = %v", sync)
+	}
+	if !mock.Called("GetTimerNextRun", "rclone-sync-xyz.timer") {
+		t.Error("the next run should be read from the sync service's timer")
 	}
 }
 
@@ -310,3 +341,73 @@ func TestServicesLogsCustomLines(t *testing.T) {
 		t.Fatalf("runServicesLogs with custom lines failed: %v", err)
 	}
 }
+
+func TestServicesStartAll(t *testing.T) {
+	oldLoadManager := loadManager
+	defer func() { loadManager = oldLoadManager }()
+
+	mock := &systemd.MockManager{
+		ListServicesResult: []systemd.ServiceStatus{
+			{Name: "rclone-mount-abc", Active: true, State: "active"},
+			{Name: "rclone-mount-def", Active: false, State: "inactive"},
+			{Name: "rclone-sync-xyz", Active: false, State: "inactive"},
+		},
+	}
+	loadManager = func() systemd.ServiceManager { return mock }
+
+	if err := runServicesStartAll(nil, nil); err != nil {
+		t.Fatalf("runServicesStartAll failed: %v", err)
+	}
+	if !mock.Called("Start", "rclone-mount-def.service") {
+		t.Error("expected the stopped mount to be started")
+	}
+	if mock.Called("Start", "rclone-mount-abc.service") || mock.Called("Start", "rclone-sync-xyz.service") {
+		t.Errorf("only stopped mounts should be started, calls: %v", mock.Calls)
+	}
+}
+
+func TestServicesRestartFailedPartialFailure(t *testing.T) {
+	oldLoadManager := loadManager
+	oldOutputJSON := outputJSON
+	defer func() {
+		loadManager = oldLoadManager
+		outputJSON = oldOutputJSON
+	}()
+
+	mock := &systemd.MockManager{
+		ListServicesResult: []systemd.ServiceStatus{
+			{Name: "rclone-mount-abc", State: "failed"},
+			{Name: "rclone-sync-xyz", State: "active", Active: true},
+		},
+		RestartErr: fmt.Errorf("unit not found"),
+	}
+	loadManager = func() systemd.ServiceManager { return mock }
+	outputJSON = true
+
+	err := runServicesRestartFailed(nil, nil)
+	if err == nil {
+		t.Fatal("expected an error when a restart fails")
+	}
+	if err.Error() != "restart failed for 1 of 1 units" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServicesRestartFailedNone(t *testing.T) {
+	oldLoadManager := loadManager
+	defer func() { loadManager = oldLoadManager }()
+
+	mock := &systemd.MockManager{
+		ListServicesResult: []systemd.ServiceStatus{
+			{Name: "rclone-mount-abc", State: "active", Active: true},
+		},
+	}
+	loadManager = func() systemd.ServiceManager { return mock }
+
+	if err := runServicesRestartFailed(nil, nil); err != nil {
+		t.Fatalf("runServicesRestartFailed failed: %v", err)
+	}
+	if mock.Called("Restart", "") {
+		t.Error("nothing should be restarted")
+	}
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the state of every mount and sync job",
+	Long: `Show the systemd state of every configured mount and sync job, with the
+next run of scheduled sync jobs.
+
+Exits non-zero when any unit has failed, so it can be used in scripts and
+monitoring checks.`,
+	RunE: runStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+}
+
+// unitStatus is one row of the status output.
+type unitStatus struct {
+	Name    string     `json:"name"`
+	Type    string     `json:"type"`
+	Unit    string     `json:"unit"`
+	State   string     `json:"state"`
+	Enabled bool       `json:"enabled"`
+	NextRun *time.Time `json:"next_run,omitempty"`
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	gen, err := loadGenerator()
+	if err != nil {
+		return err
+	}
+
+	// One batched listing gives the state of every service
+	mgr := loadManager()
+	services, err := mgr.ListServices()
+	if err != nil {
+		return fmt.Errorf("failed to read service status: %w", err)
+	}
+	byName := make(map[string]systemd.ServiceStatus, len(services))
+	for _, s := range services {
+		byName[s.Name] = s
+	}
+	row := func(id, name, unitType string) unitStatus {
+		unit := gen.ServiceName(id, unitType)
+		s, ok := byName[unit]
+		if !ok {
+			return unitStatus{Name: name, Type: unitType, Unit: unit + ".service", State: "not installed"}
+		}
+		return unitStatus{Name: name, Type: unitType, Unit: unit + ".service", State: s.State, Enabled: s.Enabled}
+	}
+
+	rows := []unitStatus{}
+	for _, m := range cfg.Mounts {
+		rows = append(rows, row(m.ID, m.Name, "mount"))
+	}
+	for _, j := range cfg.SyncJobs {
+		r := row(j.ID, j.Name, "sync")
+		if j.Schedule.Type != "manual" && r.State != "not installed" {
+			// A scheduled job is enabled through its timer
+			timer := gen.ServiceName(j.ID, "sync") + ".timer"
+			if enabled, err := mgr.IsEnabled(timer); err == nil {
+				r.Enabled = enabled
+			}
+			if next, err := mgr.GetTimerNextRun(timer); err == nil && !next.IsZero() {
+				r.NextRun = &next
+			}
+		}
+		rows = append(rows, r)
+	}
+
+	failed := 0
+	for _, r := range rows {
+		if r.State == "failed" {
+			failed++
+		}
+	}
+
+	if outputJSON {
+		if err := printJSON(rows); err != nil {
+			return err
+		}
+	} else if len(rows) == 0 {
+		fmt.Println("No mounts or sync jobs configured.")
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tTYPE\tSTATE\tENABLED\tNEXT RUN")
+		for _, r := range rows {
+			next := "-"
+			if r.NextRun != nil {
+				next = r.NextRun.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%s\n", r.Name, r.Type, r.State, r.Enabled, next)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d unit(s) failed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+func statusTestConfig() *config.Config {
+	return &config.Config{
+		Mounts: []models.MountConfig{{ID: "m1", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive"}},
+		SyncJobs: []models.SyncJobConfig{{
+			ID: "s1", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos",
+			Schedule: models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+		}},
+	}
+}
+
+func TestStatusHealthy(t *testing.T) {
+	next := time.Date(2026, 1, 2, 3, 0, 0, 0, time.Local)
+	mgr := &systemd.MockManager{
+		ListServicesResult: []systemd.ServiceStatus{
+			{Name: "rclone-mount-m1", Active: true, State: "active", Enabled: true},
+			{Name: "rclone-sync-s1", State: "inactive"},
+		},
+		IsEnabledResult:       true,
+		GetTimerNextRunResult: next,
+	}
+	useReportLoaders(t, statusTestConfig(), mgr)
+
+	out := captureStdout(t, func() {
+		if err := runStatus(nil, nil); err != nil {
+			t.Errorf("runStatus() error = %v, want nil when nothing failed", err)
+		}
+	})
+	for _, want := range []string{"NAME", "gdrive", "active", "photos", "inactive", "2026-01-02 03:00:00"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if !mgr.Called("GetTimerNextRun", "rclone-sync-s1.timer") {
+		t.Error("the next run should be read from the job's timer")
+	}
+}
+
+func TestStatusFailedUnit(t *testing.T) {
+	mgr := &systemd.MockManager{ListServicesResult: []systemd.ServiceStatus{
+		{Name: "rclone-mount-m1", State: "failed", Enabled: true},
+		{Name: "rclone-sync-s1", State: "inactive"},
+	}}
+	useReportLoaders(t, statusTestConfig(), mgr)
+
+	out := captureStdout(t, func() {
+		err := runStatus(nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "1 unit(s) failed") {
+			t.Errorf("runStatus() error = %v, want the failed unit reported", err)
+		}
+	})
+	if !strings.Contains(out, "failed") {
+		t.Errorf("the table should still be printed:\n%s", out)
+	}
+}
+
+func TestStatusNotInstalled(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	useReportLoaders(t, statusTestConfig(), mgr)
+
+	out := captureStdout(t, func() {
+		if err := runStatus(nil, nil); err != nil {
+			t.Errorf("runStatus() error = %v, want nil for units that are not installed", err)
+		}
+	})
+	if !strings.Contains(out, "not installed") {
+		t.Errorf("output should show units that are not installed:\n%s", out)
+	}
+	if mgr.Called("GetTimerNextRun", "") {
+		t.Error("a timer that is not installed should not be queried")
+	}
+}
+
+func TestStatusJSON(t *testing.T) {
+	oldOutputJSON := outputJSON
+	defer func() { outputJSON = oldOutputJSON }()
+	outputJSON = true
+
+	mgr := &systemd.MockManager{ListServicesResult: []systemd.ServiceStatus{
+		{Name: "rclone-mount-m1", State: "failed"},
+	}}
+	useReportLoaders(t, statusTestConfig(), mgr)
+
+	out := captureStdout(t, func() {
+		if err := runStatus(nil, nil); err == nil {
+			t.Error("runStatus() should fail with --json too when a unit failed")
+		}
+	})
+	var rows []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &rows); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, out)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want a mount and a sync job", len(rows))
+	}
+	for _, key := range []string{"name", "type", "unit", "state", "enabled"} {
+		if _, ok := rows[0][key]; !ok {
+			t.Errorf("row missing key %q: %v", key, rows[0])
+		}
+	}
+	if rows[0]["state"] != "failed" || rows[0]["unit"] != "rclone-mount-m1.service" {
+		t.Errorf("mount row = %v", rows[0])
+	}
+	if rows[1]["type"] != "sync" || rows[1]["state"] != "not installed" {
+		t.Errorf("sync row = %v", rows[1])
+	}
+}
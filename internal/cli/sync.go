@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 	"text/tabwriter"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
@@ -35,7 +38,8 @@ var syncDeleteCmd = &cobra.Command{
 	Short: "Delete a sync job",
 	Long: `Delete a sync job configuration and its systemd units.
 
-This will stop and disable the timer and service before removal.`,
+This will stop and disable the timer and service before removal. You are
+asked to confirm first unless --assume-yes is given.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSyncDelete,
 }
@@ -43,9 +47,12 @@ This will stop and disable the timer and service before removal.`,
 var syncRunCmd = &cobra.Command{
 	Use:   "run <name-or-id>",
 	Short: "Run a sync job immediately",
-	Long: `Trigger an immediate sync job run.
+	Long: `Run a sync job now, regardless of the timer schedule.
 
-This starts the systemd service regardless of the timer schedule.`,
+The job's rclone command runs as a transient systemd unit; its output is
+streamed to the terminal and the command exits with rclone's exit status,
+so it can be used from cron or scripts. With --background the job's own
+service is started instead and the command returns immediately.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSyncRun,
 }
@@ -56,6 +63,12 @@ var (
 	syncCreateDestination string
 	syncCreateSchedule    string
 	syncCreateEnabled     bool
+	syncCreateMounts      []string
+	syncCreateStopMount   bool
+	syncCreateSerialize   bool
+
+	syncRunDryRun     bool
+	syncRunBackground bool
 )
 
 func init() {
@@ -70,6 +83,12 @@ func init() {
 	syncCreateCmd.Flags().StringVarP(&syncCreateDestination, "destination", "d", "", "destination path (required)")
 	syncCreateCmd.Flags().StringVar(&syncCreateSchedule, "schedule", "daily", "schedule (e.g., daily, hourly, '*-*-* 02:00:00')")
 	syncCreateCmd.Flags().BoolVar(&syncCreateEnabled, "enabled", true, "enable the timer")
+	syncCreateCmd.Flags().StringSliceVar(&syncCreateMounts, "requires-mount", nil, "mount (name or ID) the job runs through; repeatable")
+	syncCreateCmd.Flags().BoolVar(&syncCreateStopMount, "stop-with-mount", false, "stop the job's service and timer when a required mount stops")
+	syncCreateCmd.Flags().BoolVar(&syncCreateSerialize, "serialize-with-mount", false, "run one at a time with other serialized jobs that require the same mounts")
+
+	syncRunCmd.Flags().BoolVar(&syncRunDryRun, "dry-run", false, "run rclone with --dry-run, changing nothing")
+	syncRunCmd.Flags().BoolVar(&syncRunBackground, "background", false, "start the job's service and return without waiting")
 
 	syncCreateCmd.MarkFlagRequired("name")
 	syncCreateCmd.MarkFlagRequired("source")
@@ -127,6 +146,16 @@ func runSyncCreate(cmd *cobra.Command, args []string) error {
 			Type:       "timer",
 			OnCalendar: syncCreateSchedule,
 		},
+		StopWithMount:            syncCreateStopMount,
+		SerializeWithSharedMount: syncCreateSerialize,
+	}
+
+	for _, idOrName := range syncCreateMounts {
+		mount := findMountByIDOrName(cfg, idOrName)
+		if mount == nil {
+			return fmt.Errorf("required mount '%s' not found", idOrName)
+		}
+		job.RequiresMounts = append(job.RequiresMounts, mount.ID)
 	}
 
 	if err := cfg.AddSyncJob(job); err != nil {
@@ -163,7 +192,7 @@ func runSyncCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
-	fmt.Printf("Sync job '%s' created successfully (ID: %s)\n", savedJob.Name, savedJob.ID)
+	printInfo("Sync job '%s' created successfully (ID: %s)\n", savedJob.Name, savedJob.ID)
 	return nil
 }
 
@@ -180,6 +209,10 @@ func runSyncDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("sync job '%s' not found", idOrName)
 	}
 
+	if err := confirm(fmt.Sprintf("Delete sync job '%s'", job.Name)); err != nil {
+		return err
+	}
+
 	generator, err := loadGenerator()
 	if err != nil {
 		return err
@@ -229,7 +262,7 @@ func runSyncDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
-	fmt.Printf("Sync job '%s' deleted successfully\n", job.Name)
+	printInfo("Sync job '%s' deleted successfully\n", job.Name)
 	return nil
 }
 
@@ -252,12 +285,32 @@ func runSyncRun(cmd *cobra.Command, args []string) error {
 	}
 
 	manager := loadManager()
-	serviceName := generator.ServiceName(job.ID, "sync") + ".service"
 
-	if err := manager.RunSyncNow(serviceName); err != nil {
-		return fmt.Errorf("failed to run sync job: %w", err)
+	if syncRunBackground {
+		if syncRunDryRun {
+			return fmt.Errorf("--dry-run cannot be combined with --background")
+		}
+		serviceName := generator.ServiceName(job.ID, "sync") + ".service"
+		if err := manager.RunSyncNow(serviceName); err != nil {
+			return fmt.Errorf("failed to run sync job: %w", err)
+		}
+		printInfo("Sync job '%s' started\n", job.Name)
+		return nil
+	}
+
+	command, err := generator.RunCommand(job, syncRunDryRun)
+	if err != nil {
+		return fmt.Errorf("cannot run sync job '%s': %w", job.Name, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	unit := generator.ServiceName(job.ID, "sync") + "-run"
+	if err := manager.RunTransient(ctx, unit, generator.RunProperties(job), command, os.Stdout); err != nil {
+		return fmt.Errorf("sync job '%s' failed: %w", job.Name, err)
 	}
 
-	fmt.Printf("Sync job '%s' started\n", job.Name)
+	printInfo("Sync job '%s' finished\n", job.Name)
 	return nil
 }
@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
@@ -73,6 +76,9 @@ func TestSyncCreateAndDeleteFlow(t *testing.T) {
 	_ = os.WriteFile(filepath.Join(tmp, serviceName), []byte("[Unit]\n"), 0644)
 	_ = os.WriteFile(filepath.Join(tmp, timerName), []byte("[Unit]\n"), 0644)
 
+	oldAssumeYes := assumeYes
+	defer func() { assumeYes = oldAssumeYes }()
+	assumeYes = true
 	if err := runSyncDelete(nil, []string{job.Name}); err != nil {
 		t.Fatalf("runSyncDelete failed: %v", err)
 	}
@@ -168,6 +174,149 @@ func TestSyncRun(t *testing.T) {
 	}
 }
 
+// useSyncRunJob points the loaders at a config with a single sync job and
+// resets the sync run flags after the test.
+func useSyncRunJob(t *testing.T, mgr *systemd.MockManager) {
+	t.Helper()
+	cfg := &config.Config{SyncJobs: []models.SyncJobConfig{{
+		ID: "abc123", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos",
+		SyncOptions: models.SyncOptions{Direction: "copy"},
+		Schedule:    models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+	}}}
+	useReportLoaders(t, cfg, mgr)
+	t.Cleanup(func() { syncRunDryRun, syncRunBackground = false, false })
+}
+
+func TestSyncRunWaitsForTransientRun(t *testing.T) {
+	mgr := &systemd.MockManager{RunTransientOutput: "Transferred: 3 / 3, 100%\n"}
+	useSyncRunJob(t, mgr)
+	syncRunDryRun = true
+
+	out := captureStdout(t, func() {
+		// Found by ID as well as by name
+		if err := runSyncRun(nil, []string{"abc123"}); err != nil {
+			t.Errorf("runSyncRun() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, "Transferred: 3 / 3") {
+		t.Errorf("rclone output should be streamed to stdout:\n%s", out)
+	}
+	if !mgr.Called("RunTransient", "rclone-sync-abc123-run") {
+		t.Fatalf("expected a transient run, calls = %v", mgr.Calls)
+	}
+	command := strings.Join(mgr.TransientCommand, " ")
+	if !strings.Contains(command, " copy gdrive:/Photos /data/photos") || !strings.HasSuffix(command, "--dry-run") {
+		t.Errorf("transient command = %q, want the job's copy with --dry-run", command)
+	}
+	if mgr.Called("RunSyncNow", "") {
+		t.Error("the job's service should not be started when waiting")
+	}
+}
+
+func TestSyncRunBisyncResyncsFirst(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	useSyncRunJob(t, mgr)
+	cfg, _ := loadConfig()
+	cfg.SyncJobs[0].SyncOptions = models.SyncOptions{Direction: "bisync", ResyncOnFirstRun: true}
+
+	if err := runSyncRun(nil, []string{"photos"}); err != nil {
+		t.Fatalf("runSyncRun() error = %v", err)
+	}
+	command := mgr.TransientCommand
+	if len(command) != 3 || command[0] != "/bin/sh" || command[1] != "-c" {
+		t.Fatalf("transient command = %q, want the resync and bisync steps", command)
+	}
+	if !strings.Contains(command[2], "bisync gdrive:/Photos /data/photos --config=/tmp/rclone.conf --create-empty-src-dirs --resync && touch") ||
+		!strings.HasSuffix(command[2], "} && /usr/bin/rclone bisync gdrive:/Photos /data/photos --config=/tmp/rclone.conf --create-empty-src-dirs") {
+		t.Errorf("run should resync once, then bisync: %q", command[2])
+	}
+}
+
+func TestSyncRunSerializedTakesMountLocks(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+	mgr := &systemd.MockManager{}
+	useSyncRunJob(t, mgr)
+	cfg, _ := loadConfig()
+	cfg.SyncJobs[0].RequiresMounts = []string{"m1"}
+	cfg.SyncJobs[0].SerializeWithSharedMount = true
+	cfg.SyncJobs[0].SyncOptions.Nice = 10
+
+	if err := runSyncRun(nil, []string{"photos"}); err != nil {
+		t.Fatalf("runSyncRun() error = %v", err)
+	}
+	command := strings.Join(mgr.TransientCommand, " ")
+	if !strings.HasPrefix(command, "/usr/bin/flock /run/user/1000/rclone-mount-sync-m1.lock /usr/bin/rclone copy") {
+		t.Errorf("transient command = %q, want rclone under the mount's lock", command)
+	}
+	if strings.Join(mgr.TransientProperties, " ") != "Nice=10" {
+		t.Errorf("transient properties = %q, want the service's Nice=10", mgr.TransientProperties)
+	}
+}
+
+func TestSyncRunUsesDefaultExtraFlags(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	useSyncRunJob(t, mgr)
+	cfg, _ := loadConfig()
+	cfg.Defaults.Sync.ExtraFlags = "--fast-list --exclude=*.tmp"
+
+	oldNewGenerator := newGenerator
+	t.Cleanup(func() { newGenerator = oldNewGenerator })
+	tmp := t.TempDir()
+	loadGenerator = generatorFromConfig
+	newGenerator = func(systemd.Scope) (*systemd.Generator, error) { return systemd.NewTestGenerator(tmp), nil }
+
+	if err := runSyncRun(nil, []string{"photos"}); err != nil {
+		t.Fatalf("runSyncRun() error = %v", err)
+	}
+	command := strings.Join(mgr.TransientCommand, " ")
+	if !strings.Contains(command, "--fast-list --exclude=*.tmp") {
+		t.Errorf("transient command = %q, want the default sync flags", command)
+	}
+}
+
+func TestSyncRunExitCode(t *testing.T) {
+	mgr := &systemd.MockManager{RunTransientErr: &systemd.ExitError{Unit: "rclone-sync-abc123-run", Code: 7}}
+	useSyncRunJob(t, mgr)
+
+	err := runSyncRun(nil, []string{"photos"})
+	if err == nil {
+		t.Fatal("runSyncRun() should fail when rclone fails")
+	}
+	if code := ExitCode(err); code != 7 {
+		t.Errorf("ExitCode() = %d, want rclone's exit status 7", code)
+	}
+	if strings.Contains(strings.Join(mgr.TransientCommand, " "), "--dry-run") {
+		t.Errorf("--dry-run added without the flag: %q", mgr.TransientCommand)
+	}
+}
+
+func TestSyncRunBackground(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	useSyncRunJob(t, mgr)
+	syncRunBackground = true
+
+	if err := runSyncRun(nil, []string{"photos"}); err != nil {
+		t.Fatalf("runSyncRun() error = %v", err)
+	}
+	if !mgr.Called("RunSyncNow", "rclone-sync-abc123.service") || mgr.Called("RunTransient", "") {
+		t.Errorf("--background should start the job's service, calls = %v", mgr.Calls)
+	}
+
+	syncRunDryRun = true
+	if err := runSyncRun(nil, []string{"photos"}); err == nil {
+		t.Error("--dry-run with --background should be rejected")
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	if code := ExitCode(fmt.Errorf("sync job failed: %w", &systemd.ExitError{Code: 3})); code != 3 {
+		t.Errorf("ExitCode() of a wrapped exit error = %d, want 3", code)
+	}
+	if code := ExitCode(errors.New("boom")); code != 1 {
+		t.Errorf("ExitCode() of another error = %d, want 1", code)
+	}
+}
+
 func TestSyncDeleteNotFound(t *testing.T) {
 	cfg := &config.Config{
 		Defaults: config.DefaultConfig{
@@ -259,3 +408,58 @@ func TestSyncCreateValidationMissingFields(t *testing.T) {
 		t.Fatal("expected runSyncCreate to fail when destination is missing")
 	}
 }
+
+func TestSyncCreateWithRequiredMount(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := &config.Config{
+		Mounts: []models.MountConfig{
+			{ID: "m0unt001", Name: "gdrive", Remote: "gdrive", MountPoint: "/mnt/gdrive"},
+		},
+	}
+
+	oldLoadConfig := loadConfig
+	oldLoadGenerator := loadGenerator
+	oldLoadManager := loadManager
+	defer func() {
+		loadConfig = oldLoadConfig
+		loadGenerator = oldLoadGenerator
+		loadManager = oldLoadManager
+		syncCreateMounts = nil
+		syncCreateStopMount = false
+	}()
+
+	loadConfig = func() (*config.Config, error) { return cfg, nil }
+	loadGenerator = func() (*systemd.Generator, error) { return systemd.NewTestGenerator(tmp), nil }
+	loadManager = func() systemd.ServiceManager { return &systemd.MockManager{} }
+
+	syncCreateName = "bound-sync"
+	syncCreateSource = "/mnt/gdrive/Photos"
+	syncCreateDestination = "/home/user/Backup/Photos"
+	syncCreateSchedule = "daily"
+	syncCreateEnabled = false
+	syncCreateMounts = []string{"missing"}
+	syncCreateStopMount = true
+
+	if err := runSyncCreate(nil, nil); err == nil {
+		t.Fatal("runSyncCreate should reject an unknown required mount")
+	}
+
+	syncCreateMounts = []string{"gdrive"}
+	if err := runSyncCreate(nil, nil); err != nil {
+		t.Fatalf("runSyncCreate failed: %v", err)
+	}
+
+	job := cfg.GetSyncJob("bound-sync")
+	if job == nil || len(job.RequiresMounts) != 1 || job.RequiresMounts[0] != "m0unt001" {
+		t.Fatalf("RequiresMounts = %v, want the mount ID", job)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmp, "rclone-sync-"+job.ID+".service"))
+	if err != nil {
+		t.Fatalf("failed to read service unit: %v", err)
+	}
+	if !strings.Contains(string(content), "BindsTo=rclone-mount-m0unt001.service") {
+		t.Errorf("service unit should bind to the mount:\n%s", content)
+	}
+}
@@ -0,0 +1,126 @@
+// Package clipboard copies text to the system clipboard for the TUI's copy
+// actions. It tries the terminal's OSC 52 escape sequence, then clipboard
+// tools, and reports when neither is available so the caller can show the
+// text for the user to copy by hand.
+package clipboard
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Method is how Copy placed text on the clipboard.
+type Method int
+
+const (
+	// Manual means nothing could copy the text; the caller should show it
+	// for the user to select and copy.
+	Manual Method = iota
+	// OSC52 means the terminal was asked to copy the text.
+	OSC52
+	// Tool means a clipboard program such as wl-copy or xclip copied it.
+	Tool
+)
+
+// Result describes the outcome of Copy.
+type Result struct {
+	Method Method
+	Tool   string // The program used, when Method is Tool
+	Text   string
+}
+
+// Copied reports whether the text reached the clipboard.
+func (r Result) Copied() bool {
+	return r.Method != Manual
+}
+
+// Message returns a short status line describing the result.
+func (r Result) Message() string {
+	switch r.Method {
+	case OSC52:
+		return "Copied to clipboard via the terminal"
+	case Tool:
+		return fmt.Sprintf("Copied to clipboard with %s", r.Tool)
+	default:
+		return "Clipboard unavailable; copy the text manually"
+	}
+}
+
+// Hooks into the environment, overridden by tests.
+var (
+	getenv             = os.Getenv
+	lookPath           = exec.LookPath
+	terminal io.Writer = os.Stdout
+	runTool            = func(name string, args []string, text string) error {
+		cmd := exec.Command(name, args...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+)
+
+// Copy places text on the clipboard with the first method that is available.
+func Copy(text string) Result {
+	if osc52Supported() {
+		if _, err := io.WriteString(terminal, osc52Sequence(text)); err == nil {
+			return Result{Method: OSC52, Text: text}
+		}
+	}
+	for _, tool := range tools() {
+		if _, err := lookPath(tool[0]); err != nil {
+			continue
+		}
+		if err := runTool(tool[0], tool[1:], text); err == nil {
+			return Result{Method: Tool, Tool: tool[0], Text: text}
+		}
+	}
+	return Result{Method: Manual, Text: text}
+}
+
+// osc52Terminals are TERM prefixes of terminals known to honour OSC 52.
+// Others, such as VTE-based terminals and the Linux console, ignore it
+// silently, so it is only used where it is known to work.
+var osc52Terminals = []string{"xterm-kitty", "xterm-ghostty", "alacritty", "foot", "wezterm", "contour"}
+
+// osc52Supported reports whether the terminal is known to support OSC 52.
+func osc52Supported() bool {
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "ghostty":
+		return true
+	}
+	term := getenv("TERM")
+	for _, prefix := range osc52Terminals {
+		if strings.HasPrefix(term, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// osc52Sequence returns the escape sequence that sets the clipboard to text,
+// wrapped for tmux's passthrough when running inside tmux.
+func osc52Sequence(text string) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\x07"
+	if getenv("TMUX") != "" {
+		seq = "\x1bPtmux;\x1b" + seq + "\x1b\\"
+	}
+	return seq
+}
+
+// tools returns the clipboard programs to try, with their arguments, for the
+// current session.
+func tools() [][]string {
+	var candidates [][]string
+	if getenv("WAYLAND_DISPLAY") != "" {
+		candidates = append(candidates, []string{"wl-copy"})
+	}
+	if getenv("DISPLAY") != "" {
+		candidates = append(candidates,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"})
+	}
+	return append(candidates, []string{"pbcopy"})
+}
@@ -0,0 +1,86 @@
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// fakeEnv replaces the environment hooks for one test. Tools in installed
+// are found on PATH; runs records each tool run.
+func fakeEnv(t *testing.T, env map[string]string, installed ...string) (term *strings.Builder, runs *[]string) {
+	t.Helper()
+	oldGetenv, oldLookPath, oldTerminal, oldRunTool := getenv, lookPath, terminal, runTool
+	t.Cleanup(func() { getenv, lookPath, terminal, runTool = oldGetenv, oldLookPath, oldTerminal, oldRunTool })
+
+	term = &strings.Builder{}
+	runs = &[]string{}
+	getenv = func(key string) string { return env[key] }
+	lookPath = func(name string) (string, error) {
+		for _, tool := range installed {
+			if tool == name {
+				return "/usr/bin/" + name, nil
+			}
+		}
+		return "", errors.New("not found")
+	}
+	terminal = term
+	runTool = func(name string, args []string, text string) error {
+		*runs = append(*runs, name+" "+strings.Join(args, " "))
+		return nil
+	}
+	return term, runs
+}
+
+func TestCopyOSC52(t *testing.T) {
+	term, runs := fakeEnv(t, map[string]string{"TERM": "xterm-kitty", "DISPLAY": ":0"}, "xclip")
+
+	result := Copy("/mnt/gdrive")
+	if result.Method != OSC52 || !result.Copied() {
+		t.Fatalf("Copy() = %+v, want OSC 52", result)
+	}
+	want := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte("/mnt/gdrive")) + "\x07"
+	if term.String() != want {
+		t.Errorf("terminal got %q, want %q", term.String(), want)
+	}
+	if len(*runs) != 0 {
+		t.Errorf("no tool should run when OSC 52 works, ran %v", *runs)
+	}
+}
+
+func TestCopyOSC52InsideTmux(t *testing.T) {
+	term, _ := fakeEnv(t, map[string]string{"TERM_PROGRAM": "WezTerm", "TMUX": "/tmp/tmux-1000/default,1,0"})
+
+	Copy("x")
+	if !strings.HasPrefix(term.String(), "\x1bPtmux;\x1b\x1b]52;c;") || !strings.HasSuffix(term.String(), "\x1b\\") {
+		t.Errorf("sequence should be wrapped for tmux, got %q", term.String())
+	}
+}
+
+func TestCopyFallsBackToTool(t *testing.T) {
+	term, runs := fakeEnv(t, map[string]string{"TERM": "xterm-256color", "DISPLAY": ":0"}, "xsel")
+
+	result := Copy("/mnt/gdrive")
+	if result.Method != Tool || result.Tool != "xsel" {
+		t.Fatalf("Copy() = %+v, want xsel", result)
+	}
+	if term.Len() != 0 {
+		t.Errorf("OSC 52 should not be sent to an unknown terminal, got %q", term.String())
+	}
+	if len(*runs) != 1 || (*runs)[0] != "xsel --clipboard --input" {
+		t.Errorf("runs = %v", *runs)
+	}
+	if !strings.Contains(result.Message(), "xsel") {
+		t.Errorf("Message() = %q, should name the tool", result.Message())
+	}
+}
+
+func TestCopyManual(t *testing.T) {
+	fakeEnv(t, map[string]string{"TERM": "linux"})
+
+	result := Copy("/mnt/gdrive")
+	if result.Method != Manual || result.Copied() || result.Text != "/mnt/gdrive" {
+		t.Errorf("Copy() = %+v, want a manual result with the text", result)
+	}
+}
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// backupPrefix is the file name prefix shared by all config backups.
+const backupPrefix = "config.yaml.bak"
+
+// backupFile returns the path of the rotated backup with the given index:
+// config.yaml.bak for the most recent, config.yaml.bak.1 for the one before.
+// The most recent keeps the name of the single backup earlier versions
+// kept, so it is still found and restored after an upgrade.
+func backupFile(configPath string, index int) string {
+	if index == 0 {
+		return configPath + ".bak"
+	}
+	return fmt.Sprintf("%s.bak.%d", configPath, index)
+}
+
+// rotateBackups backs up the config file at configPath as the most recent
+// backup, moving the existing ones down a place and pruning those beyond
+// depth, including any left by an earlier, larger depth.
+func rotateBackups(configPath string, depth int) error {
+	for i := depth - 1; ; i++ {
+		path := backupFile(configPath, i)
+		if _, err := os.Stat(path); err != nil {
+			break
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to prune backup: %w", err)
+		}
+	}
+
+	for i := depth - 2; i >= 0; i-- {
+		path := backupFile(configPath, i)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		if err := os.Rename(path, backupFile(configPath, i+1)); err != nil {
+			return fmt.Errorf("failed to rotate backup: %w", err)
+		}
+	}
+
+	return createBackup(configPath, backupFile(configPath, 0))
+}
+
+// BackupInfo describes a config backup file.
+type BackupInfo struct {
+	Path    string
+	Name    string
+	ModTime time.Time
+	Size    int64
+}
+
+// ListBackups returns the config backups in the config directory, newest first.
+func ListBackups() ([]BackupInfo, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config directory: %w", err)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(configDir, backupPrefix+"*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	backups := make([]BackupInfo, 0, len(matches))
+	for _, path := range matches {
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		backups = append(backups, BackupInfo{
+			Path:    path,
+			Name:    info.Name(),
+			ModTime: info.ModTime(),
+			Size:    info.Size(),
+		})
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].ModTime.After(backups[j].ModTime)
+	})
+	return backups, nil
+}
+
+// RestoreBackup replaces the current config file with the given backup.
+// The backup itself is kept so the restore can be repeated.
+func RestoreBackup(path string) error {
+	configPath, err := checkBackupPath(path)
+	if err != nil {
+		return err
+	}
+
+	if err := createBackup(path, configPath); err != nil {
+		return fmt.Errorf("failed to restore from backup: %w", err)
+	}
+	return nil
+}
+
+// DeleteBackup removes the given backup file.
+func DeleteBackup(path string) error {
+	if _, err := checkBackupPath(path); err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to delete backup: %w", err)
+	}
+	return nil
+}
+
+// DiffBackup compares a backup with the current config file. Each returned
+// line is prefixed with "- " (only in the backup), "+ " (only in the current
+// config) or "  " (unchanged).
+func DiffBackup(path string) ([]string, error) {
+	configPath, err := checkBackupPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup: %w", err)
+	}
+	current, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	return diffLines(splitLines(string(backup)), splitLines(string(current))), nil
+}
+
+// checkBackupPath verifies that path names a backup in the config directory
+// and returns the path of the config file it belongs to.
+func checkBackupPath(path string) (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+
+	if filepath.Dir(path) != configDir || !strings.HasPrefix(filepath.Base(path), backupPrefix) {
+		return "", fmt.Errorf("not a config backup: %s", path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("no backup file found")
+		}
+		return "", err
+	}
+
+	return filepath.Join(configDir, "config.yaml"), nil
+}
+
+// splitLines splits text into lines without a trailing empty line.
+func splitLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// diffLines produces a line diff of a and b from their longest common subsequence.
+func diffLines(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+ "+b[j])
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setupBackupDir points the config directory at a temp dir holding a config
+// file and two backups, the ".bak" one being the newest.
+func setupBackupDir(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	t.Cleanup(func() { getConfigDir = origGetConfigDir })
+
+	files := map[string]string{
+		"config.yaml":              "version: 1\nmounts: []\n",
+		"config.yaml.bak":          "version: 1\nmounts:\n- name: old\n",
+		"config.yaml.bak.20240101": "version: 0\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	older := time.Now().Add(-48 * time.Hour)
+	if err := os.Chtimes(filepath.Join(tmpDir, "config.yaml.bak.20240101"), older, older); err != nil {
+		t.Fatalf("failed to set mtime: %v", err)
+	}
+	return tmpDir
+}
+
+func TestListBackups(t *testing.T) {
+	tmpDir := setupBackupDir(t)
+
+	backups, err := ListBackups()
+	if err != nil {
+		t.Fatalf("ListBackups() error = %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("ListBackups() returned %d backups, want 2", len(backups))
+	}
+	if backups[0].Name != "config.yaml.bak" || backups[1].Name != "config.yaml.bak.20240101" {
+		t.Errorf("ListBackups() order = %s, %s; want newest first", backups[0].Name, backups[1].Name)
+	}
+	if backups[0].Path != filepath.Join(tmpDir, "config.yaml.bak") {
+		t.Errorf("Path = %q", backups[0].Path)
+	}
+	if backups[1].Size != int64(len("version: 0\n")) {
+		t.Errorf("Size = %d", backups[1].Size)
+	}
+}
+
+func TestListBackups_None(t *testing.T) {
+	origGetConfigDir := getConfigDir
+	tmpDir := t.TempDir()
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	backups, err := ListBackups()
+	if err != nil {
+		t.Fatalf("ListBackups() error = %v", err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("ListBackups() = %v, want none", backups)
+	}
+}
+
+func TestRestoreBackup(t *testing.T) {
+	tmpDir := setupBackupDir(t)
+	backupPath := filepath.Join(tmpDir, "config.yaml.bak")
+
+	if err := RestoreBackup(backupPath); err != nil {
+		t.Fatalf("RestoreBackup() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "config.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+	if !strings.Contains(string(content), "name: old") {
+		t.Errorf("config should hold the backup content, got %q", content)
+	}
+	if _, err := os.Stat(backupPath); err != nil {
+		t.Error("RestoreBackup() should keep the backup file")
+	}
+}
+
+func TestRestoreBackup_RejectsOtherFiles(t *testing.T) {
+	tmpDir := setupBackupDir(t)
+
+	tests := []string{
+		filepath.Join(tmpDir, "config.yaml"),
+		filepath.Join(t.TempDir(), "config.yaml.bak"),
+		filepath.Join(tmpDir, "config.yaml.bak.missing"),
+	}
+	for _, path := range tests {
+		if err := RestoreBackup(path); err == nil {
+			t.Errorf("RestoreBackup(%q) should fail", path)
+		}
+		if err := DeleteBackup(path); err == nil {
+			t.Errorf("DeleteBackup(%q) should fail", path)
+		}
+	}
+}
+
+func TestDeleteBackup(t *testing.T) {
+	tmpDir := setupBackupDir(t)
+	backupPath := filepath.Join(tmpDir, "config.yaml.bak.20240101")
+
+	if err := DeleteBackup(backupPath); err != nil {
+		t.Fatalf("DeleteBackup() error = %v", err)
+	}
+	if _, err := os.Stat(backupPath); !os.IsNotExist(err) {
+		t.Error("DeleteBackup() should remove the file")
+	}
+}
+
+func TestDiffBackup(t *testing.T) {
+	tmpDir := setupBackupDir(t)
+
+	diff, err := DiffBackup(filepath.Join(tmpDir, "config.yaml.bak"))
+	if err != nil {
+		t.Fatalf("DiffBackup() error = %v", err)
+	}
+
+	want := []string{
+		"  version: 1",
+		"- mounts:",
+		"- - name: old",
+		"+ mounts: []",
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("DiffBackup() = %q, want %q", diff, want)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want []string
+	}{
+		{"identical", []string{"a", "b"}, []string{"a", "b"}, []string{"  a", "  b"}},
+		{"added", nil, []string{"a"}, []string{"+ a"}},
+		{"removed", []string{"a"}, nil, []string{"- a"}},
+		{"changed middle", []string{"a", "b", "c"}, []string{"a", "x", "c"}, []string{"  a", "- b", "+ x", "  c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffLines(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/dtg01100/rclone-mount-sync/internal/actionlog"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
 	"github.com/dtg01100/rclone-mount-sync/pkg/utils"
 	"github.com/google/uuid"
@@ -35,16 +37,68 @@ type ExportData struct {
 	Mounts   []models.MountConfig   `json:"mounts" yaml:"mounts"`
 	SyncJobs []models.SyncJobConfig `json:"sync_jobs" yaml:"sync_jobs"`
 	Exported string                 `json:"exported" yaml:"exported"`
+
+	// Portable exports replace home-relative paths with $HOME and carry
+	// the settings that make sense on another machine.
+	Portable bool              `json:"portable,omitempty" yaml:"portable,omitempty"`
+	Settings *PortableSettings `json:"settings,omitempty" yaml:"settings,omitempty"`
+
+	// Sections lists what a selective export holds, e.g. ["mounts"]. A
+	// replace import only replaces those sections; empty means everything.
+	Sections []string `json:"sections,omitempty" yaml:"sections,omitempty"`
+}
+
+// Export sections, as listed in ExportData.Sections.
+const (
+	SectionMounts   = "mounts"
+	SectionSyncJobs = "sync_jobs"
+	SectionSettings = "settings"
+)
+
+// ExportOptions selects what ExportConfigFiltered writes.
+type ExportOptions struct {
+	IncludeMounts   bool
+	IncludeSyncJobs bool
+	IncludeSettings bool
+
+	// Names limits the exported mounts and sync jobs to those with these
+	// names; empty exports all of the included kinds.
+	Names []string
+}
+
+// includes reports whether the export data holds the given section.
+func (d ExportData) includes(section string) bool {
+	if len(d.Sections) == 0 {
+		return true
+	}
+	for _, s := range d.Sections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// PortableSettings holds the settings included in a portable export.
+// Machine-specific settings such as the rclone binary path are left out.
+type PortableSettings struct {
+	DefaultMountDir     string `json:"default_mount_dir,omitempty" yaml:"default_mount_dir,omitempty"`
+	AutoRefreshInterval string `json:"auto_refresh_interval,omitempty" yaml:"auto_refresh_interval,omitempty"`
 }
 
 // Config represents the application configuration.
 type Config struct {
 	mu       sync.RWMutex
+	disk     diskState
 	Version  string                 `mapstructure:"version"`
 	Mounts   []models.MountConfig   `mapstructure:"mounts"`
 	SyncJobs []models.SyncJobConfig `mapstructure:"sync_jobs"`
 	Settings Settings               `mapstructure:"settings"`
 	Defaults DefaultConfig          `mapstructure:"defaults"`
+
+	// Keybindings moves TUI actions to other keys, e.g. "stop": "K".
+	// Actions not listed keep their default keys.
+	Keybindings map[string]string `mapstructure:"keybindings"`
 }
 
 // Settings holds application-wide settings.
@@ -53,6 +107,96 @@ type Settings struct {
 	DefaultMountDir  string   `mapstructure:"default_mount_dir"`
 	Editor           string   `mapstructure:"editor"`
 	RecentPaths      []string `mapstructure:"recent_paths"`
+
+	// AutoRefreshInterval is how often status screens refresh when
+	// auto-refresh is toggled on (a Go duration such as "30s").
+	AutoRefreshInterval string `mapstructure:"auto_refresh_interval"`
+
+	// FixedWidth caps the width used to render the list screens, keeping
+	// columns steady on terminals that report fluctuating sizes (0 = auto).
+	FixedWidth int `mapstructure:"fixed_width"`
+
+	// WatchConfig reloads config.yaml while the TUI runs when another
+	// program changes it, and offers to regenerate the unit files.
+	WatchConfig bool `mapstructure:"watch_config"`
+
+	// ActionLog records config saves, imports, unit writes and service
+	// actions to actionlog.FileName in the config directory.
+	ActionLog bool `mapstructure:"action_log"`
+
+	// HideDisabled leaves disabled mounts and sync jobs out of their lists
+	// in the TUI; toggled with H on either screen.
+	HideDisabled bool `mapstructure:"hide_disabled"`
+
+	// BackupCount is how many previous versions of config.yaml a save
+	// keeps: config.yaml.bak is the newest, then config.yaml.bak.1 and on.
+	BackupCount int `mapstructure:"backup_count"`
+
+	// RemoteCheckTimeout is how long the startup checks and doctor wait
+	// for each configured remote to answer (a Go duration such as "10s").
+	RemoteCheckTimeout string `mapstructure:"remote_check_timeout"`
+
+	// Scope is where units are installed: "user" (the default) for the
+	// user's systemd instance, or "system" for /etc/systemd/system, which
+	// needs root.
+	Scope string `mapstructure:"scope"`
+
+	// Theme picks the TUI colors: "dark" (the default), "light" or "none".
+	// The NO_COLOR environment variable forces none.
+	Theme string `mapstructure:"theme"`
+}
+
+// DefaultBackupCount is used when BackupCount is unset or below one.
+const DefaultBackupCount = 5
+
+// BackupDepth returns BackupCount, falling back to DefaultBackupCount when
+// it is below one so a failed edit can always be rolled back.
+func (s Settings) BackupDepth() int {
+	if s.BackupCount < 1 {
+		return DefaultBackupCount
+	}
+	return s.BackupCount
+}
+
+// DefaultAutoRefreshInterval is used when AutoRefreshInterval is unset or invalid.
+const DefaultAutoRefreshInterval = 30 * time.Second
+
+// minAutoRefreshInterval keeps auto-refresh from hammering systemctl.
+const minAutoRefreshInterval = time.Second
+
+// AutoRefreshDuration parses AutoRefreshInterval, falling back to
+// DefaultAutoRefreshInterval when it is empty, invalid or below one second.
+func (s Settings) AutoRefreshDuration() time.Duration {
+	d, err := time.ParseDuration(s.AutoRefreshInterval)
+	if err != nil || d < minAutoRefreshInterval {
+		return DefaultAutoRefreshInterval
+	}
+	return d
+}
+
+// DefaultRemoteCheckTimeout is used when RemoteCheckTimeout is unset or invalid.
+const DefaultRemoteCheckTimeout = 10 * time.Second
+
+// RemoteCheckDuration parses RemoteCheckTimeout, falling back to
+// DefaultRemoteCheckTimeout when it is empty, invalid or not positive.
+func (s Settings) RemoteCheckDuration() time.Duration {
+	d, err := time.ParseDuration(s.RemoteCheckTimeout)
+	if err != nil || d <= 0 {
+		return DefaultRemoteCheckTimeout
+	}
+	return d
+}
+
+// validScopes are the values Scope accepts; empty is the user scope.
+var validScopes = map[string]bool{"": true, "user": true, "system": true}
+
+// RenderWidth returns the width the list screens should render at for a
+// terminal of termWidth columns.
+func (s Settings) RenderWidth(termWidth int) int {
+	if s.FixedWidth > 0 && s.FixedWidth < termWidth {
+		return s.FixedWidth
+	}
+	return termWidth
 }
 
 // DefaultConfig holds default settings for mounts and sync jobs.
@@ -66,13 +210,40 @@ type MountDefaults struct {
 	LogLevel     string `mapstructure:"log_level"`
 	VFSCacheMode string `mapstructure:"vfs_cache_mode"`
 	BufferSize   string `mapstructure:"buffer_size"`
+	ExtraFlags   string `mapstructure:"extra_flags"` // Added to every mount unit
+
+	// VFSCacheMaxSize and VFSCacheMaxAge limit the VFS cache of new mounts
+	// (e.g., "10G" and "24h"); empty leaves rclone's defaults.
+	VFSCacheMaxSize string `mapstructure:"vfs_cache_max_size"`
+	VFSCacheMaxAge  string `mapstructure:"vfs_cache_max_age"`
+
+	// DirCacheTime and AttrTimeout are how long new mounts cache directory
+	// listings and file attributes (e.g., "1h" and "10s"); empty leaves
+	// rclone's defaults.
+	DirCacheTime string `mapstructure:"dir_cache_time"`
+	AttrTimeout  string `mapstructure:"attr_timeout"`
 }
 
 // SyncDefaults holds default sync job settings.
 type SyncDefaults struct {
-	LogLevel  string `mapstructure:"log_level"`
-	Transfers int    `mapstructure:"transfers"`
-	Checkers  int    `mapstructure:"checkers"`
+	LogLevel   string `mapstructure:"log_level"`
+	Transfers  int    `mapstructure:"transfers"`
+	Checkers   int    `mapstructure:"checkers"`
+	ExtraFlags string `mapstructure:"extra_flags"` // Added to every sync unit
+}
+
+// configureActionLog turns the action log on or off to match s.
+func configureActionLog(s Settings) {
+	if !s.ActionLog {
+		actionlog.Configure("")
+		return
+	}
+	configDir, err := getConfigDir()
+	if err != nil {
+		actionlog.Configure("")
+		return
+	}
+	actionlog.Configure(filepath.Join(configDir, actionlog.FileName))
 }
 
 // AppConfigDir returns the application configuration directory.
@@ -80,6 +251,8 @@ const appName = "rclone-mount-sync"
 
 // Load reads the configuration from the default config file location.
 // If the config file doesn't exist, it returns a new Config with defaults.
+// A config file from an older schema version is migrated and written back,
+// keeping the previous file as a backup.
 func Load() (*Config, error) {
 	v := viper.New()
 
@@ -89,6 +262,8 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to get config directory: %w", err)
 	}
 
+	removeStaleTempFiles(configDir)
+
 	v.SetConfigName("config")
 	v.SetConfigType("yaml")
 	v.AddConfigPath(configDir)
@@ -103,13 +278,28 @@ func Load() (*Config, error) {
 			return nil, fmt.Errorf("failed to read config file: %w", err)
 		}
 		// Config file not found, create a new one with defaults
-		return newConfigWithDefaults(), nil
+		cfg := newConfigWithDefaults()
+		configureActionLog(cfg.Settings)
+		return cfg, nil
+	}
+
+	if migrated, err := migrateConfigFile(v.ConfigFileUsed()); err != nil {
+		return nil, err
+	} else if migrated {
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read migrated config file: %w", err)
+		}
 	}
 
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	if !validScopes[cfg.Settings.Scope] {
+		return nil, fmt.Errorf("invalid settings.scope %q (use user or system)", cfg.Settings.Scope)
+	}
+	cfg.disk.record(v.ConfigFileUsed())
+	configureActionLog(cfg.Settings)
 
 	return &cfg, nil
 }
@@ -143,10 +333,21 @@ func (c *Config) Reload() error {
 		return fmt.Errorf("failed to read config file: %w", err)
 	}
 
+	if migrated, err := migrateConfigFile(v.ConfigFileUsed()); err != nil {
+		return err
+	} else if migrated {
+		if err := v.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed to read migrated config file: %w", err)
+		}
+	}
+
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return fmt.Errorf("failed to parse config: %w", err)
 	}
+	if !validScopes[cfg.Settings.Scope] {
+		return fmt.Errorf("invalid settings.scope %q (use user or system)", cfg.Settings.Scope)
+	}
 
 	// Update the existing config struct
 	c.Version = cfg.Version
@@ -154,6 +355,9 @@ func (c *Config) Reload() error {
 	c.SyncJobs = cfg.SyncJobs
 	c.Settings = cfg.Settings
 	c.Defaults = cfg.Defaults
+	c.Keybindings = cfg.Keybindings
+	c.disk.record(v.ConfigFileUsed())
+	configureActionLog(c.Settings)
 
 	return nil
 }
@@ -162,6 +366,18 @@ func (c *Config) Reload() error {
 // It uses an atomic write pattern: writes to a temp file first, then renames.
 // A backup of the existing config is created before overwriting.
 func (c *Config) Save() error {
+	err := c.save()
+
+	c.mu.RLock()
+	configureActionLog(c.Settings)
+	c.mu.RUnlock()
+	path, _ := ConfigPath()
+	actionlog.Record("save-config", path, err)
+	return err
+}
+
+// save does the work of Save.
+func (c *Config) save() error {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
@@ -175,10 +391,9 @@ func (c *Config) Save() error {
 	}
 
 	configPath := filepath.Join(configDir, "config.yaml")
-	backupPath := configPath + ".bak"
 
 	if _, err := os.Stat(configPath); err == nil {
-		if err := createBackup(configPath, backupPath); err != nil {
+		if err := rotateBackups(configPath, c.Settings.BackupDepth()); err != nil {
 			return fmt.Errorf("failed to create backup: %w", err)
 		}
 	}
@@ -195,14 +410,32 @@ func (c *Config) Save() error {
 	v.Set("settings.default_mount_dir", c.Settings.DefaultMountDir)
 	v.Set("settings.editor", c.Settings.Editor)
 	v.Set("settings.recent_paths", c.Settings.RecentPaths)
+	v.Set("settings.auto_refresh_interval", c.Settings.AutoRefreshInterval)
+	v.Set("settings.fixed_width", c.Settings.FixedWidth)
+	v.Set("settings.watch_config", c.Settings.WatchConfig)
+	v.Set("settings.action_log", c.Settings.ActionLog)
+	v.Set("settings.hide_disabled", c.Settings.HideDisabled)
+	v.Set("settings.backup_count", c.Settings.BackupCount)
+	v.Set("settings.remote_check_timeout", c.Settings.RemoteCheckTimeout)
+	v.Set("settings.scope", c.Settings.Scope)
+	v.Set("settings.theme", c.Settings.Theme)
 	v.Set("defaults.mount.log_level", c.Defaults.Mount.LogLevel)
 	v.Set("defaults.mount.vfs_cache_mode", c.Defaults.Mount.VFSCacheMode)
 	v.Set("defaults.mount.buffer_size", c.Defaults.Mount.BufferSize)
+	v.Set("defaults.mount.extra_flags", c.Defaults.Mount.ExtraFlags)
+	v.Set("defaults.mount.vfs_cache_max_size", c.Defaults.Mount.VFSCacheMaxSize)
+	v.Set("defaults.mount.vfs_cache_max_age", c.Defaults.Mount.VFSCacheMaxAge)
+	v.Set("defaults.mount.dir_cache_time", c.Defaults.Mount.DirCacheTime)
+	v.Set("defaults.mount.attr_timeout", c.Defaults.Mount.AttrTimeout)
 	v.Set("defaults.sync.log_level", c.Defaults.Sync.LogLevel)
 	v.Set("defaults.sync.transfers", c.Defaults.Sync.Transfers)
 	v.Set("defaults.sync.checkers", c.Defaults.Sync.Checkers)
+	v.Set("defaults.sync.extra_flags", c.Defaults.Sync.ExtraFlags)
+	if len(c.Keybindings) > 0 {
+		v.Set("keybindings", c.Keybindings)
+	}
 
-	tempPath := configPath + ".tmp.yaml"
+	tempPath := configPath + tempFileSuffix
 
 	if err := v.WriteConfigAs(tempPath); err != nil {
 		os.Remove(tempPath)
@@ -213,22 +446,62 @@ func (c *Config) Save() error {
 		os.Remove(tempPath)
 		return fmt.Errorf("failed to rename temp file: %w", err)
 	}
+	c.disk.record(configPath)
 
 	return nil
 }
 
-// RestoreFromBackup restores the configuration from the backup file.
-// Returns an error if no backup exists.
-func RestoreFromBackup() error {
+// tempFileSuffix ends the name of the temp file a save writes before renaming
+// it over the config.
+const tempFileSuffix = ".tmp.yaml"
+
+// staleTempAge is how old a temp file must be to count as left over from an
+// interrupted save; a younger one may belong to a save still in progress.
+var staleTempAge = 10 * time.Minute
+
+// removeStaleTempFiles removes temp files that saves interrupted by a crash
+// left in dir, so they don't confuse users browsing the config directory.
+// Only files named like a save's temp file and older than staleTempAge are
+// removed. It returns the paths that were removed.
+func removeStaleTempFiles(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var removed []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasSuffix(entry.Name(), tempFileSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < staleTempAge {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		err = os.Remove(path)
+		actionlog.Record("remove-stale-temp", path, err)
+		if err == nil {
+			removed = append(removed, path)
+		}
+	}
+	return removed
+}
+
+// RestoreFromBackup restores the configuration from a backup, consuming it.
+// Index 0 is the most recent backup, 1 the one before it, and so on; the
+// older backups move up to fill the gap. Returns an error if the backup
+// doesn't exist.
+func RestoreFromBackup(index int) error {
 	configDir, err := getConfigDir()
 	if err != nil {
 		return fmt.Errorf("failed to get config directory: %w", err)
 	}
 
 	configPath := filepath.Join(configDir, "config.yaml")
-	backupPath := configPath + ".bak"
+	backupPath := backupFile(configPath, index)
 
-	if _, err := os.Stat(backupPath); os.IsNotExist(err) {
+	if _, err := os.Stat(backupPath); index < 0 || os.IsNotExist(err) {
 		return fmt.Errorf("no backup file found")
 	}
 
@@ -236,29 +509,43 @@ func RestoreFromBackup() error {
 		return fmt.Errorf("failed to restore from backup: %w", err)
 	}
 
+	for i := index + 1; ; i++ {
+		older := backupFile(configPath, i)
+		if _, err := os.Stat(older); err != nil {
+			break
+		}
+		if err := os.Rename(older, backupFile(configPath, i-1)); err != nil {
+			return fmt.Errorf("failed to renumber backups: %w", err)
+		}
+	}
+
 	return nil
 }
 
-// HasBackup returns true if a backup file exists.
-func HasBackup() (bool, error) {
+// HasBackup returns how many rotated backups exist, zero when there are none.
+func HasBackup() (int, error) {
 	configDir, err := getConfigDir()
 	if err != nil {
-		return false, fmt.Errorf("failed to get config directory: %w", err)
+		return 0, fmt.Errorf("failed to get config directory: %w", err)
 	}
 
-	backupPath := filepath.Join(configDir, "config.yaml.bak")
-	_, err = os.Stat(backupPath)
-	if os.IsNotExist(err) {
-		return false, nil
-	}
-	if err != nil {
-		return false, err
+	configPath := filepath.Join(configDir, "config.yaml")
+	count := 0
+	for ; ; count++ {
+		_, err := os.Stat(backupFile(configPath, count))
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
 	}
-	return true, nil
+	return count, nil
 }
 
-// createBackup creates a backup of the existing config file.
-// It overwrites any existing backup to keep only the most recent one.
+// createBackup copies the config file at configPath to backupPath,
+// replacing any file already there. rotateBackups moves the older backups
+// out of the way first.
 func createBackup(configPath, backupPath string) error {
 	srcFile, err := os.Open(configPath)
 	if err != nil {
@@ -315,9 +602,7 @@ func (c *Config) AddMount(mount models.MountConfig) error {
 		return fmt.Errorf("mount point is required")
 	}
 
-	if mount.RemotePath == "" {
-		mount.RemotePath = "/"
-	}
+	mount.RemotePath = models.NormalizeRemotePath(mount.RemotePath)
 
 	// Generate ID if not provided
 	if mount.ID == "" {
@@ -329,11 +614,15 @@ func (c *Config) AddMount(mount models.MountConfig) error {
 	mount.CreatedAt = now
 	mount.ModifiedAt = now
 
-	// Check for duplicate name
+	// Check for duplicate name or mount point
+	mountPoint := cleanLocalPath(mount.MountPoint)
 	for _, m := range c.Mounts {
 		if m.Name == mount.Name {
 			return fmt.Errorf("mount with name %q already exists", mount.Name)
 		}
+		if cleanLocalPath(m.MountPoint) == mountPoint {
+			return fmt.Errorf("mount point %s already used by %q", mountPoint, m.Name)
+		}
 	}
 
 	c.Mounts = append(c.Mounts, mount)
@@ -384,6 +673,9 @@ func (c *Config) AddSyncJob(job models.SyncJobConfig) error {
 	if strings.TrimSpace(job.SyncOptions.Direction) == "" {
 		job.SyncOptions.Direction = "sync"
 	}
+	if err := c.validateRequiredMounts(job); err != nil {
+		return err
+	}
 
 	// Generate ID if not provided
 	if job.ID == "" {
@@ -395,17 +687,75 @@ func (c *Config) AddSyncJob(job models.SyncJobConfig) error {
 	job.CreatedAt = now
 	job.ModifiedAt = now
 
-	// Check for duplicate name
+	// Check for duplicate name or overlapping destination
+	destRemote, destPath := SplitLocation(job.Destination)
 	for _, j := range c.SyncJobs {
 		if j.Name == job.Name {
 			return fmt.Errorf("sync job with name %q already exists", job.Name)
 		}
+		if remote, path := SplitLocation(j.Destination); remote == destRemote && PathsOverlap(path, destPath) {
+			return fmt.Errorf("destination %s overlaps %s used by %q", job.Destination, j.Destination, j.Name)
+		}
 	}
 
 	c.SyncJobs = append(c.SyncJobs, job)
 	return nil
 }
 
+// cleanLocalPath expands a leading ~ and cleans a local path so that
+// spellings of the same directory compare equal.
+func cleanLocalPath(path string) string {
+	return filepath.Clean(utils.ExpandHome(strings.TrimSpace(path)))
+}
+
+// SplitLocation splits a sync location into its remote name and a cleaned
+// path. Local paths have an empty remote.
+func SplitLocation(location string) (remote, path string) {
+	location = strings.TrimSpace(location)
+	if idx := strings.Index(location, ":"); idx > 0 {
+		return location[:idx], filepath.Clean("/" + location[idx+1:])
+	}
+	return "", cleanLocalPath(location)
+}
+
+// PathsOverlap reports whether a and b are the same path or one contains the other.
+func PathsOverlap(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return strings.HasPrefix(a, strings.TrimSuffix(b, "/")+"/") ||
+		strings.HasPrefix(b, strings.TrimSuffix(a, "/")+"/")
+}
+
+// ValidateRequiredMounts checks that every mount a sync job requires exists.
+func (c *Config) ValidateRequiredMounts(job models.SyncJobConfig) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.validateRequiredMounts(job)
+}
+
+func (c *Config) validateRequiredMounts(job models.SyncJobConfig) error {
+	if job.StopWithMount && len(job.RequiresMounts) == 0 {
+		return fmt.Errorf("stop with mount needs at least one required mount")
+	}
+	if job.SerializeWithSharedMount && len(job.RequiresMounts) == 0 {
+		return fmt.Errorf("serialize with shared mount needs at least one required mount")
+	}
+	for _, id := range job.RequiresMounts {
+		found := false
+		for _, m := range c.Mounts {
+			if m.ID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("required mount %q not found", id)
+		}
+	}
+	return nil
+}
+
 // RemoveSyncJob removes a sync job configuration by name.
 func (c *Config) RemoveSyncJob(name string) error {
 	c.mu.Lock()
@@ -433,6 +783,157 @@ func (c *Config) GetSyncJob(name string) *models.SyncJobConfig {
 	return nil
 }
 
+// SetAllAutoStart turns auto-start on or off for every mount and sync job
+// and returns how many of each changed. Enabled is left alone, so an item
+// the user disabled stays disabled; the caller enables or disables the
+// units themselves.
+func (c *Config) SetAllAutoStart(on bool) (mounts, syncJobs int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for i := range c.Mounts {
+		m := &c.Mounts[i]
+		if m.AutoStart != on {
+			m.AutoStart, m.ModifiedAt = on, now
+			mounts++
+		}
+	}
+	for i := range c.SyncJobs {
+		j := &c.SyncJobs[i]
+		if j.AutoStart != on {
+			j.AutoStart, j.ModifiedAt = on, now
+			syncJobs++
+		}
+	}
+	return mounts, syncJobs
+}
+
+// ReferencedRemotes returns the names of the rclone remotes used by the
+// mounts and by the sources and destinations of the sync jobs, sorted and
+// without duplicates. Local paths and URLs are left out.
+func (c *Config) ReferencedRemotes() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	for _, m := range c.Mounts {
+		if m.Remote != "" {
+			seen[strings.TrimSuffix(m.Remote, ":")] = true
+		}
+	}
+	for _, j := range c.SyncJobs {
+		for _, location := range []string{j.Source, j.Destination} {
+			if remote, ok := remoteOf(location); ok {
+				seen[remote] = true
+			}
+		}
+	}
+
+	remotes := make([]string, 0, len(seen))
+	for remote := range seen {
+		remotes = append(remotes, remote)
+	}
+	sort.Strings(remotes)
+	return remotes
+}
+
+// RemoteUsers returns the names of the mounts and of the sync jobs that use
+// remote, in config order.
+func (c *Config) RemoteUsers(remote string) (mounts, syncJobs []string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, m := range c.Mounts {
+		if strings.TrimSuffix(m.Remote, ":") == remote {
+			mounts = append(mounts, m.Name)
+		}
+	}
+	for _, j := range c.SyncJobs {
+		for _, location := range []string{j.Source, j.Destination} {
+			if r, ok := remoteOf(location); ok && r == remote {
+				syncJobs = append(syncJobs, j.Name)
+				break
+			}
+		}
+	}
+	return mounts, syncJobs
+}
+
+// RenameRemote points the mounts and sync jobs using oldName at newName and
+// returns how many of each changed. The caller saves the config and
+// rewrites the units.
+func (c *Config) RenameRemote(oldName, newName string) (mounts, syncJobs int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for i := range c.Mounts {
+		m := &c.Mounts[i]
+		if strings.TrimSuffix(m.Remote, ":") == oldName {
+			m.Remote = newName + strings.TrimPrefix(m.Remote, oldName)
+			m.ModifiedAt = now
+			mounts++
+		}
+	}
+	for i := range c.SyncJobs {
+		j := &c.SyncJobs[i]
+		changed := false
+		for _, location := range []*string{&j.Source, &j.Destination} {
+			if r, ok := remoteOf(*location); ok && r == oldName {
+				*location = newName + strings.TrimPrefix(*location, oldName)
+				changed = true
+			}
+		}
+		if changed {
+			j.ModifiedAt = now
+			syncJobs++
+		}
+	}
+	return mounts, syncJobs
+}
+
+// ReferencedFlags returns the extra flags, without their values, added to
+// any mount or sync unit, sorted and without duplicates.
+func (c *Config) ReferencedFlags() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	extraArgs := []string{c.Defaults.Mount.ExtraFlags, c.Defaults.Sync.ExtraFlags}
+	for _, m := range c.Mounts {
+		extraArgs = append(extraArgs, m.MountOptions.ExtraArgs)
+	}
+	for _, j := range c.SyncJobs {
+		extraArgs = append(extraArgs, j.SyncOptions.ExtraArgs)
+	}
+
+	seen := make(map[string]bool)
+	for _, args := range extraArgs {
+		for _, field := range strings.Fields(args) {
+			if name, _, _ := strings.Cut(field, "="); strings.HasPrefix(name, "--") && len(name) > 2 {
+				seen[name] = true
+			}
+		}
+	}
+
+	flags := make([]string, 0, len(seen))
+	for flag := range seen {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	return flags
+}
+
+// remoteOf returns the remote name of a remote:path location. Local paths,
+// URLs and on-the-fly remotes such as ":local:" have none.
+func remoteOf(location string) (string, bool) {
+	remote, path, ok := strings.Cut(location, ":")
+	if !ok || remote == "" || strings.ContainsAny(remote, `/\`) || strings.HasPrefix(path, "//") {
+		return "", false
+	}
+	return remote, true
+}
+
 // AddRecentPath adds a path to the front of the recent paths list,
 // removes duplicates, and keeps only the 10 most recent paths.
 func (c *Config) AddRecentPath(path string) {
@@ -457,8 +958,18 @@ func (c *Config) AddRecentPath(path string) {
 	c.Settings.RecentPaths = result
 }
 
+// configHome is the base directory set with SetConfigHome, in place of the
+// user config directory.
+var (
+	configHomeMu sync.RWMutex
+	configHome   string
+)
+
 // getConfigDir returns the configuration directory path.
 var getConfigDir = func() (string, error) {
+	if home := ConfigHome(); home != "" {
+		return filepath.Join(home, appName), nil
+	}
 	configDir, err := os.UserConfigDir()
 	if err != nil {
 		return "", err
@@ -466,32 +977,85 @@ var getConfigDir = func() (string, error) {
 	return filepath.Join(configDir, appName), nil
 }
 
+// ConfigPath returns the path of the config file for the active config directory.
+func ConfigPath() (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+	return filepath.Join(configDir, "config.yaml"), nil
+}
+
+// ConfigHome returns the base directory set with SetConfigHome, or "" when
+// the config is found in the user config directory.
+func ConfigHome() string {
+	configHomeMu.RLock()
+	defer configHomeMu.RUnlock()
+	return configHome
+}
+
+// SetConfigHome points config resolution at a different base directory,
+// in place of the user config directory. A path to an existing file is
+// resolved to its parent directory, and an empty path goes back to the user
+// config directory. XDG_CONFIG_HOME is left alone, so the systemd user unit
+// directory stays where systemd reads it.
+func SetConfigHome(path string) error {
+	resolvedDir := path
+	if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+		resolvedDir = filepath.Dir(path)
+	}
+
+	configHomeMu.Lock()
+	defer configHomeMu.Unlock()
+	configHome = resolvedDir
+	return nil
+}
+
 // setDefaults sets default values in viper.
 func setDefaults(v *viper.Viper) {
-	v.SetDefault("version", "1.0")
+	v.SetDefault("version", CurrentVersion)
 	v.SetDefault("settings.rclone_binary_path", "")
 	v.SetDefault("settings.default_mount_dir", "~/mnt")
 	v.SetDefault("settings.editor", "")
 	v.SetDefault("settings.recent_paths", []string{})
+	v.SetDefault("settings.auto_refresh_interval", "30s")
+	v.SetDefault("settings.fixed_width", 0)
+	v.SetDefault("settings.watch_config", false)
+	v.SetDefault("settings.action_log", false)
+	v.SetDefault("settings.hide_disabled", false)
+	v.SetDefault("settings.backup_count", DefaultBackupCount)
+	v.SetDefault("settings.remote_check_timeout", "10s")
+	v.SetDefault("settings.scope", "user")
+	v.SetDefault("settings.theme", "dark")
 	v.SetDefault("defaults.mount.log_level", "INFO")
 	v.SetDefault("defaults.mount.vfs_cache_mode", "full")
 	v.SetDefault("defaults.mount.buffer_size", "16M")
+	v.SetDefault("defaults.mount.extra_flags", "")
+	v.SetDefault("defaults.mount.vfs_cache_max_size", "")
+	v.SetDefault("defaults.mount.vfs_cache_max_age", "")
+	v.SetDefault("defaults.mount.dir_cache_time", "")
+	v.SetDefault("defaults.mount.attr_timeout", "")
 	v.SetDefault("defaults.sync.log_level", "INFO")
 	v.SetDefault("defaults.sync.transfers", 4)
 	v.SetDefault("defaults.sync.checkers", 8)
+	v.SetDefault("defaults.sync.extra_flags", "")
 }
 
 // newConfigWithDefaults creates a new Config with default values.
 func newConfigWithDefaults() *Config {
 	return &Config{
-		Version:  "1.0",
+		Version:  CurrentVersion,
 		Mounts:   []models.MountConfig{},
 		SyncJobs: []models.SyncJobConfig{},
 		Settings: Settings{
-			RcloneBinaryPath: "",
-			DefaultMountDir:  "~/mnt",
-			Editor:           "",
-			RecentPaths:      []string{},
+			RcloneBinaryPath:    "",
+			DefaultMountDir:     "~/mnt",
+			Editor:              "",
+			RecentPaths:         []string{},
+			AutoRefreshInterval: "30s",
+			BackupCount:         DefaultBackupCount,
+			Scope:               "user",
+			Theme:               "dark",
 		},
 		Defaults: DefaultConfig{
 			Mount: MountDefaults{
@@ -508,6 +1072,21 @@ func newConfigWithDefaults() *Config {
 	}
 }
 
+// ResetToDefaults sets Settings and Defaults back to the built-in defaults,
+// leaving the mounts and sync jobs alone. Recent paths are history rather
+// than a setting, so they are kept. The caller saves the config.
+func (c *Config) ResetToDefaults() {
+	defaults := newConfigWithDefaults()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	recent := c.Settings.RecentPaths
+	c.Settings = defaults.Settings
+	c.Settings.RecentPaths = recent
+	c.Defaults = defaults.Defaults
+}
+
 // generateID generates a unique ID for mounts and sync jobs.
 func generateID() string {
 	return uuid.New().String()[:8]
@@ -526,6 +1105,117 @@ func (c *Config) ExportConfig(filePath string) error {
 		Exported: time.Now().Format(time.RFC3339),
 	}
 
+	return writeExport(filePath, data)
+}
+
+// ExportConfigFiltered exports the parts of the config selected by opts.
+// Excluded kinds are written as empty lists, so the file imports like a full
+// export, except that a replace import leaves the excluded kinds alone. Names
+// that match no included mount or sync job are an error.
+func (c *Config) ExportConfigFiltered(filePath string, opts ExportOptions) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(opts.Names))
+	for _, name := range opts.Names {
+		wanted[name] = true
+	}
+	matched := make(map[string]bool)
+	keep := func(name string) bool {
+		if len(wanted) > 0 && !wanted[name] {
+			return false
+		}
+		matched[name] = true
+		return true
+	}
+
+	data := ExportData{
+		Version:  c.Version,
+		Mounts:   []models.MountConfig{},
+		SyncJobs: []models.SyncJobConfig{},
+		Exported: time.Now().Format(time.RFC3339),
+	}
+	if opts.IncludeMounts {
+		data.Sections = append(data.Sections, SectionMounts)
+		for _, mount := range c.Mounts {
+			if keep(mount.Name) {
+				data.Mounts = append(data.Mounts, mount)
+			}
+		}
+	}
+	if opts.IncludeSyncJobs {
+		data.Sections = append(data.Sections, SectionSyncJobs)
+		for _, job := range c.SyncJobs {
+			if keep(job.Name) {
+				data.SyncJobs = append(data.SyncJobs, job)
+			}
+		}
+	}
+	if opts.IncludeSettings {
+		data.Sections = append(data.Sections, SectionSettings)
+		data.Settings = &PortableSettings{
+			DefaultMountDir:     c.Settings.DefaultMountDir,
+			AutoRefreshInterval: c.Settings.AutoRefreshInterval,
+		}
+	}
+	if len(data.Sections) == 0 {
+		return fmt.Errorf("nothing to export: include mounts, sync jobs or settings")
+	}
+
+	var unknown []string
+	for _, name := range opts.Names {
+		if !matched[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("no mount or sync job named: %s", strings.Join(unknown, ", "))
+	}
+
+	return writeExport(filePath, data)
+}
+
+// ExportPortable exports mounts, sync jobs and portable settings as a
+// template that can be imported on another machine or by another user.
+// Paths under the home directory are written as $HOME/..., and the rclone
+// binary path and recent paths are omitted.
+func (c *Config) ExportPortable(filePath string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	home, err := userHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to get home directory: %w", err)
+	}
+	toPortable := func(path string) string { return portablePath(path, home) }
+
+	mounts := make([]models.MountConfig, len(c.Mounts))
+	for i, mount := range c.Mounts {
+		mounts[i] = rewriteMountPaths(mount, toPortable)
+	}
+	jobs := make([]models.SyncJobConfig, len(c.SyncJobs))
+	for i, job := range c.SyncJobs {
+		jobs[i] = rewriteSyncJobPaths(job, toPortable)
+	}
+
+	data := ExportData{
+		Version:  c.Version,
+		Mounts:   mounts,
+		SyncJobs: jobs,
+		Exported: time.Now().Format(time.RFC3339),
+		Portable: true,
+		Settings: &PortableSettings{
+			DefaultMountDir:     toPortable(c.Settings.DefaultMountDir),
+			AutoRefreshInterval: c.Settings.AutoRefreshInterval,
+		},
+	}
+
+	return writeExport(filePath, data)
+}
+
+// writeExport writes export data to a file. The file format is determined by
+// the file extension (.json or .yaml/.yml).
+func writeExport(filePath string, data ExportData) error {
 	fileDir := filepath.Dir(filePath)
 	if fileDir != "" && fileDir != "." {
 		if err := utils.EnsureDir(fileDir); err != nil {
@@ -564,19 +1254,71 @@ func (c *Config) ExportConfig(filePath string) error {
 	return nil
 }
 
+// Import entry kinds, as reported in ImportEntry.Kind.
+const (
+	ImportKindMount   = "mount"
+	ImportKindSyncJob = "sync job"
+)
+
+// ImportEntry is a mount or sync job affected by an import.
+type ImportEntry struct {
+	Kind   string // ImportKindMount or ImportKindSyncJob
+	Name   string
+	Reason string // Why a skipped entry is skipped, e.g. "duplicate name"
+}
+
+// ImportPlan describes what importing a file would change.
+type ImportPlan struct {
+	Mode    ImportMode
+	Added   []ImportEntry
+	Skipped []ImportEntry
+	Removed []ImportEntry
+
+	data     ExportData // Imported data, settings included
+	mounts   []models.MountConfig
+	syncJobs []models.SyncJobConfig
+}
+
+// PreviewImport reads an import file and reports which mounts and sync jobs
+// ImportConfig would add, skip and remove, without changing the config.
+func (c *Config) PreviewImport(filePath string, mode ImportMode) (*ImportPlan, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	data, err := readImport(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return c.planImport(data, mode), nil
+}
+
 // ImportConfig imports mounts and sync jobs from a file.
 // The import mode determines how conflicts are handled.
-func (c *Config) ImportConfig(filePath string, mode ImportMode) error {
+func (c *Config) ImportConfig(filePath string, mode ImportMode) (err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	defer func() { actionlog.Record("import-config", filePath, err) }()
+
+	data, err := readImport(filePath)
+	if err != nil {
+		return err
+	}
+	c.applyImport(c.planImport(data, mode))
+	return nil
+}
+
+// readImport reads and validates an import file, expanding portable paths.
+// The file format is determined by the file extension (.json or .yaml/.yml).
+func readImport(filePath string) (ExportData, error) {
+	var data ExportData
 
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return fmt.Errorf("import file does not exist: %s", filePath)
+		return data, fmt.Errorf("import file does not exist: %s", filePath)
 	}
 
 	file, err := os.Open(filePath)
 	if err != nil {
-		return fmt.Errorf("failed to open import file: %w", err)
+		return data, fmt.Errorf("failed to open import file: %w", err)
 	}
 	defer func() {
 		if cerr := file.Close(); cerr != nil {
@@ -584,82 +1326,137 @@ func (c *Config) ImportConfig(filePath string, mode ImportMode) error {
 		}
 	}()
 
-	var data ExportData
 	ext := strings.ToLower(filepath.Ext(filePath))
 
 	switch ext {
 	case ".json":
 		decoder := json.NewDecoder(file)
 		if err := decoder.Decode(&data); err != nil {
-			return fmt.Errorf("failed to decode JSON: %w", err)
+			return data, fmt.Errorf("failed to decode JSON: %w", err)
 		}
 	case ".yaml", ".yml":
 		decoder := yaml.NewDecoder(file)
 		if err := decoder.Decode(&data); err != nil {
-			return fmt.Errorf("failed to decode YAML: %w", err)
+			return data, fmt.Errorf("failed to decode YAML: %w", err)
 		}
 	default:
-		return fmt.Errorf("unsupported file format: %s (use .json, .yaml, or .yml)", ext)
+		return data, fmt.Errorf("unsupported file format: %s (use .json, .yaml, or .yml)", ext)
 	}
 
-	if data.Version == "" && len(data.Mounts) == 0 && len(data.SyncJobs) == 0 {
-		return fmt.Errorf("invalid config file: no valid configuration data found")
+	if data.Version == "" && len(data.Mounts) == 0 && len(data.SyncJobs) == 0 && len(data.Sections) == 0 {
+		return data, fmt.Errorf("invalid config file: no valid configuration data found")
 	}
 
-	switch mode {
-	case ImportModeReplace:
-		c.Mounts = data.Mounts
-		c.SyncJobs = data.SyncJobs
-	case ImportModeMerge:
-		c.mergeImport(data)
+	if err := expandPortableData(&data); err != nil {
+		return data, err
 	}
-
-	return nil
+	return data, nil
 }
 
-// mergeImport merges the imported data with the existing configuration.
-// Items with duplicate names are skipped with an error recorded.
-func (c *Config) mergeImport(data ExportData) {
-	// Note: mergeImport is called from ImportConfig, which already holds the lock.
-	existingMountNames := make(map[string]bool)
-	for _, m := range c.Mounts {
-		existingMountNames[m.Name] = true
-	}
+// planImport works out what importing data changes. In merge mode, items
+// whose name is already taken are skipped and the others get an ID and
+// timestamps if they lack them. In replace mode, every item of the sections
+// the data holds is replaced. The caller must hold the lock.
+func (c *Config) planImport(data ExportData, mode ImportMode) *ImportPlan {
+	plan := &ImportPlan{Mode: mode, data: data}
 
-	for _, mount := range data.Mounts {
-		if existingMountNames[mount.Name] {
-			continue
+	switch mode {
+	case ImportModeReplace:
+		if data.includes(SectionMounts) {
+			for _, m := range c.Mounts {
+				plan.Removed = append(plan.Removed, ImportEntry{Kind: ImportKindMount, Name: m.Name})
+			}
+			for _, m := range data.Mounts {
+				plan.Added = append(plan.Added, ImportEntry{Kind: ImportKindMount, Name: m.Name})
+			}
+			plan.mounts = data.Mounts
 		}
-		if mount.ID == "" {
-			mount.ID = generateID()
+		if data.includes(SectionSyncJobs) {
+			for _, j := range c.SyncJobs {
+				plan.Removed = append(plan.Removed, ImportEntry{Kind: ImportKindSyncJob, Name: j.Name})
+			}
+			for _, j := range data.SyncJobs {
+				plan.Added = append(plan.Added, ImportEntry{Kind: ImportKindSyncJob, Name: j.Name})
+			}
+			plan.syncJobs = data.SyncJobs
 		}
-		if mount.CreatedAt.IsZero() {
-			mount.CreatedAt = time.Now()
+
+	case ImportModeMerge:
+		existingMountNames := make(map[string]bool)
+		for _, m := range c.Mounts {
+			existingMountNames[m.Name] = true
 		}
-		if mount.ModifiedAt.IsZero() {
-			mount.ModifiedAt = time.Now()
+
+		for _, mount := range data.Mounts {
+			entry := ImportEntry{Kind: ImportKindMount, Name: mount.Name}
+			if existingMountNames[mount.Name] {
+				entry.Reason = "duplicate name"
+				plan.Skipped = append(plan.Skipped, entry)
+				continue
+			}
+			if mount.ID == "" {
+				mount.ID = generateID()
+			}
+			if mount.CreatedAt.IsZero() {
+				mount.CreatedAt = time.Now()
+			}
+			if mount.ModifiedAt.IsZero() {
+				mount.ModifiedAt = time.Now()
+			}
+			plan.Added = append(plan.Added, entry)
+			plan.mounts = append(plan.mounts, mount)
 		}
-		c.Mounts = append(c.Mounts, mount)
-	}
 
-	existingSyncJobNames := make(map[string]bool)
-	for _, j := range c.SyncJobs {
-		existingSyncJobNames[j.Name] = true
-	}
+		existingSyncJobNames := make(map[string]bool)
+		for _, j := range c.SyncJobs {
+			existingSyncJobNames[j.Name] = true
+		}
 
-	for _, job := range data.SyncJobs {
-		if existingSyncJobNames[job.Name] {
-			continue
+		for _, job := range data.SyncJobs {
+			entry := ImportEntry{Kind: ImportKindSyncJob, Name: job.Name}
+			if existingSyncJobNames[job.Name] {
+				entry.Reason = "duplicate name"
+				plan.Skipped = append(plan.Skipped, entry)
+				continue
+			}
+			if job.ID == "" {
+				job.ID = generateID()
+			}
+			if job.CreatedAt.IsZero() {
+				job.CreatedAt = time.Now()
+			}
+			if job.ModifiedAt.IsZero() {
+				job.ModifiedAt = time.Now()
+			}
+			plan.Added = append(plan.Added, entry)
+			plan.syncJobs = append(plan.syncJobs, job)
 		}
-		if job.ID == "" {
-			job.ID = generateID()
+	}
+
+	return plan
+}
+
+// applyImport carries out a plan made by planImport. The caller must hold
+// the lock.
+func (c *Config) applyImport(plan *ImportPlan) {
+	switch plan.Mode {
+	case ImportModeReplace:
+		if plan.data.includes(SectionMounts) {
+			c.Mounts = plan.mounts
 		}
-		if job.CreatedAt.IsZero() {
-			job.CreatedAt = time.Now()
+		if plan.data.includes(SectionSyncJobs) {
+			c.SyncJobs = plan.syncJobs
 		}
-		if job.ModifiedAt.IsZero() {
-			job.ModifiedAt = time.Now()
+		if settings := plan.data.Settings; settings != nil {
+			if settings.DefaultMountDir != "" {
+				c.Settings.DefaultMountDir = settings.DefaultMountDir
+			}
+			if settings.AutoRefreshInterval != "" {
+				c.Settings.AutoRefreshInterval = settings.AutoRefreshInterval
+			}
 		}
-		c.SyncJobs = append(c.SyncJobs, job)
+	case ImportModeMerge:
+		c.Mounts = append(c.Mounts, plan.mounts...)
+		c.SyncJobs = append(c.SyncJobs, plan.syncJobs...)
 	}
 }
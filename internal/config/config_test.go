@@ -4,18 +4,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/dtg01100/rclone-mount-sync/internal/actionlog"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/pkg/utils"
 )
 
 func TestNewConfigWithDefaults(t *testing.T) {
 	cfg := newConfigWithDefaults()
 
-	if cfg.Version != "1.0" {
-		t.Errorf("Version = %q, want %q", cfg.Version, "1.0")
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %q, want %q", cfg.Version, CurrentVersion)
 	}
 
 	if cfg.Settings.DefaultMountDir != "~/mnt" {
@@ -89,6 +93,135 @@ func TestConfigAddMountDuplicate(t *testing.T) {
 	}
 }
 
+func TestConfigAddMountDuplicateMountPoint(t *testing.T) {
+	home, err := utils.GetHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	tests := []struct {
+		name     string
+		existing string
+		added    string
+		wantErr  bool
+	}{
+		{"exact match", "/mnt/gdrive", "/mnt/gdrive", true},
+		{"trailing slash", "/mnt/gdrive", "/mnt/gdrive/", true},
+		{"unclean path", "/mnt/gdrive", "/mnt/./other/../gdrive", true},
+		{"tilde expansion", filepath.Join(home, "gdrive"), "~/gdrive", true},
+		{"different directory", "/mnt/gdrive", "/mnt/gdrive2", false},
+		{"nested directory", "/mnt/gdrive", "/mnt/gdrive/photos", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfigWithDefaults()
+			if err := cfg.AddMount(models.MountConfig{Name: "Google Drive", Remote: "gdrive:", MountPoint: tt.existing}); err != nil {
+				t.Fatalf("AddMount() error = %v", err)
+			}
+			err := cfg.AddMount(models.MountConfig{Name: "other", Remote: "other:", MountPoint: tt.added})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddMount(%q) error = %v, wantErr %v", tt.added, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), `already used by "Google Drive"`) {
+				t.Errorf("error = %v, want it to name the existing mount", err)
+			}
+		})
+	}
+}
+
+func TestConfigAddSyncJobOverlappingDestination(t *testing.T) {
+	home, err := utils.GetHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	tests := []struct {
+		name     string
+		existing string
+		added    string
+		wantErr  bool
+	}{
+		{"exact match", "/backup/photos", "/backup/photos", true},
+		{"trailing slash", "/backup/photos", "/backup/photos/", true},
+		{"tilde expansion", filepath.Join(home, "backup"), "~/backup", true},
+		{"inside existing", "/backup", "/backup/photos", true},
+		{"contains existing", "/backup/photos", "/backup", true},
+		{"same remote path", "b2:bucket/photos", "b2:bucket/photos/", true},
+		{"sibling directory", "/backup/photos", "/backup/docs", false},
+		{"other remote", "b2:bucket/photos", "s3:bucket/photos", false},
+		{"local and remote", "/bucket/photos", "b2:bucket/photos", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfigWithDefaults()
+			if err := cfg.AddSyncJob(models.SyncJobConfig{Name: "photos", Source: "gdrive:/Photos", Destination: tt.existing}); err != nil {
+				t.Fatalf("AddSyncJob() error = %v", err)
+			}
+			err := cfg.AddSyncJob(models.SyncJobConfig{Name: "other", Source: "gdrive:/Other", Destination: tt.added})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddSyncJob(%q) error = %v, wantErr %v", tt.added, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), `used by "photos"`) {
+				t.Errorf("error = %v, want it to name the existing job", err)
+			}
+		})
+	}
+}
+
+func TestConfigAddMountNormalizesRemotePath(t *testing.T) {
+	cfg := newConfigWithDefaults()
+
+	mount := models.MountConfig{
+		Name:       "music",
+		Remote:     "gdrive:",
+		RemotePath: "Media//Music/",
+		MountPoint: "/mnt/music",
+	}
+	if err := cfg.AddMount(mount); err != nil {
+		t.Fatalf("AddMount() error = %v", err)
+	}
+
+	if got := cfg.GetMount("music").RemotePath; got != "/Media/Music" {
+		t.Errorf("RemotePath = %q, want %q", got, "/Media/Music")
+	}
+}
+
+func TestConfigSaveRecordsActionLog(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Cleanup(func() { actionlog.Configure("") })
+	logPath := filepath.Join(dir, appName, actionlog.FileName)
+
+	cfg := newConfigWithDefaults()
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(logPath); !os.IsNotExist(err) {
+		t.Fatal("no action log should be written while ActionLog is off")
+	}
+
+	cfg.Settings.ActionLog = true
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("action log should be written once enabled: %v", err)
+	}
+	if !strings.Contains(string(data), "msg=save-config") {
+		t.Errorf("action log should record the save, got %q", data)
+	}
+
+	// Loading a config with the log off turns recording off again
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	if _, err := Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if actionlog.Path() != "" {
+		t.Errorf("actionlog.Path() = %q, want recording off", actionlog.Path())
+	}
+}
+
 func TestConfigAddMountValidation(t *testing.T) {
 	cfg := newConfigWithDefaults()
 
@@ -951,6 +1084,38 @@ func TestGetSyncJobTableDriven(t *testing.T) {
 	}
 }
 
+func TestSaveAndLoadKeybindings(t *testing.T) {
+	tmpDir := t.TempDir()
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	cfg := newConfigWithDefaults()
+	cfg.Keybindings = map[string]string{"stop": "K", "refresh": "ctrl+r"}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Keybindings["stop"] != "K" || loaded.Keybindings["refresh"] != "ctrl+r" {
+		t.Errorf("Keybindings = %v, want stop K and refresh ctrl+r", loaded.Keybindings)
+	}
+
+	loaded.Keybindings["stop"] = "Z"
+	if err := loaded.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := cfg.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if cfg.Keybindings["stop"] != "Z" {
+		t.Errorf("Keybindings after Reload = %v, want stop Z", cfg.Keybindings)
+	}
+}
+
 func TestSaveAndLoadWithRecentPaths(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "config-test-*")
 	if err != nil {
@@ -1102,8 +1267,8 @@ func TestLoadNonExistentConfig(t *testing.T) {
 		t.Fatalf("Load() error = %v", err)
 	}
 
-	if cfg.Version != "1.0" {
-		t.Errorf("Version = %q, want %q", cfg.Version, "1.0")
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %q, want %q", cfg.Version, CurrentVersion)
 	}
 
 	if len(cfg.Mounts) != 0 {
@@ -1148,6 +1313,34 @@ func TestLoadExistingConfig(t *testing.T) {
 	}
 }
 
+func TestLoadScope(t *testing.T) {
+	tmpDir := t.TempDir()
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	cfg := newConfigWithDefaults()
+	cfg.Settings.Scope = "system"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Settings.Scope != "system" {
+		t.Errorf("Scope = %q, want system", loaded.Settings.Scope)
+	}
+
+	cfg.Settings.Scope = "global"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "settings.scope") {
+		t.Errorf("Load() error = %v, want an invalid scope error", err)
+	}
+}
+
 func TestTimestampsSetOnAdd(t *testing.T) {
 	cfg := newConfigWithDefaults()
 
@@ -1299,6 +1492,42 @@ func TestAtomicWriteTempFileCleanup(t *testing.T) {
 	}
 }
 
+func TestLoadRemovesStaleTempFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	old := time.Now().Add(-time.Hour)
+	plant := func(name string, modTime time.Time) string {
+		path := filepath.Join(tmpDir, name)
+		if err := os.WriteFile(path, []byte("version: \"1.0\"\n"), 0644); err != nil {
+			t.Fatalf("failed to plant %s: %v", name, err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatalf("failed to age %s: %v", name, err)
+		}
+		return path
+	}
+	stale := plant("config.yaml.tmp.yaml", old)
+	fresh := plant("other.tmp.yaml", time.Now())
+	unrelated := plant("config.yaml.bak", old)
+
+	if _, err := Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Error("Load() should remove a stale temp file")
+	}
+	if _, err := os.Stat(fresh); err != nil {
+		t.Error("Load() should keep a temp file that may belong to a save in progress")
+	}
+	if _, err := os.Stat(unrelated); err != nil {
+		t.Error("Load() should only remove files matching the temp file pattern")
+	}
+}
+
 func TestRestoreFromBackup(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "config-test-*")
 	if err != nil {
@@ -1325,12 +1554,12 @@ func TestRestoreFromBackup(t *testing.T) {
 	if err != nil {
 		t.Fatalf("HasBackup() error = %v", err)
 	}
-	if !hasBackup {
+	if hasBackup == 0 {
 		t.Fatal("HasBackup() should return true")
 	}
 
-	if err := RestoreFromBackup(); err != nil {
-		t.Fatalf("RestoreFromBackup() error = %v", err)
+	if err := RestoreFromBackup(0); err != nil {
+		t.Fatalf("RestoreFromBackup(0) error = %v", err)
 	}
 
 	loaded, err := Load()
@@ -1346,7 +1575,7 @@ func TestRestoreFromBackup(t *testing.T) {
 	if err != nil {
 		t.Fatalf("HasBackup() after restore error = %v", err)
 	}
-	if hasBackup {
+	if hasBackup != 0 {
 		t.Error("HasBackup() should return false after restore (backup consumed)")
 	}
 }
@@ -1366,59 +1595,95 @@ func TestRestoreFromBackupNoBackup(t *testing.T) {
 	if err != nil {
 		t.Fatalf("HasBackup() error = %v", err)
 	}
-	if hasBackup {
+	if hasBackup != 0 {
 		t.Error("HasBackup() should return false when no backup exists")
 	}
 
-	err = RestoreFromBackup()
+	err = RestoreFromBackup(0)
 	if err == nil {
-		t.Error("RestoreFromBackup() should return error when no backup exists")
+		t.Error("RestoreFromBackup(0) should return error when no backup exists")
 	}
 }
 
-func TestBackupOnlyKeepsMostRecent(t *testing.T) {
-	tmpDir, err := os.MkdirTemp("", "config-test-*")
-	if err != nil {
-		t.Fatalf("Failed to create temp dir: %v", err)
-	}
-	defer os.RemoveAll(tmpDir)
-
+func TestBackupRotation(t *testing.T) {
+	tmpDir := t.TempDir()
 	origGetConfigDir := getConfigDir
 	getConfigDir = func() (string, error) { return tmpDir, nil }
 	defer func() { getConfigDir = origGetConfigDir }()
 
 	cfg := newConfigWithDefaults()
-	for i := 0; i < 5; i++ {
+	cfg.Settings.BackupCount = 3
+	for i := 0; i < 6; i++ {
 		cfg.Settings.DefaultMountDir = fmt.Sprintf("/mnt/%d", i)
 		if err := cfg.Save(); err != nil {
 			t.Fatalf("Save() iteration %d error = %v", i, err)
 		}
 	}
 
-	entries, err := os.ReadDir(tmpDir)
-	if err != nil {
-		t.Fatalf("Failed to read config dir: %v", err)
+	count, err := HasBackup()
+	if err != nil || count != 3 {
+		t.Fatalf("HasBackup() = %d, %v, want 3", count, err)
 	}
-
-	backupCount := 0
-	for _, entry := range entries {
-		if strings.HasSuffix(entry.Name(), ".bak") {
-			backupCount++
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	for i, want := range []string{"/mnt/4", "/mnt/3", "/mnt/2"} {
+		content, err := os.ReadFile(backupFile(configPath, i))
+		if err != nil || !strings.Contains(string(content), want) {
+			t.Errorf("backup %d should hold %s, got %q, %v", i, want, content, err)
 		}
 	}
+	if _, err := os.Stat(configPath + ".bak.3"); !os.IsNotExist(err) {
+		t.Error("backups beyond the count should be pruned")
+	}
 
-	if backupCount != 1 {
-		t.Errorf("Expected 1 backup file, got %d", backupCount)
+	// Lowering the count prunes the extra backups on the next save
+	cfg.Settings.BackupCount = 1
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if count, _ := HasBackup(); count != 1 {
+		t.Errorf("HasBackup() = %d after lowering the count, want 1", count)
 	}
+	if content, _ := os.ReadFile(configPath + ".bak"); !strings.Contains(string(content), "/mnt/5") {
+		t.Errorf("the remaining backup should hold /mnt/5, got %q", content)
+	}
+}
 
-	backupPath := filepath.Join(tmpDir, "config.yaml.bak")
-	backupContent, err := os.ReadFile(backupPath)
+func TestRestoreFromBackup_Index(t *testing.T) {
+	tmpDir := t.TempDir()
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	cfg := newConfigWithDefaults()
+	for i := 0; i < 4; i++ {
+		cfg.Settings.DefaultMountDir = fmt.Sprintf("/mnt/%d", i)
+		if err := cfg.Save(); err != nil {
+			t.Fatalf("Save() iteration %d error = %v", i, err)
+		}
+	}
+
+	// Backups hold /mnt/2, /mnt/1 and /mnt/0; restore the middle one
+	if err := RestoreFromBackup(1); err != nil {
+		t.Fatalf("RestoreFromBackup(1) error = %v", err)
+	}
+	loaded, err := Load()
 	if err != nil {
-		t.Fatalf("Failed to read backup: %v", err)
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Settings.DefaultMountDir != "/mnt/1" {
+		t.Errorf("DefaultMountDir = %q, want /mnt/1", loaded.Settings.DefaultMountDir)
 	}
 
-	if !strings.Contains(string(backupContent), "/mnt/3") {
-		t.Errorf("Backup should contain the second-to-last config (/mnt/3)")
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if count, _ := HasBackup(); count != 2 {
+		t.Errorf("HasBackup() = %d after restoring, want 2", count)
+	}
+	if content, _ := os.ReadFile(configPath + ".bak.1"); !strings.Contains(string(content), "/mnt/0") {
+		t.Errorf("older backups should move up, backup 1 holds %q", content)
+	}
+
+	if err := RestoreFromBackup(5); err == nil {
+		t.Error("RestoreFromBackup() should fail for a missing index")
 	}
 }
 
@@ -1437,7 +1702,7 @@ func TestHasBackup(t *testing.T) {
 	if err != nil {
 		t.Fatalf("HasBackup() error = %v", err)
 	}
-	if hasBackup {
+	if hasBackup != 0 {
 		t.Error("HasBackup() should be false initially")
 	}
 
@@ -1448,7 +1713,7 @@ func TestHasBackup(t *testing.T) {
 	}
 
 	hasBackup, _ = HasBackup()
-	if hasBackup {
+	if hasBackup != 0 {
 		t.Error("HasBackup() should be false after first save (no prior config)")
 	}
 
@@ -1461,7 +1726,7 @@ func TestHasBackup(t *testing.T) {
 	if err != nil {
 		t.Fatalf("HasBackup() after second save error = %v", err)
 	}
-	if !hasBackup {
+	if hasBackup == 0 {
 		t.Error("HasBackup() should be true after second save")
 	}
 }
@@ -1516,6 +1781,158 @@ func TestExportConfig(t *testing.T) {
 	}
 }
 
+// Multiline notes must survive save/load and both export formats unchanged.
+func TestNotesRoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return filepath.Join(tmpDir, "config"), nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	notes := "rotate creds quarterly\nowner: ops: \"storage\" team\n  - indented line"
+
+	cfg := newConfigWithDefaults()
+	cfg.AddMount(models.MountConfig{
+		Name:       "test-mount",
+		Remote:     "gdrive:",
+		MountPoint: "/mnt/test",
+		Notes:      notes,
+	})
+	cfg.AddSyncJob(models.SyncJobConfig{
+		Name:        "test-sync",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup/photos",
+		Notes:       notes,
+	})
+
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Mounts[0].Notes != notes || loaded.SyncJobs[0].Notes != notes {
+		t.Errorf("notes after load = %q / %q, want %q", loaded.Mounts[0].Notes, loaded.SyncJobs[0].Notes, notes)
+	}
+
+	for _, name := range []string{"export.yaml", "export.json"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(tmpDir, name)
+			if err := cfg.ExportConfig(path); err != nil {
+				t.Fatalf("ExportConfig() error = %v", err)
+			}
+
+			imported := newConfigWithDefaults()
+			if err := imported.ImportConfig(path, ImportModeReplace); err != nil {
+				t.Fatalf("ImportConfig() error = %v", err)
+			}
+			if imported.Mounts[0].Notes != notes {
+				t.Errorf("mount notes = %q, want %q", imported.Mounts[0].Notes, notes)
+			}
+			if imported.SyncJobs[0].Notes != notes {
+				t.Errorf("sync job notes = %q, want %q", imported.SyncJobs[0].Notes, notes)
+			}
+		})
+	}
+}
+
+func TestRunHistoryRoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	defer func() { getConfigDir = origGetConfigDir }()
+
+	finished := time.Date(2024, 6, 2, 15, 30, 0, 0, time.UTC)
+	cfg := newConfigWithDefaults()
+	cfg.AddSyncJob(models.SyncJobConfig{
+		Name:        "test-sync",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup/photos",
+	})
+	cfg.SyncJobs[0].RecordRun(models.RunOutcome{FinishedAt: finished, Success: true})
+	cfg.SyncJobs[0].RecordRun(models.RunOutcome{FinishedAt: finished.Add(time.Hour)})
+
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	history := loaded.SyncJobs[0].RunHistory
+	if len(history) != 2 {
+		t.Fatalf("len(RunHistory) = %d, want 2", len(history))
+	}
+	if !history[0].FinishedAt.Equal(finished) || !history[0].Success || history[1].Success {
+		t.Errorf("RunHistory = %+v", history)
+	}
+}
+
+func TestSetAllAutoStart(t *testing.T) {
+	cfg := newConfigWithDefaults()
+	cfg.Mounts = []models.MountConfig{
+		{Name: "gdrive", AutoStart: true, Enabled: true},
+		{Name: "dropbox", Enabled: true},
+		{Name: "archive"},
+	}
+	cfg.SyncJobs = []models.SyncJobConfig{{Name: "photos", AutoStart: true, Enabled: true}}
+
+	mounts, syncJobs := cfg.SetAllAutoStart(false)
+	if mounts != 1 || syncJobs != 1 {
+		t.Errorf("SetAllAutoStart(false) changed %d mounts and %d sync jobs, want 1 and 1", mounts, syncJobs)
+	}
+	for _, m := range cfg.Mounts {
+		if m.AutoStart {
+			t.Errorf("mount %s still starts automatically", m.Name)
+		}
+	}
+	if cfg.SyncJobs[0].AutoStart {
+		t.Error("sync job still starts automatically")
+	}
+	if !cfg.Mounts[0].Enabled || !cfg.SyncJobs[0].Enabled {
+		t.Error("SetAllAutoStart(false) should leave Enabled alone")
+	}
+
+	if mounts, syncJobs = cfg.SetAllAutoStart(true); mounts != 3 || syncJobs != 1 {
+		t.Errorf("SetAllAutoStart(true) changed %d mounts and %d sync jobs, want 3 and 1", mounts, syncJobs)
+	}
+	if !cfg.Mounts[1].AutoStart {
+		t.Error("SetAllAutoStart(true) should turn auto-start on everywhere")
+	}
+	if cfg.Mounts[2].Enabled {
+		t.Error("SetAllAutoStart(true) should not enable a disabled mount")
+	}
+}
+
+func TestValidateRequiredMounts(t *testing.T) {
+	cfg := newConfigWithDefaults()
+	cfg.Mounts = []models.MountConfig{{ID: "a1b2c3d4", Name: "gdrive"}}
+
+	tests := []struct {
+		name    string
+		job     models.SyncJobConfig
+		wantErr bool
+	}{
+		{"no dependencies", models.SyncJobConfig{}, false},
+		{"existing mount", models.SyncJobConfig{RequiresMounts: []string{"a1b2c3d4"}, StopWithMount: true}, false},
+		{"missing mount", models.SyncJobConfig{RequiresMounts: []string{"deadbeef"}}, true},
+		{"stop without mounts", models.SyncJobConfig{StopWithMount: true}, true},
+		{"serialize without mounts", models.SyncJobConfig{SerializeWithSharedMount: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cfg.ValidateRequiredMounts(tt.job)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRequiredMounts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestExportConfigUnsupportedFormat(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "config-test-*")
 	if err != nil {
@@ -1550,6 +1967,88 @@ func TestExportConfigCreatesDirectory(t *testing.T) {
 	}
 }
 
+func TestExportConfigFiltered(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newConfigWithDefaults()
+	cfg.AddMount(models.MountConfig{Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/gdrive"})
+	cfg.AddMount(models.MountConfig{Name: "dropbox", Remote: "dropbox:", MountPoint: "/mnt/dropbox"})
+	cfg.AddSyncJob(models.SyncJobConfig{Name: "photos", Source: "gdrive:/Photos", Destination: "/backup/photos"})
+
+	// Mounts only, filtered by name
+	exportPath := filepath.Join(tmpDir, "mounts.yaml")
+	if err := cfg.ExportConfigFiltered(exportPath, ExportOptions{IncludeMounts: true, Names: []string{"dropbox"}}); err != nil {
+		t.Fatalf("ExportConfigFiltered() error = %v", err)
+	}
+
+	target := newConfigWithDefaults()
+	target.AddMount(models.MountConfig{Name: "old", Remote: "old:", MountPoint: "/mnt/old"})
+	target.AddSyncJob(models.SyncJobConfig{Name: "keep", Source: "old:/", Destination: "/keep"})
+	if err := target.ImportConfig(exportPath, ImportModeReplace); err != nil {
+		t.Fatalf("ImportConfig(replace) error = %v", err)
+	}
+	if len(target.Mounts) != 1 || target.Mounts[0].Name != "dropbox" {
+		t.Errorf("replace import should swap in the exported mounts, got %+v", target.Mounts)
+	}
+	if len(target.SyncJobs) != 1 || target.SyncJobs[0].Name != "keep" {
+		t.Errorf("replace import should leave sync jobs alone, got %+v", target.SyncJobs)
+	}
+
+	// Sync jobs and settings as JSON, merged
+	exportPath = filepath.Join(tmpDir, "jobs.json")
+	cfg.Settings.DefaultMountDir = "/srv/mnt"
+	if err := cfg.ExportConfigFiltered(exportPath, ExportOptions{IncludeSyncJobs: true, IncludeSettings: true}); err != nil {
+		t.Fatalf("ExportConfigFiltered() error = %v", err)
+	}
+	merged := newConfigWithDefaults()
+	if err := merged.ImportConfig(exportPath, ImportModeMerge); err != nil {
+		t.Fatalf("ImportConfig(merge) error = %v", err)
+	}
+	if len(merged.Mounts) != 0 || len(merged.SyncJobs) != 1 {
+		t.Errorf("merge import got %d mounts and %d sync jobs, want 0 and 1", len(merged.Mounts), len(merged.SyncJobs))
+	}
+}
+
+func TestExportConfigFiltered_Empty(t *testing.T) {
+	exportPath := filepath.Join(t.TempDir(), "empty.json")
+	cfg := newConfigWithDefaults()
+	if err := cfg.ExportConfigFiltered(exportPath, ExportOptions{IncludeMounts: true, IncludeSyncJobs: true}); err != nil {
+		t.Fatalf("ExportConfigFiltered() error = %v", err)
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("Failed to read export file: %v", err)
+	}
+	if !strings.Contains(string(content), `"mounts": []`) || !strings.Contains(string(content), `"sync_jobs": []`) {
+		t.Errorf("empty export should hold empty lists, got:\n%s", content)
+	}
+
+	for _, mode := range []ImportMode{ImportModeMerge, ImportModeReplace} {
+		if err := newConfigWithDefaults().ImportConfig(exportPath, mode); err != nil {
+			t.Errorf("ImportConfig(%d) of an empty export error = %v", mode, err)
+		}
+	}
+}
+
+func TestExportConfigFiltered_Errors(t *testing.T) {
+	exportPath := filepath.Join(t.TempDir(), "export.yaml")
+	cfg := newConfigWithDefaults()
+	cfg.AddMount(models.MountConfig{Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/gdrive"})
+	cfg.AddSyncJob(models.SyncJobConfig{Name: "photos", Source: "gdrive:/Photos", Destination: "/backup/photos"})
+
+	err := cfg.ExportConfigFiltered(exportPath, ExportOptions{IncludeMounts: true, Names: []string{"gdrive", "photos", "nope"}})
+	if err == nil || !strings.Contains(err.Error(), "photos, nope") {
+		t.Errorf("error = %v, want it to name photos and nope", err)
+	}
+	if _, statErr := os.Stat(exportPath); !os.IsNotExist(statErr) {
+		t.Error("no file should be written when names are unknown")
+	}
+
+	if err := cfg.ExportConfigFiltered(exportPath, ExportOptions{}); err == nil {
+		t.Error("exporting nothing should be an error")
+	}
+}
+
 func TestImportConfigYAML(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "config-test-*")
 	if err != nil {
@@ -1899,6 +2398,65 @@ func TestExportImportRoundTrip(t *testing.T) {
 	}
 }
 
+func TestPreviewImport(t *testing.T) {
+	exportPath := filepath.Join(t.TempDir(), "import.yaml")
+	exportContent := `version: "1.1"
+mounts:
+  - name: gdrive
+    remote: "gdrive:"
+    mount_point: /mnt/gdrive
+  - name: dropbox
+    remote: "dropbox:"
+    mount_point: /mnt/dropbox
+sync_jobs:
+  - name: photos
+    source: "gdrive:/Photos"
+    destination: /backup/photos
+`
+	if err := os.WriteFile(exportPath, []byte(exportContent), 0644); err != nil {
+		t.Fatalf("Failed to write export file: %v", err)
+	}
+
+	cfg := newConfigWithDefaults()
+	cfg.AddMount(models.MountConfig{Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/old"})
+	cfg.AddSyncJob(models.SyncJobConfig{Name: "docs", Source: "gdrive:/Docs", Destination: "/backup/docs"})
+
+	plan, err := cfg.PreviewImport(exportPath, ImportModeMerge)
+	if err != nil {
+		t.Fatalf("PreviewImport(merge) error = %v", err)
+	}
+	wantAdded := []ImportEntry{{Kind: ImportKindMount, Name: "dropbox"}, {Kind: ImportKindSyncJob, Name: "photos"}}
+	wantSkipped := []ImportEntry{{Kind: ImportKindMount, Name: "gdrive", Reason: "duplicate name"}}
+	if !reflect.DeepEqual(plan.Added, wantAdded) || !reflect.DeepEqual(plan.Skipped, wantSkipped) || len(plan.Removed) != 0 {
+		t.Errorf("merge plan = %+v / %+v / %+v", plan.Added, plan.Skipped, plan.Removed)
+	}
+
+	plan, err = cfg.PreviewImport(exportPath, ImportModeReplace)
+	if err != nil {
+		t.Fatalf("PreviewImport(replace) error = %v", err)
+	}
+	wantRemoved := []ImportEntry{{Kind: ImportKindMount, Name: "gdrive"}, {Kind: ImportKindSyncJob, Name: "docs"}}
+	if len(plan.Added) != 3 || !reflect.DeepEqual(plan.Removed, wantRemoved) || len(plan.Skipped) != 0 {
+		t.Errorf("replace plan = %+v / %+v / %+v", plan.Added, plan.Skipped, plan.Removed)
+	}
+
+	if len(cfg.Mounts) != 1 || cfg.Mounts[0].MountPoint != "/mnt/old" || len(cfg.SyncJobs) != 1 {
+		t.Error("PreviewImport() must not change the config")
+	}
+
+	// The preview matches what the import then does
+	if err := cfg.ImportConfig(exportPath, ImportModeMerge); err != nil {
+		t.Fatalf("ImportConfig() error = %v", err)
+	}
+	if len(cfg.Mounts) != 2 || len(cfg.SyncJobs) != 2 || cfg.Mounts[1].ID == "" {
+		t.Errorf("import got %d mounts and %d sync jobs, want 2 and 2 with IDs", len(cfg.Mounts), len(cfg.SyncJobs))
+	}
+
+	if _, err := cfg.PreviewImport(filepath.Join(t.TempDir(), "missing.yaml"), ImportModeMerge); err == nil {
+		t.Error("PreviewImport() should fail for a missing file")
+	}
+}
+
 func TestImportConfigGeneratesMissingIDs(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "config-test-*")
 	if err != nil {
@@ -2199,3 +2757,250 @@ func TestReloadConfigWithSyncJobs(t *testing.T) {
 		t.Errorf("SyncJob name = %q, want %q", cfg.SyncJobs[0].Name, "sync1")
 	}
 }
+
+func TestSetConfigHomeDirectory(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Cleanup(func() { SetConfigHome("") })
+	dir := t.TempDir()
+
+	if err := SetConfigHome(dir); err != nil {
+		t.Fatalf("SetConfigHome() error = %v", err)
+	}
+
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("ConfigPath() error = %v", err)
+	}
+	want := filepath.Join(dir, appName, "config.yaml")
+	if path != want {
+		t.Errorf("ConfigPath() = %q, want %q", path, want)
+	}
+}
+
+func TestSetConfigHomeFileUsesParent(t *testing.T) {
+	t.Cleanup(func() { SetConfigHome("") })
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(file, []byte("version: \"1.0\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	if err := SetConfigHome(file); err != nil {
+		t.Fatalf("SetConfigHome() error = %v", err)
+	}
+
+	if got := ConfigHome(); got != dir {
+		t.Errorf("ConfigHome() = %q, want %q", got, dir)
+	}
+}
+
+func TestSetConfigHomeKeepsUnitDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", home)
+	t.Cleanup(func() { SetConfigHome("") })
+	before, err := systemd.GetUserSystemdPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SetConfigHome(t.TempDir()); err != nil {
+		t.Fatalf("SetConfigHome() error = %v", err)
+	}
+
+	if after, _ := systemd.GetUserSystemdPath(); after != before {
+		t.Errorf("user unit dir = %q after a switch, want %q", after, before)
+	}
+	if got := os.Getenv("XDG_CONFIG_HOME"); got != home {
+		t.Errorf("XDG_CONFIG_HOME = %q, want it left at %q", got, home)
+	}
+
+	// An empty path goes back to the user config directory
+	if err := SetConfigHome(""); err != nil {
+		t.Fatalf("SetConfigHome() error = %v", err)
+	}
+	if path, _ := ConfigPath(); path != filepath.Join(home, appName, "config.yaml") {
+		t.Errorf("ConfigPath() = %q after clearing the config home", path)
+	}
+}
+
+func TestAutoRefreshDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", DefaultAutoRefreshInterval},
+		{"10s", 10 * time.Second},
+		{"2m", 2 * time.Minute},
+		{"bogus", DefaultAutoRefreshInterval},
+		{"100ms", DefaultAutoRefreshInterval},
+	}
+
+	for _, tt := range tests {
+		s := Settings{AutoRefreshInterval: tt.value}
+		if got := s.AutoRefreshDuration(); got != tt.want {
+			t.Errorf("AutoRefreshDuration(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRemoteCheckDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", DefaultRemoteCheckTimeout},
+		{"30s", 30 * time.Second},
+		{"bogus", DefaultRemoteCheckTimeout},
+		{"-5s", DefaultRemoteCheckTimeout},
+	}
+
+	for _, tt := range tests {
+		s := Settings{RemoteCheckTimeout: tt.value}
+		if got := s.RemoteCheckDuration(); got != tt.want {
+			t.Errorf("RemoteCheckDuration(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestReferencedRemotes(t *testing.T) {
+	cfg := &Config{
+		Mounts: []models.MountConfig{
+			{Name: "drive", Remote: "gdrive"},
+			{Name: "box", Remote: "dropbox:"},
+		},
+		SyncJobs: []models.SyncJobConfig{
+			{Name: "photos", Source: "gdrive:/Photos", Destination: "/backup/photos"},
+			{Name: "offsite", Source: "/home/user/docs", Destination: "b2:bucket/docs"},
+			{Name: "iso", Source: "https://example.com/a.iso", Destination: "/srv/iso"},
+			{Name: "inline", Source: ":local:/tmp", Destination: "/tmp/copy"},
+		},
+	}
+
+	got := cfg.ReferencedRemotes()
+	want := []string{"b2", "dropbox", "gdrive"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ReferencedRemotes() = %v, want %v", got, want)
+	}
+}
+
+func TestRemoteUsers(t *testing.T) {
+	cfg := &Config{
+		Mounts: []models.MountConfig{
+			{Name: "drive", Remote: "gdrive"},
+			{Name: "drive-music", Remote: "gdrive:"},
+			{Name: "box", Remote: "dropbox:"},
+		},
+		SyncJobs: []models.SyncJobConfig{
+			{Name: "photos", Source: "gdrive:/Photos", Destination: "/backup/photos"},
+			{Name: "both", Source: "gdrive:a", Destination: "gdrive:b"},
+			{Name: "prefix", Source: "gdrive2:/x", Destination: "/tmp/x"},
+			{Name: "local", Source: "/home/gdrive", Destination: "/tmp/gdrive"},
+		},
+	}
+
+	mounts, syncJobs := cfg.RemoteUsers("gdrive")
+	if strings.Join(mounts, ",") != "drive,drive-music" {
+		t.Errorf("RemoteUsers() mounts = %v", mounts)
+	}
+	if strings.Join(syncJobs, ",") != "photos,both" {
+		t.Errorf("RemoteUsers() sync jobs = %v", syncJobs)
+	}
+
+	if mounts, syncJobs := cfg.RemoteUsers("b2"); len(mounts)+len(syncJobs) != 0 {
+		t.Errorf("RemoteUsers(b2) = %v, %v, want none", mounts, syncJobs)
+	}
+}
+
+func TestRenameRemote(t *testing.T) {
+	cfg := &Config{
+		Mounts: []models.MountConfig{
+			{Name: "drive", Remote: "gdrive"},
+			{Name: "drive-music", Remote: "gdrive:"},
+			{Name: "box", Remote: "dropbox:"},
+		},
+		SyncJobs: []models.SyncJobConfig{
+			{Name: "both", Source: "gdrive:a", Destination: "gdrive:b"},
+			{Name: "prefix", Source: "gdrive2:/x", Destination: "/tmp/x"},
+		},
+	}
+
+	mounts, syncJobs := cfg.RenameRemote("gdrive", "drive")
+	if mounts != 2 || syncJobs != 1 {
+		t.Errorf("RenameRemote() = %d, %d, want 2, 1", mounts, syncJobs)
+	}
+	if cfg.Mounts[0].Remote != "drive" || cfg.Mounts[1].Remote != "drive:" || cfg.Mounts[2].Remote != "dropbox:" {
+		t.Errorf("mount remotes = %q, %q, %q", cfg.Mounts[0].Remote, cfg.Mounts[1].Remote, cfg.Mounts[2].Remote)
+	}
+	if cfg.Mounts[0].ModifiedAt.IsZero() || !cfg.Mounts[2].ModifiedAt.IsZero() {
+		t.Error("only changed mounts should be marked modified")
+	}
+	if j := cfg.SyncJobs[0]; j.Source != "drive:a" || j.Destination != "drive:b" {
+		t.Errorf("sync job = %q -> %q, want drive:a -> drive:b", j.Source, j.Destination)
+	}
+	if cfg.SyncJobs[1].Source != "gdrive2:/x" {
+		t.Errorf("a remote sharing the prefix was renamed: %q", cfg.SyncJobs[1].Source)
+	}
+}
+
+func TestResetToDefaults(t *testing.T) {
+	cfg := newConfigWithDefaults()
+	cfg.Mounts = []models.MountConfig{{Name: "drive", Remote: "gdrive:"}}
+	cfg.SyncJobs = []models.SyncJobConfig{{Name: "backup", Source: "/home", Destination: "b2:home"}}
+	cfg.Settings.DefaultMountDir = "/media"
+	cfg.Settings.BackupCount = 1
+	cfg.Settings.RecentPaths = []string{"/media/drive"}
+	cfg.Defaults.Mount.VFSCacheMode = "off"
+	cfg.Defaults.Sync.Transfers = 16
+
+	cfg.ResetToDefaults()
+
+	want := newConfigWithDefaults()
+	if cfg.Settings.DefaultMountDir != want.Settings.DefaultMountDir || cfg.Settings.BackupCount != want.Settings.BackupCount {
+		t.Errorf("Settings = %+v, want the defaults", cfg.Settings)
+	}
+	if cfg.Defaults != want.Defaults {
+		t.Errorf("Defaults = %+v, want %+v", cfg.Defaults, want.Defaults)
+	}
+	if len(cfg.Settings.RecentPaths) != 1 {
+		t.Errorf("RecentPaths = %v, want them kept", cfg.Settings.RecentPaths)
+	}
+	if len(cfg.Mounts) != 1 || cfg.Mounts[0].Name != "drive" || len(cfg.SyncJobs) != 1 || cfg.SyncJobs[0].Name != "backup" {
+		t.Errorf("mounts = %+v, sync jobs = %+v, want them kept", cfg.Mounts, cfg.SyncJobs)
+	}
+}
+
+func TestReferencedFlags(t *testing.T) {
+	cfg := &Config{
+		Defaults: DefaultConfig{Mount: MountDefaults{ExtraFlags: "--vfs-refresh --fast-list"}},
+		Mounts: []models.MountConfig{
+			{Name: "drive", MountOptions: models.MountOptions{ExtraArgs: "--vfs-cache-min-free-space=10G"}},
+		},
+		SyncJobs: []models.SyncJobConfig{
+			{Name: "photos", SyncOptions: models.SyncOptions{ExtraArgs: "--max-lock 2m --fast-list -v"}},
+		},
+	}
+
+	got := cfg.ReferencedFlags()
+	want := []string{"--fast-list", "--max-lock", "--vfs-cache-min-free-space", "--vfs-refresh"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ReferencedFlags() = %v, want %v", got, want)
+	}
+}
+
+func TestRenderWidth(t *testing.T) {
+	tests := []struct {
+		fixed, term, want int
+	}{
+		{0, 120, 120},
+		{100, 120, 100},
+		{100, 80, 80},
+		{-5, 120, 120},
+	}
+
+	for _, tt := range tests {
+		s := Settings{FixedWidth: tt.fixed}
+		if got := s.RenderWidth(tt.term); got != tt.want {
+			t.Errorf("RenderWidth(%d) with FixedWidth %d = %d, want %d", tt.term, tt.fixed, got, tt.want)
+		}
+	}
+}
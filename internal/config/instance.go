@@ -0,0 +1,155 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/pkg/utils"
+)
+
+// instanceFilePattern matches the markers written next to config.yaml while
+// the TUI runs, one per instance, such as instance-nas-1234.json. It also
+// matches the single instance.json of earlier versions.
+const instanceFilePattern = "instance*.json"
+
+// instanceStaleAfter is how long a marker from another host is trusted.
+// Processes on other hosts cannot be probed, so old markers are assumed to be
+// left over from a crash.
+const instanceStaleAfter = 24 * time.Hour
+
+// InstanceInfo identifies a running copy of the application.
+type InstanceInfo struct {
+	PID       int       `json:"pid"`
+	Hostname  string    `json:"hostname"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// String returns a short description such as "pid 1234 on nas (started 2024-05-01 10:04)".
+func (i InstanceInfo) String() string {
+	return fmt.Sprintf("pid %d on %s (started %s)", i.PID, i.Hostname, i.StartedAt.Local().Format("2006-01-02 15:04"))
+}
+
+// InstanceMarker is the marker claimed by this process.
+type InstanceMarker struct {
+	path string
+	info InstanceInfo
+}
+
+// Hostname and process probes, replaceable in tests.
+var (
+	getHostname  = os.Hostname
+	processAlive = func(pid int) bool {
+		err := syscall.Kill(pid, 0)
+		return err == nil || errors.Is(err, syscall.EPERM)
+	}
+)
+
+// ClaimInstance writes an instance marker for this process into the config
+// directory. Each instance has its own marker, so instances can start and
+// exit in any order without losing track of the others. If another live
+// instance holds a marker, the longest running one is returned so the
+// caller can warn. Markers of instances that are no longer running are
+// removed.
+func ClaimInstance() (*InstanceMarker, *InstanceInfo, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get config directory: %w", err)
+	}
+	if err := utils.EnsureDir(configDir); err != nil {
+		return nil, nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	host, err := getHostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	info := InstanceInfo{
+		PID:       os.Getpid(),
+		Hostname:  host,
+		StartedAt: time.Now(),
+	}
+	marker := &InstanceMarker{path: instanceFile(configDir, info), info: info}
+
+	other := liveInstance(configDir, info)
+
+	data, err := json.MarshalIndent(marker.info, "", "  ")
+	if err != nil {
+		return nil, other, fmt.Errorf("failed to encode instance marker: %w", err)
+	}
+	if err := os.WriteFile(marker.path, data, 0644); err != nil {
+		return nil, other, fmt.Errorf("failed to write instance marker: %w", err)
+	}
+
+	return marker, other, nil
+}
+
+// Release removes the marker of this instance.
+func (m *InstanceMarker) Release() error {
+	if m == nil {
+		return nil
+	}
+	if err := os.Remove(m.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove instance marker: %w", err)
+	}
+	return nil
+}
+
+// instanceFile returns the path of the marker of an instance.
+func instanceFile(configDir string, info InstanceInfo) string {
+	return filepath.Join(configDir, fmt.Sprintf("instance-%s-%d.json", info.Hostname, info.PID))
+}
+
+// liveInstance returns the longest running other instance with a marker in
+// configDir, or nil if there is none, and removes the markers left behind
+// by instances that are gone.
+func liveInstance(configDir string, self InstanceInfo) *InstanceInfo {
+	paths, _ := filepath.Glob(filepath.Join(configDir, instanceFilePattern))
+
+	var oldest *InstanceInfo
+	for _, path := range paths {
+		existing, err := readInstanceInfo(path)
+		if err != nil {
+			continue
+		}
+		if existing.PID == self.PID && existing.Hostname == self.Hostname {
+			continue
+		}
+		if !isLiveInstance(existing, self) {
+			_ = os.Remove(path)
+			continue
+		}
+		if oldest == nil || existing.StartedAt.Before(oldest.StartedAt) {
+			oldest = existing
+		}
+	}
+	return oldest
+}
+
+// readInstanceInfo reads an instance marker file.
+func readInstanceInfo(path string) (*InstanceInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var info InstanceInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse instance marker: %w", err)
+	}
+	return &info, nil
+}
+
+// isLiveInstance reports whether the existing marker belongs to another
+// instance that still appears to be running.
+func isLiveInstance(existing *InstanceInfo, self InstanceInfo) bool {
+	if existing.Hostname == self.Hostname {
+		return existing.PID != self.PID && processAlive(existing.PID)
+	}
+	return time.Since(existing.StartedAt) < instanceStaleAfter
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupInstanceTest(t *testing.T, host string, alive func(int) bool) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+
+	origGetConfigDir := getConfigDir
+	origHostname := getHostname
+	origAlive := processAlive
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	getHostname = func() (string, error) { return host, nil }
+	processAlive = alive
+	t.Cleanup(func() {
+		getConfigDir = origGetConfigDir
+		getHostname = origHostname
+		processAlive = origAlive
+	})
+
+	return tmpDir
+}
+
+func writeTestMarker(t *testing.T, dir string, info InstanceInfo) {
+	t.Helper()
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("failed to encode marker: %v", err)
+	}
+	if err := os.WriteFile(instanceFile(dir, info), data, 0644); err != nil {
+		t.Fatalf("failed to write marker: %v", err)
+	}
+}
+
+func TestClaimInstanceNoExistingMarker(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return true })
+
+	marker, other, err := ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+	if other != nil {
+		t.Errorf("ClaimInstance() other = %v, want nil", other)
+	}
+
+	info, err := readInstanceInfo(marker.path)
+	if err != nil {
+		t.Fatalf("marker not written: %v", err)
+	}
+	if info.PID != os.Getpid() || info.Hostname != "host-a" {
+		t.Errorf("marker = %+v, want this process on host-a", info)
+	}
+
+	if err := marker.Release(); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, err := os.Stat(marker.path); !os.IsNotExist(err) {
+		t.Error("Release() should remove the marker")
+	}
+	if filepath.Dir(marker.path) != dir {
+		t.Errorf("marker written to %s, want %s", marker.path, dir)
+	}
+}
+
+func TestClaimInstanceLiveLocalInstance(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return true })
+	writeTestMarker(t, dir, InstanceInfo{PID: os.Getpid() + 1, Hostname: "host-a", StartedAt: time.Now()})
+
+	_, other, err := ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+	if other == nil || other.PID != os.Getpid()+1 {
+		t.Errorf("ClaimInstance() other = %v, want the live instance", other)
+	}
+}
+
+func TestClaimInstanceDeadLocalInstance(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return false })
+	dead := InstanceInfo{PID: os.Getpid() + 1, Hostname: "host-a", StartedAt: time.Now()}
+	writeTestMarker(t, dir, dead)
+
+	_, other, err := ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+	if other != nil {
+		t.Errorf("ClaimInstance() other = %v, want nil for a dead process", other)
+	}
+	if _, err := os.Stat(instanceFile(dir, dead)); !os.IsNotExist(err) {
+		t.Error("ClaimInstance() should remove the marker of a dead process")
+	}
+}
+
+func TestClaimInstanceRemoteHost(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return false })
+
+	writeTestMarker(t, dir, InstanceInfo{PID: 42, Hostname: "host-b", StartedAt: time.Now().Add(-time.Hour)})
+	_, other, _ := ClaimInstance()
+	if other == nil || other.Hostname != "host-b" {
+		t.Errorf("ClaimInstance() other = %v, want recent marker from host-b", other)
+	}
+
+	writeTestMarker(t, dir, InstanceInfo{PID: 42, Hostname: "host-b", StartedAt: time.Now().Add(-2 * instanceStaleAfter)})
+	_, other, _ = ClaimInstance()
+	if other != nil {
+		t.Errorf("ClaimInstance() other = %v, want nil for a stale remote marker", other)
+	}
+}
+
+func TestInstanceMarkerReleaseKeepsOtherMarker(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return true })
+
+	marker, _, err := ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+
+	// Another instance starts after this one
+	other := InstanceInfo{PID: 42, Hostname: "host-b", StartedAt: time.Now()}
+	writeTestMarker(t, dir, other)
+
+	if err := marker.Release(); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, err := os.Stat(instanceFile(dir, other)); err != nil {
+		t.Error("Release() should not remove another instance's marker")
+	}
+}
+
+// TestClaimInstanceAfterLaterInstanceExits tests that an instance that
+// exits does not hide the instances still running: A starts, B starts, B
+// exits and C starts, and C is still warned about A.
+func TestClaimInstanceAfterLaterInstanceExits(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return true })
+	a := InstanceInfo{PID: os.Getpid() + 1, Hostname: "host-a", StartedAt: time.Now().Add(-time.Minute)}
+	writeTestMarker(t, dir, a)
+
+	b, other, err := ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+	if other == nil || other.PID != a.PID {
+		t.Fatalf("B: ClaimInstance() other = %v, want A", other)
+	}
+	if err := b.Release(); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	_, other, err = ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+	if other == nil || other.PID != a.PID {
+		t.Errorf("C: ClaimInstance() other = %v, want A, which is still running", other)
+	}
+	if _, err := os.Stat(instanceFile(dir, a)); err != nil {
+		t.Error("A's marker should be kept while A runs")
+	}
+}
+
+func TestClaimInstanceLegacyMarker(t *testing.T) {
+	dir := setupInstanceTest(t, "host-a", func(int) bool { return true })
+	data, _ := json.Marshal(InstanceInfo{PID: os.Getpid() + 1, Hostname: "host-a", StartedAt: time.Now()})
+	if err := os.WriteFile(filepath.Join(dir, "instance.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, other, err := ClaimInstance()
+	if err != nil {
+		t.Fatalf("ClaimInstance() error = %v", err)
+	}
+	if other == nil || other.PID != os.Getpid()+1 {
+		t.Errorf("ClaimInstance() other = %v, want the instance of the old marker", other)
+	}
+}
+
+func TestInstanceInfoString(t *testing.T) {
+	info := InstanceInfo{PID: 1234, Hostname: "nas", StartedAt: time.Now()}
+	if got := info.String(); !strings.Contains(got, "pid 1234 on nas") {
+		t.Errorf("String() = %q, want pid and host", got)
+	}
+}
+
+func TestNilInstanceMarkerRelease(t *testing.T) {
+	var marker *InstanceMarker
+	if err := marker.Release(); err != nil {
+		t.Errorf("Release() on nil marker error = %v", err)
+	}
+}
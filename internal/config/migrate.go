@@ -0,0 +1,171 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentVersion is the config schema version written by this build.
+const CurrentVersion = "1.1"
+
+// migration upgrades a decoded config file from one schema version to the
+// next. apply must only transform the data it is given.
+type migration struct {
+	from  string
+	to    string
+	apply func(data map[string]interface{}) error
+}
+
+// migrations is the chain of schema upgrades, oldest first. Each entry's to
+// version must be the next entry's from version, and the last must end at
+// CurrentVersion.
+var migrations = []migration{
+	{from: "1.0", to: "1.1", apply: migrate1_0To1_1},
+}
+
+// migrate1_0To1_1 gives IDs to mounts and sync jobs that have none. Version
+// 1.0 files edited by hand could leave the ID out, which named the item's
+// units after an empty ID.
+func migrate1_0To1_1(data map[string]interface{}) error {
+	for _, key := range []string{"mounts", "sync_jobs"} {
+		items, _ := data[key].([]interface{})
+		for i, item := range items {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s[%d] is not a mapping", key, i)
+			}
+			if id, _ := fields["id"].(string); id == "" {
+				fields["id"] = generateID()
+			}
+		}
+	}
+	return nil
+}
+
+// MigrateConfig upgrades decoded config file data to CurrentVersion in
+// place, running each migration from the data's version onwards. A missing
+// version counts as 1.0. It reports whether anything was migrated, and fails
+// if the data's version is newer than this build supports.
+func MigrateConfig(data map[string]interface{}) (bool, error) {
+	version := "1.0"
+	if raw, ok := data["version"]; ok && raw != nil {
+		version = fmt.Sprint(raw)
+	}
+
+	newer, err := compareVersions(version, CurrentVersion)
+	if err != nil {
+		return false, err
+	}
+	if newer > 0 {
+		return false, fmt.Errorf("config version %s is newer than the supported version %s; upgrade rclone-mount-sync to use this config", version, CurrentVersion)
+	}
+
+	migrated := false
+	for _, m := range migrations {
+		if cmp, _ := compareVersions(version, m.from); cmp > 0 {
+			continue
+		}
+		if err := m.apply(data); err != nil {
+			return false, fmt.Errorf("failed to migrate config from %s to %s: %w", m.from, m.to, err)
+		}
+		version = m.to
+		migrated = true
+	}
+	if migrated {
+		data["version"] = version
+	}
+	return migrated, nil
+}
+
+// migrateConfigFile runs MigrateConfig on the config file at path. When the
+// file was migrated, the existing file is backed up and the upgraded one is
+// written atomically in its place. Keys the migrations don't know about are
+// kept as they are.
+func migrateConfigFile(path string) (bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	data := make(map[string]interface{})
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return false, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	migrated, err := MigrateConfig(data)
+	if err != nil || !migrated {
+		return false, err
+	}
+
+	out, err := yaml.Marshal(data)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode migrated config: %w", err)
+	}
+	depth := DefaultBackupCount
+	if settings, ok := data["settings"].(map[string]interface{}); ok {
+		if n, ok := settings["backup_count"].(int); ok {
+			depth = Settings{BackupCount: n}.BackupDepth()
+		}
+	}
+	if err := rotateBackups(path, depth); err != nil {
+		return false, fmt.Errorf("failed to create backup: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat config file: %w", err)
+	}
+	tempPath := path + tempFileSuffix
+	if err := os.WriteFile(tempPath, out, info.Mode().Perm()); err != nil {
+		os.Remove(tempPath)
+		return false, fmt.Errorf("failed to write migrated config: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return false, fmt.Errorf("failed to rename temp file: %w", err)
+	}
+	return true, nil
+}
+
+// compareVersions compares two "major.minor" versions, returning -1, 0 or 1.
+// A bare major version such as "1" counts as "1.0".
+func compareVersions(a, b string) (int, error) {
+	pa, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1, nil
+		case pa[i] > pb[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// parseVersion splits a "major.minor" version into its numbers.
+func parseVersion(version string) ([2]int, error) {
+	var parsed [2]int
+	major, minor, hasMinor := strings.Cut(strings.TrimSpace(version), ".")
+	if !hasMinor {
+		minor = "0"
+	}
+	for i, part := range []string{major, minor} {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, fmt.Errorf("invalid config version %q", version)
+		}
+		parsed[i] = n
+	}
+	return parsed, nil
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateConfig_1_0(t *testing.T) {
+	data := map[string]interface{}{
+		"version": "1.0",
+		"mounts": []interface{}{
+			map[string]interface{}{"name": "gdrive"},
+			map[string]interface{}{"id": "a1b2c3d4", "name": "dropbox"},
+		},
+		"sync_jobs": []interface{}{
+			map[string]interface{}{"name": "photos"},
+		},
+		"unknown": "kept",
+	}
+
+	migrated, err := MigrateConfig(data)
+	if err != nil {
+		t.Fatalf("MigrateConfig() error = %v", err)
+	}
+	if !migrated {
+		t.Fatal("MigrateConfig() should report a migration")
+	}
+	if data["version"] != CurrentVersion {
+		t.Errorf("version = %v, want %s", data["version"], CurrentVersion)
+	}
+
+	mounts := data["mounts"].([]interface{})
+	if id, _ := mounts[0].(map[string]interface{})["id"].(string); len(id) != 8 {
+		t.Errorf("mount without an ID got ID %q", id)
+	}
+	if id := mounts[1].(map[string]interface{})["id"]; id != "a1b2c3d4" {
+		t.Errorf("existing mount ID changed to %v", id)
+	}
+	job := data["sync_jobs"].([]interface{})[0].(map[string]interface{})
+	if id, _ := job["id"].(string); len(id) != 8 {
+		t.Errorf("sync job without an ID got ID %q", id)
+	}
+	if data["unknown"] != "kept" {
+		t.Error("unknown keys should be kept")
+	}
+
+	// Migrating again changes nothing
+	migrated, err = MigrateConfig(data)
+	if err != nil || migrated {
+		t.Errorf("second MigrateConfig() = %v, %v, want false, nil", migrated, err)
+	}
+}
+
+func TestMigrateConfig_Versions(t *testing.T) {
+	tests := []struct {
+		version  interface{}
+		migrated bool
+		wantErr  string
+	}{
+		{nil, true, ""},
+		{1, true, ""},
+		{"1.0", true, ""},
+		{CurrentVersion, false, ""},
+		{"2.0", false, "newer than the supported version"},
+		{"1.10", false, "newer than the supported version"},
+		{"beta", false, "invalid config version"},
+	}
+	for _, tt := range tests {
+		data := map[string]interface{}{}
+		if tt.version != nil {
+			data["version"] = tt.version
+		}
+		migrated, err := MigrateConfig(data)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("version %v: error = %v, want %q", tt.version, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || migrated != tt.migrated {
+			t.Errorf("version %v: got %v, %v, want %v, nil", tt.version, migrated, err, tt.migrated)
+		}
+	}
+}
+
+func TestMigrationChain(t *testing.T) {
+	for i, m := range migrations {
+		if i > 0 && m.from != migrations[i-1].to {
+			t.Errorf("migration %d starts at %s, previous ends at %s", i, m.from, migrations[i-1].to)
+		}
+	}
+	if last := migrations[len(migrations)-1]; last.to != CurrentVersion {
+		t.Errorf("migrations end at %s, want %s", last.to, CurrentVersion)
+	}
+}
+
+func TestLoad_MigratesOldConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	t.Cleanup(func() { getConfigDir = origGetConfigDir })
+
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	original := `version: "1.0"
+mounts:
+  - name: gdrive
+    remote: "gdrive:"
+    mount_point: /mnt/gdrive
+    enabled: true
+`
+	if err := os.WriteFile(configPath, []byte(original), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %q, want %q", cfg.Version, CurrentVersion)
+	}
+	if len(cfg.Mounts) != 1 || len(cfg.Mounts[0].ID) != 8 || !cfg.Mounts[0].Enabled {
+		t.Fatalf("mount not migrated correctly: %+v", cfg.Mounts)
+	}
+
+	backup, err := os.ReadFile(configPath + ".bak")
+	if err != nil || string(backup) != original {
+		t.Errorf("backup should hold the original config, got %q, %v", backup, err)
+	}
+	info, err := os.Stat(configPath)
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("migrated config should keep its permissions, got %v, %v", info.Mode(), err)
+	}
+
+	// The upgraded file loads without migrating again
+	again, err := Load()
+	if err != nil {
+		t.Fatalf("second Load() error = %v", err)
+	}
+	if again.Mounts[0].ID != cfg.Mounts[0].ID {
+		t.Errorf("mount ID changed on reload: %q, want %q", again.Mounts[0].ID, cfg.Mounts[0].ID)
+	}
+}
+
+func TestLoad_RejectsNewerConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	origGetConfigDir := getConfigDir
+	getConfigDir = func() (string, error) { return tmpDir, nil }
+	t.Cleanup(func() { getConfigDir = origGetConfigDir })
+
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	content := "version: \"9.0\"\nfuture_setting: true\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "newer than the supported version") {
+		t.Fatalf("Load() error = %v, want a version error", err)
+	}
+	if got, _ := os.ReadFile(configPath); string(got) != content {
+		t.Error("a newer config must be left untouched")
+	}
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// homePlaceholder stands in for the home directory in portable exports.
+const homePlaceholder = "$HOME"
+
+// userHomeDir looks up the home directory, replaceable in tests.
+var userHomeDir = os.UserHomeDir
+
+// portablePath replaces a home directory prefix with $HOME. Remote paths
+// and paths outside home are returned unchanged.
+func portablePath(path, home string) string {
+	if path == "" || home == "" || !filepath.IsAbs(path) {
+		return path
+	}
+	if path == home {
+		return homePlaceholder
+	}
+	if rest, ok := strings.CutPrefix(path, strings.TrimSuffix(home, "/")+"/"); ok {
+		return homePlaceholder + "/" + rest
+	}
+	return path
+}
+
+// expandPortablePath replaces a leading $HOME with the home directory.
+func expandPortablePath(path, home string) string {
+	if path == homePlaceholder {
+		return home
+	}
+	if rest, ok := strings.CutPrefix(path, homePlaceholder+"/"); ok {
+		return filepath.Join(home, rest)
+	}
+	return path
+}
+
+// rewriteMountPaths applies rewrite to the local paths of a mount.
+func rewriteMountPaths(mount models.MountConfig, rewrite func(string) string) models.MountConfig {
+	mount.MountPoint = rewrite(mount.MountPoint)
+	mount.MountOptions.Config = rewrite(mount.MountOptions.Config)
+	return mount
+}
+
+// rewriteSyncJobPaths applies rewrite to the local paths of a sync job.
+func rewriteSyncJobPaths(job models.SyncJobConfig, rewrite func(string) string) models.SyncJobConfig {
+	job.Source = rewrite(job.Source)
+	job.Destination = rewrite(job.Destination)
+	job.SyncOptions.BackupDir = rewrite(job.SyncOptions.BackupDir)
+	job.SyncOptions.Config = rewrite(job.SyncOptions.Config)
+	job.SyncOptions.WorkingDir = rewrite(job.SyncOptions.WorkingDir)
+	return job
+}
+
+// expandPortableData expands $HOME placeholders in imported data. Data
+// without placeholders passes through unchanged.
+func expandPortableData(data *ExportData) error {
+	home, err := userHomeDir()
+	if err != nil {
+		if data.Portable {
+			return fmt.Errorf("failed to get home directory: %w", err)
+		}
+		return nil
+	}
+	expand := func(path string) string { return expandPortablePath(path, home) }
+
+	for i := range data.Mounts {
+		data.Mounts[i] = rewriteMountPaths(data.Mounts[i], expand)
+	}
+	for i := range data.SyncJobs {
+		data.SyncJobs[i] = rewriteSyncJobPaths(data.SyncJobs[i], expand)
+	}
+	if data.Settings != nil {
+		data.Settings.DefaultMountDir = expand(data.Settings.DefaultMountDir)
+	}
+	return nil
+}
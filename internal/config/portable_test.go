@@ -0,0 +1,167 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// withHomeDir makes userHomeDir return home for the duration of a test.
+func withHomeDir(t *testing.T, home string) {
+	t.Helper()
+	orig := userHomeDir
+	userHomeDir = func() (string, error) { return home, nil }
+	t.Cleanup(func() { userHomeDir = orig })
+}
+
+func TestPortablePath(t *testing.T) {
+	home := "/home/alice"
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"", ""},
+		{"/home/alice", "$HOME"},
+		{"/home/alice/mnt/gdrive", "$HOME/mnt/gdrive"},
+		{"/home/alicia/mnt", "/home/alicia/mnt"},
+		{"/mnt/shared", "/mnt/shared"},
+		{"gdrive:/Photos", "gdrive:/Photos"},
+		{"~/mnt", "~/mnt"},
+	}
+
+	for _, tt := range tests {
+		if got := portablePath(tt.path, home); got != tt.want {
+			t.Errorf("portablePath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestExpandPortablePath(t *testing.T) {
+	home := "/home/bob"
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"$HOME", "/home/bob"},
+		{"$HOME/mnt/gdrive", "/home/bob/mnt/gdrive"},
+		{"$HOMEWORK/x", "$HOMEWORK/x"},
+		{"/mnt/shared", "/mnt/shared"},
+		{"gdrive:/Photos", "gdrive:/Photos"},
+	}
+
+	for _, tt := range tests {
+		if got := expandPortablePath(tt.path, home); got != tt.want {
+			t.Errorf("expandPortablePath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestExportPortable(t *testing.T) {
+	withHomeDir(t, "/home/alice")
+	exportPath := filepath.Join(t.TempDir(), "portable.yaml")
+
+	cfg := newConfigWithDefaults()
+	cfg.Settings.RcloneBinaryPath = "/home/alice/bin/rclone"
+	cfg.Settings.DefaultMountDir = "/home/alice/mnt"
+	cfg.Settings.RecentPaths = []string{"/home/alice/secret"}
+	cfg.Mounts = []models.MountConfig{{
+		ID:           "a1b2c3d4",
+		Name:         "gdrive",
+		Remote:       "gdrive",
+		MountPoint:   "/home/alice/mnt/gdrive",
+		MountOptions: models.MountOptions{Config: "/home/alice/.config/rclone/rclone.conf"},
+	}}
+	cfg.SyncJobs = []models.SyncJobConfig{{
+		ID:          "b2c3d4e5",
+		Name:        "photos",
+		Source:      "gdrive:/Photos",
+		Destination: "/home/alice/Backup/Photos",
+		SyncOptions: models.SyncOptions{BackupDir: "/srv/old-photos", WorkingDir: "/home/alice/.cache/photos"},
+	}}
+
+	if err := cfg.ExportPortable(exportPath); err != nil {
+		t.Fatalf("ExportPortable() error = %v", err)
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	text := string(content)
+
+	for _, want := range []string{"portable: true", "$HOME/mnt/gdrive", "$HOME/Backup/Photos", "$HOME/.config/rclone/rclone.conf", "default_mount_dir: $HOME/mnt", "/srv/old-photos", "$HOME/.cache/photos"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("portable export missing %q:\n%s", want, text)
+		}
+	}
+	for _, unwanted := range []string{"/home/alice", "rclone_binary_path", "secret"} {
+		if strings.Contains(text, unwanted) {
+			t.Errorf("portable export should not contain %q:\n%s", unwanted, text)
+		}
+	}
+
+	// The config itself is left untouched
+	if cfg.Mounts[0].MountPoint != "/home/alice/mnt/gdrive" {
+		t.Errorf("ExportPortable() modified the config: %q", cfg.Mounts[0].MountPoint)
+	}
+
+	// Importing as another user expands $HOME to their home directory
+	withHomeDir(t, "/home/bob")
+	imported := newConfigWithDefaults()
+	if err := imported.ImportConfig(exportPath, ImportModeReplace); err != nil {
+		t.Fatalf("ImportConfig() error = %v", err)
+	}
+
+	if got := imported.Mounts[0].MountPoint; got != "/home/bob/mnt/gdrive" {
+		t.Errorf("imported MountPoint = %q", got)
+	}
+	if got := imported.Mounts[0].MountOptions.Config; got != "/home/bob/.config/rclone/rclone.conf" {
+		t.Errorf("imported rclone config = %q", got)
+	}
+	if got := imported.SyncJobs[0].Destination; got != "/home/bob/Backup/Photos" {
+		t.Errorf("imported Destination = %q", got)
+	}
+	if got := imported.SyncJobs[0].SyncOptions.WorkingDir; got != "/home/bob/.cache/photos" {
+		t.Errorf("imported WorkingDir = %q", got)
+	}
+	if got := imported.SyncJobs[0].Source; got != "gdrive:/Photos" {
+		t.Errorf("imported Source = %q", got)
+	}
+	if got := imported.Settings.DefaultMountDir; got != "/home/bob/mnt" {
+		t.Errorf("imported DefaultMountDir = %q", got)
+	}
+	if got := imported.Settings.RcloneBinaryPath; got != "" {
+		t.Errorf("RcloneBinaryPath = %q, should keep the local default", got)
+	}
+}
+
+func TestImportConfig_MergeExpandsPlaceholders(t *testing.T) {
+	withHomeDir(t, "/home/carol")
+	importPath := filepath.Join(t.TempDir(), "portable.json")
+	content := `{
+  "version": "1.0",
+  "portable": true,
+  "mounts": [{"id": "c3d4e5f6", "name": "dropbox", "remote": "dropbox", "remote_path": "/", "mount_point": "$HOME/Dropbox"}],
+  "sync_jobs": [],
+  "settings": {"default_mount_dir": "$HOME/cloud"}
+}`
+	if err := os.WriteFile(importPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	cfg := newConfigWithDefaults()
+	cfg.Settings.DefaultMountDir = "/mnt/local"
+	if err := cfg.ImportConfig(importPath, ImportModeMerge); err != nil {
+		t.Fatalf("ImportConfig() error = %v", err)
+	}
+
+	if got := cfg.Mounts[0].MountPoint; got != "/home/carol/Dropbox" {
+		t.Errorf("MountPoint = %q, want expanded path", got)
+	}
+	if cfg.Settings.DefaultMountDir != "/mnt/local" {
+		t.Errorf("merge import should keep local settings, got %q", cfg.Settings.DefaultMountDir)
+	}
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// CopyMountTo adds a copy of the named mount to dst. The copy gets a new ID
+// and, if dst already has a mount with that name, a numbered name. With move
+// the mount is then removed from c. The copy as added to dst is returned;
+// saving either config is left to the caller.
+func (c *Config) CopyMountTo(dst *Config, name string, move bool) (models.MountConfig, error) {
+	if dst == c {
+		return models.MountConfig{}, fmt.Errorf("source and target config are the same")
+	}
+
+	src := c.GetMount(name)
+	if src == nil {
+		return models.MountConfig{}, fmt.Errorf("mount %q not found", name)
+	}
+	mount := *src
+	mount.ID = ""
+	mount.Name = uniqueName(mount.Name, func(n string) bool { return dst.GetMount(n) != nil })
+
+	if err := dst.AddMount(mount); err != nil {
+		return models.MountConfig{}, err
+	}
+	added := *dst.GetMount(mount.Name)
+
+	if move {
+		if err := c.RemoveMount(name); err != nil {
+			return added, err
+		}
+	}
+	return added, nil
+}
+
+// CopySyncJobTo adds a copy of the named sync job to dst, like CopyMountTo.
+// Required mounts are matched by name in dst, since IDs differ between
+// configs; the copy fails if one of them is missing there. Run history is
+// not carried over.
+func (c *Config) CopySyncJobTo(dst *Config, name string, move bool) (models.SyncJobConfig, error) {
+	if dst == c {
+		return models.SyncJobConfig{}, fmt.Errorf("source and target config are the same")
+	}
+
+	src := c.GetSyncJob(name)
+	if src == nil {
+		return models.SyncJobConfig{}, fmt.Errorf("sync job %q not found", name)
+	}
+	job := *src
+	job.ID = ""
+	job.LastRun = time.Time{}
+	job.RunHistory = nil
+	job.Name = uniqueName(job.Name, func(n string) bool { return dst.GetSyncJob(n) != nil })
+
+	job.RequiresMounts = nil
+	for _, id := range src.RequiresMounts {
+		required := c.mountByID(id)
+		if required == nil {
+			return models.SyncJobConfig{}, fmt.Errorf("required mount %q not found", id)
+		}
+		target := dst.GetMount(required.Name)
+		if target == nil {
+			return models.SyncJobConfig{}, fmt.Errorf("required mount %q is not in the target config", required.Name)
+		}
+		job.RequiresMounts = append(job.RequiresMounts, target.ID)
+	}
+
+	if err := dst.AddSyncJob(job); err != nil {
+		return models.SyncJobConfig{}, err
+	}
+	added := *dst.GetSyncJob(job.Name)
+
+	if move {
+		if err := c.RemoveSyncJob(name); err != nil {
+			return added, err
+		}
+	}
+	return added, nil
+}
+
+// mountByID returns the mount with the given ID, or nil.
+func (c *Config) mountByID(id string) *models.MountConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for i := range c.Mounts {
+		if c.Mounts[i].ID == id {
+			return &c.Mounts[i]
+		}
+	}
+	return nil
+}
+
+// uniqueName returns name, or name with the lowest free numeric suffix
+// (name-2, name-3, ...) if taken reports it as in use.
+func uniqueName(name string, taken func(string) bool) string {
+	if !taken(name) {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+func TestCopyMountTo(t *testing.T) {
+	src := newConfigWithDefaults()
+	dst := newConfigWithDefaults()
+	mount := models.MountConfig{ID: "m1", Name: "photos", Remote: "gdrive", MountPoint: "/mnt/photos"}
+	src.Mounts = []models.MountConfig{mount}
+	dst.Mounts = []models.MountConfig{{ID: "m2", Name: "photos", Remote: "s3", MountPoint: "/mnt/s3"}}
+
+	added, err := src.CopyMountTo(dst, "photos", false)
+	if err != nil {
+		t.Fatalf("CopyMountTo() error = %v", err)
+	}
+	if added.Name != "photos-2" {
+		t.Errorf("copy name = %q, want photos-2 to avoid the duplicate", added.Name)
+	}
+	if added.ID == "" || added.ID == mount.ID {
+		t.Errorf("copy should get a new ID, got %q", added.ID)
+	}
+	if added.Remote != "gdrive" || len(dst.Mounts) != 2 || len(src.Mounts) != 1 {
+		t.Error("copy should add the mount to the target and keep the source")
+	}
+
+	if _, err := src.CopyMountTo(dst, "photos", false); err == nil {
+		t.Error("copying again should fail, the mount point is taken")
+	}
+
+	moveDst := newConfigWithDefaults()
+	if _, err := src.CopyMountTo(moveDst, "photos", true); err != nil {
+		t.Fatalf("CopyMountTo(move) error = %v", err)
+	}
+	if len(src.Mounts) != 0 || moveDst.GetMount("photos") == nil {
+		t.Error("move should remove the mount from the source")
+	}
+
+	if _, err := src.CopyMountTo(dst, "missing", false); err == nil {
+		t.Error("copying an unknown mount should fail")
+	}
+	if _, err := dst.CopyMountTo(dst, "photos", false); err == nil {
+		t.Error("copying into the same config should fail")
+	}
+}
+
+func TestCopySyncJobTo(t *testing.T) {
+	src := newConfigWithDefaults()
+	dst := newConfigWithDefaults()
+	src.Mounts = []models.MountConfig{{ID: "m1", Name: "nas", Remote: "nas", MountPoint: "/mnt/nas"}}
+	src.SyncJobs = []models.SyncJobConfig{{
+		ID: "j1", Name: "backup", Source: "/mnt/nas/docs", Destination: "b2:docs",
+		RequiresMounts: []string{"m1"},
+		LastRun:        time.Now(),
+		RunHistory:     []models.RunOutcome{{FinishedAt: time.Now(), Success: true}},
+	}}
+
+	if _, err := src.CopySyncJobTo(dst, "backup", false); err == nil {
+		t.Error("copy should fail while the required mount is missing from the target")
+	}
+
+	dst.Mounts = []models.MountConfig{{ID: "m9", Name: "nas", Remote: "nas", MountPoint: "/mnt/nas"}}
+	added, err := src.CopySyncJobTo(dst, "backup", true)
+	if err != nil {
+		t.Fatalf("CopySyncJobTo() error = %v", err)
+	}
+	if len(added.RequiresMounts) != 1 || added.RequiresMounts[0] != "m9" {
+		t.Errorf("RequiresMounts = %v, want the target's mount ID", added.RequiresMounts)
+	}
+	if !added.LastRun.IsZero() || len(added.RunHistory) != 0 {
+		t.Error("run history should not be copied")
+	}
+	if len(src.SyncJobs) != 0 || len(src.Mounts[0].ID) == 0 {
+		t.Error("move should remove only the sync job from the source")
+	}
+}
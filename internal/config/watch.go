@@ -0,0 +1,142 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// diskState remembers the config file contents this process last read or
+// wrote, so changes made by other programs can be told apart from its own.
+type diskState struct {
+	mu   sync.Mutex
+	path string
+	sum  []byte
+}
+
+// record stores the checksum of the file at path.
+func (d *diskState) record(path string) {
+	data, err := os.ReadFile(path)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.path = path
+	if err != nil {
+		d.sum = nil
+		return
+	}
+	sum := sha256.Sum256(data)
+	d.sum = sum[:]
+}
+
+// ChangedOnDisk reports whether the config file differs from what was last
+// loaded or saved through c. A missing file is not reported as a change.
+func (c *Config) ChangedOnDisk() bool {
+	c.disk.mu.Lock()
+	path, known := c.disk.path, c.disk.sum
+	c.disk.mu.Unlock()
+
+	if path == "" {
+		var err error
+		if path, err = ConfigPath(); err != nil {
+			return false
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	sum := sha256.Sum256(data)
+	return !bytes.Equal(sum[:], known)
+}
+
+// Watcher reports writes to a config file. Bursts of events, such as an
+// editor's save or the write and rename done by Save, are reported once the
+// file has been quiet for the debounce interval.
+type Watcher struct {
+	path    string
+	fs      *fsnotify.Watcher
+	changes chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+// NewWatcher starts watching the config file at path. The directory is
+// watched rather than the file, so the file being replaced is noticed too.
+func NewWatcher(path string, debounce time.Duration) (*Watcher, error) {
+	fs, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := fs.Add(filepath.Dir(path)); err != nil {
+		fs.Close()
+		return nil, err
+	}
+
+	w := &Watcher{
+		path:    filepath.Clean(path),
+		fs:      fs,
+		changes: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go w.run(debounce)
+	return w, nil
+}
+
+// Path returns the watched config file.
+func (w *Watcher) Path() string {
+	return w.path
+}
+
+// Changes receives a value after each burst of changes. It is closed when
+// the watcher is closed.
+func (w *Watcher) Changes() <-chan struct{} {
+	return w.changes
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	var err error
+	w.once.Do(func() {
+		close(w.done)
+		err = w.fs.Close()
+	})
+	return err
+}
+
+func (w *Watcher) run(debounce time.Duration) {
+	defer close(w.changes)
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.fs.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != w.path || event.Op == fsnotify.Chmod {
+				continue
+			}
+			timer.Reset(debounce)
+		case _, ok := <-w.fs.Errors:
+			if !ok {
+				return
+			}
+		case <-timer.C:
+			select {
+			case w.changes <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
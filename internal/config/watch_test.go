@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfig_ChangedOnDisk(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.ChangedOnDisk() {
+		t.Error("a missing config file should not count as changed")
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if cfg.ChangedOnDisk() {
+		t.Error("the config's own save should not count as changed")
+	}
+
+	other, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	other.Settings.Editor = "vim"
+	if err := other.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if !cfg.ChangedOnDisk() {
+		t.Error("a save through another config should count as changed")
+	}
+
+	if err := cfg.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if cfg.ChangedOnDisk() {
+		t.Error("reloading should pick up the change")
+	}
+}
+
+// waitForChange reports whether w reports a change within d.
+func waitForChange(w *Watcher, d time.Duration) bool {
+	select {
+	case <-w.Changes():
+		return true
+	case <-time.After(d):
+		return false
+	}
+}
+
+func TestWatcher_DebouncesWrites(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+
+	w, err := NewWatcher(path, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher() error = %v", err)
+	}
+	defer w.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile(path, []byte("version: x\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !waitForChange(w, 2*time.Second) {
+		t.Fatal("a write to the config file should be reported")
+	}
+	if waitForChange(w, 200*time.Millisecond) {
+		t.Error("a burst of writes should be reported once")
+	}
+
+	// Replacing the file, as Save does, is reported too
+	tmp := path + ".tmp.yaml"
+	if err := os.WriteFile(tmp, []byte("version: y\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+	if !waitForChange(w, 2*time.Second) {
+		t.Error("replacing the config file should be reported")
+	}
+}
+
+func TestWatcher_IgnoresOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	w, err := NewWatcher(filepath.Join(dir, "config.yaml"), 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher() error = %v", err)
+	}
+	defer w.Close()
+
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml.bak"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if waitForChange(w, 200*time.Millisecond) {
+		t.Error("writes to other files should not be reported")
+	}
+}
+
+func TestWatcher_CloseEndsChanges(t *testing.T) {
+	w, err := NewWatcher(filepath.Join(t.TempDir(), "config.yaml"), time.Second)
+	if err != nil {
+		t.Fatalf("NewWatcher() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+
+	select {
+	case _, ok := <-w.Changes():
+		if ok {
+			t.Error("Changes() should be closed, got a change")
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("Changes() should be closed after Close()")
+	}
+}
@@ -2,6 +2,8 @@
 package models
 
 import (
+	"path"
+	"strings"
 	"time"
 )
 
@@ -11,6 +13,11 @@ type MountConfig struct {
 	ID          string `json:"id" yaml:"id" mapstructure:"id"`
 	Name        string `json:"name" yaml:"name" mapstructure:"name"`
 	Description string `json:"description,omitempty" yaml:"description,omitempty" mapstructure:"description,omitempty"`
+	Notes       string `json:"notes,omitempty" yaml:"notes,omitempty" mapstructure:"notes,omitempty"`          // Free-form operational notes, may span lines
+	Favorite    bool   `json:"favorite,omitempty" yaml:"favorite,omitempty" mapstructure:"favorite,omitempty"` // Pinned to the top of lists
+
+	// DocumentationURL is added to the unit's Documentation=, e.g. a runbook
+	DocumentationURL string `json:"documentation_url,omitempty" yaml:"documentation_url,omitempty" mapstructure:"documentation_url,omitempty"`
 
 	// Rclone Configuration
 	Remote     string `json:"remote" yaml:"remote" mapstructure:"remote"`                // e.g., "gdrive:"
@@ -27,6 +34,23 @@ type MountConfig struct {
 	// Metadata
 	CreatedAt  time.Time `json:"created_at" yaml:"created_at" mapstructure:"created_at"`
 	ModifiedAt time.Time `json:"modified_at" yaml:"modified_at" mapstructure:"modified_at"`
+	Adopted    bool      `json:"adopted,omitempty" yaml:"adopted,omitempty" mapstructure:"adopted,omitempty"` // Taken over from an rclone mount started outside this tool
+}
+
+// NormalizeRemotePath returns p with a leading slash, repeated slashes
+// collapsed and any trailing slash removed. An empty path is the remote root.
+func NormalizeRemotePath(p string) string {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return "/"
+	}
+	return path.Clean("/" + p)
+}
+
+// FullRemotePath returns the exact remote:path string passed to rclone mount,
+// e.g. "gdrive:/Music". Remote may be stored with or without its colon.
+func (m MountConfig) FullRemotePath() string {
+	return strings.TrimSuffix(strings.TrimSpace(m.Remote), ":") + ":" + NormalizeRemotePath(m.RemotePath)
 }
 
 // MountOptions contains all configurable options for an rclone mount.
@@ -38,9 +62,13 @@ type MountOptions struct {
 	UID        int    `json:"uid,omitempty" yaml:"uid,omitempty" mapstructure:"uid,omitempty"`
 	GID        int    `json:"gid,omitempty" yaml:"gid,omitempty" mapstructure:"gid,omitempty"`
 
+	DefaultPermissions bool `json:"default_permissions,omitempty" yaml:"default_permissions,omitempty" mapstructure:"default_permissions,omitempty"` // Kernel enforces file modes
+
 	// Performance Options
 	BufferSize       string `json:"buffer_size,omitempty" yaml:"buffer_size,omitempty" mapstructure:"buffer_size,omitempty"` // e.g., "16M"
 	DirCacheTime     string `json:"dir_cache_time,omitempty" yaml:"dir_cache_time,omitempty" mapstructure:"dir_cache_time,omitempty"`
+	AttrTimeout      string `json:"attr_timeout,omitempty" yaml:"attr_timeout,omitempty" mapstructure:"attr_timeout,omitempty"`    // e.g., "10s"; how long the kernel caches file attributes
+	PollInterval     string `json:"poll_interval,omitempty" yaml:"poll_interval,omitempty" mapstructure:"poll_interval,omitempty"` // e.g., "5m"; "0" disables change polling
 	VFSReadChunkSize string `json:"vfs_read_chunk_size,omitempty" yaml:"vfs_read_chunk_size,omitempty" mapstructure:"vfs_read_chunk_size,omitempty"`
 	VFSCacheMode     string `json:"vfs_cache_mode,omitempty" yaml:"vfs_cache_mode,omitempty" mapstructure:"vfs_cache_mode,omitempty"`          // off, full, writes
 	VFSCacheMaxAge   string `json:"vfs_cache_max_age,omitempty" yaml:"vfs_cache_max_age,omitempty" mapstructure:"vfs_cache_max_age,omitempty"` // e.g., "24h"
@@ -51,10 +79,15 @@ type MountOptions struct {
 	NoModTime  bool `json:"no_modtime,omitempty" yaml:"no_modtime,omitempty" mapstructure:"no_modtime,omitempty"`
 	NoChecksum bool `json:"no_checksum,omitempty" yaml:"no_checksum,omitempty" mapstructure:"no_checksum,omitempty"`
 	ReadOnly   bool `json:"read_only,omitempty" yaml:"read_only,omitempty" mapstructure:"read_only,omitempty"`
+	Immutable  bool `json:"immutable,omitempty" yaml:"immutable,omitempty" mapstructure:"immutable,omitempty"` // Archive mount: read-only, no write caching
+
+	// Platform-specific Options
+	NetworkMode bool `json:"network_mode,omitempty" yaml:"network_mode,omitempty" mapstructure:"network_mode,omitempty"` // Windows only: mount as a network drive
 
 	// Network Options
 	ConnectTimeout string `json:"connect_timeout,omitempty" yaml:"connect_timeout,omitempty" mapstructure:"connect_timeout,omitempty"`
 	Timeout        string `json:"timeout,omitempty" yaml:"timeout,omitempty" mapstructure:"timeout,omitempty"`
+	BandwidthLimit string `json:"bandwidth_limit,omitempty" yaml:"bandwidth_limit,omitempty" mapstructure:"bandwidth_limit,omitempty"` // e.g., "10M" or "08:00,512k 19:00,off"
 
 	// Logging Options
 	LogLevel string `json:"log_level,omitempty" yaml:"log_level,omitempty" mapstructure:"log_level,omitempty"` // ERROR, NOTICE, INFO, DEBUG
@@ -64,12 +97,65 @@ type MountOptions struct {
 	ExtraArgs string `json:"extra_args,omitempty" yaml:"extra_args,omitempty" mapstructure:"extra_args,omitempty"` // Additional CLI args
 }
 
+// MountCapabilities describes which platform-specific mount options rclone
+// supports on an operating system.
+type MountCapabilities struct {
+	FUSEPermissions bool // --allow-other, --allow-root, --umask, --uid, --gid and --default-permissions
+	NetworkMode     bool // --network-mode
+}
+
+// PlatformMountCapabilities is keyed by GOOS. Mounts on platforms that are
+// not listed are not checked.
+var PlatformMountCapabilities = map[string]MountCapabilities{
+	"linux":   {FUSEPermissions: true},
+	"darwin":  {FUSEPermissions: true},
+	"freebsd": {FUSEPermissions: true},
+	"windows": {NetworkMode: true},
+}
+
+// UnsupportedMountOptions returns the flags of the options set in opts that
+// rclone does not support on goos.
+func UnsupportedMountOptions(opts MountOptions, goos string) []string {
+	caps, ok := PlatformMountCapabilities[goos]
+	if !ok {
+		return nil
+	}
+
+	var flags []string
+	if !caps.FUSEPermissions {
+		for _, o := range []struct {
+			flag string
+			set  bool
+		}{
+			{"--allow-other", opts.AllowOther},
+			{"--allow-root", opts.AllowRoot},
+			{"--umask", opts.Umask != ""},
+			{"--uid", opts.UID > 0},
+			{"--gid", opts.GID > 0},
+			{"--default-permissions", opts.DefaultPermissions},
+		} {
+			if o.set {
+				flags = append(flags, o.flag)
+			}
+		}
+	}
+	if !caps.NetworkMode && opts.NetworkMode {
+		flags = append(flags, "--network-mode")
+	}
+	return flags
+}
+
 // SyncJobConfig represents the configuration for an rclone sync job.
 type SyncJobConfig struct {
 	// Identification
 	ID          string `json:"id" yaml:"id" mapstructure:"id"`
 	Name        string `json:"name" yaml:"name" mapstructure:"name"`
 	Description string `json:"description,omitempty" yaml:"description,omitempty" mapstructure:"description,omitempty"`
+	Notes       string `json:"notes,omitempty" yaml:"notes,omitempty" mapstructure:"notes,omitempty"`          // Free-form operational notes, may span lines
+	Favorite    bool   `json:"favorite,omitempty" yaml:"favorite,omitempty" mapstructure:"favorite,omitempty"` // Pinned to the top of lists
+
+	// DocumentationURL is added to the units' Documentation=, e.g. a runbook
+	DocumentationURL string `json:"documentation_url,omitempty" yaml:"documentation_url,omitempty" mapstructure:"documentation_url,omitempty"`
 
 	// Rclone Configuration
 	Source      string `json:"source" yaml:"source" mapstructure:"source"`                // e.g., "gdrive:/Photos"
@@ -81,6 +167,15 @@ type SyncJobConfig struct {
 	// Schedule Configuration
 	Schedule ScheduleConfig `json:"schedule" yaml:"schedule" mapstructure:"schedule"`
 
+	// Mount Dependencies
+	RequiresMounts []string `json:"requires_mounts,omitempty" yaml:"requires_mounts,omitempty" mapstructure:"requires_mounts,omitempty"` // IDs of mounts the job runs through
+	StopWithMount  bool     `json:"stop_with_mount,omitempty" yaml:"stop_with_mount,omitempty" mapstructure:"stop_with_mount,omitempty"` // Stop the job's units when a required mount stops
+
+	// SerializeWithSharedMount runs the job one at a time with other
+	// serialized jobs that require any of the same mounts, so they don't
+	// compete for the mount's VFS cache
+	SerializeWithSharedMount bool `json:"serialize_with_shared_mount,omitempty" yaml:"serialize_with_shared_mount,omitempty" mapstructure:"serialize_with_shared_mount,omitempty"`
+
 	// Service Configuration
 	AutoStart bool `json:"auto_start" yaml:"auto_start" mapstructure:"auto_start"` // Start timer on boot
 	Enabled   bool `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
@@ -89,12 +184,19 @@ type SyncJobConfig struct {
 	CreatedAt  time.Time `json:"created_at" yaml:"created_at" mapstructure:"created_at"`
 	ModifiedAt time.Time `json:"modified_at" yaml:"modified_at" mapstructure:"modified_at"`
 	LastRun    time.Time `json:"last_run,omitempty" yaml:"last_run,omitempty" mapstructure:"last_run,omitempty"`
+
+	// RunHistory holds the outcomes of the most recent runs, oldest first
+	RunHistory []RunOutcome `json:"run_history,omitempty" yaml:"run_history,omitempty" mapstructure:"run_history,omitempty"`
 }
 
 // SyncOptions contains all configurable options for an rclone sync job.
 type SyncOptions struct {
 	// Sync Direction & Behavior
-	Direction string `json:"direction" yaml:"direction" mapstructure:"direction"` // "sync", "copy", "move"
+	Direction string `json:"direction" yaml:"direction" mapstructure:"direction"` // "sync", "copy", "move", "bisync"
+
+	// ResyncOnFirstRun runs bisync once with --resync before the first
+	// regular run, to establish the baseline bisync needs. Bisync only.
+	ResyncOnFirstRun bool `json:"resync_on_first_run,omitempty" yaml:"resync_on_first_run,omitempty" mapstructure:"resync_on_first_run,omitempty"`
 
 	// Conflict Resolution
 	ConflictResolution string `json:"conflict_resolution,omitempty" yaml:"conflict_resolution,omitempty" mapstructure:"conflict_resolution,omitempty"`
@@ -104,27 +206,59 @@ type SyncOptions struct {
 	DeleteExtraneous bool `json:"delete_extraneous,omitempty" yaml:"delete_extraneous,omitempty" mapstructure:"delete_extraneous,omitempty"`
 	DeleteAfter      bool `json:"delete_after,omitempty" yaml:"delete_after,omitempty" mapstructure:"delete_after,omitempty"`
 
+	// Backups of overwritten and deleted files
+	BackupDir string `json:"backup_dir,omitempty" yaml:"backup_dir,omitempty" mapstructure:"backup_dir,omitempty"` // Must not overlap the destination
+	Suffix    string `json:"suffix,omitempty" yaml:"suffix,omitempty" mapstructure:"suffix,omitempty"`             // e.g., ".bak"
+
 	// Filtering
 	IncludePattern string `json:"include_pattern,omitempty" yaml:"include_pattern,omitempty" mapstructure:"include_pattern,omitempty"`
 	ExcludePattern string `json:"exclude_pattern,omitempty" yaml:"exclude_pattern,omitempty" mapstructure:"exclude_pattern,omitempty"`
 	MaxAge         string `json:"max_age,omitempty" yaml:"max_age,omitempty" mapstructure:"max_age,omitempty"` // e.g., "30d"
 	MinAge         string `json:"min_age,omitempty" yaml:"min_age,omitempty" mapstructure:"min_age,omitempty"`
 
+	// Filters are rclone filter rules applied in order, e.g. "- *.tmp" or
+	// "+ *.jpg"; "include" and "exclude" are accepted in place of + and -
+	Filters []string `json:"filters,omitempty" yaml:"filters,omitempty" mapstructure:"filters,omitempty"`
+
 	// Performance
 	Transfers      int    `json:"transfers,omitempty" yaml:"transfers,omitempty" mapstructure:"transfers,omitempty"` // Parallel transfers
 	Checkers       int    `json:"checkers,omitempty" yaml:"checkers,omitempty" mapstructure:"checkers,omitempty"`
-	BandwidthLimit string `json:"bandwidth_limit,omitempty" yaml:"bandwidth_limit,omitempty" mapstructure:"bandwidth_limit,omitempty"` // e.g., "10M"
+	BandwidthLimit string `json:"bandwidth_limit,omitempty" yaml:"bandwidth_limit,omitempty" mapstructure:"bandwidth_limit,omitempty"` // e.g., "10M" or "08:00,512k 19:00,10M 23:00,off"
+
+	// Process priority set by systemd, so background syncs yield to
+	// interactive work. Zero leaves the default.
+	Nice           int `json:"nice,omitempty" yaml:"nice,omitempty" mapstructure:"nice,omitempty"`                                  // 1-19, higher is lower CPU priority
+	IONiceClass    int `json:"ionice_class,omitempty" yaml:"ionice_class,omitempty" mapstructure:"ionice_class,omitempty"`          // 2 best-effort, 3 idle
+	IONicePriority int `json:"ionice_priority,omitempty" yaml:"ionice_priority,omitempty" mapstructure:"ionice_priority,omitempty"` // 1-7 within best-effort, higher is lower
 
 	// Verification
 	CheckSum bool `json:"checksum,omitempty" yaml:"checksum,omitempty" mapstructure:"checksum,omitempty"`
 	DryRun   bool `json:"dry_run,omitempty" yaml:"dry_run,omitempty" mapstructure:"dry_run,omitempty"`
 
+	// ModifyWindow is the tolerance when comparing modification times, for
+	// destinations with coarse timestamps such as FAT or SMB (e.g., "2s")
+	ModifyWindow string `json:"modify_window,omitempty" yaml:"modify_window,omitempty" mapstructure:"modify_window,omitempty"`
+
+	// VerifyAfter runs `rclone check` after a successful sync or copy
+	VerifyAfter bool `json:"verify_after,omitempty" yaml:"verify_after,omitempty" mapstructure:"verify_after,omitempty"`
+
+	// MaxDuration stops a run that takes longer, e.g. "2h". systemd stops
+	// the unit shortly after in case rclone does not exit.
+	MaxDuration string `json:"max_duration,omitempty" yaml:"max_duration,omitempty" mapstructure:"max_duration,omitempty"`
+
+	// MaxRetries makes systemd rerun a failed run up to this many times,
+	// RetryDelay apart (a systemd time span, e.g. "30s" or "5min"; 30s if
+	// empty). Zero never retries.
+	MaxRetries int    `json:"max_retries,omitempty" yaml:"max_retries,omitempty" mapstructure:"max_retries,omitempty"`
+	RetryDelay string `json:"retry_delay,omitempty" yaml:"retry_delay,omitempty" mapstructure:"retry_delay,omitempty"`
+
 	// Logging Options
 	LogLevel string `json:"log_level,omitempty" yaml:"log_level,omitempty" mapstructure:"log_level,omitempty"` // ERROR, NOTICE, INFO, DEBUG
 
 	// Advanced
-	Config    string `json:"config,omitempty" yaml:"config,omitempty" mapstructure:"config,omitempty"`
-	ExtraArgs string `json:"extra_args,omitempty" yaml:"extra_args,omitempty" mapstructure:"extra_args,omitempty"`
+	Config     string `json:"config,omitempty" yaml:"config,omitempty" mapstructure:"config,omitempty"`
+	ExtraArgs  string `json:"extra_args,omitempty" yaml:"extra_args,omitempty" mapstructure:"extra_args,omitempty"`
+	WorkingDir string `json:"working_dir,omitempty" yaml:"working_dir,omitempty" mapstructure:"working_dir,omitempty"` // WorkingDirectory of the service, e.g. for bisync state
 }
 
 // ScheduleConfig defines the schedule for a sync job.
@@ -156,9 +290,10 @@ type ServiceStatus struct {
 	SubState    string `json:"sub_state" mapstructure:"sub_state"`       // "running", "exited", "dead", etc.
 
 	// Service Details
-	Enabled  bool `json:"enabled" mapstructure:"enabled"`
-	MainPID  int  `json:"main_pid,omitempty" mapstructure:"main_pid,omitempty"`
-	ExitCode int  `json:"exit_code,omitempty" mapstructure:"exit_code,omitempty"`
+	Enabled  bool   `json:"enabled" mapstructure:"enabled"`
+	MainPID  int    `json:"main_pid,omitempty" mapstructure:"main_pid,omitempty"`
+	ExitCode int    `json:"exit_code,omitempty" mapstructure:"exit_code,omitempty"`
+	Result   string `json:"result,omitempty" mapstructure:"result,omitempty"` // systemd's Result, e.g. "success", "timeout"
 
 	// Timestamps
 	ActivatedAt time.Time `json:"activated_at,omitempty" mapstructure:"activated_at,omitempty"`
@@ -173,3 +308,86 @@ type ServiceStatus struct {
 	NextRun     time.Time `json:"next_run,omitempty" mapstructure:"next_run,omitempty"`
 	TimerActive bool      `json:"timer_active,omitempty" mapstructure:"timer_active,omitempty"`
 }
+
+// MaxRunHistory is the number of run outcomes kept per sync job.
+const MaxRunHistory = 10
+
+// RunOutcome is the result of one finished sync job run.
+type RunOutcome struct {
+	FinishedAt time.Time `json:"finished_at" yaml:"finished_at" mapstructure:"finished_at"`
+	Success    bool      `json:"success" yaml:"success" mapstructure:"success"`
+	TimedOut   bool      `json:"timed_out,omitempty" yaml:"timed_out,omitempty" mapstructure:"timed_out,omitempty"` // Stopped by the job's max duration
+}
+
+// RunningStats is how far a running sync job has got, from rclone's
+// transfer stats.
+type RunningStats struct {
+	Bytes          int64         `json:"bytes"`           // Transferred so far
+	TotalBytes     int64         `json:"total_bytes"`     // Expected in total, 0 while unknown
+	Speed          float64       `json:"speed"`           // Bytes per second
+	ETA            time.Duration `json:"eta"`             // 0 while unknown
+	Transfers      int64         `json:"transfers"`       // Files transferred so far
+	TotalTransfers int64         `json:"total_transfers"` // Files expected in total, 0 while unknown
+}
+
+// Percent returns the share of the expected bytes transferred, from 0 to
+// 100, or -1 while the total is unknown.
+func (s RunningStats) Percent() int {
+	if s.TotalBytes <= 0 {
+		return -1
+	}
+	if s.Bytes >= s.TotalBytes {
+		return 100
+	}
+	return int(s.Bytes * 100 / s.TotalBytes)
+}
+
+// RunRecord is one run of a sync job's service, read from the journal.
+// What the journal did not record is left zero.
+type RunRecord struct {
+	Start      time.Time     `json:"start"`
+	Duration   time.Duration `json:"duration"`              // 0 while running
+	ExitStatus *int          `json:"exit_status,omitempty"` // Main process exit status, nil if not logged
+	Result     string        `json:"result,omitempty"`      // systemd's result, e.g. "success" or "exit-code"; empty while running
+	Bytes      int64         `json:"bytes,omitempty"`       // Transferred, from rclone's last logged stats
+}
+
+// rcloneDurationExceeded is rclone's exit code when --max-duration is reached.
+const rcloneDurationExceeded = 10
+
+// RunOutcomeFromStatus returns the outcome of the last finished run of a sync
+// service, or false if the service has not finished a run or is running.
+func RunOutcomeFromStatus(status *ServiceStatus) (RunOutcome, bool) {
+	if status == nil || status.InactiveAt.IsZero() {
+		return RunOutcome{}, false
+	}
+	timedOut := status.Result == "timeout" || status.ExitCode == rcloneDurationExceeded
+	switch status.ActiveState {
+	case "inactive":
+		return RunOutcome{FinishedAt: status.InactiveAt, Success: status.ExitCode == 0 && !timedOut, TimedOut: timedOut}, true
+	case "failed":
+		return RunOutcome{FinishedAt: status.InactiveAt, Success: false, TimedOut: timedOut}, true
+	}
+	return RunOutcome{}, false
+}
+
+// RecordRun adds a run outcome to the job's history unless it is already
+// recorded, keeping the last MaxRunHistory outcomes and updating LastRun.
+// It reports whether the history changed.
+func (j *SyncJobConfig) RecordRun(outcome RunOutcome) bool {
+	for _, r := range j.RunHistory {
+		if r.FinishedAt.Equal(outcome.FinishedAt) {
+			return false
+		}
+	}
+	if n := len(j.RunHistory); n > 0 && outcome.FinishedAt.Before(j.RunHistory[n-1].FinishedAt) {
+		return false
+	}
+
+	j.RunHistory = append(j.RunHistory, outcome)
+	if len(j.RunHistory) > MaxRunHistory {
+		j.RunHistory = j.RunHistory[len(j.RunHistory)-MaxRunHistory:]
+	}
+	j.LastRun = outcome.FinishedAt
+	return true
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 )
@@ -1451,3 +1452,115 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestRunOutcomeFromStatus(t *testing.T) {
+	finished := time.Date(2024, 6, 2, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		status *ServiceStatus
+		want   RunOutcome
+		wantOK bool
+	}{
+		{"nil status", nil, RunOutcome{}, false},
+		{"never run", &ServiceStatus{ActiveState: "inactive"}, RunOutcome{}, false},
+		{"running", &ServiceStatus{ActiveState: "activating", InactiveAt: finished}, RunOutcome{}, false},
+		{"succeeded", &ServiceStatus{ActiveState: "inactive", InactiveAt: finished}, RunOutcome{FinishedAt: finished, Success: true}, true},
+		{"failed", &ServiceStatus{ActiveState: "failed", InactiveAt: finished, ExitCode: 1}, RunOutcome{FinishedAt: finished}, true},
+		{"timed out in systemd", &ServiceStatus{ActiveState: "failed", InactiveAt: finished, Result: "timeout"}, RunOutcome{FinishedAt: finished, TimedOut: true}, true},
+		{"rclone max duration", &ServiceStatus{ActiveState: "inactive", InactiveAt: finished, ExitCode: 10}, RunOutcome{FinishedAt: finished, TimedOut: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RunOutcomeFromStatus(tt.status)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("RunOutcomeFromStatus() = %+v, %v; want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSyncJobConfig_RecordRun(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	var job SyncJobConfig
+
+	for i := 0; i < MaxRunHistory+3; i++ {
+		if !job.RecordRun(RunOutcome{FinishedAt: start.Add(time.Duration(i) * time.Hour), Success: i%2 == 0}) {
+			t.Fatalf("RecordRun() #%d should record a new run", i)
+		}
+	}
+
+	if len(job.RunHistory) != MaxRunHistory {
+		t.Fatalf("len(RunHistory) = %d, want %d", len(job.RunHistory), MaxRunHistory)
+	}
+	last := start.Add(time.Duration(MaxRunHistory+2) * time.Hour)
+	if !job.RunHistory[MaxRunHistory-1].FinishedAt.Equal(last) || !job.LastRun.Equal(last) {
+		t.Errorf("newest run = %v, LastRun = %v; want %v", job.RunHistory[MaxRunHistory-1].FinishedAt, job.LastRun, last)
+	}
+
+	if job.RecordRun(RunOutcome{FinishedAt: last}) {
+		t.Error("RecordRun() should skip a run that is already recorded")
+	}
+	if job.RecordRun(RunOutcome{FinishedAt: start}) {
+		t.Error("RecordRun() should skip a run older than the history")
+	}
+}
+
+func TestNormalizeRemotePath(t *testing.T) {
+	tests := map[string]string{
+		"":               "/",
+		"  ":             "/",
+		"/":              "/",
+		"Music":          "/Music",
+		"/Music/":        "/Music",
+		"//Media//Music": "/Media/Music",
+		" /Photos/2024 ": "/Photos/2024",
+	}
+	for in, want := range tests {
+		if got := NormalizeRemotePath(in); got != want {
+			t.Errorf("NormalizeRemotePath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMountConfig_FullRemotePath(t *testing.T) {
+	tests := []struct {
+		remote, path, want string
+	}{
+		{"gdrive:", "/", "gdrive:/"},
+		{"gdrive", "/Music", "gdrive:/Music"},
+		{"gdrive:", "Media//Music/", "gdrive:/Media/Music"},
+		{"s3:", "", "s3:/"},
+	}
+	for _, tt := range tests {
+		m := MountConfig{Remote: tt.remote, RemotePath: tt.path}
+		if got := m.FullRemotePath(); got != tt.want {
+			t.Errorf("FullRemotePath() for %q + %q = %q, want %q", tt.remote, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestUnsupportedMountOptions(t *testing.T) {
+	fuse := MountOptions{AllowOther: true, Umask: "002", UID: 1000, DefaultPermissions: true}
+	tests := []struct {
+		name string
+		opts MountOptions
+		goos string
+		want []string
+	}{
+		{"FUSE options on linux", fuse, "linux", nil},
+		{"FUSE options on windows", fuse, "windows", []string{"--allow-other", "--umask", "--uid", "--default-permissions"}},
+		{"network mode on linux", MountOptions{NetworkMode: true}, "linux", []string{"--network-mode"}},
+		{"network mode on windows", MountOptions{NetworkMode: true}, "windows", nil},
+		{"unknown platform is not checked", MountOptions{NetworkMode: true, AllowRoot: true}, "plan9", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnsupportedMountOptions(tt.opts, tt.goos)
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("UnsupportedMountOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package rclone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ErrAboutNotSupported is returned by About for backends that cannot report
+// their usage, such as most S3 providers.
+var ErrAboutNotSupported = errors.New("remote does not report its usage")
+
+// RemoteUsage is the storage usage of a remote, as reported by
+// `rclone about`. Backends leave out what they do not know, so each figure
+// is nil when unreported.
+type RemoteUsage struct {
+	Total *int64 `json:"total"`
+	Used  *int64 `json:"used"`
+	Free  *int64 `json:"free"`
+}
+
+// About returns the usage of a remote, given as "name:" or "name:path".
+func (c *Client) About(ctx context.Context, remote string) (*RemoteUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	output, err := c.runCommand(ctx, "about", remote, "--json")
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			stderr := strings.TrimSpace(string(exitErr.Stderr))
+			if strings.Contains(stderr, "doesn't support about") {
+				return nil, ErrAboutNotSupported
+			}
+			lines := strings.Split(stderr, "\n")
+			if msg := strings.TrimSpace(lines[len(lines)-1]); msg != "" {
+				return nil, fmt.Errorf("rclone about failed: %s", msg)
+			}
+		}
+		return nil, fmt.Errorf("rclone about failed: %w", err)
+	}
+
+	var usage RemoteUsage
+	if err := json.Unmarshal(output, &usage); err != nil {
+		return nil, fmt.Errorf("failed to parse rclone about output: %w", err)
+	}
+	return &usage, nil
+}
@@ -0,0 +1,81 @@
+package rclone
+
+import "strings"
+
+// BackendFlag is a commonly tuned backend-specific rclone flag.
+type BackendFlag struct {
+	Flag        string // Flag with a suggested value, e.g. "--s3-upload-concurrency=8"
+	Description string
+}
+
+// Name returns the flag without its value.
+func (f BackendFlag) Name() string {
+	name, _, _ := strings.Cut(f.Flag, "=")
+	return name
+}
+
+// backendFlags is a curated list of useful flags per remote type, as
+// reported by "rclone config show". It is not meant to be complete, only to
+// make the extra arguments discoverable for the common backends.
+var backendFlags = map[string][]BackendFlag{
+	"s3": {
+		{"--s3-upload-concurrency=8", "Upload more parts of large files in parallel"},
+		{"--s3-chunk-size=16M", "Larger multipart chunks, faster for big files"},
+		{"--s3-no-check-bucket", "Don't check or create the bucket, for keys without that permission"},
+	},
+	"drive": {
+		{"--drive-chunk-size=64M", "Larger upload chunks, faster for big files"},
+		{"--drive-skip-gdocs", "Skip Google Docs, Sheets and Slides"},
+		{"--drive-skip-shortcuts", "Ignore shortcuts to other files and folders"},
+	},
+	"dropbox": {
+		{"--dropbox-chunk-size=48M", "Larger upload chunks, faster for big files"},
+		{"--dropbox-batch-mode=async", "Batch uploads to avoid Dropbox rate limits"},
+	},
+	"onedrive": {
+		{"--onedrive-chunk-size=10M", "Larger upload chunks, faster for big files"},
+		{"--onedrive-no-versions", "Remove old versions created by overwriting files"},
+	},
+	"b2": {
+		{"--b2-upload-concurrency=8", "Upload more parts of large files in parallel"},
+		{"--b2-hard-delete", "Delete files instead of hiding them"},
+	},
+	"azureblob": {
+		{"--azureblob-upload-concurrency=16", "Upload more parts of large files in parallel"},
+		{"--azureblob-chunk-size=8M", "Larger upload chunks, faster for big files"},
+	},
+	"google cloud storage": {
+		{"--gcs-bucket-policy-only", "Use bucket-level access control, required by uniform access buckets"},
+	},
+	"sftp": {
+		{"--sftp-disable-hashcheck", "Don't run md5sum/sha1sum on the server"},
+		{"--sftp-concurrency=128", "More outstanding requests per file, faster on high latency links"},
+	},
+}
+
+// BackendFlagHints returns the suggested flags for a remote type, or nil
+// when there are none.
+func BackendFlagHints(remoteType string) []BackendFlag {
+	return backendFlags[strings.ToLower(strings.TrimSpace(remoteType))]
+}
+
+// AddFlags appends flags to extra arguments, skipping any flag whose name
+// the arguments already set so a chosen value is never overridden.
+func AddFlags(extraArgs string, flags []string) string {
+	present := make(map[string]bool)
+	for _, field := range strings.Fields(extraArgs) {
+		name, _, _ := strings.Cut(field, "=")
+		present[name] = true
+	}
+
+	args := strings.TrimSpace(extraArgs)
+	for _, flag := range flags {
+		name, _, _ := strings.Cut(flag, "=")
+		if present[name] {
+			continue
+		}
+		present[name] = true
+		args = strings.TrimSpace(args + " " + flag)
+	}
+	return args
+}
@@ -0,0 +1,65 @@
+package rclone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// IsURL reports whether source is an http(s) URL rather than an rclone path.
+func IsURL(source string) bool {
+	lower := strings.ToLower(source)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// FetchArgs returns the rclone arguments for a one-shot fetch of source to
+// dest. URLs are downloaded with copyurl, which names the file after the URL
+// when dest ends in a slash; anything else is copied with copy.
+func FetchArgs(source, dest string) []string {
+	if IsURL(source) {
+		if strings.HasSuffix(dest, "/") {
+			return []string{"copyurl", "--auto-filename", source, dest}
+		}
+		return []string{"copyurl", source, dest}
+	}
+	return []string{"copy", source, dest}
+}
+
+// Fetch copies source to dest in a single rclone run, without creating a
+// unit or config entry. rclone's output, including its progress stats, is
+// written to out. A non-zero exit is reported with its exit status.
+func (c *Client) Fetch(ctx context.Context, source, dest string, out io.Writer, extraArgs ...string) error {
+	source = strings.TrimSpace(source)
+	dest = strings.TrimSpace(dest)
+	if source == "" || dest == "" {
+		return fmt.Errorf("fetch needs both a source and a destination")
+	}
+	if source == dest {
+		return fmt.Errorf("source and destination are the same")
+	}
+
+	fetchArgs := FetchArgs(source, dest)
+	args := append(fetchArgs, extraArgs...)
+	if c.configPath != "" {
+		args = append([]string{"--config", c.configPath}, args...)
+	}
+
+	cmd := exec.CommandContext(ctx, c.binaryPath, args...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	// out is not a file, so the output is copied through a pipe that a
+	// process rclone left behind could hold open after a cancel
+	cmd.WaitDelay = time.Second
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return fmt.Errorf("rclone %s exited with status %d", fetchArgs[0], exitErr.ExitCode())
+		}
+		return fmt.Errorf("failed to run rclone: %w", err)
+	}
+	return nil
+}
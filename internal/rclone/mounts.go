@@ -0,0 +1,90 @@
+package rclone
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ActiveMount is an rclone FUSE mount that is currently mounted.
+type ActiveMount struct {
+	Remote     string // Remote name without the trailing colon
+	RemotePath string // Path on the remote, "/" for its root
+	MountPoint string
+
+	// FUSE options the mount was made with
+	ReadOnly           bool
+	AllowOther         bool
+	DefaultPermissions bool
+}
+
+// procMountsPath is the mount table read by ListActiveMounts; tests override it.
+var procMountsPath = "/proc/mounts"
+
+// ListActiveMounts returns the rclone FUSE mounts in the kernel's mount
+// table, whether or not they were started by this tool.
+func ListActiveMounts() ([]ActiveMount, error) {
+	f, err := os.Open(procMountsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseProcMounts(f)
+}
+
+// parseProcMounts parses a mount table in the format of /proc/mounts and
+// returns its rclone mounts of a remote. Mounts of local paths are skipped.
+func parseProcMounts(r io.Reader) ([]ActiveMount, error) {
+	var mounts []ActiveMount
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[2] != "fuse.rclone" {
+			continue
+		}
+
+		remote, path, ok := strings.Cut(unescapeMountField(fields[0]), ":")
+		if !ok || remote == "" || strings.ContainsAny(remote, "/") {
+			continue
+		}
+		m := ActiveMount{
+			Remote:     remote,
+			RemotePath: "/" + strings.Trim(path, "/"),
+			MountPoint: unescapeMountField(fields[1]),
+		}
+		for _, opt := range strings.Split(fields[3], ",") {
+			switch opt {
+			case "ro":
+				m.ReadOnly = true
+			case "allow_other":
+				m.AllowOther = true
+			case "default_permissions":
+				m.DefaultPermissions = true
+			}
+		}
+		mounts = append(mounts, m)
+	}
+	return mounts, scanner.Err()
+}
+
+// unescapeMountField decodes the octal escapes, such as \040 for a space,
+// that the kernel uses for whitespace and backslashes in the mount table.
+func unescapeMountField(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if n, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
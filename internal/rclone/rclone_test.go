@@ -2,12 +2,14 @@ package rclone
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -962,3 +964,299 @@ echo "success"
 		t.Errorf("GetRetryConfig().RetryMultiplier = %v, want %v", retrievedConfig.RetryMultiplier, customConfig.RetryMultiplier)
 	}
 }
+
+func TestFetchArgs(t *testing.T) {
+	tests := []struct {
+		source, dest string
+		want         string
+	}{
+		{"https://example.com/a.iso", "/tmp/a.iso", "copyurl https://example.com/a.iso /tmp/a.iso"},
+		{"HTTP://example.com/a.iso", "/tmp/", "copyurl --auto-filename HTTP://example.com/a.iso /tmp/"},
+		{"gdrive:/Docs/report.pdf", "/tmp", "copy gdrive:/Docs/report.pdf /tmp"},
+	}
+
+	for _, tt := range tests {
+		if got := strings.Join(FetchArgs(tt.source, tt.dest), " "); got != tt.want {
+			t.Errorf("FetchArgs(%q, %q) = %q, want %q", tt.source, tt.dest, got, tt.want)
+		}
+	}
+}
+
+func TestFetch(t *testing.T) {
+	mockScript := `#!/bin/sh
+echo "args: $*"
+`
+	mockPath := createMockRclone(t, mockScript)
+	c := NewClientWithPath(mockPath)
+
+	var out strings.Builder
+	if err := c.Fetch(context.Background(), "gdrive:/a.txt", "/tmp", &out, "--progress"); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "args: copy gdrive:/a.txt /tmp --progress") {
+		t.Errorf("Fetch() output = %q", out.String())
+	}
+
+	if err := c.Fetch(context.Background(), "", "/tmp", &out); err == nil {
+		t.Error("Fetch() should reject an empty source")
+	}
+}
+
+func TestFetchExitStatus(t *testing.T) {
+	mockScript := `#!/bin/sh
+echo "ERROR : 404 Not Found" >&2
+exit 3
+`
+	mockPath := createMockRclone(t, mockScript)
+	c := NewClientWithPath(mockPath)
+
+	var out strings.Builder
+	err := c.Fetch(context.Background(), "https://example.com/missing", "/tmp/", &out)
+	if err == nil || !strings.Contains(err.Error(), "rclone copyurl exited with status 3") {
+		t.Errorf("Fetch() error = %v, want exit status 3", err)
+	}
+	if !strings.Contains(out.String(), "404 Not Found") {
+		t.Errorf("Fetch() should pass stderr to out, got %q", out.String())
+	}
+}
+
+// startWriter discards output and closes started on the first write.
+type startWriter struct {
+	once    sync.Once
+	started chan struct{}
+}
+
+func (w *startWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.started) })
+	return len(p), nil
+}
+
+func TestFetchStopsOnCancel(t *testing.T) {
+	// The background sleep keeps the output pipe open after the script is
+	// killed, as a child of rclone could
+	mockScript := `#!/bin/sh
+sleep 30 &
+echo started
+wait
+`
+	c := NewClientWithPath(createMockRclone(t, mockScript))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := &startWriter{started: make(chan struct{})}
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() { done <- c.Fetch(ctx, "gdrive:/a.txt", "/tmp", out) }()
+
+	<-out.started
+	cancel()
+	if err := <-done; err == nil {
+		t.Error("Fetch() should fail once cancelled")
+	}
+	if elapsed := time.Since(start); elapsed >= 30*time.Second {
+		t.Errorf("Fetch() returned after %v, want it not to wait for the pipe", elapsed)
+	}
+}
+
+func TestProbe(t *testing.T) {
+	mockScript := `#!/bin/sh
+if [ "$2" = "missing:" ]; then
+	echo "NOTICE: starting" >&2
+	echo "CRITICAL: Failed to create file system: didn't find section in config file" >&2
+	exit 1
+fi
+`
+	c := NewClientWithPath(createMockRclone(t, mockScript))
+
+	if err := c.Probe(context.Background(), "lsd", "gdrive:/"); err != nil {
+		t.Errorf("Probe() error = %v", err)
+	}
+	err := c.Probe(context.Background(), "lsd", "missing:")
+	if err == nil || !strings.Contains(err.Error(), "rclone lsd failed: CRITICAL: Failed to create file system") {
+		t.Errorf("Probe() error = %v, want the last stderr line", err)
+	}
+}
+
+func TestParseProcMounts(t *testing.T) {
+	table := `sysfs /sys sysfs rw,nosuid,nodev,noexec,relatime 0 0
+gdrive:Photos/2024 /home/user/mnt/my\040photos fuse.rclone ro,nosuid,nodev,relatime,user_id=1000,group_id=1000,allow_other 0 0
+dropbox: /home/user/mnt/dropbox fuse.rclone rw,nosuid,nodev,relatime,user_id=1000,group_id=1000,default_permissions 0 0
+/srv/data /home/user/mnt/local fuse.rclone rw,nosuid,nodev 0 0
+sshfs:host /home/user/mnt/ssh fuse.sshfs rw 0 0
+`
+	mounts, err := parseProcMounts(strings.NewReader(table))
+	if err != nil {
+		t.Fatalf("parseProcMounts: %v", err)
+	}
+	want := []ActiveMount{
+		{Remote: "gdrive", RemotePath: "/Photos/2024", MountPoint: "/home/user/mnt/my photos", ReadOnly: true, AllowOther: true},
+		{Remote: "dropbox", RemotePath: "/", MountPoint: "/home/user/mnt/dropbox", DefaultPermissions: true},
+	}
+	if len(mounts) != len(want) {
+		t.Fatalf("got %d mounts, want %d: %+v", len(mounts), len(want), mounts)
+	}
+	for i := range want {
+		if mounts[i] != want[i] {
+			t.Errorf("mount %d = %+v, want %+v", i, mounts[i], want[i])
+		}
+	}
+}
+
+func TestBackendFlagHints(t *testing.T) {
+	hints := BackendFlagHints("S3")
+	if len(hints) == 0 {
+		t.Fatal("expected hints for s3")
+	}
+	for _, hint := range hints {
+		if !strings.HasPrefix(hint.Name(), "--s3-") || hint.Description == "" {
+			t.Errorf("unexpected s3 hint %+v", hint)
+		}
+	}
+	if hints := BackendFlagHints("local"); hints != nil {
+		t.Errorf("expected no hints for local, got %v", hints)
+	}
+}
+
+func TestAddFlags(t *testing.T) {
+	tests := []struct {
+		extraArgs string
+		flags     []string
+		want      string
+	}{
+		{"", []string{"--s3-chunk-size=16M"}, "--s3-chunk-size=16M"},
+		{"--fast-list", []string{"--s3-no-check-bucket"}, "--fast-list --s3-no-check-bucket"},
+		{"--s3-chunk-size=64M", []string{"--s3-chunk-size=16M", "--s3-no-check-bucket"}, "--s3-chunk-size=64M --s3-no-check-bucket"},
+		{" --fast-list ", nil, "--fast-list"},
+	}
+	for _, tt := range tests {
+		if got := AddFlags(tt.extraArgs, tt.flags); got != tt.want {
+			t.Errorf("AddFlags(%q, %v) = %q, want %q", tt.extraArgs, tt.flags, got, tt.want)
+		}
+	}
+}
+
+func TestAbout(t *testing.T) {
+	mockScript := `#!/bin/sh
+case "$2" in
+	gdrive:) echo '{"total":107374182400,"used":48533130035,"free":58841052365,"trashed":0}' ;;
+	onedrive:) echo '{"used":1024}' ;;
+	s3:) echo "ERROR : : about: s3 root '' doesn't support about" >&2; exit 1 ;;
+	*) echo "CRITICAL: Failed to create file system: didn't find section in config file" >&2; exit 1 ;;
+esac
+`
+	c := NewClientWithPath(createMockRclone(t, mockScript))
+
+	usage, err := c.About(context.Background(), "gdrive:")
+	if err != nil {
+		t.Fatalf("About() error = %v", err)
+	}
+	if usage.Total == nil || *usage.Total != 107374182400 || usage.Used == nil || *usage.Used != 48533130035 || usage.Free == nil || *usage.Free != 58841052365 {
+		t.Errorf("About() = %+v, want total, used and free", usage)
+	}
+
+	if usage, err = c.About(context.Background(), "onedrive:"); err != nil || usage.Total != nil || usage.Used == nil {
+		t.Errorf("About() = %+v, %v, want only the used bytes", usage, err)
+	}
+
+	if _, err := c.About(context.Background(), "s3:"); !errors.Is(err, ErrAboutNotSupported) {
+		t.Errorf("About() error = %v, want ErrAboutNotSupported", err)
+	}
+
+	if _, err := c.About(context.Background(), "missing:"); err == nil || !strings.Contains(err.Error(), "didn't find section") {
+		t.Errorf("About() error = %v, want the last stderr line", err)
+	}
+}
+
+func TestParseCoreStats(t *testing.T) {
+	// Trimmed from the output of `rclone rc core/stats` during a sync
+	payload := `{
+		"bytes": 1073741824,
+		"checks": 12,
+		"deletes": 0,
+		"elapsedTime": 95.2,
+		"errors": 0,
+		"eta": 272,
+		"fatalError": false,
+		"renames": 0,
+		"retryError": false,
+		"speed": 11274289.5,
+		"totalBytes": 4294967296,
+		"totalChecks": 12,
+		"totalTransfers": 40,
+		"transferTime": 94.8,
+		"transfers": 9,
+		"transferring": [{"name": "photos/2024.zip", "percentage": 41}]
+	}`
+	stats, err := ParseCoreStats([]byte(payload))
+	if err != nil {
+		t.Fatalf("ParseCoreStats() error = %v", err)
+	}
+	if stats.Bytes != 1<<30 || stats.TotalBytes != 4<<30 || stats.Percent() != 25 {
+		t.Errorf("bytes = %d / %d (%d%%), want 1 GiB / 4 GiB (25%%)", stats.Bytes, stats.TotalBytes, stats.Percent())
+	}
+	if stats.ETA != 272*time.Second || stats.Speed != 11274289.5 {
+		t.Errorf("ETA = %v, speed = %v", stats.ETA, stats.Speed)
+	}
+	if stats.Transfers != 9 || stats.TotalTransfers != 40 {
+		t.Errorf("transfers = %d / %d, want 9 / 40", stats.Transfers, stats.TotalTransfers)
+	}
+
+	// The ETA is null until rclone can estimate it
+	stats, err = ParseCoreStats([]byte(`{"bytes": 0, "totalBytes": 0, "eta": null}`))
+	if err != nil || stats.ETA != 0 || stats.Percent() != -1 {
+		t.Errorf("ParseCoreStats() without ETA = %+v, %v", stats, err)
+	}
+
+	if _, err := ParseCoreStats([]byte("not json")); err == nil {
+		t.Error("ParseCoreStats() should fail on invalid JSON")
+	}
+}
+
+func TestParseStatsLog(t *testing.T) {
+	logs := `Oct 16 10:00:00 host rclone[42]: Transferred:   	  100 MiB / 1 GiB, 10%, 2 MiB/s, ETA 7m40s
+Oct 16 10:00:00 host rclone[42]: Transferred:            1 / 20, 5%
+Oct 16 10:01:00 host rclone[42]: Transferred:   	  512 MiB / 1 GiB, 50%, 8.5 MiB/s, ETA 1m0s
+Oct 16 10:01:00 host rclone[42]: Checks:                20 / 20, 100%
+Oct 16 10:01:00 host rclone[42]: Transferred:           10 / 20, 50%
+Oct 16 10:01:00 host rclone[42]: Elapsed time:      1m0.5s`
+
+	stats, ok := ParseStatsLog(logs)
+	if !ok {
+		t.Fatal("ParseStatsLog() found no stats")
+	}
+	if stats.Bytes != 512<<20 || stats.TotalBytes != 1<<30 || stats.Percent() != 50 {
+		t.Errorf("bytes = %d / %d, want the last block's 512 MiB / 1 GiB", stats.Bytes, stats.TotalBytes)
+	}
+	if stats.ETA != time.Minute || stats.Speed != 8.5*(1<<20) {
+		t.Errorf("ETA = %v, speed = %v", stats.ETA, stats.Speed)
+	}
+	if stats.Transfers != 10 || stats.TotalTransfers != 20 {
+		t.Errorf("transfers = %d / %d, want 10 / 20", stats.Transfers, stats.TotalTransfers)
+	}
+
+	if _, ok := ParseStatsLog("Oct 16 10:00:00 host rclone[42]: INFO  : There was nothing to transfer"); ok {
+		t.Error("ParseStatsLog() should report no stats")
+	}
+	if eta := parseStatsETA("1d2h"); eta != 26*time.Hour {
+		t.Errorf("parseStatsETA(1d2h) = %v", eta)
+	}
+}
+
+func TestRCAddr(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		enabled bool
+	}{
+		{"", DefaultRCAddr, false},
+		{"--rc", DefaultRCAddr, true},
+		{"--fast-list --rc --rc-addr=:5580", "localhost:5580", true},
+		{"--rc --rc-addr 127.0.0.1:6000", "127.0.0.1:6000", true},
+		{"--rc-addr=:5580", "localhost:5580", false},
+	}
+	for _, tt := range tests {
+		addr, enabled := RCAddr(tt.args)
+		if addr != tt.want || enabled != tt.enabled {
+			t.Errorf("RCAddr(%q) = %q, %v; want %q, %v", tt.args, addr, enabled, tt.want, tt.enabled)
+		}
+	}
+}
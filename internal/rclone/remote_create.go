@@ -0,0 +1,258 @@
+package rclone
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BackendField is a setting asked for when creating a remote.
+type BackendField struct {
+	Key      string // rclone option name, e.g. "access_key_id"
+	Label    string // Prompt shown to the user
+	Help     string // One-line explanation
+	Default  string // Suggested value
+	Required bool
+	Secret   bool // Masked when entered
+	Obscured bool // Stored obscured by rclone; the wizard obscures what is typed
+}
+
+// Backend describes a storage type the remote wizard can create.
+type Backend struct {
+	Type        string // rclone backend type, e.g. "drive"
+	Description string
+	OAuth       bool // Needs a token from rclone authorize
+	Fields      []BackendField
+}
+
+// Backends are the storage types the remote wizard knows the common
+// settings of. Others can still be created with explicit key=value params.
+var Backends = []Backend{
+	{
+		Type:        "drive",
+		Description: "Google Drive",
+		OAuth:       true,
+		Fields: []BackendField{
+			{Key: "scope", Label: "Scope", Help: "drive, drive.readonly or drive.file", Default: "drive"},
+			{Key: "client_id", Label: "Client ID", Help: "Your own OAuth client ID; leave empty to use rclone's"},
+			{Key: "client_secret", Label: "Client Secret", Help: "Secret of your own OAuth client", Secret: true},
+			{Key: "root_folder_id", Label: "Root Folder ID", Help: "Folder to use as the root; leave empty for My Drive"},
+		},
+	},
+	{
+		Type:        "s3",
+		Description: "Amazon S3 and compatible storage",
+		Fields: []BackendField{
+			{Key: "provider", Label: "Provider", Help: "AWS, Minio, Wasabi, Cloudflare, Other...", Default: "AWS", Required: true},
+			{Key: "access_key_id", Label: "Access Key ID", Required: true},
+			{Key: "secret_access_key", Label: "Secret Access Key", Required: true, Secret: true},
+			{Key: "region", Label: "Region", Help: "e.g. us-east-1; leave empty for the provider's default"},
+			{Key: "endpoint", Label: "Endpoint", Help: "Only needed for S3-compatible providers"},
+		},
+	},
+	{
+		Type:        "dropbox",
+		Description: "Dropbox",
+		OAuth:       true,
+		Fields: []BackendField{
+			{Key: "client_id", Label: "Client ID", Help: "Your own app key; leave empty to use rclone's"},
+			{Key: "client_secret", Label: "Client Secret", Help: "Secret of your own app", Secret: true},
+		},
+	},
+	{
+		Type:        "sftp",
+		Description: "SSH/SFTP server",
+		Fields: []BackendField{
+			{Key: "host", Label: "Host", Help: "Server to connect to, e.g. example.com", Required: true},
+			{Key: "user", Label: "User", Help: "Leave empty for the current user"},
+			{Key: "port", Label: "Port", Default: "22"},
+			{Key: "pass", Label: "Password", Help: "Leave empty to use a key file or ssh-agent", Secret: true, Obscured: true},
+			{Key: "key_file", Label: "Key File", Help: "Path to a PEM-encoded private key, e.g. ~/.ssh/id_ed25519"},
+		},
+	},
+}
+
+// FindBackend returns the wizard's description of a backend type.
+func FindBackend(backendType string) (Backend, bool) {
+	for _, b := range Backends {
+		if b.Type == backendType {
+			return b, true
+		}
+	}
+	return Backend{}, false
+}
+
+// remoteNamePattern matches the remote names rclone accepts: letters,
+// digits and _.+@, with spaces and dashes only between them.
+var remoteNamePattern = regexp.MustCompile(`^[\w.+@]+(?:[ -]+[\w.+@-]+)*$`)
+
+// ValidateRemoteName checks that rclone accepts name as a remote name.
+func ValidateRemoteName(name string) error {
+	if name == "" {
+		return fmt.Errorf("remote name is required")
+	}
+	if !remoteNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid remote name %q: use letters, digits and _.+@-, not starting with - or a space", name)
+	}
+	return nil
+}
+
+// CreateRemoteArgs returns the rclone arguments that create a remote named
+// name of backendType with params. Params are sorted by key so the command
+// is stable, and empty values are left out so rclone uses its defaults.
+func CreateRemoteArgs(name, backendType string, params map[string]string) ([]string, error) {
+	if err := ValidateRemoteName(name); err != nil {
+		return nil, err
+	}
+	if backendType == "" {
+		return nil, fmt.Errorf("remote type is required")
+	}
+
+	keys := make([]string, 0, len(params))
+	for key, value := range params {
+		if value != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	args := []string{"config", "create", name, backendType}
+	for _, key := range keys {
+		args = append(args, key+"="+params[key])
+	}
+	return append(args, "--non-interactive"), nil
+}
+
+// CreateRemote creates a remote with rclone config create. When obscured
+// is set, the passwords in params have already been through Obscure and
+// rclone is told to store them as given; otherwise rclone obscures the
+// passwords it recognises itself.
+func (c *Client) CreateRemote(ctx context.Context, name, backendType string, params map[string]string, obscured bool) error {
+	args, err := CreateRemoteArgs(name, backendType, params)
+	if err != nil {
+		return err
+	}
+	if obscured {
+		args = append(args, "--no-obscure")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	if _, err := c.runCommand(ctx, args...); err != nil {
+		return commandError(fmt.Sprintf("failed to create remote %s", name), err)
+	}
+	return nil
+}
+
+// Obscure returns plaintext in the obscured form rclone stores passwords
+// in, using rclone obscure. The plaintext is passed on stdin rather than as
+// an argument, so it does not show in the process list.
+func (c *Client) Obscure(plaintext string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.binaryPath, "obscure", "-")
+	cmd.Stdin = strings.NewReader(plaintext)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", commandError("failed to obscure password", err)
+	}
+	obscured := strings.TrimSpace(string(output))
+	if obscured == "" {
+		return "", fmt.Errorf("rclone obscure printed nothing")
+	}
+	return obscured, nil
+}
+
+// AuthorizeArgs returns the rclone arguments that get an OAuth token for
+// backendType, passing the client ID and secret when params has its own.
+func AuthorizeArgs(backendType string, params map[string]string) []string {
+	args := []string{"authorize", backendType}
+	if id := params["client_id"]; id != "" {
+		args = append(args, id, params["client_secret"])
+	}
+	return args
+}
+
+// authorizeURLPattern matches the local address rclone authorize serves
+// the OAuth login from.
+var authorizeURLPattern = regexp.MustCompile(`https?://(?:127\.0\.0\.1|localhost):\d+/auth\S*`)
+
+// Markers rclone authorize prints around the token.
+const (
+	authorizeTokenStart = "--->"
+	authorizeTokenEnd   = "<---End paste"
+)
+
+// ParseAuthorizeToken returns the token rclone authorize printed between
+// its paste markers.
+func ParseAuthorizeToken(output string) (string, error) {
+	start := strings.Index(output, authorizeTokenStart)
+	if start < 0 {
+		return "", fmt.Errorf("rclone authorize did not print a token")
+	}
+	rest := output[start+len(authorizeTokenStart):]
+	end := strings.Index(rest, authorizeTokenEnd)
+	if end < 0 {
+		return "", fmt.Errorf("rclone authorize did not print a complete token")
+	}
+	token := strings.TrimSpace(rest[:end])
+	if token == "" {
+		return "", fmt.Errorf("rclone authorize printed an empty token")
+	}
+	return token, nil
+}
+
+// authorizeWriter collects the output of rclone authorize and reports the
+// login address the first time it appears.
+type authorizeWriter struct {
+	output bytes.Buffer
+	onURL  func(string)
+	seen   bool
+}
+
+func (w *authorizeWriter) Write(p []byte) (int, error) {
+	w.output.Write(p)
+	if !w.seen && w.onURL != nil {
+		if url := authorizeURLPattern.FindString(w.output.String()); url != "" {
+			w.seen = true
+			w.onURL(url)
+		}
+	}
+	return len(p), nil
+}
+
+// Authorize runs rclone authorize for backendType and returns the token to
+// store with the remote. rclone opens a browser for the login; onURL, if
+// not nil, is called with the address to open should that fail. It blocks
+// until the login completes or ctx is done.
+func (c *Client) Authorize(ctx context.Context, backendType string, params map[string]string, onURL func(string)) (string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	w := &authorizeWriter{onURL: onURL}
+	cmd := exec.CommandContext(ctx, c.binaryPath, AuthorizeArgs(backendType, params)...)
+	cmd.Stdout = w
+	cmd.Stderr = w
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("authorization cancelled: %w", ctx.Err())
+		}
+		return "", fmt.Errorf("rclone authorize failed: %w: %s", err, lastLine(w.output.String()))
+	}
+	return ParseAuthorizeToken(w.output.String())
+}
+
+// lastLine returns the last non-empty line of output.
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,171 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCreateRemoteArgs(t *testing.T) {
+	tests := []struct {
+		name        string
+		remote      string
+		backendType string
+		params      map[string]string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "sorted params, empty ones left out",
+			remote:      "backup",
+			backendType: "s3",
+			params:      map[string]string{"region": "eu-west-1", "provider": "AWS", "endpoint": "", "access_key_id": "AKIA"},
+			want:        "config create backup s3 access_key_id=AKIA provider=AWS region=eu-west-1 --non-interactive",
+		},
+		{
+			name:        "values keep spaces and equals signs",
+			remote:      "my nas",
+			backendType: "sftp",
+			params:      map[string]string{"host": "nas.local", "pass": "a b=c"},
+			want:        "config create my nas sftp host=nas.local pass=a b=c --non-interactive",
+		},
+		{
+			name:        "no params",
+			remote:      "local-copy",
+			backendType: "local",
+			want:        "config create local-copy local --non-interactive",
+		},
+		{name: "missing name", backendType: "s3", wantErr: true},
+		{name: "name starting with a dash", remote: "-x", backendType: "s3", wantErr: true},
+		{name: "name with a colon", remote: "a:b", backendType: "s3", wantErr: true},
+		{name: "missing type", remote: "backup", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := CreateRemoteArgs(tt.remote, tt.backendType, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateRemoteArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := strings.Join(args, " "); !tt.wantErr && got != tt.want {
+				t.Errorf("CreateRemoteArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Each param is one argument, whatever it contains
+	args, _ := CreateRemoteArgs("nas", "sftp", map[string]string{"pass": "a b"})
+	if args[4] != "pass=a b" {
+		t.Errorf("param split into several arguments: %q", args)
+	}
+}
+
+func TestAuthorizeArgs(t *testing.T) {
+	if got := strings.Join(AuthorizeArgs("drive", map[string]string{"scope": "drive"}), " "); got != "authorize drive" {
+		t.Errorf("AuthorizeArgs() = %q", got)
+	}
+	got := strings.Join(AuthorizeArgs("dropbox", map[string]string{"client_id": "id", "client_secret": "secret"}), " ")
+	if got != "authorize dropbox id secret" {
+		t.Errorf("AuthorizeArgs() with own client = %q", got)
+	}
+}
+
+func TestParseAuthorizeToken(t *testing.T) {
+	output := `2026/10/16 12:00:00 NOTICE: Log in and authorize rclone for access
+2026/10/16 12:00:05 NOTICE: Got code
+Paste the following into your remote machine --->
+{"access_token":"ya29","token_type":"Bearer","expiry":"2026-10-16T13:00:00Z"}
+<---End paste
+`
+	token, err := ParseAuthorizeToken(output)
+	if err != nil || token != `{"access_token":"ya29","token_type":"Bearer","expiry":"2026-10-16T13:00:00Z"}` {
+		t.Errorf("ParseAuthorizeToken() = %q, %v", token, err)
+	}
+
+	for _, bad := range []string{"", "NOTICE: Failed to get token", "--->\n{\"a\":1}", "--->\n<---End paste"} {
+		if _, err := ParseAuthorizeToken(bad); err == nil {
+			t.Errorf("ParseAuthorizeToken(%q) should fail", bad)
+		}
+	}
+}
+
+func TestClientCreateRemoteAndAuthorize(t *testing.T) {
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	script := `#!/bin/sh
+echo "$*" >> ` + calls + `
+case "$1" in
+authorize)
+	echo "NOTICE: go to the following link: http://127.0.0.1:53682/auth?state=xyz" >&2
+	echo "Paste the following into your remote machine --->"
+	echo '{"access_token":"t"}'
+	echo "<---End paste" ;;
+--config) if [ "$6" = broken ]; then echo "Failed to create: bad type" >&2; exit 1; fi ;;
+esac
+`
+	path := filepath.Join(dir, "rclone")
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	c := NewClientWithPath(path)
+	c.SetConfigPath("/tmp/rclone.conf")
+
+	var url string
+	token, err := c.Authorize(context.Background(), "drive", nil, func(u string) { url = u })
+	if err != nil || token != `{"access_token":"t"}` {
+		t.Fatalf("Authorize() = %q, %v", token, err)
+	}
+	if url != "http://127.0.0.1:53682/auth?state=xyz" {
+		t.Errorf("onURL got %q", url)
+	}
+
+	if err := c.CreateRemote(context.Background(), "photos", "drive", map[string]string{"token": token}, true); err != nil {
+		t.Fatalf("CreateRemote() error = %v", err)
+	}
+	err = c.CreateRemote(context.Background(), "oops", "broken", nil, false)
+	if err == nil || !strings.Contains(err.Error(), "bad type") {
+		t.Errorf("CreateRemote() error = %v, want rclone's message", err)
+	}
+
+	got, _ := os.ReadFile(calls)
+	want := "authorize drive\n" +
+		`--config /tmp/rclone.conf config create photos drive token={"access_token":"t"} --non-interactive --no-obscure` + "\n" +
+		"--config /tmp/rclone.conf config create oops broken --non-interactive\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestClientObscure(t *testing.T) {
+	dir := t.TempDir()
+	args := filepath.Join(dir, "args")
+	stdin := filepath.Join(dir, "stdin")
+	script := `#!/bin/sh
+echo "$*" > ` + args + `
+cat > ` + stdin + `
+echo "  zXTq0fV3obscured  "
+`
+	path := filepath.Join(dir, "rclone")
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	c := NewClientWithPath(path)
+	c.SetConfigPath("/tmp/rclone.conf")
+
+	obscured, err := c.Obscure("hunter2")
+	if err != nil || obscured != "zXTq0fV3obscured" {
+		t.Fatalf("Obscure() = %q, %v", obscured, err)
+	}
+	if got, _ := os.ReadFile(args); string(got) != "obscure -\n" {
+		t.Errorf("rclone ran with %q, want obscure -", got)
+	}
+	if got, _ := os.ReadFile(stdin); string(got) != "hunter2" {
+		t.Errorf("rclone read %q on stdin, want the password", got)
+	}
+
+	failing := NewClientWithPath(filepath.Join(dir, "missing"))
+	if _, err := failing.Obscure("hunter2"); err == nil || strings.Contains(err.Error(), "hunter2") {
+		t.Errorf("Obscure() error = %v, want a failure without the password", err)
+	}
+}
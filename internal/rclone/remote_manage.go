@@ -0,0 +1,126 @@
+package rclone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DeleteRemoteArgs returns the rclone arguments that delete the remote name.
+func DeleteRemoteArgs(name string) []string {
+	return []string{"config", "delete", name}
+}
+
+// CopyRemoteArgs returns the rclone arguments that create a remote named
+// name with the settings of another remote, as printed by rclone config
+// dump. Passwords in a dump are already obscured, so rclone is told not to
+// obscure them again.
+func CopyRemoteArgs(name string, settings map[string]string) ([]string, error) {
+	params := make(map[string]string, len(settings))
+	for key, value := range settings {
+		if key != "type" {
+			params[key] = value
+		}
+	}
+	args, err := CreateRemoteArgs(name, settings["type"], params)
+	if err != nil {
+		return nil, err
+	}
+	return append(args, "--no-obscure"), nil
+}
+
+// DeleteRemote deletes a remote with rclone config delete.
+func (c *Client) DeleteRemote(ctx context.Context, name string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := c.runCommand(ctx, DeleteRemoteArgs(name)...); err != nil {
+		return commandError(fmt.Sprintf("failed to delete remote %s", name), err)
+	}
+	return nil
+}
+
+// RemoteSettings returns the stored settings of a remote, including its
+// type, from rclone config dump.
+func (c *Client) RemoteSettings(ctx context.Context, name string) (map[string]string, error) {
+	dump, err := c.configDump(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings, ok := dump[name]
+	if !ok {
+		return nil, fmt.Errorf("remote %q not found", name)
+	}
+	return settings, nil
+}
+
+// configDump returns the settings of every remote, keyed by remote name.
+func (c *Client) configDump(ctx context.Context) (map[string]map[string]string, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	output, err := c.runCommand(ctx, "config", "dump")
+	if err != nil {
+		return nil, commandError("failed to read the rclone config", err)
+	}
+	var dump map[string]map[string]string
+	if err := json.Unmarshal(output, &dump); err != nil {
+		return nil, fmt.Errorf("failed to parse rclone config dump: %w", err)
+	}
+	return dump, nil
+}
+
+// RenameRemote renames a remote. rclone has no command for this, so a copy
+// of the remote is created under the new name before the old one is
+// deleted.
+func (c *Client) RenameRemote(ctx context.Context, oldName, newName string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	dump, err := c.configDump(ctx)
+	if err != nil {
+		return err
+	}
+	settings, ok := dump[oldName]
+	if !ok {
+		return fmt.Errorf("remote %q not found", oldName)
+	}
+	if _, taken := dump[newName]; taken {
+		return fmt.Errorf("remote %q already exists", newName)
+	}
+
+	args, err := CopyRemoteArgs(newName, settings)
+	if err != nil {
+		return err
+	}
+	createCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+	if _, err := c.runCommand(createCtx, args...); err != nil {
+		return commandError(fmt.Sprintf("failed to create remote %s", newName), err)
+	}
+
+	if err := c.DeleteRemote(ctx, oldName); err != nil {
+		return fmt.Errorf("remote %s created, but %w; delete %s by hand", newName, err, oldName)
+	}
+	return nil
+}
+
+// commandError wraps the error of an rclone command, preferring what
+// rclone printed on stderr.
+func commandError(what string, err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%s: %s", what, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return fmt.Errorf("%s: %w", what, err)
+}
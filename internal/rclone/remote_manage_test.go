@@ -0,0 +1,75 @@
+package rclone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDeleteRemoteArgs(t *testing.T) {
+	got := strings.Join(DeleteRemoteArgs("old drive"), "|")
+	if got != "config|delete|old drive" {
+		t.Errorf("DeleteRemoteArgs() = %q", got)
+	}
+}
+
+func TestCopyRemoteArgs(t *testing.T) {
+	args, err := CopyRemoteArgs("backup", map[string]string{
+		"type":              "s3",
+		"provider":          "AWS",
+		"secret_access_key": "obscured",
+		"region":            "",
+	})
+	if err != nil {
+		t.Fatalf("CopyRemoteArgs() error = %v", err)
+	}
+	got := strings.Join(args, " ")
+	want := "config create backup s3 provider=AWS secret_access_key=obscured --non-interactive --no-obscure"
+	if got != want {
+		t.Errorf("CopyRemoteArgs() = %q, want %q", got, want)
+	}
+
+	if _, err := CopyRemoteArgs("backup", map[string]string{"provider": "AWS"}); err == nil {
+		t.Error("CopyRemoteArgs() without a type should fail")
+	}
+}
+
+func TestClientRenameRemote(t *testing.T) {
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	script := `#!/bin/sh
+case "$*" in
+*"config dump") echo '{"gdrive":{"type":"drive","token":"{\"access_token\":\"t\"}"},"box":{"type":"dropbox"}}'; exit 0 ;;
+esac
+echo "$*" >> ` + calls + `
+if [ "$4" = delete ] && [ "$5" = stuck ]; then echo "Failed to delete: locked" >&2; exit 1; fi
+`
+	path := filepath.Join(dir, "rclone")
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	c := NewClientWithPath(path)
+	c.SetConfigPath("/tmp/rclone.conf")
+
+	if err := c.RenameRemote(context.Background(), "gdrive", "drive"); err != nil {
+		t.Fatalf("RenameRemote() error = %v", err)
+	}
+	got, _ := os.ReadFile(calls)
+	want := `--config /tmp/rclone.conf config create drive drive token={"access_token":"t"} --non-interactive --no-obscure` + "\n" +
+		"--config /tmp/rclone.conf config delete gdrive\n"
+	if string(got) != want {
+		t.Errorf("rclone ran\n%s\nwant\n%s", got, want)
+	}
+
+	if err := c.RenameRemote(context.Background(), "gdrive", "box"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("RenameRemote() onto an existing remote error = %v", err)
+	}
+	if err := c.RenameRemote(context.Background(), "missing", "other"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("RenameRemote() of a missing remote error = %v", err)
+	}
+	if err := c.DeleteRemote(context.Background(), "stuck"); err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Errorf("DeleteRemote() error = %v, want rclone's message", err)
+	}
+}
@@ -2,6 +2,7 @@ package rclone
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os/exec"
@@ -230,6 +231,27 @@ func (c *Client) ValidateRemote(ctx context.Context, remote string) error {
 	return fmt.Errorf("remote %q not found in rclone configuration", remote)
 }
 
+// Probe runs a short rclone command, such as a listing, to check that it
+// succeeds. On failure the last line rclone wrote to stderr is returned.
+func (c *Client) Probe(ctx context.Context, args ...string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	_, err := c.runCommand(ctx, args...)
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		lines := strings.Split(strings.TrimSpace(string(exitErr.Stderr)), "\n")
+		if msg := strings.TrimSpace(lines[len(lines)-1]); msg != "" {
+			return fmt.Errorf("rclone %s failed: %s", args[0], msg)
+		}
+		return fmt.Errorf("rclone %s exited with status %d", args[0], exitErr.ExitCode())
+	}
+	return fmt.Errorf("failed to run rclone: %w", err)
+}
+
 // TestRemoteAccess tests if a remote path is accessible.
 // This performs a simple directory listing to verify connectivity.
 func (c *Client) TestRemoteAccess(ctx context.Context, remote, path string) error {
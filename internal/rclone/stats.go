@@ -0,0 +1,188 @@
+package rclone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// DefaultRCAddr is where rclone's remote control listens when --rc is given
+// without --rc-addr.
+const DefaultRCAddr = "localhost:5572"
+
+// RCAddr returns the address rclone's remote control listens on for a
+// command with the given extra flags, or false if they do not include --rc.
+func RCAddr(extraArgs string) (string, bool) {
+	enabled, addr := false, DefaultRCAddr
+	fields := strings.Fields(extraArgs)
+	for i, f := range fields {
+		switch {
+		case f == "--rc" || f == "--rc=true":
+			enabled = true
+		case f == "--rc=false":
+			enabled = false
+		case strings.HasPrefix(f, "--rc-addr="):
+			addr = strings.TrimPrefix(f, "--rc-addr=")
+		case f == "--rc-addr" && i+1 < len(fields):
+			addr = fields[i+1]
+		}
+	}
+	if strings.HasPrefix(addr, ":") {
+		addr = "localhost" + addr
+	}
+	return addr, enabled
+}
+
+// coreStats is the part of rclone's core/stats response that progress needs.
+type coreStats struct {
+	Bytes          int64    `json:"bytes"`
+	TotalBytes     int64    `json:"totalBytes"`
+	Speed          float64  `json:"speed"`
+	ETA            *float64 `json:"eta"` // Seconds, null while unknown
+	Transfers      int64    `json:"transfers"`
+	TotalTransfers int64    `json:"totalTransfers"`
+}
+
+// ParseCoreStats parses the JSON returned by rclone's core/stats.
+func ParseCoreStats(data []byte) (*models.RunningStats, error) {
+	var raw coreStats
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse rclone stats: %w", err)
+	}
+	stats := &models.RunningStats{
+		Bytes:          raw.Bytes,
+		TotalBytes:     raw.TotalBytes,
+		Speed:          raw.Speed,
+		Transfers:      raw.Transfers,
+		TotalTransfers: raw.TotalTransfers,
+	}
+	if raw.ETA != nil && *raw.ETA > 0 {
+		stats.ETA = time.Duration(*raw.ETA * float64(time.Second))
+	}
+	return stats, nil
+}
+
+// FetchCoreStats asks the remote control of a running rclone at addr for
+// its transfer stats.
+func FetchCoreStats(ctx context.Context, addr string) (*models.RunningStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://"+addr+"/core/stats", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach rclone remote control: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rclone remote control returned %s", resp.Status)
+	}
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+	return ParseCoreStats(buf.Bytes())
+}
+
+var (
+	// Transferred:   1.234 GiB / 5.678 GiB, 22%, 12.345 MiB/s, ETA 6m5s
+	statsBytesLine = regexp.MustCompile(`Transferred:\s+([\d.]+ ?[A-Za-z]+) / ([\d.]+ ?[A-Za-z]+), (?:\d+|-)%, ([\d.]+ ?[A-Za-z]+)/s, ETA (\S+)`)
+	// Transferred:            3 / 10, 30%
+	statsFilesLine = regexp.MustCompile(`Transferred:\s+(\d+) / (\d+), (?:\d+|-)%\s*$`)
+)
+
+// ParseStatsLog returns the progress in the last stats block rclone logged,
+// or false if the logs hold none. rclone logs its stats every minute at the
+// INFO log level.
+func ParseStatsLog(logs string) (*models.RunningStats, bool) {
+	lines := strings.Split(logs, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		m := statsBytesLine.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		done, err1 := parseStatsSize(m[1])
+		total, err2 := parseStatsSize(m[2])
+		speed, err3 := parseStatsSize(m[3])
+		if err1 != nil || err2 != nil || err3 != nil {
+			return nil, false
+		}
+		stats := &models.RunningStats{Bytes: done, TotalBytes: total, Speed: float64(speed), ETA: parseStatsETA(m[4])}
+
+		// The file counts follow the bytes in the same block
+		for _, line := range lines[i+1:] {
+			if strings.Contains(line, "Transferred:") {
+				if f := statsFilesLine.FindStringSubmatch(line); f != nil {
+					stats.Transfers, _ = strconv.ParseInt(f[1], 10, 64)
+					stats.TotalTransfers, _ = strconv.ParseInt(f[2], 10, 64)
+				}
+				break
+			}
+		}
+		return stats, true
+	}
+	return nil, false
+}
+
+// statsUnits are the size suffixes rclone uses in its stats, binary since
+// v1.57 and the older spellings before that.
+var statsUnits = map[string]int64{
+	"B": 1, "Byte": 1, "Bytes": 1,
+	"KiB": 1 << 10, "k": 1 << 10, "kBytes": 1 << 10,
+	"MiB": 1 << 20, "M": 1 << 20, "MBytes": 1 << 20,
+	"GiB": 1 << 30, "G": 1 << 30, "GBytes": 1 << 30,
+	"TiB": 1 << 40, "T": 1 << 40, "TBytes": 1 << 40,
+	"PiB": 1 << 50, "P": 1 << 50, "PBytes": 1 << 50,
+}
+
+// parseStatsSize parses a size from rclone's stats, such as "1.234 GiB".
+func parseStatsSize(s string) (int64, error) {
+	s = strings.ReplaceAll(s, " ", "")
+	i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if i <= 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	n, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return 0, err
+	}
+	unit, ok := statsUnits[s[i:]]
+	if !ok {
+		return 0, fmt.Errorf("unknown size unit in %q", s)
+	}
+	return int64(n * float64(unit)), nil
+}
+
+// parseStatsETA parses an ETA from rclone's stats, such as "6m5s" or
+// "1d2h3m4s". It returns 0 for "-" and anything else it cannot read.
+func parseStatsETA(s string) time.Duration {
+	var days int64
+	if i := strings.Index(s, "d"); i > 0 {
+		n, err := strconv.ParseInt(s[:i], 10, 64)
+		if err != nil {
+			return 0
+		}
+		days, s = n, s[i+1:]
+	}
+	d := time.Duration(0)
+	if s != "" {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0
+		}
+	}
+	return d + time.Duration(days)*24*time.Hour
+}
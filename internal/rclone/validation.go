@@ -3,10 +3,14 @@ package rclone
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,18 +21,92 @@ type CheckResult struct {
 	Message    string // Error or success message
 	Suggestion string // User-friendly suggestion for fixing the issue
 	IsCritical bool   // If true, the application cannot continue without this check passing
+
+	Timeout  time.Duration // Time limit the check ran under (zero if run without one)
+	TimedOut bool          // True if the check did not finish within Timeout
+}
+
+// Per-check time limits used by PreflightChecks. The remote listing and
+// systemd checks enforce their own inner timeouts; these are an outer bound
+// so a hung command cannot stall startup.
+var (
+	binaryCheckTimeout     = 5 * time.Second
+	versionCheckTimeout    = 15 * time.Second
+	remotesCheckTimeout    = 35 * time.Second
+	systemdCheckTimeout    = 15 * time.Second
+	fusermountCheckTimeout = 5 * time.Second
+)
+
+// preflightCheck describes a single check run by PreflightChecks.
+type preflightCheck struct {
+	name     string
+	critical bool
+	timeout  time.Duration
+	run      func() CheckResult
 }
 
 // PreflightChecks runs all pre-flight validation checks and returns the results.
 // It uses the provided RcloneClient for rclone-specific checks.
+// Independent checks run concurrently, each under its own timeout, and the
+// results are always returned in the same order.
 func PreflightChecks(client *Client) []CheckResult {
-	var results []CheckResult
-
-	// 1. Check rclone binary exists
-	results = append(results, checkRcloneBinary(client))
-
-	// If rclone binary doesn't exist, we can't run other rclone checks
-	if len(results) == 0 || !results[0].Passed {
+	// 1. Check rclone binary exists; the other rclone checks depend on it
+	binary := runCheck(preflightCheck{
+		name:     "Rclone Binary",
+		critical: true,
+		timeout:  binaryCheckTimeout,
+		run:      func() CheckResult { return checkRcloneBinary(client) },
+	})
+
+	var checks []preflightCheck
+	if binary.Passed {
+		checks = append(checks,
+			// 2. Check rclone version
+			preflightCheck{
+				name:     "Rclone Version",
+				critical: true,
+				timeout:  versionCheckTimeout,
+				run:      func() CheckResult { return checkRcloneVersion(client) },
+			},
+			// 3. Check configured remotes
+			preflightCheck{
+				name:     "Configured Remotes",
+				critical: false,
+				timeout:  remotesCheckTimeout,
+				run:      func() CheckResult { return checkConfiguredRemotes(client) },
+			},
+		)
+	}
+	checks = append(checks,
+		// 4. Check systemd user session
+		preflightCheck{
+			name:     "Systemd User Session",
+			critical: true,
+			timeout:  systemdCheckTimeout,
+			run:      checkSystemdUserSession,
+		},
+		// 5. Check fusermount availability
+		preflightCheck{
+			name:     "Fusermount",
+			critical: false,
+			timeout:  fusermountCheckTimeout,
+			run:      checkFusermount,
+		},
+	)
+
+	concurrent := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check preflightCheck) {
+			defer wg.Done()
+			concurrent[i] = runCheck(check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	results := []CheckResult{binary}
+	if !binary.Passed {
 		// Add placeholder failures for rclone-dependent checks
 		results = append(results, CheckResult{
 			Name:       "Rclone Version",
@@ -44,21 +122,53 @@ func PreflightChecks(client *Client) []CheckResult {
 			Suggestion: "Install rclone first to check configured remotes",
 			IsCritical: true,
 		})
-	} else {
-		// 2. Check rclone version
-		results = append(results, checkRcloneVersion(client))
-
-		// 3. Check configured remotes
-		results = append(results, checkConfiguredRemotes(client))
 	}
+	return append(results, concurrent...)
+}
 
-	// 4. Check systemd user session
-	results = append(results, checkSystemdUserSession())
+// runCheck runs a single check, giving up once its timeout elapses.
+// A check that panics or times out is reported as a failed result.
+func runCheck(check preflightCheck) CheckResult {
+	done := make(chan CheckResult, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- CheckResult{
+					Name:       check.name,
+					Passed:     false,
+					Message:    fmt.Sprintf("Check panicked: %v", r),
+					IsCritical: check.critical,
+				}
+			}
+		}()
+		done <- check.run()
+	}()
 
-	// 5. Check fusermount availability
-	results = append(results, checkFusermount())
+	timer := time.NewTimer(check.timeout)
+	defer timer.Stop()
 
-	return results
+	select {
+	case result := <-done:
+		result.Timeout = check.timeout
+		return result
+	case <-timer.C:
+		return CheckResult{
+			Name:       check.name,
+			Passed:     false,
+			Message:    fmt.Sprintf("Check timed out after %s", check.timeout),
+			Suggestion: "The command did not respond in time. Check that it is not hung and retry",
+			IsCritical: check.critical,
+			Timeout:    check.timeout,
+			TimedOut:   true,
+		}
+	}
+}
+
+// CheckBinary runs the rclone binary pre-flight check on its own.
+// It lets callers such as the TUI forms reuse the same message and suggestion
+// shown at startup without running the full set of checks.
+func CheckBinary(client *Client) CheckResult {
+	return checkRcloneBinary(client)
 }
 
 // checkRcloneBinary verifies that the rclone binary exists at the configured path or in PATH.
@@ -104,6 +214,192 @@ func checkRcloneBinary(client *Client) CheckResult {
 	return result
 }
 
+// CheckBinaryConsistency compares the rclone binary configured in the
+// settings with the one found on PATH, which the generated units fall back
+// to. Differing versions are reported as an optional failure because jobs may
+// behave differently in the TUI and under systemd. An empty configuredPath
+// means PATH is used everywhere, so there is nothing to compare.
+func CheckBinaryConsistency(configuredPath string) CheckResult {
+	result := CheckResult{
+		Name:       "Rclone Binary Consistency",
+		IsCritical: false,
+	}
+
+	if configuredPath == "" || configuredPath == "rclone" {
+		result.Passed = true
+		result.Message = "No custom rclone binary configured; units and the client both use PATH"
+		return result
+	}
+
+	pathBinary, err := exec.LookPath("rclone")
+	if err != nil {
+		result.Passed = true
+		result.Message = fmt.Sprintf("No rclone on PATH; only the configured binary %s is in use", configuredPath)
+		return result
+	}
+
+	if sameFile(configuredPath, pathBinary) {
+		result.Passed = true
+		result.Message = fmt.Sprintf("Configured binary %s is the rclone on PATH", configuredPath)
+		return result
+	}
+
+	configuredVersion, err := NewClientWithPath(configuredPath).GetVersion()
+	if err != nil {
+		result.Passed = false
+		result.Message = fmt.Sprintf("Failed to get version of configured binary %s: %v", configuredPath, err)
+		result.Suggestion = "Verify the rclone_binary_path in your settings"
+		return result
+	}
+	pathVersion, err := NewClientWithPath(pathBinary).GetVersion()
+	if err != nil {
+		result.Passed = false
+		result.Message = fmt.Sprintf("Failed to get version of %s on PATH: %v", pathBinary, err)
+		result.Suggestion = "Ensure the rclone on PATH is a working installation"
+		return result
+	}
+
+	configured, cErr := parseVersion(configuredVersion)
+	onPath, pErr := parseVersion(pathVersion)
+	if cErr == nil && pErr == nil && compareVersions(configured, onPath) == 0 {
+		result.Passed = true
+		result.Message = fmt.Sprintf("Configured binary %s and %s on PATH are both %s", configuredPath, pathBinary, configuredVersion)
+		return result
+	}
+
+	result.Passed = false
+	result.Message = fmt.Sprintf("rclone versions differ:\n    configured: %s (%s)\n    on PATH:    %s (%s)",
+		configuredPath, configuredVersion, pathBinary, pathVersion)
+	result.Suggestion = "Units and the TUI may run different rclone binaries. Point rclone_binary_path at the rclone on PATH, or set RCLONE_BINARY_PATH so units use the same binary"
+	return result
+}
+
+// SourceCheckTimeout bounds the listing done by CheckSyncSource, so a slow or
+// unreachable remote holds up the sync job form and doctor for at most this
+// long.
+var SourceCheckTimeout = 15 * time.Second
+
+// rclone exit codes for a missing directory or file.
+const (
+	exitDirNotFound  = 3
+	exitFileNotFound = 4
+)
+
+// CheckSyncSource confirms that a sync job's source exists and can be listed,
+// using rclone lsf <source> --max-depth 1. A failure is never critical: a job
+// may be set up for a path that only exists later, but until then it
+// silently transfers nothing.
+func CheckSyncSource(ctx context.Context, client *Client, source string) CheckResult {
+	result := CheckResult{
+		Name:       "Sync Source",
+		IsCritical: false,
+		Timeout:    SourceCheckTimeout,
+	}
+
+	if client == nil {
+		result.Passed = false
+		result.Message = "Rclone client is not initialized"
+		return result
+	}
+
+	remote, path, ok := strings.Cut(source, ":")
+	if !ok || remote == "" {
+		result.Passed = false
+		result.Message = fmt.Sprintf("Source %q is not a remote:path location", source)
+		result.Suggestion = "Set the source to a configured rclone remote, e.g., gdrive:/Photos"
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, SourceCheckTimeout)
+	defer cancel()
+
+	err := client.TestRemoteAccess(ctx, remote, path)
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		result.Passed = true
+		result.Message = fmt.Sprintf("Source %s exists and is accessible", source)
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Passed = false
+		result.TimedOut = true
+		result.Message = fmt.Sprintf("Timed out after %s listing source %s", SourceCheckTimeout, source)
+		result.Suggestion = "Check your network connection and that the remote is reachable"
+	case errors.As(err, &exitErr) && (exitErr.ExitCode() == exitDirNotFound || exitErr.ExitCode() == exitFileNotFound):
+		result.Passed = false
+		result.Message = fmt.Sprintf("Source %s does not exist", source)
+		result.Suggestion = "Create the path on the remote or correct the source; until it exists the job transfers nothing"
+	default:
+		result.Passed = false
+		result.Message = fmt.Sprintf("Cannot access source %s: %v", source, err)
+		result.Suggestion = fmt.Sprintf("Run 'rclone lsf %s --max-depth 1' to see the full error", source)
+	}
+	return result
+}
+
+// CheckRemoteConnections lists the top level of each remote with rclone lsd
+// <remote>: --max-depth 1, to confirm it is reachable and its credentials
+// are accepted. The remotes are checked concurrently, each for at most
+// timeout, and there is one result per remote in the same order. Failures
+// are never critical: the other remotes and local sync jobs still work.
+func CheckRemoteConnections(client *Client, remotes []string, timeout time.Duration) []CheckResult {
+	results := make([]CheckResult, len(remotes))
+	var wg sync.WaitGroup
+	for i, remote := range remotes {
+		wg.Add(1)
+		go func(i int, remote string) {
+			defer wg.Done()
+			results[i] = checkRemoteConnection(client, remote, timeout)
+		}(i, remote)
+	}
+	wg.Wait()
+	return results
+}
+
+// checkRemoteConnection checks a single remote for CheckRemoteConnections.
+func checkRemoteConnection(client *Client, remote string, timeout time.Duration) CheckResult {
+	result := CheckResult{
+		Name:       "Remote Connection: " + remote,
+		IsCritical: false,
+		Timeout:    timeout,
+	}
+
+	if client == nil {
+		result.Passed = false
+		result.Message = "Rclone client is not initialized"
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := client.Probe(ctx, "lsd", remote+":", "--max-depth", "1")
+	switch {
+	case err == nil:
+		result.Passed = true
+		result.Message = fmt.Sprintf("Remote %s: is reachable", remote)
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Passed = false
+		result.TimedOut = true
+		result.Message = fmt.Sprintf("Timed out after %s listing remote %s:", timeout, remote)
+		result.Suggestion = "Check your network connection, or raise remote_check_timeout in the settings for slow remotes"
+	default:
+		result.Passed = false
+		result.Message = fmt.Sprintf("Cannot access remote %s: %v", remote, err)
+		result.Suggestion = fmt.Sprintf("If the credentials expired, run 'rclone config reconnect %s:'; run 'rclone lsd %s:' to see the full error", remote, remote)
+	}
+	return result
+}
+
+// sameFile reports whether two paths resolve to the same file.
+func sameFile(a, b string) bool {
+	resolvedA, errA := filepath.EvalSymlinks(a)
+	resolvedB, errB := filepath.EvalSymlinks(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return resolvedA == resolvedB
+}
+
 // checkRcloneVersion verifies that rclone version is at least 1.60.0.
 func checkRcloneVersion(client *Client) CheckResult {
 	result := CheckResult{
@@ -128,15 +424,13 @@ func checkRcloneVersion(client *Client) CheckResult {
 		return result
 	}
 
-	// Check minimum version (1.60.0)
-	minVersion := versionTuple{1, 60, 0}
-	if compareVersions(version, minVersion) >= 0 {
+	if compareVersions(version, MinimumVersion.tuple()) >= 0 {
 		result.Passed = true
-		result.Message = fmt.Sprintf("Rclone version %d.%d.%d meets minimum requirement (1.60.0)", version.major, version.minor, version.patch)
+		result.Message = fmt.Sprintf("Rclone version %d.%d.%d meets minimum requirement (%s)", version.major, version.minor, version.patch, MinimumVersion)
 	} else {
 		result.Passed = false
-		result.Message = fmt.Sprintf("Rclone version %d.%d.%d is below minimum required version 1.60.0", version.major, version.minor, version.patch)
-		result.Suggestion = "Upgrade rclone to version 1.60.0 or later from https://rclone.org/install/"
+		result.Message = fmt.Sprintf("Rclone version %d.%d.%d is below minimum required version %s", version.major, version.minor, version.patch, MinimumVersion)
+		result.Suggestion = fmt.Sprintf("Upgrade rclone to version %s or later from https://rclone.org/install/", MinimumVersion)
 	}
 
 	return result
@@ -287,6 +581,72 @@ func checkFusermount() CheckResult {
 	return result
 }
 
+// The FUSE device and the lookups CheckFUSE uses, replaceable in tests.
+var (
+	fuseDevice = "/dev/fuse"
+	statFile   = os.Stat
+	lookPath   = exec.LookPath
+)
+
+// CheckFUSE verifies that the FUSE device exists and fusermount or
+// fusermount3 is on PATH. Mounts cannot start without them, so the result is
+// critical when mounts are configured; sync-only setups just get a warning.
+func CheckFUSE(mountsConfigured bool) CheckResult {
+	result := CheckResult{
+		Name:       "FUSE",
+		IsCritical: mountsConfigured,
+	}
+
+	var missing []string
+	if _, err := statFile(fuseDevice); err != nil {
+		missing = append(missing, fuseDevice)
+	}
+	binary := ""
+	for _, name := range []string{"fusermount3", "fusermount"} {
+		if _, err := lookPath(name); err == nil {
+			binary = name
+			break
+		}
+	}
+	if binary == "" {
+		missing = append(missing, "fusermount or fusermount3 on PATH")
+	}
+
+	if len(missing) == 0 {
+		result.Passed = true
+		result.Message = fmt.Sprintf("FUSE is available (%s, %s)", fuseDevice, binary)
+		return result
+	}
+
+	result.Message = "FUSE is not available: missing " + strings.Join(missing, " and ")
+	result.Suggestion = "Install FUSE with 'sudo apt install fuse3' (or your distribution's fuse3 package) and load the module with 'sudo modprobe fuse'."
+	if mountsConfigured {
+		result.Suggestion += " Mounts cannot start until FUSE is available."
+	} else {
+		result.Suggestion += " Sync jobs work without FUSE; only mounts need it."
+	}
+	return result
+}
+
+// FuseConfPath is the FUSE configuration file, replaceable in tests.
+var FuseConfPath = "/etc/fuse.conf"
+
+// FuseAllowsOther reports whether the FUSE configuration at path sets
+// user_allow_other, without which non-root users cannot mount with
+// --allow-other. A missing file allows nothing.
+func FuseAllowsOther(path string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) == "user_allow_other" {
+			return true
+		}
+	}
+	return false
+}
+
 // versionTuple represents a semantic version.
 type versionTuple struct {
 	major, minor, patch int
@@ -295,20 +655,11 @@ type versionTuple struct {
 // parseVersion extracts version numbers from a version string.
 // Handles formats like "rclone v1.62.0", "v1.62.0", "1.62.0", etc.
 func parseVersion(versionStr string) (versionTuple, error) {
-	// Regular expression to find version numbers
-	re := regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
-	matches := re.FindStringSubmatch(versionStr)
-
-	if len(matches) != 4 {
-		return versionTuple{}, fmt.Errorf("could not find version pattern in %q", versionStr)
-	}
-
-	var v versionTuple
-	if _, err := fmt.Sscanf(matches[0], "%d.%d.%d", &v.major, &v.minor, &v.patch); err != nil {
-		return versionTuple{}, fmt.Errorf("failed to parse version numbers: %w", err)
+	v, err := ParseVersion(versionStr)
+	if err != nil {
+		return versionTuple{}, err
 	}
-
-	return v, nil
+	return v.tuple(), nil
 }
 
 // compareVersions compares two version tuples.
@@ -1,6 +1,7 @@
 package rclone
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -8,6 +9,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 )
 
 func createMockRcloneValidation(t *testing.T, script string) string {
@@ -699,6 +701,63 @@ func TestCheckFusermountNotFound(t *testing.T) {
 	}
 }
 
+// useFakeFUSE makes the FUSE device and fusermount present or missing.
+func useFakeFUSE(t *testing.T, device bool, binary string) {
+	t.Helper()
+	oldStat, oldLookPath := statFile, lookPath
+	t.Cleanup(func() { statFile, lookPath = oldStat, oldLookPath })
+
+	statFile = func(name string) (os.FileInfo, error) {
+		if device && name == fuseDevice {
+			return nil, nil
+		}
+		return nil, os.ErrNotExist
+	}
+	lookPath = func(file string) (string, error) {
+		if file == binary {
+			return "/usr/bin/" + file, nil
+		}
+		return "", exec.ErrNotFound
+	}
+}
+
+func TestCheckFUSE(t *testing.T) {
+	tests := []struct {
+		name         string
+		device       bool
+		binary       string
+		mounts       bool
+		wantPassed   bool
+		wantCritical bool
+		wantMessage  string
+	}{
+		{"available", true, "fusermount3", true, true, true, "fusermount3"},
+		{"older fusermount", true, "fusermount", false, true, false, "fusermount"},
+		{"no device with mounts", false, "fusermount3", true, false, true, "/dev/fuse"},
+		{"no binary with mounts", true, "", true, false, true, "fusermount or fusermount3"},
+		{"nothing, sync only", false, "", false, false, false, "/dev/fuse and fusermount"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useFakeFUSE(t, tt.device, tt.binary)
+
+			result := CheckFUSE(tt.mounts)
+			if result.Passed != tt.wantPassed || result.IsCritical != tt.wantCritical {
+				t.Errorf("CheckFUSE(%v) Passed = %v, IsCritical = %v; want %v, %v", tt.mounts, result.Passed, result.IsCritical, tt.wantPassed, tt.wantCritical)
+			}
+			if !strings.Contains(result.Message, tt.wantMessage) {
+				t.Errorf("Message = %q, want it to mention %q", result.Message, tt.wantMessage)
+			}
+			if !tt.wantPassed && !strings.Contains(result.Suggestion, "fuse3") {
+				t.Errorf("Suggestion = %q, want install instructions", result.Suggestion)
+			}
+			if HasCriticalFailure([]CheckResult{result}) != (!tt.wantPassed && tt.mounts) {
+				t.Errorf("HasCriticalFailure() should only block when FUSE is missing and mounts exist")
+			}
+		})
+	}
+}
+
 func TestPreflightChecksFullFlow(t *testing.T) {
 	mockScript := `#!/bin/sh
 case "$1" in
@@ -2293,3 +2352,231 @@ func BenchmarkFormatResults(b *testing.B) {
 		_ = FormatResults(results)
 	}
 }
+
+func TestCheckBinary(t *testing.T) {
+	result := CheckBinary(NewClientWithPath("/nonexistent/path/to/rclone"))
+
+	if result.Passed {
+		t.Error("CheckBinary() should fail for nonexistent binary")
+	}
+	if result.Name != "Rclone Binary" {
+		t.Errorf("CheckBinary().Name = %q, want %q", result.Name, "Rclone Binary")
+	}
+}
+
+func TestRunCheckCompletes(t *testing.T) {
+	result := runCheck(preflightCheck{
+		name:     "Fast",
+		critical: true,
+		timeout:  time.Second,
+		run: func() CheckResult {
+			return CheckResult{Name: "Fast", Passed: true, Message: "ok", IsCritical: true}
+		},
+	})
+
+	if !result.Passed {
+		t.Error("runCheck() should return the check's result")
+	}
+	if result.TimedOut {
+		t.Error("runCheck() should not report a timeout for a fast check")
+	}
+	if result.Timeout != time.Second {
+		t.Errorf("runCheck().Timeout = %v, want %v", result.Timeout, time.Second)
+	}
+}
+
+func TestRunCheckTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	result := runCheck(preflightCheck{
+		name:     "Slow",
+		critical: false,
+		timeout:  10 * time.Millisecond,
+		run: func() CheckResult {
+			<-release
+			return CheckResult{Name: "Slow", Passed: true}
+		},
+	})
+
+	if result.Passed {
+		t.Error("runCheck() should fail a check that times out")
+	}
+	if !result.TimedOut {
+		t.Error("runCheck() should set TimedOut")
+	}
+	if result.Name != "Slow" || result.IsCritical {
+		t.Errorf("runCheck() = %+v, want name and criticality from the check", result)
+	}
+	if !strings.Contains(result.Message, "timed out") {
+		t.Errorf("runCheck().Message = %q, should mention the timeout", result.Message)
+	}
+}
+
+func TestRunCheckRecoversPanic(t *testing.T) {
+	result := runCheck(preflightCheck{
+		name:     "Panics",
+		critical: true,
+		timeout:  time.Second,
+		run:      func() CheckResult { panic("boom") },
+	})
+
+	if result.Passed {
+		t.Error("runCheck() should fail a check that panics")
+	}
+	if !strings.Contains(result.Message, "boom") {
+		t.Errorf("runCheck().Message = %q, should include the panic value", result.Message)
+	}
+}
+
+func TestPreflightChecksStableOrder(t *testing.T) {
+	c := NewClientWithPath("/nonexistent/path/to/rclone")
+
+	for i := 0; i < 3; i++ {
+		results := PreflightChecks(c)
+		want := []string{"Rclone Binary", "Rclone Version", "Configured Remotes", "Systemd User Session", "Fusermount"}
+		if len(results) != len(want) {
+			t.Fatalf("PreflightChecks() returned %d results, want %d", len(results), len(want))
+		}
+		for j, name := range want {
+			if results[j].Name != name {
+				t.Errorf("results[%d].Name = %q, want %q", j, results[j].Name, name)
+			}
+		}
+	}
+}
+
+func TestCheckBinaryConsistency(t *testing.T) {
+	onPath := createMockRcloneValidation(t, "#!/bin/sh\necho \"rclone v1.65.0\"\n")
+	t.Setenv("PATH", filepath.Dir(onPath))
+
+	sameVersion := createMockRcloneValidation(t, "#!/bin/sh\necho \"rclone v1.65.0\"\n")
+	otherVersion := createMockRcloneValidation(t, "#!/bin/sh\necho \"rclone v1.60.1\"\n")
+
+	tests := []struct {
+		name       string
+		configured string
+		wantPassed bool
+	}{
+		{name: "not configured", configured: "", wantPassed: true},
+		{name: "same binary", configured: onPath, wantPassed: true},
+		{name: "same version", configured: sameVersion, wantPassed: true},
+		{name: "different version", configured: otherVersion, wantPassed: false},
+		{name: "configured binary missing", configured: "/nonexistent/rclone", wantPassed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CheckBinaryConsistency(tt.configured)
+			if result.Passed != tt.wantPassed {
+				t.Errorf("Passed = %v, want %v (%s)", result.Passed, tt.wantPassed, result.Message)
+			}
+			if result.IsCritical {
+				t.Error("binary consistency check should not be critical")
+			}
+		})
+	}
+
+	result := CheckBinaryConsistency(otherVersion)
+	for _, want := range []string{otherVersion, onPath, "v1.60.1", "v1.65.0"} {
+		if !strings.Contains(result.Message, want) {
+			t.Errorf("mismatch message should mention %q, got %q", want, result.Message)
+		}
+	}
+}
+
+func TestCheckSyncSource(t *testing.T) {
+	mockScript := `#!/bin/sh
+for arg in "$@"; do
+	case "$arg" in
+		gdrive:/missing) echo "directory not found" >&2; exit 3 ;;
+		gdrive:/denied) echo "access denied" >&2; exit 1 ;;
+		gdrive:/slow) exec sleep 5 ;;
+	esac
+done
+echo "file.txt"
+`
+	c := NewClientWithPath(createMockRcloneValidation(t, mockScript))
+	c.SetRetryConfig(RetryConfig{MaxRetries: 0})
+
+	oldTimeout := SourceCheckTimeout
+	SourceCheckTimeout = 200 * time.Millisecond
+	defer func() { SourceCheckTimeout = oldTimeout }()
+
+	tests := []struct {
+		source   string
+		passed   bool
+		timedOut bool
+		message  string
+	}{
+		{source: "gdrive:/Photos", passed: true, message: "exists"},
+		{source: "gdrive:/missing", message: "does not exist"},
+		{source: "gdrive:/denied", message: "Cannot access"},
+		{source: "gdrive:/slow", timedOut: true, message: "Timed out"},
+		{source: "/local/path", message: "not a remote:path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			result := CheckSyncSource(context.Background(), c, tt.source)
+			if result.Passed != tt.passed {
+				t.Errorf("Passed = %v, want %v (%s)", result.Passed, tt.passed, result.Message)
+			}
+			if result.TimedOut != tt.timedOut {
+				t.Errorf("TimedOut = %v, want %v", result.TimedOut, tt.timedOut)
+			}
+			if result.IsCritical {
+				t.Error("a sync source check should never be critical")
+			}
+			if !strings.Contains(result.Message, tt.message) {
+				t.Errorf("Message = %q, want it to contain %q", result.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestCheckRemoteConnections(t *testing.T) {
+	mockScript := `#!/bin/sh
+case "$2" in
+	gdrive:) echo "          -1 2024-01-01 00:00:00        -1 Photos" ;;
+	expired:) echo "CRITICAL: Failed to create file system for \"expired:\": couldn't fetch token: invalid_grant" >&2; exit 1 ;;
+	slow:) exec sleep 5 ;;
+esac
+`
+	c := NewClientWithPath(createMockRcloneValidation(t, mockScript))
+
+	results := CheckRemoteConnections(c, []string{"gdrive", "expired", "slow"}, 200*time.Millisecond)
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want one per remote", len(results))
+	}
+
+	tests := []struct {
+		name     string
+		passed   bool
+		timedOut bool
+		message  string
+	}{
+		{name: "Remote Connection: gdrive", passed: true, message: "is reachable"},
+		{name: "Remote Connection: expired", message: "invalid_grant"},
+		{name: "Remote Connection: slow", timedOut: true, message: "Timed out after 200ms"},
+	}
+	for i, tt := range tests {
+		result := results[i]
+		if result.Name != tt.name {
+			t.Errorf("result %d Name = %q, want %q", i, result.Name, tt.name)
+		}
+		if result.Passed != tt.passed || result.TimedOut != tt.timedOut {
+			t.Errorf("%s: Passed = %v, TimedOut = %v, want %v, %v (%s)", tt.name, result.Passed, result.TimedOut, tt.passed, tt.timedOut, result.Message)
+		}
+		if !strings.Contains(result.Message, tt.message) {
+			t.Errorf("%s: Message = %q, want it to contain %q", tt.name, result.Message, tt.message)
+		}
+	}
+
+	if HasCriticalFailure(results) {
+		t.Error("failed remote connections should not be critical")
+	}
+	if out := FormatResults(results); !strings.Contains(out, "⚠ FAIL (optional)] Remote Connection: expired") {
+		t.Errorf("FormatResults() should list the failed remote as optional:\n%s", out)
+	}
+}
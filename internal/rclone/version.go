@@ -0,0 +1,134 @@
+package rclone
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed rclone version. Prerelease holds the suffix of beta
+// and development builds, such as "beta.7801.9e5e0ac5e.master" or "DEV".
+type Version struct {
+	Major, Minor, Patch int
+	Prerelease          string
+}
+
+// MinimumVersion is the oldest rclone the generated units work with.
+var MinimumVersion = Version{Major: 1, Minor: 60}
+
+// FlagVersions maps flags newer than MinimumVersion to the rclone release
+// that added them, so configs using them can be checked against the
+// installed rclone.
+var FlagVersions = map[string]Version{
+	"--vfs-cache-min-free-space": {Major: 1, Minor: 62},
+	"--vfs-refresh":              {Major: 1, Minor: 64},
+	"--vfs-block-norm-dupes":     {Major: 1, Minor: 66},
+	"--conflict-resolve":         {Major: 1, Minor: 66},
+	"--conflict-loser":           {Major: 1, Minor: 66},
+	"--max-lock":                 {Major: 1, Minor: 66},
+	"--recover":                  {Major: 1, Minor: 66},
+	"--resilient":                {Major: 1, Minor: 66},
+}
+
+// versionPattern matches "v1.66.0", "1.66.0-DEV" and the like anywhere in
+// the output of rclone version.
+var versionPattern = regexp.MustCompile(`v?(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z.-]+))?`)
+
+// ParseVersion parses a version from the output of rclone version, e.g.
+// "rclone v1.66.0", "rclone v1.67.0-beta.7801.9e5e0ac5e.master" or
+// "rclone v1.68.0-DEV".
+func ParseVersion(s string) (Version, error) {
+	m := versionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, fmt.Errorf("could not find version pattern in %q", s)
+	}
+	var v Version
+	var err error
+	for i, n := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		if *n, err = strconv.Atoi(m[i+1]); err != nil {
+			return Version{}, fmt.Errorf("failed to parse version numbers: %w", err)
+		}
+	}
+	v.Prerelease = m[4]
+	return v, nil
+}
+
+// String returns the version without the leading "v", e.g. "1.66.0".
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// AtLeast reports whether v is min or newer. Only the numbers are compared:
+// beta and development builds already carry the features of the release
+// they lead up to.
+func (v Version) AtLeast(min Version) bool {
+	return compareVersions(v.tuple(), min.tuple()) >= 0
+}
+
+func (v Version) tuple() versionTuple {
+	return versionTuple{v.Major, v.Minor, v.Patch}
+}
+
+// Version returns the parsed version of the rclone binary.
+func (c *Client) Version() (Version, error) {
+	out, err := c.GetVersion()
+	if err != nil {
+		return Version{}, err
+	}
+	return ParseVersion(out)
+}
+
+// UnsupportedFlags returns the flags, sorted, that need a newer rclone than
+// v, each with the version it needs, e.g. "--vfs-refresh (1.64.0)". Flags
+// may carry values ("--max-lock=2m"); unknown flags are assumed supported.
+func UnsupportedFlags(flags []string, v Version) []string {
+	seen := make(map[string]bool)
+	var unsupported []string
+	for _, flag := range flags {
+		name, _, _ := strings.Cut(flag, "=")
+		min, ok := FlagVersions[name]
+		if !ok || seen[name] || v.AtLeast(min) {
+			continue
+		}
+		seen[name] = true
+		unsupported = append(unsupported, fmt.Sprintf("%s (%s)", name, min))
+	}
+	sort.Strings(unsupported)
+	return unsupported
+}
+
+// CheckFlagVersions verifies that the installed rclone supports the flags
+// the config uses. A flag needing a newer rclone is critical, since units
+// using it would fail to start.
+func CheckFlagVersions(client *Client, flags []string) CheckResult {
+	result := CheckResult{Name: "Rclone Flag Support"}
+
+	if len(flags) == 0 {
+		result.Passed = true
+		result.Message = "No extra flags configured"
+		return result
+	}
+	v, err := client.Version()
+	if err != nil {
+		result.Message = fmt.Sprintf("Skipped: could not read the rclone version: %v", err)
+		result.Suggestion = "Fix the Rclone Version check first"
+		return result
+	}
+
+	unsupported := UnsupportedFlags(flags, v)
+	if len(unsupported) == 0 {
+		result.Passed = true
+		result.Message = fmt.Sprintf("All configured flags are supported by rclone %s", v)
+		return result
+	}
+	result.IsCritical = true
+	result.Message = fmt.Sprintf("rclone %s does not support %s", v, strings.Join(unsupported, ", "))
+	result.Suggestion = "Upgrade rclone from https://rclone.org/install/ or remove these flags from the extra flags"
+	return result
+}
@@ -0,0 +1,90 @@
+package rclone
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseVersionFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Version
+		wantErr bool
+	}{
+		{"release", "rclone v1.66.0\n- os/version: debian 12.5 (64 bit)\n- go/version: go1.22.1", Version{1, 66, 0, ""}, false},
+		{"beta", "rclone v1.67.0-beta.7801.9e5e0ac5e.master\n- os/arch: linux/amd64", Version{1, 67, 0, "beta.7801.9e5e0ac5e.master"}, false},
+		{"dev build", "rclone v1.68.0-DEV", Version{1, 68, 0, "DEV"}, false},
+		{"distro package without v", "rclone 1.60.1-DEV", Version{1, 60, 1, "DEV"}, false},
+		{"bare", "v1.62.2", Version{1, 62, 2, ""}, false},
+		{"no version", "rclone: command not found", Version{}, true},
+		{"incomplete", "rclone v1.62", Version{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVersion(tt.input)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseVersion(%q) = %+v, %v; want %+v, error %v", tt.input, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	beta := Version{1, 66, 0, "beta.7700"}
+	if !beta.AtLeast(Version{Major: 1, Minor: 66}) {
+		t.Error("a beta should carry the features of its release")
+	}
+	if beta.AtLeast(Version{Major: 1, Minor: 66, Patch: 1}) {
+		t.Error("1.66.0 should be older than 1.66.1")
+	}
+	if got := beta.String(); got != "1.66.0-beta.7700" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestUnsupportedFlags(t *testing.T) {
+	flags := []string{"--fast-list", "--vfs-refresh", "--max-lock=2m", "--resilient", "--vfs-cache-min-free-space"}
+
+	got := UnsupportedFlags(flags, Version{Major: 1, Minor: 63})
+	want := []string{"--max-lock (1.66.0)", "--resilient (1.66.0)", "--vfs-refresh (1.64.0)"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("UnsupportedFlags() = %v, want %v", got, want)
+	}
+
+	if got := UnsupportedFlags(flags, Version{Major: 1, Minor: 66}); len(got) != 0 {
+		t.Errorf("rclone 1.66 should support every flag, got %v", got)
+	}
+}
+
+func TestClientVersion(t *testing.T) {
+	c := NewClientWithPath(createMockRcloneValidation(t, "#!/bin/sh\necho 'rclone v1.65.2'\necho '- go/version: go1.21.6'\n"))
+	v, err := c.Version()
+	if err != nil || v != (Version{Major: 1, Minor: 65, Patch: 2}) {
+		t.Errorf("Version() = %+v, %v; want 1.65.2", v, err)
+	}
+}
+
+func TestCheckFlagVersions(t *testing.T) {
+	c := NewClientWithPath(createMockRcloneValidation(t, "#!/bin/sh\necho 'rclone v1.63.1'\n"))
+
+	result := CheckFlagVersions(c, []string{"--vfs-refresh", "--fast-list"})
+	if result.Passed || !result.IsCritical {
+		t.Errorf("Passed = %v, IsCritical = %v; want a critical failure", result.Passed, result.IsCritical)
+	}
+	if !strings.Contains(result.Message, "--vfs-refresh (1.64.0)") || !strings.Contains(result.Message, "1.63.1") {
+		t.Errorf("Message = %q, want the flag, its version and the installed version", result.Message)
+	}
+
+	if result := CheckFlagVersions(c, []string{"--fast-list"}); !result.Passed {
+		t.Errorf("supported flags should pass, got %q", result.Message)
+	}
+
+	broken := NewClientWithPath("/nonexistent/rclone")
+	if result := CheckFlagVersions(broken, nil); !result.Passed {
+		t.Errorf("no flags should pass without running rclone, got %q", result.Message)
+	}
+	if result := CheckFlagVersions(broken, []string{"--vfs-refresh"}); result.Passed || result.IsCritical {
+		t.Errorf("an unknown version should be a non-critical failure, got %+v", result)
+	}
+}
@@ -0,0 +1,281 @@
+// Package report renders a human-readable document of the configured mounts
+// and sync jobs, with their schedules and a snapshot of their service status,
+// for documenting and reviewing a setup.
+package report
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// Report formats
+const (
+	FormatMarkdown = "md"
+	FormatHTML     = "html"
+)
+
+// Report is the setup as rendered into a document.
+type Report struct {
+	Generated time.Time
+	Mounts    []Mount
+	SyncJobs  []SyncJob
+}
+
+// Mount is one mount in a report.
+type Mount struct {
+	Name       string
+	Remote     string
+	MountPoint string
+	Enabled    bool
+	Status     string
+}
+
+// SyncJob is one sync job in a report.
+type SyncJob struct {
+	Name        string
+	Source      string
+	Destination string
+	Direction   string
+	Schedule    string
+	Enabled     bool
+	Status      string
+	LastRun     string
+}
+
+// Build collects the mounts and sync jobs of cfg into a report. statuses is
+// a snapshot of the rclone services, as returned by ServiceManager.ListServices;
+// nil means the status could not be read and is reported as unknown.
+func Build(cfg *config.Config, gen systemd.UnitGenerator, statuses []systemd.ServiceStatus, generated time.Time) *Report {
+	byName := make(map[string]systemd.ServiceStatus, len(statuses))
+	for _, s := range statuses {
+		byName[s.Name] = s
+	}
+	status := func(id, unitType string) string {
+		if statuses == nil {
+			return "unknown"
+		}
+		s, ok := byName[gen.ServiceName(id, unitType)]
+		switch {
+		case !ok:
+			return "not installed"
+		case s.State == "activating" && unitType == "sync":
+			return "syncing"
+		case s.Active:
+			return "running"
+		default:
+			return s.State
+		}
+	}
+
+	r := &Report{Generated: generated}
+	for _, m := range cfg.Mounts {
+		r.Mounts = append(r.Mounts, Mount{
+			Name:       m.Name,
+			Remote:     m.FullRemotePath(),
+			MountPoint: m.MountPoint,
+			Enabled:    m.Enabled,
+			Status:     status(m.ID, "mount"),
+		})
+	}
+	for _, j := range cfg.SyncJobs {
+		r.SyncJobs = append(r.SyncJobs, SyncJob{
+			Name:        j.Name,
+			Source:      j.Source,
+			Destination: j.Destination,
+			Direction:   j.SyncOptions.Direction,
+			Schedule:    DescribeSchedule(j.Schedule),
+			Enabled:     j.Enabled,
+			Status:      status(j.ID, "sync"),
+			LastRun:     describeLastRun(j),
+		})
+	}
+	return r
+}
+
+// describeLastRun returns the outcome and time of the job's last finished run.
+func describeLastRun(j models.SyncJobConfig) string {
+	if n := len(j.RunHistory); n > 0 {
+		last := j.RunHistory[n-1]
+		outcome := "failed"
+		if last.Success {
+			outcome = "succeeded"
+		} else if last.TimedOut {
+			outcome = "timed out"
+		}
+		return fmt.Sprintf("%s %s", outcome, last.FinishedAt.Format("2006-01-02 15:04"))
+	}
+	if !j.LastRun.IsZero() {
+		return j.LastRun.Format("2006-01-02 15:04")
+	}
+	return "never"
+}
+
+// namedSchedules describes systemd's calendar shorthands.
+var namedSchedules = map[string]string{
+	"minutely":     "Every minute",
+	"hourly":       "Every hour",
+	"daily":        "Every day at midnight",
+	"weekly":       "Every Monday at midnight",
+	"monthly":      "On the 1st of every month at midnight",
+	"quarterly":    "On the 1st of January, April, July and October at midnight",
+	"semiannually": "On the 1st of January and July at midnight",
+	"yearly":       "Every year on January 1st at midnight",
+	"annually":     "Every year on January 1st at midnight",
+}
+
+// calendarPattern matches the common OnCalendar forms: an optional list of
+// weekdays, a date that is every day or a day of every month, and a time.
+var calendarPattern = regexp.MustCompile(`^(?:([A-Za-z]{3}(?:,[A-Za-z]{3})*)\s+)?\*-\*-(\*|\d{1,2})\s+(\d{1,2}):(\d{2})(?::(\d{2}))?$`)
+
+// DescribeSchedule returns a sync job schedule in plain words, such as
+// "Every day at 02:00". Calendar expressions it cannot put into words are
+// returned as written.
+func DescribeSchedule(s models.ScheduleConfig) string {
+	var desc string
+	switch s.Type {
+	case "timer":
+		desc = describeCalendar(s.OnCalendar)
+	case "onboot":
+		desc = "At boot"
+		if s.OnBootSec != "" {
+			desc = s.OnBootSec + " after boot"
+		}
+	default:
+		return "Manual only"
+	}
+
+	if s.RequireACPower {
+		desc += ", on AC power only"
+	}
+	if s.RequireUnmetered {
+		desc += ", on unmetered networks only"
+	}
+	return desc
+}
+
+// describeCalendar puts a systemd OnCalendar expression into words.
+func describeCalendar(calendar string) string {
+	calendar = strings.TrimSpace(calendar)
+	if calendar == "" {
+		return "Every day at midnight"
+	}
+	if desc, ok := namedSchedules[strings.ToLower(calendar)]; ok {
+		return desc
+	}
+
+	m := calendarPattern.FindStringSubmatch(calendar)
+	if m == nil {
+		return "On calendar " + calendar
+	}
+	hour, _ := strconv.Atoi(m[3])
+	weekdays, day, at := m[1], m[2], fmt.Sprintf("%02d:%s", hour, m[4])
+	if m[5] != "" && m[5] != "00" {
+		at += ":" + m[5]
+	}
+
+	switch {
+	case day != "*" && weekdays != "":
+		return "On calendar " + calendar
+	case day != "*":
+		return fmt.Sprintf("On day %s of every month at %s", strings.TrimLeft(day, "0"), at)
+	case weekdays != "":
+		return fmt.Sprintf("Every %s at %s", strings.ReplaceAll(weekdays, ",", ", "), at)
+	default:
+		return "Every day at " + at
+	}
+}
+
+// Write renders r in format to w.
+func Write(w io.Writer, r *Report, format string) error {
+	switch format {
+	case FormatMarkdown:
+		return markdownTemplate.Execute(w, r)
+	case FormatHTML:
+		return htmlTemplate.Execute(w, r)
+	default:
+		return fmt.Errorf("unknown report format %q (use %s or %s)", format, FormatMarkdown, FormatHTML)
+	}
+}
+
+// mdCell escapes a value for a Markdown table cell.
+func mdCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// yesNo formats a boolean for a table.
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+var markdownTemplate = template.Must(template.New("md").Funcs(template.FuncMap{
+	"cell":  mdCell,
+	"yesNo": yesNo,
+}).Parse(`# rclone-mount-sync setup
+
+Generated {{.Generated.Format "2006-01-02 15:04 MST"}}.
+
+## Mounts
+{{if .Mounts}}
+| Name | Remote | Mount point | Enabled | Status |
+|------|--------|-------------|---------|--------|
+{{range .Mounts}}| {{cell .Name}} | {{cell .Remote}} | {{cell .MountPoint}} | {{yesNo .Enabled}} | {{cell .Status}} |
+{{end}}{{else}}
+No mounts configured.
+{{end}}
+## Sync Jobs
+{{if .SyncJobs}}
+| Name | Source | Destination | Mode | Schedule | Enabled | Status | Last run |
+|------|--------|-------------|------|----------|---------|--------|----------|
+{{range .SyncJobs}}| {{cell .Name}} | {{cell .Source}} | {{cell .Destination}} | {{cell .Direction}} | {{cell .Schedule}} | {{yesNo .Enabled}} | {{cell .Status}} | {{cell .LastRun}} |
+{{end}}{{else}}
+No sync jobs configured.
+{{end}}`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+	"yesNo": yesNo,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>rclone-mount-sync setup</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>rclone-mount-sync setup</h1>
+<p>Generated {{.Generated.Format "2006-01-02 15:04 MST"}}.</p>
+
+<h2>Mounts</h2>
+{{if .Mounts}}<table>
+<tr><th>Name</th><th>Remote</th><th>Mount point</th><th>Enabled</th><th>Status</th></tr>
+{{range .Mounts}}<tr><td>{{.Name}}</td><td>{{.Remote}}</td><td>{{.MountPoint}}</td><td>{{yesNo .Enabled}}</td><td>{{.Status}}</td></tr>
+{{end}}</table>
+{{else}}<p>No mounts configured.</p>
+{{end}}
+<h2>Sync Jobs</h2>
+{{if .SyncJobs}}<table>
+<tr><th>Name</th><th>Source</th><th>Destination</th><th>Mode</th><th>Schedule</th><th>Enabled</th><th>Status</th><th>Last run</th></tr>
+{{range .SyncJobs}}<tr><td>{{.Name}}</td><td>{{.Source}}</td><td>{{.Destination}}</td><td>{{.Direction}}</td><td>{{.Schedule}}</td><td>{{yesNo .Enabled}}</td><td>{{.Status}}</td><td>{{.LastRun}}</td></tr>
+{{end}}</table>
+{{else}}<p>No sync jobs configured.</p>
+{{end}}</body>
+</html>
+`))
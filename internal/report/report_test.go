@@ -0,0 +1,134 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+func TestDescribeSchedule(t *testing.T) {
+	tests := []struct {
+		schedule models.ScheduleConfig
+		want     string
+	}{
+		{models.ScheduleConfig{Type: "manual"}, "Manual only"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "daily"}, "Every day at midnight"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "Hourly"}, "Every hour"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "*-*-* 02:00:00"}, "Every day at 02:00"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "*-*-* 2:30"}, "Every day at 02:30"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "Mon,Fri *-*-* 09:00:00"}, "Every Mon, Fri at 09:00"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "*-*-01 04:00:00"}, "On day 1 of every month at 04:00"},
+		{models.ScheduleConfig{Type: "timer", OnCalendar: "2024-01-01 00:00:00"}, "On calendar 2024-01-01 00:00:00"},
+		{models.ScheduleConfig{Type: "onboot", OnBootSec: "5min"}, "5min after boot"},
+		{models.ScheduleConfig{Type: "onboot"}, "At boot"},
+		{
+			models.ScheduleConfig{Type: "timer", OnCalendar: "daily", RequireACPower: true, RequireUnmetered: true},
+			"Every day at midnight, on AC power only, on unmetered networks only",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := DescribeSchedule(tt.schedule); got != tt.want {
+			t.Errorf("DescribeSchedule(%+v) = %q, want %q", tt.schedule, got, tt.want)
+		}
+	}
+}
+
+// testReportConfig returns a config with one mount and two sync jobs.
+func testReportConfig() *config.Config {
+	finished := time.Date(2026, 3, 1, 2, 5, 0, 0, time.UTC)
+	return &config.Config{
+		Mounts: []models.MountConfig{
+			{ID: "m1", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive", Enabled: true},
+		},
+		SyncJobs: []models.SyncJobConfig{
+			{
+				ID: "s1", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos",
+				SyncOptions: models.SyncOptions{Direction: "sync"},
+				Schedule:    models.ScheduleConfig{Type: "timer", OnCalendar: "*-*-* 02:00:00"},
+				RunHistory:  []models.RunOutcome{{FinishedAt: finished, Success: true}},
+				Enabled:     true,
+			},
+			{
+				ID: "s2", Name: "a|b <docs>", Source: "gdrive:/Docs", Destination: "/data/docs",
+				SyncOptions: models.SyncOptions{Direction: "copy"},
+				Schedule:    models.ScheduleConfig{Type: "manual"},
+			},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	statuses := []systemd.ServiceStatus{
+		{Name: "rclone-mount-m1", Active: true, State: "active"},
+		{Name: "rclone-sync-s1", State: "activating"},
+	}
+	r := Build(testReportConfig(), systemd.NewTestGenerator(t.TempDir()), statuses, time.Now())
+
+	if len(r.Mounts) != 1 || r.Mounts[0].Remote != "gdrive:/" || r.Mounts[0].Status != "running" {
+		t.Errorf("Mounts = %+v", r.Mounts)
+	}
+	if len(r.SyncJobs) != 2 {
+		t.Fatalf("len(SyncJobs) = %d, want 2", len(r.SyncJobs))
+	}
+	photos, docs := r.SyncJobs[0], r.SyncJobs[1]
+	if photos.Status != "syncing" || photos.Schedule != "Every day at 02:00" || photos.LastRun != "succeeded 2026-03-01 02:05" {
+		t.Errorf("photos = %+v", photos)
+	}
+	if docs.Status != "not installed" || docs.LastRun != "never" {
+		t.Errorf("docs = %+v", docs)
+	}
+
+	unknown := Build(testReportConfig(), systemd.NewTestGenerator(t.TempDir()), nil, time.Now())
+	if unknown.Mounts[0].Status != "unknown" {
+		t.Errorf("status without a snapshot = %q, want unknown", unknown.Mounts[0].Status)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	r := Build(testReportConfig(), systemd.NewTestGenerator(t.TempDir()), nil, time.Now())
+
+	var md bytes.Buffer
+	if err := Write(&md, r, FormatMarkdown); err != nil {
+		t.Fatalf("Write(md) error = %v", err)
+	}
+	for _, want := range []string{
+		"## Mounts",
+		"| gdrive | gdrive:/ | /mnt/gdrive | yes | unknown |",
+		"| photos | gdrive:/Photos | /data/photos | sync | Every day at 02:00 | yes | unknown | succeeded 2026-03-01 02:05 |",
+		`| a\|b <docs> |`,
+	} {
+		if !strings.Contains(md.String(), want) {
+			t.Errorf("Markdown report missing %q:\n%s", want, md.String())
+		}
+	}
+
+	var html bytes.Buffer
+	if err := Write(&html, r, FormatHTML); err != nil {
+		t.Fatalf("Write(html) error = %v", err)
+	}
+	for _, want := range []string{"<h2>Sync Jobs</h2>", "<td>Every day at 02:00</td>", "a|b &lt;docs&gt;"} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("HTML report missing %q:\n%s", want, html.String())
+		}
+	}
+
+	if err := Write(&bytes.Buffer{}, r, "pdf"); err == nil {
+		t.Error("Write() should reject an unknown format")
+	}
+}
+
+func TestWrite_Empty(t *testing.T) {
+	var md bytes.Buffer
+	if err := Write(&md, Build(&config.Config{}, systemd.NewTestGenerator(t.TempDir()), nil, time.Now()), FormatMarkdown); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(md.String(), "No mounts configured.") || !strings.Contains(md.String(), "No sync jobs configured.") {
+		t.Errorf("empty report should say nothing is configured:\n%s", md.String())
+	}
+}
@@ -0,0 +1,196 @@
+package systemd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// Apply actions, in the order ApplyPlan.Execute carries them out.
+const (
+	ApplyWrite   = "write"
+	ApplyRemove  = "remove"
+	ApplyEnable  = "enable"
+	ApplyDisable = "disable"
+)
+
+// ApplyChange is one step needed to make the installed units match the config.
+type ApplyChange struct {
+	Action string // One of the Apply* actions
+	Unit   string // Unit file name, e.g. "rclone-mount-abc12345.service"
+	Err    error  // Set by Execute when the step failed
+
+	content string        // Unit content to write
+	orphan  *OrphanedUnit // Orphan to remove, nil for stale timers
+}
+
+// ApplyPlan lists the changes that make the installed units match the
+// config. An empty plan means the system already matches it.
+type ApplyPlan struct {
+	Changes []ApplyChange
+
+	generator *Generator
+	manager   ServiceManager
+}
+
+// PlanApply compares the unit files and their enabled state with the given
+// mounts and sync jobs. Units whose content differs from the generator output
+// are rewritten, units of items no longer in the config are removed, and each
+// mount service and sync timer is enabled exactly when its item is Enabled.
+// Nothing is changed until Execute is called.
+func PlanApply(gen *Generator, mgr ServiceManager, mounts []models.MountConfig, jobs []models.SyncJobConfig) (*ApplyPlan, error) {
+	plan := &ApplyPlan{generator: gen, manager: mgr}
+	var toggles []ApplyChange
+
+	validMounts := make(map[string]bool)
+	for i := range mounts {
+		mount := &mounts[i]
+		validMounts[mount.ID] = true
+
+		content, err := gen.GenerateMountService(mount)
+		if err != nil {
+			return nil, fmt.Errorf("mount %s: %w", mount.Name, err)
+		}
+		unit := gen.ServiceName(mount.ID, "mount") + ".service"
+		plan.addWrite(unit, content)
+		toggles = appendToggle(toggles, mgr, unit, mount.Enabled)
+	}
+
+	validSyncs := make(map[string]bool)
+	for i := range jobs {
+		job := &jobs[i]
+		validSyncs[job.ID] = true
+
+		content, err := gen.GenerateSyncService(job)
+		if err != nil {
+			return nil, fmt.Errorf("sync job %s: %w", job.Name, err)
+		}
+		name := gen.ServiceName(job.ID, "sync")
+		plan.addWrite(name+".service", content)
+
+		timer := name + ".timer"
+		if job.Schedule.Type == "manual" {
+			// A timer left over from an earlier schedule would still fire
+			if _, err := os.Stat(filepath.Join(gen.GetSystemdDir(), timer)); err == nil {
+				plan.Changes = append(plan.Changes, ApplyChange{Action: ApplyRemove, Unit: timer})
+			}
+			continue
+		}
+		content, err = gen.GenerateSyncTimer(job)
+		if err != nil {
+			return nil, fmt.Errorf("sync job %s: %w", job.Name, err)
+		}
+		plan.addWrite(timer, content)
+		toggles = appendToggle(toggles, mgr, timer, job.Enabled)
+	}
+
+	orphans, err := NewReconciler(gen, mgr).ScanForOrphans(validMounts, validSyncs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range orphans.OrphanedUnits {
+		orphan := orphans.OrphanedUnits[i]
+		plan.Changes = append(plan.Changes, ApplyChange{Action: ApplyRemove, Unit: orphan.Name, orphan: &orphan})
+	}
+
+	plan.Changes = append(plan.Changes, toggles...)
+	return plan, nil
+}
+
+// addWrite adds a write for a unit whose file is missing or differs from content.
+func (p *ApplyPlan) addWrite(unit, content string) {
+	current, err := os.ReadFile(filepath.Join(p.generator.GetSystemdDir(), unit))
+	if err == nil && string(current) == content {
+		return
+	}
+	p.Changes = append(p.Changes, ApplyChange{Action: ApplyWrite, Unit: unit, content: content})
+}
+
+// appendToggle adds an enable or disable when the unit's state differs from want.
+func appendToggle(changes []ApplyChange, mgr ServiceManager, unit string, want bool) []ApplyChange {
+	enabled, _ := mgr.IsEnabled(unit)
+	switch {
+	case want && !enabled:
+		return append(changes, ApplyChange{Action: ApplyEnable, Unit: unit})
+	case !want && enabled:
+		return append(changes, ApplyChange{Action: ApplyDisable, Unit: unit})
+	}
+	return changes
+}
+
+// Count returns the number of changes with the given action.
+func (p *ApplyPlan) Count(action string) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Execute carries out the plan: unit files are written and removed, systemd
+// is reloaded once, then units are enabled or disabled. A failed step does
+// not stop the others; its error is recorded on the change and included in
+// the returned error.
+func (p *ApplyPlan) Execute() error {
+	reload := false
+	for i := range p.Changes {
+		c := &p.Changes[i]
+		switch c.Action {
+		case ApplyWrite:
+			c.Err = p.generator.WriteUnitFile(c.Unit, c.content)
+			reload = true
+		case ApplyRemove:
+			c.Err = p.remove(c)
+			reload = true
+		}
+	}
+	if reload {
+		if err := p.manager.DaemonReload(); err != nil {
+			return fmt.Errorf("failed to reload systemd daemon: %w", err)
+		}
+	}
+
+	for i := range p.Changes {
+		c := &p.Changes[i]
+		switch c.Action {
+		case ApplyEnable:
+			c.Err = p.manager.Enable(c.Unit)
+		case ApplyDisable:
+			c.Err = p.manager.Disable(c.Unit)
+		}
+	}
+
+	var errs []error
+	for _, c := range p.Changes {
+		if c.Err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", c.Action, c.Unit, c.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// remove stops and removes an orphaned unit, or disables and removes a
+// stale timer.
+func (p *ApplyPlan) remove(c *ApplyChange) error {
+	if c.orphan != nil {
+		return NewReconciler(p.generator, p.manager).RemoveOrphan(*c.orphan)
+	}
+	name := strings.TrimSuffix(c.Unit, ".timer")
+	if enabled, _ := p.manager.IsEnabled(c.Unit); enabled {
+		if err := p.manager.DisableTimer(name); err != nil {
+			return err
+		}
+	}
+	if active, _ := p.manager.IsActive(c.Unit); active {
+		if err := p.manager.StopTimer(name); err != nil {
+			return err
+		}
+	}
+	return p.generator.RemoveUnit(c.Unit)
+}
@@ -0,0 +1,96 @@
+package systemd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+func TestPlanApply(t *testing.T) {
+	dir := t.TempDir()
+	g := NewTestGenerator(dir)
+	mounts := []models.MountConfig{
+		{ID: "a1b2c3d4", Name: "gdrive", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/gdrive", Enabled: true},
+	}
+	jobs := []models.SyncJobConfig{
+		{ID: "e5f6a7b8", Name: "photos", Source: "gdrive:/Photos", Destination: "/backup", Schedule: models.ScheduleConfig{Type: "manual"}},
+	}
+	for _, name := range []string{"rclone-sync-e5f6a7b8.timer", "rclone-mount-99999999.service"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("[Unit]\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mgr := &MockManager{}
+	plan, err := PlanApply(g, mgr, mounts, jobs)
+	if err != nil {
+		t.Fatalf("PlanApply() error = %v", err)
+	}
+	want := []ApplyChange{
+		{Action: ApplyWrite, Unit: "rclone-mount-a1b2c3d4.service"},
+		{Action: ApplyWrite, Unit: "rclone-sync-e5f6a7b8.service"},
+		{Action: ApplyRemove, Unit: "rclone-sync-e5f6a7b8.timer"},
+		{Action: ApplyRemove, Unit: "rclone-mount-99999999.service"},
+		{Action: ApplyEnable, Unit: "rclone-mount-a1b2c3d4.service"},
+	}
+	if len(plan.Changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(plan.Changes), len(want), plan.Changes)
+	}
+	for i, c := range plan.Changes {
+		if c.Action != want[i].Action || c.Unit != want[i].Unit {
+			t.Errorf("change %d = %s %s, want %s %s", i, c.Action, c.Unit, want[i].Action, want[i].Unit)
+		}
+	}
+	if len(mgr.Calls) != 1 || !mgr.Called("IsEnabled", "rclone-mount-a1b2c3d4.service") {
+		t.Errorf("planning should only query the enabled state, calls: %v", mgr.Calls)
+	}
+
+	if err := plan.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !mgr.Called("DaemonReload", "") || !mgr.Called("Enable", "rclone-mount-a1b2c3d4.service") {
+		t.Errorf("expected a reload and the mount to be enabled, calls: %v", mgr.Calls)
+	}
+	for _, name := range []string{"rclone-sync-e5f6a7b8.timer", "rclone-mount-99999999.service"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s should have been removed", name)
+		}
+	}
+
+	// Applying again finds nothing to do
+	mgr = &MockManager{IsEnabledResult: true}
+	plan, err = PlanApply(g, mgr, mounts, jobs)
+	if err != nil {
+		t.Fatalf("PlanApply() error = %v", err)
+	}
+	if len(plan.Changes) != 0 {
+		t.Errorf("second plan should be empty, got %+v", plan.Changes)
+	}
+}
+
+func TestPlanApply_DisablesAndReportsFailures(t *testing.T) {
+	g := NewTestGenerator(t.TempDir())
+	jobs := []models.SyncJobConfig{
+		{ID: "e5f6a7b8", Name: "photos", Source: "gdrive:/Photos", Destination: "/backup", Schedule: models.ScheduleConfig{Type: "timer", OnCalendar: "daily"}},
+	}
+
+	mgr := &MockManager{IsEnabledResult: true, DisableErr: os.ErrPermission}
+	plan, err := PlanApply(g, mgr, nil, jobs)
+	if err != nil {
+		t.Fatalf("PlanApply() error = %v", err)
+	}
+	if plan.Count(ApplyWrite) != 2 || plan.Count(ApplyDisable) != 1 {
+		t.Fatalf("expected both units written and the timer disabled, got %+v", plan.Changes)
+	}
+
+	if err := plan.Execute(); err == nil {
+		t.Fatal("Execute() should report the failed disable")
+	}
+	for _, c := range plan.Changes {
+		if (c.Err != nil) != (c.Action == ApplyDisable) {
+			t.Errorf("%s %s: unexpected error state %v", c.Action, c.Unit, c.Err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package systemd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// BatchAction is an operation applied to several units at once.
+type BatchAction string
+
+// Supported batch actions.
+const (
+	BatchStart   BatchAction = "start"
+	BatchStop    BatchAction = "stop"
+	BatchRestart BatchAction = "restart"
+	BatchEnable  BatchAction = "enable"
+	BatchDisable BatchAction = "disable"
+)
+
+// ActionResult is the outcome of a batch action on a single unit.
+type ActionResult struct {
+	Unit     string      `json:"unit"`
+	Action   BatchAction `json:"action"`
+	Attempts int         `json:"attempts"`
+	Err      error       `json:"-"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// OK reports whether the action succeeded, possibly after a retry.
+func (r ActionResult) OK() bool {
+	return r.Err == nil
+}
+
+// batchRetryDelay is how long to wait before retrying a transient failure.
+var batchRetryDelay = 500 * time.Millisecond
+
+// transientErrorMarkers are systemctl error fragments that usually clear up
+// on their own, e.g. while another job for the unit is still running.
+var transientErrorMarkers = []string{
+	"transaction is destructive",
+	"canceled",
+	"timed out",
+	"temporarily unavailable",
+	"failed to connect to bus",
+	"connection reset",
+}
+
+// isTransientError reports whether err looks worth retrying.
+func isTransientError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, marker := range transientErrorMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// RunBatch applies action to every unit and collects one result per unit.
+// A failure on one unit does not stop the others; transient failures are
+// retried once.
+func RunBatch(mgr ServiceManager, action BatchAction, units []string) []ActionResult {
+	var run func(string) error
+	switch action {
+	case BatchStart:
+		run = mgr.Start
+	case BatchStop:
+		run = mgr.Stop
+	case BatchRestart:
+		run = mgr.Restart
+	case BatchEnable:
+		run = mgr.Enable
+	case BatchDisable:
+		run = mgr.Disable
+	}
+
+	results := make([]ActionResult, 0, len(units))
+	for _, unit := range units {
+		result := ActionResult{Unit: unit, Action: action}
+		if run == nil {
+			result.Err = fmt.Errorf("unsupported batch action %q", action)
+		} else {
+			result.Attempts = 1
+			result.Err = run(unit)
+			if result.Err != nil && isTransientError(result.Err) {
+				time.Sleep(batchRetryDelay)
+				result.Attempts++
+				result.Err = run(unit)
+			}
+		}
+		if result.Err != nil {
+			result.Error = result.Err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// RunBatch applies action to every unit; see the package-level RunBatch.
+func (m *Manager) RunBatch(action BatchAction, units []string) []ActionResult {
+	return RunBatch(m, action, units)
+}
+
+// CountResults returns how many results succeeded and failed.
+func CountResults(results []ActionResult) (succeeded, failed int) {
+	for _, r := range results {
+		if r.OK() {
+			succeeded++
+		} else {
+			failed++
+		}
+	}
+	return succeeded, failed
+}
+
+// InactiveMountUnits returns the service units of mounts that are not
+// running. Sync services are left out since starting one runs the sync.
+func InactiveMountUnits(services []ServiceStatus) []string {
+	var units []string
+	for _, s := range services {
+		if strings.HasPrefix(s.Name, "rclone-mount-") && !s.Active {
+			units = append(units, strings.TrimSuffix(s.Name, ".service")+".service")
+		}
+	}
+	sort.Strings(units)
+	return units
+}
+
+// AutoStartUnits returns the units that start the given mounts and sync jobs
+// on login: each enabled mount's service and each enabled scheduled sync
+// job's timer. Disabled items are left out, so turning auto-start on does
+// not bring them back; manual sync jobs have no timer to enable.
+func AutoStartUnits(gen UnitGenerator, mounts []models.MountConfig, jobs []models.SyncJobConfig) []string {
+	var units []string
+	for _, m := range mounts {
+		if m.Enabled {
+			units = append(units, gen.ServiceName(m.ID, "mount")+".service")
+		}
+	}
+	for _, j := range jobs {
+		if j.Enabled && j.Schedule.Type != "manual" {
+			units = append(units, gen.ServiceName(j.ID, "sync")+".timer")
+		}
+	}
+	return units
+}
+
+// FailedUnits returns the service units that are in the failed state.
+func FailedUnits(services []ServiceStatus) []string {
+	var units []string
+	for _, s := range services {
+		if s.State == "failed" {
+			units = append(units, strings.TrimSuffix(s.Name, ".service")+".service")
+		}
+	}
+	sort.Strings(units)
+	return units
+}
@@ -0,0 +1,154 @@
+package systemd
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// flakyManager fails each unit with the queued errors before succeeding.
+type flakyManager struct {
+	MockManager
+	errs map[string][]error
+}
+
+func (f *flakyManager) next(name string) error {
+	queue := f.errs[name]
+	if len(queue) == 0 {
+		return nil
+	}
+	f.errs[name] = queue[1:]
+	return queue[0]
+}
+
+func (f *flakyManager) Start(name string) error {
+	f.record("Start", name)
+	return f.next(name)
+}
+
+func (f *flakyManager) Restart(name string) error {
+	f.record("Restart", name)
+	return f.next(name)
+}
+
+func noRetryDelay(t *testing.T) {
+	t.Helper()
+	orig := batchRetryDelay
+	batchRetryDelay = 0
+	t.Cleanup(func() { batchRetryDelay = orig })
+}
+
+func TestRunBatch_PartialFailure(t *testing.T) {
+	noRetryDelay(t)
+	mgr := &flakyManager{errs: map[string][]error{
+		"b.service": {errors.New("Job for b.service failed because the control process exited")},
+	}}
+
+	results := RunBatch(mgr, BatchStart, []string{"a.service", "b.service", "c.service"})
+
+	if len(results) != 3 {
+		t.Fatalf("RunBatch() returned %d results, want 3", len(results))
+	}
+	if !results[0].OK() || !results[2].OK() {
+		t.Error("units after a failure should still be started")
+	}
+	if results[1].OK() || results[1].Attempts != 1 {
+		t.Errorf("b.service: ok=%v attempts=%d; want a single failed attempt", results[1].OK(), results[1].Attempts)
+	}
+	if results[1].Error == "" {
+		t.Error("failed result should carry the error text")
+	}
+	if succeeded, failed := CountResults(results); succeeded != 2 || failed != 1 {
+		t.Errorf("CountResults() = %d, %d; want 2, 1", succeeded, failed)
+	}
+}
+
+func TestRunBatch_RetriesTransientFailure(t *testing.T) {
+	noRetryDelay(t)
+	mgr := &flakyManager{errs: map[string][]error{
+		"a.service": {errors.New("Job for a.service canceled")},
+		"b.service": {errors.New("Connection timed out"), errors.New("Connection timed out")},
+	}}
+
+	results := RunBatch(mgr, BatchRestart, []string{"a.service", "b.service"})
+
+	if !results[0].OK() || results[0].Attempts != 2 {
+		t.Errorf("a.service: ok=%v attempts=%d; want success on retry", results[0].OK(), results[0].Attempts)
+	}
+	if results[1].OK() || results[1].Attempts != 2 {
+		t.Errorf("b.service: ok=%v attempts=%d; want failure after one retry", results[1].OK(), results[1].Attempts)
+	}
+	if n := len(mgr.Calls); n != 4 {
+		t.Errorf("Restart called %d times, want 4", n)
+	}
+}
+
+func TestRunBatch_UnsupportedAction(t *testing.T) {
+	mgr := &MockManager{}
+
+	results := RunBatch(mgr, BatchAction("reload"), []string{"a.service"})
+
+	if len(results) != 1 || results[0].OK() || results[0].Attempts != 0 {
+		t.Errorf("RunBatch() = %+v, want an unattempted failure", results)
+	}
+	if len(mgr.Calls) != 0 {
+		t.Errorf("no manager calls expected, got %v", mgr.Calls)
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want bool
+	}{
+		{"Transaction is destructive", true},
+		{"Failed to connect to bus: No such file or directory", true},
+		{"Unit rclone-mount-x.service not found.", false},
+		{"exit status 1", false},
+	}
+	for _, tt := range tests {
+		if got := isTransientError(errors.New(tt.msg)); got != tt.want {
+			t.Errorf("isTransientError(%q) = %v, want %v", tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestBatchUnitSelectors(t *testing.T) {
+	services := []ServiceStatus{
+		{Name: "rclone-mount-b", Active: false, State: "failed"},
+		{Name: "rclone-mount-a.service", Active: false, State: "inactive"},
+		{Name: "rclone-mount-c", Active: true, State: "active"},
+		{Name: "rclone-sync-d", Active: false, State: "failed"},
+	}
+
+	if got, want := InactiveMountUnits(services), []string{"rclone-mount-a.service", "rclone-mount-b.service"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InactiveMountUnits() = %v, want %v", got, want)
+	}
+	if got, want := FailedUnits(services), []string{"rclone-mount-b.service", "rclone-sync-d.service"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FailedUnits() = %v, want %v", got, want)
+	}
+}
+
+func TestAutoStartUnits(t *testing.T) {
+	mounts := []models.MountConfig{{ID: "m1", Enabled: true}, {ID: "m2"}}
+	jobs := []models.SyncJobConfig{
+		{ID: "s1", Enabled: true, Schedule: models.ScheduleConfig{Type: "timer"}},
+		{ID: "s2", Enabled: true, Schedule: models.ScheduleConfig{Type: "manual"}},
+		{ID: "s3", Schedule: models.ScheduleConfig{Type: "timer"}},
+	}
+
+	got := AutoStartUnits(&Generator{}, mounts, jobs)
+	if want := []string{"rclone-mount-m1.service", "rclone-sync-s1.timer"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AutoStartUnits() = %v, want %v", got, want)
+	}
+
+	mgr := &MockManager{}
+	if results := RunBatch(mgr, BatchDisable, got); len(results) != 2 || results[0].Err != nil {
+		t.Fatalf("RunBatch(disable) = %v", results)
+	}
+	if !mgr.Called("Disable", "rclone-sync-s1.timer") {
+		t.Errorf("expected the timer to be disabled, calls: %v", mgr.Calls)
+	}
+}
@@ -0,0 +1,214 @@
+package systemd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SplitExtraArgs splits user supplied extra arguments into separate
+// arguments. Arguments are separated by spaces or newlines; single or
+// double quotes keep a value with spaces together, and a backslash escapes
+// the next character outside single quotes.
+func SplitExtraArgs(extraArgs string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range extraArgs {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in extra arguments", quote)
+	}
+	if escaped {
+		return nil, fmt.Errorf("extra arguments end with a backslash")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// NormalizeExtraArgs rewrites extra arguments as a single line, quoting
+// only the arguments that need it. Arguments that cannot be split are
+// returned unchanged.
+func NormalizeExtraArgs(extraArgs string) string {
+	args, err := SplitExtraArgs(extraArgs)
+	if err != nil {
+		return strings.TrimSpace(extraArgs)
+	}
+	return joinArgs(args, quoteArg)
+}
+
+// execExtraArgs returns extra arguments for an ExecStart= line, each quoted
+// and escaped with quoteExecArg. Arguments that cannot be split are
+// returned unchanged.
+func execExtraArgs(extraArgs string) string {
+	args, err := SplitExtraArgs(extraArgs)
+	if err != nil {
+		return strings.TrimSpace(extraArgs)
+	}
+	return joinArgs(args, quoteExecArg)
+}
+
+// joinArgs joins arguments with spaces, quoting each one with quote.
+func joinArgs(args []string, quote func(string) string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = quote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quoteArg double quotes an argument that contains spaces, quotes or
+// backslashes, so SplitExtraArgs and systemd both read it back as a single
+// argument.
+func quoteArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n\r\"'\\") {
+		return arg
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + replacer.Replace(arg) + `"`
+}
+
+// execEscaper doubles the characters systemd expands in Exec lines: % for
+// specifiers and $ for environment variables.
+var execEscaper = strings.NewReplacer("%", "%%", "$", "$$")
+
+// quoteExecArg quotes an argument for an ExecStart= line as quoteArg does,
+// with % and $ doubled so systemd passes them to rclone unchanged.
+func quoteExecArg(arg string) string {
+	return quoteArg(execEscaper.Replace(arg))
+}
+
+// execUnescaper undoes execEscaper, as systemd does when it runs a line.
+var execUnescaper = strings.NewReplacer("%%", "%", "$$", "$")
+
+// splitExecOptions splits options built for an ExecStart= line back into
+// the arguments systemd passes to rclone.
+func splitExecOptions(options string) ([]string, error) {
+	args, err := SplitExtraArgs(flattenOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		args[i] = execUnescaper.Replace(arg)
+	}
+	return args, nil
+}
+
+// managedMountFlags maps the rclone flags the generator sets from mount
+// options to the form field that sets them.
+var managedMountFlags = map[string]string{
+	"--vfs-cache-mode":      "VFS Cache Mode",
+	"--vfs-cache-max-age":   "VFS Cache Max Age",
+	"--vfs-cache-max-size":  "VFS Cache Max Size",
+	"--vfs-read-chunk-size": "VFS Read Chunk Size",
+	"--vfs-write-back":      "VFS Write Back",
+	"--buffer-size":         "Buffer Size",
+	"--dir-cache-time":      "Dir Cache Time",
+	"--attr-timeout":        "Attr Timeout",
+	"--poll-interval":       "Poll Interval",
+	"--allow-other":         "Allow Other",
+	"--allow-root":          "Allow Root",
+	"--default-permissions": "Default Permissions",
+	"--umask":               "Umask",
+	"--uid":                 "UID",
+	"--gid":                 "GID",
+	"--network-mode":        "Network Mode",
+	"--no-modtime":          "No ModTime",
+	"--no-checksum":         "No Checksum",
+	"--read-only":           "Read Only",
+	"--connect-timeout":     "Connect Timeout",
+	"--timeout":             "Timeout",
+	"--bwlimit":             "Bandwidth Limit",
+	"--log-level":           "Log Level",
+}
+
+// managedSyncFlags maps the rclone flags the generator sets from sync
+// options to the form field that sets them. --include and --exclude are
+// left out since rclone accepts them repeatedly.
+var managedSyncFlags = map[string]string{
+	"--delete-after":  "Delete After",
+	"--backup-dir":    "Backup Directory",
+	"--suffix":        "Backup Suffix",
+	"--max-age":       "Max Age",
+	"--min-age":       "Min Age",
+	"--transfers":     "Transfers",
+	"--checkers":      "Checkers",
+	"--bwlimit":       "Bandwidth Limit",
+	"--checksum":      "Checksum",
+	"--dry-run":       "Dry Run",
+	"--modify-window": "Modify Window",
+	"--max-duration":  "Max Duration",
+	"--log-level":     "Log Level",
+}
+
+// ManagedFlags returns the flags in extraArgs that the generator already
+// sets from a mount's or sync job's own options, unitType being "mount" or
+// "sync". Repeating them in the extra arguments silently overrides the form.
+func ManagedFlags(extraArgs, unitType string) []string {
+	managed := managedMountFlags
+	if unitType == "sync" {
+		managed = managedSyncFlags
+	}
+
+	args, err := SplitExtraArgs(extraArgs)
+	if err != nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var flags []string
+	for _, arg := range args {
+		flag, _, _ := strings.Cut(arg, "=")
+		if _, ok := managed[flag]; ok && !seen[flag] {
+			seen[flag] = true
+			flags = append(flags, flag)
+		}
+	}
+	sort.Strings(flags)
+	return flags
+}
+
+// ValidateManagedFlags rejects extra arguments that repeat a flag the
+// generator already sets, naming the option to use instead.
+func ValidateManagedFlags(extraArgs, unitType string) error {
+	flags := ManagedFlags(extraArgs, unitType)
+	if len(flags) == 0 {
+		return nil
+	}
+	managed := managedMountFlags
+	if unitType == "sync" {
+		managed = managedSyncFlags
+	}
+	return fmt.Errorf("%s is already managed (%s); set it there instead", flags[0], managed[flags[0]])
+}
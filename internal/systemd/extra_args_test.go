@@ -0,0 +1,93 @@
+package systemd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+func TestSplitExtraArgs(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    []string
+		wantErr bool
+	}{
+		{args: "", want: nil},
+		{args: "--fast-list --tpslimit 10", want: []string{"--fast-list", "--tpslimit", "10"}},
+		{args: "--fast-list\n--tpslimit=10\n", want: []string{"--fast-list", "--tpslimit=10"}},
+		{args: `--user-agent "my agent"`, want: []string{"--user-agent", "my agent"}},
+		{args: `--exclude='*.tmp files'`, want: []string{"--exclude=*.tmp files"}},
+		{args: `--header My\ Header`, want: []string{"--header", "My Header"}},
+		{args: `--password-command ""`, want: []string{"--password-command", ""}},
+		{args: `--user-agent "rms`, wantErr: true},
+		{args: `--user-agent rms\`, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := SplitExtraArgs(tt.args)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SplitExtraArgs(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitExtraArgs(%q) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeExtraArgs(t *testing.T) {
+	tests := map[string]string{
+		"--fast-list\n  --tpslimit 10\n": "--fast-list --tpslimit 10",
+		`--user-agent 'my agent'`:        `--user-agent "my agent"`,
+		`--header "a \"quoted\" value"`:  `--header "a \"quoted\" value"`,
+		`--password-command ""`:          `--password-command ""`,
+		`--user-agent "rms`:              `--user-agent "rms`,
+	}
+	for in, want := range tests {
+		if got := NormalizeExtraArgs(in); got != want {
+			t.Errorf("NormalizeExtraArgs(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestManagedFlags(t *testing.T) {
+	args := "--fast-list --buffer-size=32M --log-level DEBUG --buffer-size 64M --transfers 8"
+	if got, want := ManagedFlags(args, "mount"), []string{"--buffer-size", "--log-level"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ManagedFlags(mount) = %v, want %v", got, want)
+	}
+	if got, want := ManagedFlags(args, "sync"), []string{"--log-level", "--transfers"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ManagedFlags(sync) = %v, want %v", got, want)
+	}
+
+	if err := ValidateManagedFlags("--exclude *.tmp --include *.jpg", "sync"); err != nil {
+		t.Errorf("ValidateManagedFlags() error = %v, filters may be repeated", err)
+	}
+	err := ValidateManagedFlags("--fast-list --vfs-cache-mode=full", "mount")
+	if err == nil || !strings.Contains(err.Error(), "VFS Cache Mode") {
+		t.Errorf("ValidateManagedFlags() error = %v, want it to name the VFS Cache Mode option", err)
+	}
+}
+
+func TestGenerator_QuotesExtraArgs(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	mount := &models.MountConfig{
+		ID:           "a1b2c3d4",
+		Name:         "gdrive",
+		Remote:       "gdrive:",
+		MountPoint:   "/mnt/gdrive",
+		MountOptions: models.MountOptions{ExtraArgs: "--fast-list\n--user-agent 'my agent'"},
+	}
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if !strings.Contains(content, `--fast-list --user-agent "my agent"`) {
+		t.Errorf("extra arguments should be on one line with values quoted:\n%s", content)
+	}
+}
@@ -0,0 +1,81 @@
+package systemd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// filterPrefixes maps the accepted filter rule prefixes to rclone's own
+// "+ " and "- " forms.
+var filterPrefixes = []struct {
+	prefix string
+	rclone string
+}{
+	{"+ ", "+ "},
+	{"- ", "- "},
+	{"include ", "+ "},
+	{"exclude ", "- "},
+}
+
+// NormalizeFilterRule checks a sync job filter rule and rewrites it in
+// rclone's form, e.g. "exclude *.tmp" becomes "- *.tmp".
+func NormalizeFilterRule(rule string) (string, error) {
+	rule = strings.TrimSpace(rule)
+	lower := strings.ToLower(rule)
+	for _, p := range filterPrefixes {
+		if lower == strings.TrimSpace(p.prefix) {
+			return "", fmt.Errorf("filter rule %q has no pattern", rule)
+		}
+		if strings.HasPrefix(lower, p.prefix) {
+			return p.rclone + strings.TrimSpace(rule[len(p.prefix):]), nil
+		}
+	}
+	return "", fmt.Errorf("filter rule %q must start with \"+ \", \"- \", \"include \" or \"exclude \", e.g. \"- *.tmp\"", rule)
+}
+
+// ValidateFilters checks every filter rule of a sync job.
+func ValidateFilters(rules []string) error {
+	for i, rule := range rules {
+		if _, err := NormalizeFilterRule(rule); err != nil {
+			return fmt.Errorf("filter %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// ParseFilterLines splits multi-line filter text into rules, one per
+// non-blank line.
+func ParseFilterLines(text string) []string {
+	var rules []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			rules = append(rules, line)
+		}
+	}
+	return rules
+}
+
+// filterArgs returns a --filter argument per rule, in order, quoted for
+// ExecStart= since rules contain spaces. Invalid rules are skipped; callers
+// validate them first.
+func filterArgs(rules []string) []string {
+	args := filterFlags(rules)
+	for i, arg := range args {
+		args[i] = quoteExecArg(arg)
+	}
+	return args
+}
+
+// filterFlags returns the --filter flags of rules as plain arguments,
+// skipping invalid rules.
+func filterFlags(rules []string) []string {
+	var args []string
+	for _, rule := range rules {
+		normalized, err := NormalizeFilterRule(rule)
+		if err != nil {
+			continue
+		}
+		args = append(args, "--filter="+normalized)
+	}
+	return args
+}
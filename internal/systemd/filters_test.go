@@ -0,0 +1,93 @@
+package systemd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+func TestNormalizeFilterRule(t *testing.T) {
+	tests := []struct {
+		rule    string
+		want    string
+		wantErr string
+	}{
+		{rule: "- *.tmp", want: "- *.tmp"},
+		{rule: "  + *.jpg  ", want: "+ *.jpg"},
+		{rule: "include /Photos/**", want: "+ /Photos/**"},
+		{rule: "Exclude .DS_Store", want: "- .DS_Store"},
+		{rule: "*.tmp", wantErr: "must start with"},
+		{rule: "-*.tmp", wantErr: "must start with"},
+		{rule: "- ", wantErr: "has no pattern"},
+		{rule: "exclude   ", wantErr: "has no pattern"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeFilterRule(tt.rule)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NormalizeFilterRule(%q) error = %v, want %q", tt.rule, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizeFilterRule(%q) = %q, %v, want %q", tt.rule, got, err, tt.want)
+		}
+	}
+}
+
+func TestValidateFilters(t *testing.T) {
+	if err := ValidateFilters([]string{"- *.tmp", "+ *.jpg"}); err != nil {
+		t.Errorf("ValidateFilters() error = %v", err)
+	}
+	err := ValidateFilters([]string{"- *.tmp", "*.jpg"})
+	if err == nil || !strings.Contains(err.Error(), "filter 2:") {
+		t.Errorf("ValidateFilters() error = %v, want it to name the second rule", err)
+	}
+}
+
+func TestParseFilterLines(t *testing.T) {
+	got := ParseFilterLines("- *.tmp\n\n  + *.jpg \n")
+	if want := []string{"- *.tmp", "+ *.jpg"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseFilterLines() = %q, want %q", got, want)
+	}
+	if got := ParseFilterLines(" \n"); got != nil {
+		t.Errorf("ParseFilterLines() of blank text = %q, want nil", got)
+	}
+}
+
+func TestGenerator_SyncFilters(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "f1l2t3r4",
+		Name:        "photos",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup/photos",
+		SyncOptions: models.SyncOptions{
+			Filters:     []string{"exclude *.tmp", "+ *.jpg", "- *"},
+			VerifyAfter: true,
+		},
+	}
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	want := `"--filter=- *.tmp" \` + "\n" + `    "--filter=+ *.jpg" \` + "\n" + `    "--filter=- *"`
+	if !strings.Contains(content, want) {
+		t.Errorf("filters should be passed in order as quoted --filter arguments:\n%s", content)
+	}
+	if !strings.Contains(content, `check gdrive:/Photos /backup/photos "--filter=- *.tmp" "--filter=+ *.jpg" "--filter=- *"`) {
+		t.Errorf("the verify step should apply the same filters:\n%s", content)
+	}
+
+	job.SyncOptions.Filters = []string{"*.tmp"}
+	if _, err := g.GenerateSyncService(job); err == nil || !strings.Contains(err.Error(), "must start with") {
+		t.Errorf("GenerateSyncService() error = %v, want an invalid filter error", err)
+	}
+}
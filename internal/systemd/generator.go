@@ -2,27 +2,58 @@
 package systemd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 	"text/template"
+	"time"
 
+	"github.com/dtg01100/rclone-mount-sync/internal/actionlog"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
 )
 
 // Generator generates systemd unit files.
 type Generator struct {
-	systemdDir string // Full path to user systemd directory
+	systemdDir string // Full path to the unit directory of the scope
+	scope      Scope
 	rclonePath string // Path to rclone binary
 	configPath string // Path to rclone config file
 	logDir     string // Directory for log files
+	homeDir    string // Home directory of the user the units run as, for ~ paths
+
+	// Flags from the config defaults added to every unit of a type
+	mountDefaultArgs string
+	syncDefaultArgs  string
+}
+
+// UnitGenerator is the part of Generator that the TUI screens depend on.
+// Tests can substitute MockGenerator to check which units get written.
+type UnitGenerator interface {
+	ServiceName(id, unitType string) string
+	WriteMountService(mount *models.MountConfig) (string, error)
+	WriteSyncUnits(job *models.SyncJobConfig) (servicePath, timerPath string, err error)
+	RemoveUnit(name string) error
+	Preview(mount *models.MountConfig) (string, error)
+	PreviewSync(job *models.SyncJobConfig) (service, timer string, err error)
+	DryRunCommand(job *models.SyncJobConfig) ([]string, error)
+	RunProperties(job *models.SyncJobConfig) []string
 }
 
-// NewGenerator creates a new unit file generator.
+// NewGenerator creates a new unit file generator for user units.
 func NewGenerator() (*Generator, error) {
-	systemdDir, err := GetUserSystemdPath()
+	return NewGeneratorWithScope(ScopeUser)
+}
+
+// NewGeneratorWithScope creates a unit file generator writing units of the
+// given scope: user units to the user's systemd directory, system units to
+// SystemUnitDir.
+func NewGeneratorWithScope(scope Scope) (*Generator, error) {
+	systemdDir, err := scope.unitDir()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get systemd path: %w", err)
 	}
@@ -36,42 +67,302 @@ func NewGenerator() (*Generator, error) {
 		}
 	}
 
+	// Paths in the units are those of the user the units run as
+	homeDir, err := scope.homeDir()
+	if err != nil {
+		homeDir, _ = os.UserHomeDir()
+	}
+
 	// Get rclone config path
-	configPath := getRcloneConfigPath()
+	configPath := rcloneConfigPathIn(homeDir)
 
 	// Get log directory
-	logDir, err := getLogDir()
+	logDir, err := logDirIn(homeDir)
 	if err != nil {
 		logDir = "/tmp" // Fallback
+	} else {
+		_ = scope.chownToUnitUser(logDir, homeDir)
 	}
 
 	return &Generator{
 		systemdDir: systemdDir,
+		scope:      scope,
 		rclonePath: rclonePath,
 		configPath: configPath,
 		logDir:     logDir,
+		homeDir:    homeDir,
 	}, nil
 }
 
-// GetSystemdDir returns the systemd user directory path.
+// SetDefaultExtraArgs sets the flags added to every generated mount and sync
+// unit. They come before each item's own extra arguments, so an item can
+// override a default by repeating the flag.
+func (g *Generator) SetDefaultExtraArgs(mountArgs, syncArgs string) {
+	g.mountDefaultArgs = mountArgs
+	g.syncDefaultArgs = syncArgs
+}
+
+// MergeExtraArgs returns the effective extra arguments of a unit: the
+// defaults followed by the item's own.
+func MergeExtraArgs(defaults, item string) string {
+	return strings.TrimSpace(strings.TrimSpace(defaults) + " " + strings.TrimSpace(item))
+}
+
+// GetSystemdDir returns the directory units are written to.
 func (g *Generator) GetSystemdDir() string {
 	return g.systemdDir
 }
 
+// Scope returns the scope of the units the generator writes.
+func (g *Generator) Scope() Scope {
+	if g.scope == "" {
+		return ScopeUser
+	}
+	return g.scope
+}
+
+// expandPath expands ~ to the home directory of the user the units run as.
+func (g *Generator) expandPath(path string) string {
+	if g.homeDir == "" {
+		return expandPath(path)
+	}
+	return expandHome(path, g.homeDir)
+}
+
+// unitUser returns the User= of the generated services. User units already
+// run as their user; system units would otherwise run rclone as root, with
+// root's rclone config.
+func (g *Generator) unitUser() string {
+	if g.scope != ScopeSystem {
+		return ""
+	}
+	return unitUser()
+}
+
+// conflictingExtraArgs lists rclone flags that break the units this package
+// generates, with the reason shown to the user.
+var conflictingExtraArgs = map[string]string{
+	"--daemon":   "systemd already runs rclone in the background; --daemon makes the unit exit early and the mount fail or restart repeatedly",
+	"--log-file": "logs go to the systemd journal so the log viewer can show them; --log-file would hide them",
+	"--syslog":   "logs go to the systemd journal so the log viewer can show them; --syslog would divert them",
+	"--config":   "the unit already passes the rclone config path; a second --config would conflict with it",
+}
+
+// ValidateExtraArgs checks user supplied extra arguments for flags that
+// conflict with systemd management of the rclone process.
+func ValidateExtraArgs(extraArgs string) error {
+	args, err := SplitExtraArgs(extraArgs)
+	if err != nil {
+		return err
+	}
+	for _, field := range args {
+		if !strings.HasPrefix(field, "--") {
+			continue
+		}
+		flag, _, _ := strings.Cut(field, "=")
+		if reason, ok := conflictingExtraArgs[flag]; ok {
+			return fmt.Errorf("%s is not allowed: %s", flag, reason)
+		}
+	}
+	return nil
+}
+
+// ValidateImmutable checks that an immutable mount is not combined with
+// options that only exist to buffer writes.
+func ValidateImmutable(opts *models.MountOptions) error {
+	if !opts.Immutable {
+		return nil
+	}
+	if opts.VFSCacheMode == "writes" {
+		return fmt.Errorf("immutable mounts cannot use VFS cache mode \"writes\"")
+	}
+	if opts.VFSWriteBack != "" {
+		return fmt.Errorf("immutable mounts cannot use VFS write back")
+	}
+	return nil
+}
+
+// IOSchedulingClasses maps the ionice class numbers a sync job may use to
+// systemd's IOSchedulingClass names. The realtime class (1) is left out since
+// the user service manager is not allowed to set it.
+var IOSchedulingClasses = map[int]string{2: "best-effort", 3: "idle"}
+
+// ValidateSyncPriority checks the nice and ionice settings of a sync job.
+// A user service can only lower its priority, so negative nice values and
+// the realtime I/O class are rejected.
+func ValidateSyncPriority(opts *models.SyncOptions) error {
+	if opts.Nice < 0 {
+		return fmt.Errorf("nice level must not be negative: raising priority needs root")
+	}
+	if opts.Nice > 19 {
+		return fmt.Errorf("nice level must be between 0 and 19")
+	}
+	if _, ok := IOSchedulingClasses[opts.IONiceClass]; !ok && opts.IONiceClass != 0 {
+		if opts.IONiceClass == 1 {
+			return fmt.Errorf("the realtime I/O class needs root; use 2 (best-effort) or 3 (idle)")
+		}
+		return fmt.Errorf("I/O class must be 2 (best-effort) or 3 (idle)")
+	}
+	if opts.IONicePriority < 0 || opts.IONicePriority > 7 {
+		return fmt.Errorf("I/O priority must be between 0 and 7")
+	}
+	if opts.IONicePriority != 0 && opts.IONiceClass == 3 {
+		return fmt.Errorf("the idle I/O class has no priority levels")
+	}
+	return nil
+}
+
+// documentationSchemes are the URI schemes systemd accepts in Documentation=.
+var documentationSchemes = []string{"http://", "https://", "file:", "info:", "man:"}
+
+// maxDurationGrace is how much longer than a job's max duration systemd
+// waits before stopping the run, leaving rclone time to exit on its own
+// and a verify step time to finish.
+const maxDurationGrace = 5 * time.Minute
+
+// ValidateMaxDuration checks a sync job's max duration, e.g. "2h" or "90m".
+// Empty means no limit.
+func ValidateMaxDuration(s string) error {
+	_, err := parseMaxDuration(s)
+	return err
+}
+
+// parseMaxDuration parses a max duration, returning zero when it is empty.
+func parseMaxDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max duration %q (use e.g. 2h or 90m)", s)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("max duration must be greater than 0: %q", s)
+	}
+	return d, nil
+}
+
+// runTimeoutSec returns the TimeoutStartSec of a sync service with the
+// given max duration, or zero for no limit. Sync services are oneshot, for
+// which systemd ignores RuntimeMaxSec and limits the run by its start timeout.
+func runTimeoutSec(maxDuration time.Duration) int {
+	if maxDuration == 0 {
+		return 0
+	}
+	return int((maxDuration + maxDurationGrace + time.Second - 1) / time.Second)
+}
+
+// DefaultRetryDelay is how long systemd waits before retrying a failed sync
+// run when the job sets retries but no delay.
+const DefaultRetryDelay = "30s"
+
+// ValidateRetries checks the retry settings of a sync job. The delay is a
+// systemd time span; empty means DefaultRetryDelay.
+func ValidateRetries(opts *models.SyncOptions) error {
+	_, err := parseRetryDelay(opts)
+	return err
+}
+
+// parseRetryDelay validates the retry settings of a sync job and returns
+// the delay between retries, or zero when the job is not retried.
+func parseRetryDelay(opts *models.SyncOptions) (time.Duration, error) {
+	if opts.MaxRetries < 0 {
+		return 0, fmt.Errorf("max retries must not be negative")
+	}
+	delay, err := parseTimeSpan(opts.RetryDelay)
+	if err != nil {
+		return 0, fmt.Errorf("invalid retry delay: %w", err)
+	}
+	if opts.MaxRetries == 0 {
+		return 0, nil
+	}
+	if strings.TrimSpace(opts.RetryDelay) == "" {
+		delay, _ = parseTimeSpan(DefaultRetryDelay)
+	}
+	return delay, nil
+}
+
+// startLimitIntervalSec returns the StartLimitIntervalSec of a sync service
+// that is retried maxRetries times, delay apart. systemd only stops
+// restarting once StartLimitBurst starts fall within the interval, so it
+// must span every attempt: each one's run, bounded by the start timeout if
+// the job has a max duration, and the delay before the next.
+func startLimitIntervalSec(maxRetries int, delay time.Duration, timeoutStartSec int) int {
+	perAttempt := delay + time.Duration(timeoutStartSec)*time.Second
+	return int((time.Duration(maxRetries+1)*perAttempt + time.Second - 1) / time.Second)
+}
+
+// ValidateDocumentationURL checks that s can be used in a unit's
+// Documentation=. An empty value is valid.
+func ValidateDocumentationURL(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if strings.ContainsAny(s, " \t\n") {
+		return fmt.Errorf("documentation URL must not contain spaces")
+	}
+	for _, scheme := range documentationSchemes {
+		if strings.HasPrefix(s, scheme) {
+			return nil
+		}
+	}
+	return fmt.Errorf("documentation URL must start with http://, https://, file:, info: or man:")
+}
+
+// unitDescription returns the Description= of an item's units: its
+// description, or else the first line of its notes. It is empty when the
+// item has neither, leaving the template's default. Percent signs are
+// doubled so systemd does not expand them as specifiers.
+func unitDescription(description, notes string) string {
+	desc := strings.TrimSpace(description)
+	if desc == "" {
+		desc, _, _ = strings.Cut(strings.TrimSpace(notes), "\n")
+		desc = strings.TrimSpace(desc)
+	}
+	return strings.ReplaceAll(desc, "%", "%%")
+}
+
+// hostOS is the platform mount options are checked against; tests override it.
+var hostOS = runtime.GOOS
+
+// ValidateMountPlatform checks that rclone supports every option set in opts
+// on this platform, so a config copied from another host fails here rather
+// than in a unit that fails at runtime.
+func ValidateMountPlatform(opts *models.MountOptions) error {
+	if flags := models.UnsupportedMountOptions(*opts, hostOS); len(flags) > 0 {
+		return fmt.Errorf("%s not supported by rclone on %s", strings.Join(flags, ", "), hostOS)
+	}
+	return nil
+}
+
 // GenerateMountService generates a systemd service unit for an rclone mount.
 func (g *Generator) GenerateMountService(mount *models.MountConfig) (string, error) {
-	mountPoint := expandPath(mount.MountPoint)
+	if err := ValidateExtraArgs(g.mountDefaultArgs); err != nil {
+		return "", fmt.Errorf("invalid default mount flags: %w", err)
+	}
+	if err := ValidateMount(mount); err != nil {
+		return "", err
+	}
+	if err := ValidateDocumentationURL(mount.DocumentationURL); err != nil {
+		return "", err
+	}
+
+	mountPoint := g.expandPath(mount.MountPoint)
 	mountOptions := g.buildMountOptions(&mount.MountOptions)
 	logPath := filepath.Join(g.logDir, fmt.Sprintf("rclone-mount-%s.log", mount.ID))
 
 	data := MountUnitData{
-		Name:         mount.Name,
-		Remote:       mount.Remote,
-		RemotePath:   mount.RemotePath,
-		MountPoint:   mountPoint,
-		MountOptions: mountOptions,
-		LogPath:      logPath,
-		RclonePath:   g.rclonePath,
+		Name:             mount.Name,
+		Description:      unitDescription(mount.Description, mount.Notes),
+		DocumentationURL: strings.TrimSpace(mount.DocumentationURL),
+		Source:           mount.FullRemotePath(),
+		MountPoint:       mountPoint,
+		MountOptions:     mountOptions,
+		LogPath:          logPath,
+		RclonePath:       g.rclonePath,
+		User:             g.unitUser(),
+		WantedBy:         g.scope.installTarget(),
 	}
 
 	tmpl, err := template.New("mount-service").Parse(MountServiceTemplate)
@@ -102,8 +393,42 @@ func (g *Generator) WriteMountService(mount *models.MountConfig) (string, error)
 	return filepath.Join(g.systemdDir, filename), nil
 }
 
+// Preview returns the service unit WriteMountService would write for a
+// mount, without touching disk.
+func (g *Generator) Preview(mount *models.MountConfig) (string, error) {
+	return g.GenerateMountService(mount)
+}
+
 // GenerateSyncService generates a systemd service unit for an rclone sync job.
 func (g *Generator) GenerateSyncService(job *models.SyncJobConfig) (string, error) {
+	if err := ValidateExtraArgs(g.syncDefaultArgs); err != nil {
+		return "", fmt.Errorf("invalid default sync flags: %w", err)
+	}
+	if err := ValidateExtraArgs(job.SyncOptions.ExtraArgs); err != nil {
+		return "", fmt.Errorf("invalid extra arguments: %w", err)
+	}
+	if err := ValidateSyncPriority(&job.SyncOptions); err != nil {
+		return "", err
+	}
+	if err := ValidateFilters(job.SyncOptions.Filters); err != nil {
+		return "", err
+	}
+	if err := ValidateDocumentationURL(job.DocumentationURL); err != nil {
+		return "", err
+	}
+	maxDuration, err := parseMaxDuration(job.SyncOptions.MaxDuration)
+	if err != nil {
+		return "", err
+	}
+	retryDelay, err := parseRetryDelay(&job.SyncOptions)
+	if err != nil {
+		return "", err
+	}
+	workingDir := g.expandPath(job.SyncOptions.WorkingDir)
+	if workingDir != "" && !filepath.IsAbs(workingDir) {
+		return "", fmt.Errorf("working directory %q must be an absolute path", job.SyncOptions.WorkingDir)
+	}
+
 	syncOptions := g.buildSyncOptions(&job.SyncOptions)
 	logPath := filepath.Join(g.logDir, fmt.Sprintf("rclone-sync-%s.log", job.ID))
 
@@ -117,17 +442,58 @@ func (g *Generator) GenerateSyncService(job *models.SyncJobConfig) (string, erro
 		execCondition = `/bin/sh -c 'test "$(dbus-send --system --print-reply=literal --dest=org.freedesktop.NetworkManager /org/freedesktop/NetworkManager org.freedesktop.DBus.Properties.Get string:org.freedesktop.NetworkManager string:Metered 2>/dev/null | grep -o "\"[0-9]*\"" | tr -d "\"")" != "4" || exit 0; exit 1'`
 	}
 
+	verifyCommand := ""
+	if job.SyncOptions.VerifyAfter {
+		if direction == "move" {
+			return "", fmt.Errorf("verify after sync is not supported for move jobs")
+		}
+		verifyCommand = g.buildVerifyCommand(job, direction)
+	}
+
+	resyncCommand := ""
+	if direction == "bisync" && job.SyncOptions.ResyncOnFirstRun {
+		resyncCommand, err = g.buildResyncCommand(job, syncOptions)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	data := SyncUnitData{
-		Name:             job.Name,
-		Source:           job.Source,
-		Destination:      expandPath(job.Destination),
-		Direction:        direction,
-		SyncOptions:      syncOptions,
-		LogPath:          logPath,
-		RclonePath:       g.rclonePath,
-		RequireACPower:   job.Schedule.RequireACPower,
-		RequireUnmetered: job.Schedule.RequireUnmetered,
-		ExecCondition:    execCondition,
+		Name:                 job.Name,
+		Description:          unitDescription(job.Description, job.Notes),
+		DocumentationURL:     strings.TrimSpace(job.DocumentationURL),
+		Source:               job.Source,
+		Destination:          g.expandPath(job.Destination),
+		Direction:            direction,
+		SyncOptions:          syncOptions,
+		LogPath:              logPath,
+		RclonePath:           g.rclonePath,
+		RequireACPower:       job.Schedule.RequireACPower,
+		RequireUnmetered:     job.Schedule.RequireUnmetered,
+		ExecCondition:        execCondition,
+		VerifyCommand:        verifyCommand,
+		ResyncCommand:        resyncCommand,
+		WorkingDir:           workingDir,
+		TimeoutStartSec:      runTimeoutSec(maxDuration),
+		Nice:                 job.SyncOptions.Nice,
+		IOSchedulingClass:    IOSchedulingClasses[job.SyncOptions.IONiceClass],
+		IOSchedulingPriority: job.SyncOptions.IONicePriority,
+		MountUnits:           g.mountUnits(job.RequiresMounts),
+		StopWithMount:        job.StopWithMount,
+		User:                 g.unitUser(),
+		WantedBy:             g.scope.installTarget(),
+	}
+	if job.SyncOptions.MaxRetries > 0 {
+		data.RestartSec = strings.TrimSpace(job.SyncOptions.RetryDelay)
+		if data.RestartSec == "" {
+			data.RestartSec = DefaultRetryDelay
+		}
+		data.StartLimitBurst = job.SyncOptions.MaxRetries + 1
+		data.StartLimitSec = startLimitIntervalSec(job.SyncOptions.MaxRetries, retryDelay, data.TimeoutStartSec)
+	}
+	if job.SerializeWithSharedMount {
+		data.SharedMountLocks = sharedMountLocks(job.RequiresMounts)
+		data.FlockPath = flockPath
 	}
 
 	tmpl, err := template.New("sync-service").Parse(SyncServiceTemplate)
@@ -145,11 +511,20 @@ func (g *Generator) GenerateSyncService(job *models.SyncJobConfig) (string, erro
 
 // GenerateSyncTimer generates a systemd timer unit for an rclone sync job.
 func (g *Generator) GenerateSyncTimer(job *models.SyncJobConfig) (string, error) {
+	if err := ValidateTimeSpan(job.Schedule.RandomizedDelaySec); err != nil {
+		return "", fmt.Errorf("invalid randomized delay: %w", err)
+	}
+
 	timerDirectives := g.buildTimerDirectives(&job.Schedule)
 
 	data := TimerUnitData{
-		Name:            job.Name,
-		TimerDirectives: timerDirectives,
+		Name:             job.Name,
+		Description:      unitDescription(job.Description, job.Notes),
+		DocumentationURL: strings.TrimSpace(job.DocumentationURL),
+		TimerDirectives:  timerDirectives,
+	}
+	if job.StopWithMount {
+		data.MountUnits = g.mountUnits(job.RequiresMounts)
 	}
 
 	tmpl, err := template.New("sync-timer").Parse(SyncTimerTemplate)
@@ -196,6 +571,32 @@ func (g *Generator) WriteSyncUnits(job *models.SyncJobConfig) (servicePath, time
 	return servicePath, timerPath, nil
 }
 
+// PreviewSync returns the service and timer units WriteSyncUnits would
+// write for a sync job, without touching disk. timer is empty for manual
+// jobs, which have no timer.
+func (g *Generator) PreviewSync(job *models.SyncJobConfig) (service, timer string, err error) {
+	service, err = g.GenerateSyncService(job)
+	if err != nil {
+		return "", "", err
+	}
+	if job.Schedule.Type != "manual" {
+		timer, err = g.GenerateSyncTimer(job)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	return service, timer, nil
+}
+
+// mountUnits returns the service unit names of the given mount IDs.
+func (g *Generator) mountUnits(mountIDs []string) []string {
+	units := make([]string, 0, len(mountIDs))
+	for _, id := range mountIDs {
+		units = append(units, g.ServiceName(id, "mount")+".service")
+	}
+	return units
+}
+
 // ServiceName generates a systemd unit name from the ID.
 // Format: rclone-{type}-{id}
 // IDs are 8-character alphanumeric strings (truncated UUIDs), so no sanitization needed.
@@ -209,10 +610,20 @@ func (g *Generator) RemoveUnit(name string) error {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return nil // File doesn't exist, nothing to remove
 	}
-	return os.Remove(path)
+	err := g.scopeError(os.Remove(path))
+	actionlog.Record("remove-unit", path, err)
+	return err
+}
+
+// scopeError explains a permission error on a system unit as ErrNeedsRoot.
+func (g *Generator) scopeError(err error) error {
+	if g.scope == ScopeSystem && errors.Is(err, os.ErrPermission) {
+		return fmt.Errorf("%w: %v", ErrNeedsRoot, err)
+	}
+	return err
 }
 
-// WriteUnitFile writes a unit file to the systemd user directory.
+// WriteUnitFile writes a unit file to the unit directory of the scope.
 func (g *Generator) WriteUnitFile(filename, content string) error {
 	// Ensure directory exists
 	if err := os.MkdirAll(g.systemdDir, 0755); err != nil {
@@ -220,7 +631,40 @@ func (g *Generator) WriteUnitFile(filename, content string) error {
 	}
 
 	path := filepath.Join(g.systemdDir, filename)
-	return os.WriteFile(path, []byte(content), 0644)
+	err := g.scopeError(os.WriteFile(path, []byte(content), 0644))
+	actionlog.Record("write-unit", path, err)
+	return err
+}
+
+// ValidateMount runs the checks GenerateMountService makes on a mount's own
+// options, without generating a unit.
+func ValidateMount(mount *models.MountConfig) error {
+	if err := ValidateExtraArgs(mount.MountOptions.ExtraArgs); err != nil {
+		return fmt.Errorf("invalid extra arguments: %w", err)
+	}
+	if err := ValidateImmutable(&mount.MountOptions); err != nil {
+		return err
+	}
+	return ValidateMountPlatform(&mount.MountOptions)
+}
+
+// MountCheckArgs returns rclone arguments that list the top of a mount's
+// remote path with the mount's config file and network timeouts, a no-op
+// stand-in for mounting it. VFS and FUSE flags are left out because rclone
+// only accepts them on mount; ValidateMount covers those.
+func MountCheckArgs(mount *models.MountConfig) []string {
+	args := []string{"lsd", mount.FullRemotePath()}
+	opts := mount.MountOptions
+	if opts.Config != "" {
+		args = append(args, "--config="+expandPath(opts.Config))
+	}
+	if opts.ConnectTimeout != "" {
+		args = append(args, "--connect-timeout="+opts.ConnectTimeout)
+	}
+	if opts.Timeout != "" {
+		args = append(args, "--timeout="+opts.Timeout)
+	}
+	return args
 }
 
 // buildMountOptions builds the mount options string for rclone.
@@ -262,6 +706,12 @@ func (g *Generator) buildMountOptions(opts *models.MountOptions) string {
 	if opts.DirCacheTime != "" {
 		args = append(args, fmt.Sprintf("--dir-cache-time=%s", opts.DirCacheTime))
 	}
+	if opts.AttrTimeout != "" {
+		args = append(args, fmt.Sprintf("--attr-timeout=%s", opts.AttrTimeout))
+	}
+	if opts.PollInterval != "" {
+		args = append(args, fmt.Sprintf("--poll-interval=%s", opts.PollInterval))
+	}
 
 	// FUSE options
 	if opts.AllowOther {
@@ -270,15 +720,22 @@ func (g *Generator) buildMountOptions(opts *models.MountOptions) string {
 	if opts.AllowRoot {
 		args = append(args, "--allow-root")
 	}
+	if opts.DefaultPermissions {
+		args = append(args, "--default-permissions")
+	}
 	if opts.Umask != "" {
 		args = append(args, fmt.Sprintf("--umask=%s", opts.Umask))
 	}
+	// A zero UID or GID is unset, leaving rclone to use the mounting user's
 	if opts.UID > 0 {
 		args = append(args, fmt.Sprintf("--uid=%d", opts.UID))
 	}
 	if opts.GID > 0 {
 		args = append(args, fmt.Sprintf("--gid=%d", opts.GID))
 	}
+	if opts.NetworkMode {
+		args = append(args, "--network-mode")
+	}
 
 	// Behavior options
 	if opts.NoModTime {
@@ -287,7 +744,9 @@ func (g *Generator) buildMountOptions(opts *models.MountOptions) string {
 	if opts.NoChecksum {
 		args = append(args, "--no-checksum")
 	}
-	if opts.ReadOnly {
+	// rclone mount has no separate immutable flag; an immutable mount is
+	// enforced as a read-only VFS.
+	if opts.ReadOnly || opts.Immutable {
 		args = append(args, "--read-only")
 	}
 
@@ -298,20 +757,29 @@ func (g *Generator) buildMountOptions(opts *models.MountOptions) string {
 	if opts.Timeout != "" {
 		args = append(args, fmt.Sprintf("--timeout=%s", opts.Timeout))
 	}
+	if opts.BandwidthLimit != "" {
+		args = append(args, bwlimitArg(opts.BandwidthLimit))
+	}
 
 	// Logging options
 	if opts.LogLevel != "" {
 		args = append(args, fmt.Sprintf("--log-level=%s", opts.LogLevel))
 	}
 
-	// Extra arguments
-	if opts.ExtraArgs != "" {
-		args = append(args, opts.ExtraArgs)
+	// Extra arguments, defaults first
+	if extra := execExtraArgs(MergeExtraArgs(g.mountDefaultArgs, opts.ExtraArgs)); extra != "" {
+		args = append(args, extra)
 	}
 
 	return strings.Join(args, " \\\n    ")
 }
 
+// bwlimitArg returns the --bwlimit argument for a rate or a timetable,
+// quoted for ExecStart= since timetable entries are separated by spaces.
+func bwlimitArg(limit string) string {
+	return quoteExecArg("--bwlimit=" + strings.Join(strings.Fields(limit), " "))
+}
+
 // buildSyncOptions builds the sync options string for rclone.
 func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 	var args []string
@@ -333,6 +801,14 @@ func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 		args = append(args, "--delete-after")
 	}
 
+	// Backups of overwritten and deleted files
+	if opts.BackupDir != "" {
+		args = append(args, quoteExecArg("--backup-dir="+g.expandPath(opts.BackupDir)))
+	}
+	if opts.Suffix != "" {
+		args = append(args, quoteExecArg("--suffix="+opts.Suffix))
+	}
+
 	// Filtering
 	if opts.IncludePattern != "" {
 		args = append(args, fmt.Sprintf("--include=%s", opts.IncludePattern))
@@ -340,6 +816,7 @@ func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 	if opts.ExcludePattern != "" {
 		args = append(args, fmt.Sprintf("--exclude=%s", opts.ExcludePattern))
 	}
+	args = append(args, filterArgs(opts.Filters)...)
 	if opts.MaxAge != "" {
 		args = append(args, fmt.Sprintf("--max-age=%s", opts.MaxAge))
 	}
@@ -347,7 +824,8 @@ func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 		args = append(args, fmt.Sprintf("--min-age=%s", opts.MinAge))
 	}
 
-	// Performance
+	// Performance. Zero or negative counts are unset and leave rclone's
+	// defaults in place; passing --transfers=0 would stall the sync.
 	if opts.Transfers > 0 {
 		args = append(args, fmt.Sprintf("--transfers=%d", opts.Transfers))
 	}
@@ -355,7 +833,7 @@ func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 		args = append(args, fmt.Sprintf("--checkers=%d", opts.Checkers))
 	}
 	if opts.BandwidthLimit != "" {
-		args = append(args, fmt.Sprintf("--bwlimit=%s", opts.BandwidthLimit))
+		args = append(args, bwlimitArg(opts.BandwidthLimit))
 	}
 
 	// Verification
@@ -365,6 +843,14 @@ func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 	if opts.DryRun {
 		args = append(args, "--dry-run")
 	}
+	if opts.ModifyWindow != "" {
+		args = append(args, fmt.Sprintf("--modify-window=%s", opts.ModifyWindow))
+	}
+
+	// Time limit
+	if opts.MaxDuration != "" {
+		args = append(args, fmt.Sprintf("--max-duration=%s", opts.MaxDuration))
+	}
 
 	// Logging options
 	if opts.LogLevel != "" {
@@ -374,14 +860,227 @@ func (g *Generator) buildSyncOptions(opts *models.SyncOptions) string {
 	// Create empty source dirs
 	args = append(args, "--create-empty-src-dirs")
 
-	// Extra arguments
-	if opts.ExtraArgs != "" {
-		args = append(args, opts.ExtraArgs)
+	// Extra arguments, defaults first
+	if extra := execExtraArgs(MergeExtraArgs(g.syncDefaultArgs, opts.ExtraArgs)); extra != "" {
+		args = append(args, extra)
 	}
 
 	return strings.Join(args, " \\\n    ")
 }
 
+// buildVerifyCommand builds the ExecStartPost command that runs rclone check
+// after the sync and records the outcome in the job's verify state file.
+// A failed check fails the unit so it shows up like a failed sync.
+func (g *Generator) buildVerifyCommand(job *models.SyncJobConfig, direction string) string {
+	return shellExecLine(g.verifyScript(job, direction))
+}
+
+// verifyScript returns the shell script of the verify step: rclone check,
+// then the outcome written to the job's verify state file.
+func (g *Generator) verifyScript(job *models.SyncJobConfig, direction string) string {
+	opts := &job.SyncOptions
+	args := []string{g.rclonePath, "check", job.Source, g.expandPath(job.Destination)}
+
+	configPath := opts.Config
+	if configPath == "" {
+		configPath = g.configPath
+	}
+	if configPath != "" {
+		args = append(args, fmt.Sprintf("--config=%s", configPath))
+	}
+
+	// Copy leaves extra files in the destination, so only check one way
+	if direction == "copy" {
+		args = append(args, "--one-way")
+	}
+
+	// Apply the same filters as the sync so excluded files are not reported
+	if opts.IncludePattern != "" {
+		args = append(args, fmt.Sprintf("--include=%s", opts.IncludePattern))
+	}
+	if opts.ExcludePattern != "" {
+		args = append(args, fmt.Sprintf("--exclude=%s", opts.ExcludePattern))
+	}
+	args = append(args, filterFlags(opts.Filters)...)
+	if opts.MaxAge != "" {
+		args = append(args, fmt.Sprintf("--max-age=%s", opts.MaxAge))
+	}
+	if opts.MinAge != "" {
+		args = append(args, fmt.Sprintf("--min-age=%s", opts.MinAge))
+	}
+
+	statePath := shellQuote(verifyStateFile(g.logDir, job.ID))
+	return fmt.Sprintf("%s && echo %s > %s || { echo %s > %s; exit 1; }",
+		shellJoin(args), verifyPassed, statePath, verifyFailed, statePath)
+}
+
+// shellQuote quotes an argument for /bin/sh when needed: in double quotes
+// if nothing in it is special there, otherwise in single quotes, with each
+// single quote in it closed, escaped and reopened.
+func shellQuote(arg string) string {
+	if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_@%+=:,./-") == "" {
+		return arg
+	}
+	if !strings.ContainsAny(arg, "\"$`\\!") {
+		return `"` + arg + `"`
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// shellJoin joins args into a /bin/sh command line, quoting each as needed.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// shellExecLine returns the Exec line that runs script with /bin/sh -c. The
+// script is single quoted for systemd, which unescapes backslashes and
+// quotes inside it and expands $ and % unless doubled.
+func shellExecLine(script string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "$", "$$", "%", "%%")
+	return "/bin/sh -c '" + replacer.Replace(script) + "'"
+}
+
+// flattenOptions puts options built for an ExecStart= line, one per
+// continued line, back on a single line.
+func flattenOptions(options string) string {
+	return strings.ReplaceAll(options, " \\\n    ", " ")
+}
+
+// DryRunCommand returns the command line a sync job's service runs, with
+// --dry-run added, so the job can be run once without changing anything.
+// The job is validated the same way as when its units are generated.
+func (g *Generator) DryRunCommand(job *models.SyncJobConfig) ([]string, error) {
+	return g.RunCommand(job, true)
+}
+
+// RunCommand returns the command line that runs a sync job once outside its
+// service the way the service does: under the locks of the mounts it is
+// serialized with, with a bisync job's first --resync, and followed by the
+// verify step. dryRun adds --dry-run if the job does not already use it and
+// leaves out the resync and verify steps, which write state files; a dry
+// run of a bisync job that has not resynced yet previews the resync.
+func (g *Generator) RunCommand(job *models.SyncJobConfig, dryRun bool) ([]string, error) {
+	if _, err := g.GenerateSyncService(job); err != nil {
+		return nil, err
+	}
+
+	direction := job.SyncOptions.Direction
+	if direction == "" {
+		direction = "sync"
+	}
+	syncOptions := g.buildSyncOptions(&job.SyncOptions)
+	options, err := splitExecOptions(syncOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var locks []string
+	if job.SerializeWithSharedMount {
+		runtimeDir := g.runtimeDir()
+		for _, lock := range sharedMountLocks(job.RequiresMounts) {
+			locks = append(locks, flockPath, strings.Replace(lock, "%t", runtimeDir, 1))
+		}
+	}
+
+	command := append([]string{g.rclonePath, direction, job.Source, g.expandPath(job.Destination)}, options...)
+	resync := direction == "bisync" && job.SyncOptions.ResyncOnFirstRun
+	if dryRun {
+		if !job.SyncOptions.DryRun {
+			command = append(command, "--dry-run")
+		}
+		if resync && !g.resynced(job) {
+			command = append(command, "--resync")
+		}
+		return append(locks, command...), nil
+	}
+
+	var steps []string
+	if resync {
+		script, err := g.resyncScript(job, syncOptions)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, "{ "+script+"; }")
+	}
+	steps = append(steps, shellJoin(command))
+	if job.SyncOptions.VerifyAfter {
+		steps = append(steps, "{ "+g.verifyScript(job, direction)+"; }")
+	}
+	if len(steps) == 1 {
+		return append(locks, command...), nil
+	}
+	return append(locks, "/bin/sh", "-c", strings.Join(steps, " && ")), nil
+}
+
+// RunProperties returns the systemd-run properties that give a run of a
+// sync job outside its service the service's user, working directory and
+// priority.
+func (g *Generator) RunProperties(job *models.SyncJobConfig) []string {
+	var properties []string
+	if user := g.unitUser(); user != "" {
+		properties = append(properties, "User="+user)
+	}
+	if dir := g.expandPath(job.SyncOptions.WorkingDir); dir != "" {
+		properties = append(properties, "WorkingDirectory="+dir)
+	}
+	if job.SyncOptions.Nice != 0 {
+		properties = append(properties, fmt.Sprintf("Nice=%d", job.SyncOptions.Nice))
+	}
+	if class := IOSchedulingClasses[job.SyncOptions.IONiceClass]; class != "" {
+		properties = append(properties, "IOSchedulingClass="+class)
+	}
+	if job.SyncOptions.IONicePriority != 0 {
+		properties = append(properties, fmt.Sprintf("IOSchedulingPriority=%d", job.SyncOptions.IONicePriority))
+	}
+	return properties
+}
+
+// resynced reports whether a bisync job's first --resync run has completed.
+func (g *Generator) resynced(job *models.SyncJobConfig) bool {
+	_, err := os.Stat(resyncMarkerFile(g.logDir, job.ID))
+	return err == nil
+}
+
+// runtimeDir returns the directory %t stands for in the units of the
+// generator's scope, where the shared mount locks are kept.
+func (g *Generator) runtimeDir() string {
+	if g.scope == ScopeSystem {
+		return "/run"
+	}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return dir
+	}
+	return fmt.Sprintf("/run/user/%d", os.Getuid())
+}
+
+// buildResyncCommand builds the ExecStartPre command that runs bisync with
+// --resync until it first succeeds, then leaves a marker file so later runs
+// skip it. syncOptions are the job's options as built by buildSyncOptions.
+func (g *Generator) buildResyncCommand(job *models.SyncJobConfig, syncOptions string) (string, error) {
+	script, err := g.resyncScript(job, syncOptions)
+	if err != nil {
+		return "", err
+	}
+	return shellExecLine(script), nil
+}
+
+// resyncScript returns the shell script of the resync step.
+func (g *Generator) resyncScript(job *models.SyncJobConfig, syncOptions string) (string, error) {
+	options, err := splitExecOptions(syncOptions)
+	if err != nil {
+		return "", err
+	}
+	args := append([]string{g.rclonePath, "bisync", job.Source, g.expandPath(job.Destination)}, options...)
+	args = append(args, "--resync")
+
+	marker := shellQuote(resyncMarkerFile(g.logDir, job.ID))
+	return fmt.Sprintf("test -e %s || { %s && touch %s; }", marker, shellJoin(args), marker), nil
+}
+
 // buildTimerDirectives builds timer directives from schedule configuration.
 func (g *Generator) buildTimerDirectives(schedule *models.ScheduleConfig) string {
 	var directives []string
@@ -430,3 +1129,89 @@ func NewTestGenerator(tmpDir string) *Generator {
 		logDir:     tmpDir,
 	}
 }
+
+// MockGenerator is a mock implementation of UnitGenerator for testing.
+// It records the units it is asked to write or remove instead of touching
+// the filesystem.
+type MockGenerator struct {
+	WriteMountServiceErr error
+	WriteSyncUnitsErr    error
+	RemoveUnitErr        error
+
+	mu              sync.Mutex
+	WrittenMounts   []string // IDs passed to WriteMountService
+	WrittenSyncJobs []string // IDs passed to WriteSyncUnits
+	RemovedUnits    []string // Names passed to RemoveUnit
+}
+
+// ServiceName returns the same unit names as Generator.
+func (m *MockGenerator) ServiceName(id, unitType string) string {
+	return fmt.Sprintf("rclone-%s-%s", unitType, id)
+}
+
+// WriteMountService records the mount and returns a fake unit path.
+func (m *MockGenerator) WriteMountService(mount *models.MountConfig) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.WriteMountServiceErr != nil {
+		return "", m.WriteMountServiceErr
+	}
+	m.WrittenMounts = append(m.WrittenMounts, mount.ID)
+	return m.ServiceName(mount.ID, "mount") + ".service", nil
+}
+
+// WriteSyncUnits records the sync job and returns fake unit paths.
+func (m *MockGenerator) WriteSyncUnits(job *models.SyncJobConfig) (string, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.WriteSyncUnitsErr != nil {
+		return "", "", m.WriteSyncUnitsErr
+	}
+	m.WrittenSyncJobs = append(m.WrittenSyncJobs, job.ID)
+	name := m.ServiceName(job.ID, "sync")
+	timerPath := ""
+	if job.Schedule.Type != "manual" {
+		timerPath = name + ".timer"
+	}
+	return name + ".service", timerPath, nil
+}
+
+// Preview returns a placeholder unit naming the mount.
+func (m *MockGenerator) Preview(mount *models.MountConfig) (string, error) {
+	return fmt.Sprintf("[Unit]\nDescription=Rclone mount: %s\n", mount.Name), nil
+}
+
+// PreviewSync returns placeholder units naming the sync job.
+func (m *MockGenerator) PreviewSync(job *models.SyncJobConfig) (string, string, error) {
+	service := fmt.Sprintf("[Unit]\nDescription=Rclone sync: %s\n", job.Name)
+	timer := ""
+	if job.Schedule.Type != "manual" {
+		timer = fmt.Sprintf("[Unit]\nDescription=Timer for rclone sync: %s\n", job.Name)
+	}
+	return service, timer, nil
+}
+
+// DryRunCommand returns a minimal rclone command line with --dry-run.
+func (m *MockGenerator) DryRunCommand(job *models.SyncJobConfig) ([]string, error) {
+	direction := job.SyncOptions.Direction
+	if direction == "" {
+		direction = "sync"
+	}
+	return []string{"rclone", direction, job.Source, job.Destination, "--dry-run"}, nil
+}
+
+// RunProperties returns no properties.
+func (m *MockGenerator) RunProperties(job *models.SyncJobConfig) []string {
+	return nil
+}
+
+// RemoveUnit records the removed unit name.
+func (m *MockGenerator) RemoveUnit(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.RemoveUnitErr != nil {
+		return m.RemoveUnitErr
+	}
+	m.RemovedUnits = append(m.RemovedUnits, name)
+	return nil
+}
@@ -1,11 +1,15 @@
 package systemd
 
 import (
+	"errors"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/dtg01100/rclone-mount-sync/internal/actionlog"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
 )
 
@@ -260,6 +264,52 @@ func TestGenerator_BuildMountOptions(t *testing.T) {
 	}
 }
 
+// Read-only and allow-other are only rendered when set, once each.
+func TestGenerator_ReadOnlyAndAllowOther(t *testing.T) {
+	g := &Generator{rclonePath: "/usr/bin/rclone"}
+
+	got := g.buildMountOptions(&models.MountOptions{})
+	if strings.Contains(got, "--read-only") || strings.Contains(got, "--allow-other") {
+		t.Errorf("default mount options should be writable and private:\n%s", got)
+	}
+
+	got = g.buildMountOptions(&models.MountOptions{ReadOnly: true, AllowOther: true})
+	for _, flag := range []string{"--read-only", "--allow-other"} {
+		if n := strings.Count(got, flag); n != 1 {
+			t.Errorf("%s rendered %d times, want 1:\n%s", flag, n, got)
+		}
+	}
+}
+
+func TestGenerator_BandwidthLimit(t *testing.T) {
+	g := &Generator{rclonePath: "/usr/bin/rclone"}
+
+	tests := []struct {
+		name  string
+		limit string
+		want  string
+	}{
+		{"single rate", "10M", "--bwlimit=10M"},
+		{"timetable", "08:00,512k  19:00,10M 23:00,off", `"--bwlimit=08:00,512k 19:00,10M 23:00,off"`},
+		{"weekday timetable", "Mon-08:00,1M Sat-00:00,off", `"--bwlimit=Mon-08:00,1M Sat-00:00,off"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mount := g.buildMountOptions(&models.MountOptions{BandwidthLimit: tt.limit})
+			sync := g.buildSyncOptions(&models.SyncOptions{BandwidthLimit: tt.limit})
+			for name, got := range map[string]string{"mount": mount, "sync": sync} {
+				if n := strings.Count(got, tt.want); n != 1 {
+					t.Errorf("%s options contain %s %d times, want 1:\n%s", name, tt.want, n, got)
+				}
+			}
+		})
+	}
+
+	if got := g.buildMountOptions(&models.MountOptions{}); strings.Contains(got, "--bwlimit") {
+		t.Errorf("an empty limit should leave out --bwlimit:\n%s", got)
+	}
+}
+
 // TestBuildSyncOptions tests the buildSyncOptions method.
 func TestGenerator_BuildOptionsWithNoConfig(t *testing.T) {
 	g := &Generator{
@@ -386,6 +436,13 @@ func TestGenerator_BuildSyncOptions(t *testing.T) {
 			},
 			contains: []string{"--min-age=1d"},
 		},
+		{
+			name: "with modify window",
+			opts: models.SyncOptions{
+				ModifyWindow: "2s",
+			},
+			contains: []string{"--modify-window=2s"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -524,7 +581,7 @@ func TestGenerator_GenerateMountService(t *testing.T) {
 	// Verify the content contains expected sections
 	expectedSections := []string{
 		"[Unit]",
-		"Description=Rclone mount: gdrive",
+		"Description=Google Drive mount\n",
 		"[Service]",
 		"Type=notify",
 		"ExecStart=/usr/bin/rclone mount",
@@ -541,6 +598,32 @@ func TestGenerator_GenerateMountService(t *testing.T) {
 	}
 }
 
+// TestGenerator_GenerateMountServiceRemoteWithoutColon tests that the unit
+// uses the full remote:path even when the remote is stored without a colon.
+func TestGenerator_GenerateMountServiceRemoteWithoutColon(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	mount := &models.MountConfig{
+		ID:         "a1b2c3d4",
+		Name:       "music",
+		Remote:     "gdrive",
+		RemotePath: "Media//Music/",
+		MountPoint: "/mnt/music",
+	}
+
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if !strings.Contains(content, "    gdrive:/Media/Music \\\n") {
+		t.Errorf("GenerateMountService() should mount gdrive:/Media/Music, got:\n%s", content)
+	}
+}
+
 // TestGenerateSyncService tests the GenerateSyncService method.
 func TestGenerator_GenerateSyncService(t *testing.T) {
 	g := &Generator{
@@ -801,6 +884,34 @@ func TestGenerator_WriteUnitFile(t *testing.T) {
 	}
 }
 
+// TestGenerator_UnitChangesAreRecorded tests that unit writes and removals
+// are recorded in the action log.
+func TestGenerator_UnitChangesAreRecorded(t *testing.T) {
+	tmpDir := t.TempDir()
+	logPath := filepath.Join(tmpDir, "actions.log")
+	actionlog.Configure(logPath)
+	t.Cleanup(func() { actionlog.Configure("") })
+
+	g := NewTestGenerator(tmpDir)
+	if err := g.WriteUnitFile("test.service", "[Unit]\n"); err != nil {
+		t.Fatalf("WriteUnitFile() error = %v", err)
+	}
+	if err := g.RemoveUnit("test.service"); err != nil {
+		t.Fatalf("RemoveUnit() error = %v", err)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("failed to read action log: %v", err)
+	}
+	unitPath := filepath.Join(tmpDir, "test.service")
+	for _, want := range []string{"msg=write-unit target=" + unitPath, "msg=remove-unit target=" + unitPath} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("action log should contain %q, got:\n%s", want, data)
+		}
+	}
+}
+
 // TestWriteUnitFileCreatesDirectory tests that WriteUnitFile creates the directory if needed.
 func TestGenerator_WriteUnitFileCreatesDirectory(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -877,8 +988,7 @@ func TestGenerator_RemoveUnitNonExistent(t *testing.T) {
 func TestMountUnitData(t *testing.T) {
 	data := MountUnitData{
 		Name:         "test-mount",
-		Remote:       "gdrive:",
-		RemotePath:   "/Photos",
+		Source:       "gdrive:/Photos",
 		MountPoint:   "/mnt/gdrive",
 		ConfigPath:   "/home/user/.config/rclone/rclone.conf",
 		MountOptions: "--vfs-cache-mode=full",
@@ -890,8 +1000,8 @@ func TestMountUnitData(t *testing.T) {
 	if data.Name != "test-mount" {
 		t.Errorf("MountUnitData.Name = %q, want %q", data.Name, "test-mount")
 	}
-	if data.Remote != "gdrive:" {
-		t.Errorf("MountUnitData.Remote = %q, want %q", data.Remote, "gdrive:")
+	if data.Source != "gdrive:/Photos" {
+		t.Errorf("MountUnitData.Source = %q, want %q", data.Source, "gdrive:/Photos")
 	}
 }
 
@@ -944,8 +1054,7 @@ func TestUserSystemdDirConstant(t *testing.T) {
 func TestMountServiceTemplateContainsRequiredFields(t *testing.T) {
 	requiredFields := []string{
 		"{{.Name}}",
-		"{{.Remote}}",
-		"{{.RemotePath}}",
+		"{{.Source}}",
 		"{{.MountPoint}}",
 		"{{.MountOptions}}",
 		"{{.RclonePath}}",
@@ -1647,6 +1756,19 @@ func TestGenerator_GenerateSyncTimer_EdgeCases(t *testing.T) {
 			},
 			contains: []string{"OnBootSec=5min", "OnUnitActiveSec=1h"},
 		},
+		{
+			name: "randomized delay",
+			job: &models.SyncJobConfig{
+				ID:   "s7t8u9v0",
+				Name: "spread-out",
+				Schedule: models.ScheduleConfig{
+					Type:               "timer",
+					OnCalendar:         "hourly",
+					RandomizedDelaySec: "1h 30min",
+				},
+			},
+			contains: []string{"[Timer]\nOnCalendar=hourly\nRandomizedDelaySec=1h 30min"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1664,6 +1786,26 @@ func TestGenerator_GenerateSyncTimer_EdgeCases(t *testing.T) {
 	}
 }
 
+func TestGenerator_GenerateSyncTimer_InvalidRandomizedDelay(t *testing.T) {
+	g := NewTestGenerator(t.TempDir())
+	job := &models.SyncJobConfig{
+		ID:   "s7t8u9v0",
+		Name: "spread-out",
+		Schedule: models.ScheduleConfig{
+			Type:               "timer",
+			OnCalendar:         "hourly",
+			RandomizedDelaySec: "half an hour",
+		},
+	}
+
+	if _, err := g.GenerateSyncTimer(job); err == nil || !strings.Contains(err.Error(), "randomized delay") {
+		t.Errorf("GenerateSyncTimer() error = %v, want an invalid randomized delay error", err)
+	}
+	if _, _, err := g.WriteSyncUnits(job); err == nil {
+		t.Error("WriteSyncUnits() should refuse an invalid randomized delay")
+	}
+}
+
 // TestBuildMountOptions_AllOptions tests all mount options are included.
 func TestGenerator_BuildMountOptions_AllOptions(t *testing.T) {
 	g := &Generator{
@@ -1681,6 +1823,8 @@ func TestGenerator_BuildMountOptions_AllOptions(t *testing.T) {
 		VFSWriteBack:     "5s",
 		BufferSize:       "16M",
 		DirCacheTime:     "5m",
+		AttrTimeout:      "10s",
+		PollInterval:     "10m",
 		AllowOther:       true,
 		AllowRoot:        true,
 		Umask:            "002",
@@ -1706,6 +1850,8 @@ func TestGenerator_BuildMountOptions_AllOptions(t *testing.T) {
 		"--vfs-write-back=5s",
 		"--buffer-size=16M",
 		"--dir-cache-time=5m",
+		"--attr-timeout=10s",
+		"--poll-interval=10m",
 		"--allow-other",
 		"--allow-root",
 		"--umask=002",
@@ -1802,6 +1948,20 @@ func TestGenerator_BuildSyncOptions_CustomConfig(t *testing.T) {
 }
 
 // TestBuildMountOptions_CustomConfig tests that custom config is used when specified.
+func TestGenerator_BuildMountOptions_CacheTimes(t *testing.T) {
+	g := &Generator{rclonePath: "/usr/bin/rclone"}
+
+	result := g.buildMountOptions(&models.MountOptions{DirCacheTime: "1h", AttrTimeout: "10s"})
+	if !strings.Contains(result, "--dir-cache-time=1h") || !strings.Contains(result, "--attr-timeout=10s") {
+		t.Errorf("buildMountOptions() = %q, want both cache times", result)
+	}
+
+	result = g.buildMountOptions(&models.MountOptions{})
+	if strings.Contains(result, "--dir-cache-time") || strings.Contains(result, "--attr-timeout") {
+		t.Errorf("buildMountOptions() = %q, want rclone's defaults left alone", result)
+	}
+}
+
 func TestGenerator_BuildMountOptions_CustomConfig(t *testing.T) {
 	g := &Generator{
 		systemdDir: t.TempDir(),
@@ -1853,3 +2013,1199 @@ func TestGenerator_BuildSyncOptions_DefaultConfig(t *testing.T) {
 		t.Errorf("buildSyncOptions() should use default config, got: %s", result)
 	}
 }
+
+// TestValidateExtraArgs tests rejection of flags that conflict with systemd management.
+func TestValidateExtraArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{name: "empty", args: ""},
+		{name: "harmless flags", args: "--fast-list --tpslimit 10"},
+		{name: "value containing flag name", args: "--exclude --daemonize-me"},
+		{name: "daemon", args: "--fast-list --daemon", wantErr: "--daemon is not allowed"},
+		{name: "daemon with value", args: "--daemon=true", wantErr: "--daemon is not allowed"},
+		{name: "log file", args: "--log-file /tmp/rclone.log", wantErr: "--log-file is not allowed"},
+		{name: "syslog", args: "--syslog", wantErr: "--syslog is not allowed"},
+		{name: "config", args: "--config=/tmp/rclone.conf", wantErr: "--config is not allowed"},
+		{name: "quoted value", args: "--user-agent \"my --daemon agent\""},
+		{name: "unterminated quote", args: "--user-agent \"rms", wantErr: "unterminated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExtraArgs(tt.args)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateExtraArgs(%q) error = %v, want nil", tt.args, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateExtraArgs(%q) error = %v, want %q", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestGenerator_RejectsConflictingExtraArgs tests that units are not generated with conflicting flags.
+func TestGenerator_RejectsConflictingExtraArgs(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	mount := &models.MountConfig{
+		ID:           "a1b2c3d4",
+		Name:         "gdrive",
+		Remote:       "gdrive:",
+		MountPoint:   "/mnt/gdrive",
+		MountOptions: models.MountOptions{ExtraArgs: "--daemon"},
+	}
+	if _, err := g.GenerateMountService(mount); err == nil || !strings.Contains(err.Error(), "--daemon") {
+		t.Errorf("GenerateMountService() error = %v, want --daemon conflict", err)
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "b2c3d4e5",
+		Name:        "backup",
+		Source:      "gdrive:/docs",
+		Destination: "/backup",
+		SyncOptions: models.SyncOptions{ExtraArgs: "--log-file=/tmp/sync.log"},
+	}
+	if _, err := g.GenerateSyncService(job); err == nil || !strings.Contains(err.Error(), "--log-file") {
+		t.Errorf("GenerateSyncService() error = %v, want --log-file conflict", err)
+	}
+}
+
+// TestGenerator_GenerateSyncServiceWithBackupDir tests the backup directory and suffix options.
+func TestGenerator_GenerateSyncServiceWithBackupDir(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "c3d4e5f6",
+		Name:        "safe-sync",
+		Source:      "/home/user/Documents",
+		Destination: "gdrive:Documents",
+		SyncOptions: models.SyncOptions{
+			Direction: "sync",
+			BackupDir: "gdrive:Backups/Documents",
+			Suffix:    ".bak",
+		},
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+
+	for _, opt := range []string{"--backup-dir=gdrive:Backups/Documents", "--suffix=.bak"} {
+		if !strings.Contains(content, opt) {
+			t.Errorf("GenerateSyncService() missing expected option %q", opt)
+		}
+	}
+
+	// A path with a space stays one argument, and % is not a specifier
+	job.SyncOptions.BackupDir = "gdrive:Old Documents"
+	job.SyncOptions.Suffix = "-%Y"
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	for _, opt := range []string{`"--backup-dir=gdrive:Old Documents"`, "--suffix=-%%Y"} {
+		if !strings.Contains(content, opt) {
+			t.Errorf("GenerateSyncService() missing quoted option %q:\n%s", opt, content)
+		}
+	}
+	command, err := g.RunCommand(job, true)
+	if err != nil {
+		t.Fatalf("RunCommand() error = %v", err)
+	}
+	joined := strings.Join(command, "|")
+	if !strings.Contains(joined, "|--backup-dir=gdrive:Old Documents|--suffix=-%Y|") {
+		t.Errorf("RunCommand() = %q, want the backup options as single arguments", command)
+	}
+
+	job.SyncOptions.BackupDir = ""
+	job.SyncOptions.Suffix = ""
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if strings.Contains(content, "--backup-dir") || strings.Contains(content, "--suffix") {
+		t.Error("GenerateSyncService() should not emit backup options when unset")
+	}
+}
+
+// TestGenerator_GenerateSyncServiceWithMaxDuration tests the rclone and systemd time limits.
+func TestGenerator_GenerateSyncServiceWithMaxDuration(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "c3d4e5f6",
+		Name:        "bounded-sync",
+		Source:      "gdrive:Photos",
+		Destination: "/backup/photos",
+		SyncOptions: models.SyncOptions{MaxDuration: "2h"},
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	// Two hours plus the five minute grace period
+	for _, want := range []string{"--max-duration=2h", "TimeoutStartSec=7500\n"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("GenerateSyncService() missing %q", want)
+		}
+	}
+
+	job.SyncOptions.MaxDuration = ""
+	if content, _ = g.GenerateSyncService(job); strings.Contains(content, "max-duration") || strings.Contains(content, "TimeoutStartSec") {
+		t.Error("GenerateSyncService() should not limit the run when max duration is unset")
+	}
+
+	for _, bad := range []string{"2 hours", "-1h", "0s"} {
+		job.SyncOptions.MaxDuration = bad
+		if _, err := g.GenerateSyncService(job); err == nil {
+			t.Errorf("GenerateSyncService() should reject max duration %q", bad)
+		}
+	}
+}
+
+// TestGenerator_GenerateSyncServiceWithRetries tests that failed runs are
+// retried only when the job sets retries.
+func TestGenerator_GenerateSyncServiceWithRetries(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "d4e5f6a7",
+		Name:        "retried-sync",
+		Source:      "gdrive:Photos",
+		Destination: "/backup/photos",
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	for _, unwanted := range []string{"Restart=", "RestartSec=", "StartLimit"} {
+		if strings.Contains(content, unwanted) {
+			t.Errorf("GenerateSyncService() should not emit %q without retries", unwanted)
+		}
+	}
+
+	// Three retries a minute apart: four runs within four minutes
+	job.SyncOptions.MaxRetries = 3
+	job.SyncOptions.RetryDelay = "1min"
+	if content, err = g.GenerateSyncService(job); err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	for _, want := range []string{
+		"StartLimitIntervalSec=240\nStartLimitBurst=4\n",
+		"Restart=on-failure\nRestartSec=1min\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("GenerateSyncService() missing %q", want)
+		}
+	}
+	if strings.Index(content, "StartLimitBurst") > strings.Index(content, "[Service]") {
+		t.Error("GenerateSyncService() should put the start limit in [Unit]")
+	}
+
+	// The window also covers each run when the run time is bounded
+	job.SyncOptions.MaxDuration = "2h"
+	if content, _ = g.GenerateSyncService(job); !strings.Contains(content, "StartLimitIntervalSec=30240\n") {
+		t.Error("GenerateSyncService() should widen the start limit interval by the run timeout")
+	}
+	job.SyncOptions.MaxDuration = ""
+
+	job.SyncOptions.RetryDelay = ""
+	if content, _ = g.GenerateSyncService(job); !strings.Contains(content, "RestartSec="+DefaultRetryDelay+"\n") {
+		t.Errorf("GenerateSyncService() should default the retry delay to %s", DefaultRetryDelay)
+	}
+
+	for _, bad := range []models.SyncOptions{
+		{MaxRetries: -1},
+		{MaxRetries: 2, RetryDelay: "soon"},
+	} {
+		job.SyncOptions = bad
+		if _, err := g.GenerateSyncService(job); err == nil {
+			t.Errorf("GenerateSyncService() should reject retries %d with delay %q", bad.MaxRetries, bad.RetryDelay)
+		}
+	}
+}
+
+// TestMockGenerator tests that the mock records units and mirrors unit naming.
+func TestMockGenerator(t *testing.T) {
+	var _ UnitGenerator = &Generator{}
+	var _ UnitGenerator = &MockGenerator{}
+
+	m := &MockGenerator{}
+	real := &Generator{}
+	if got, want := m.ServiceName("a1b2c3d4", "mount"), real.ServiceName("a1b2c3d4", "mount"); got != want {
+		t.Errorf("ServiceName() = %q, want %q", got, want)
+	}
+
+	if _, err := m.WriteMountService(&models.MountConfig{ID: "a1b2c3d4"}); err != nil {
+		t.Fatalf("WriteMountService() error = %v", err)
+	}
+	_, timerPath, err := m.WriteSyncUnits(&models.SyncJobConfig{ID: "b2c3d4e5", Schedule: models.ScheduleConfig{Type: "manual"}})
+	if err != nil {
+		t.Fatalf("WriteSyncUnits() error = %v", err)
+	}
+	if timerPath != "" {
+		t.Errorf("WriteSyncUnits() timerPath = %q, want empty for manual jobs", timerPath)
+	}
+	if err := m.RemoveUnit("rclone-mount-a1b2c3d4.service"); err != nil {
+		t.Fatalf("RemoveUnit() error = %v", err)
+	}
+
+	if len(m.WrittenMounts) != 1 || len(m.WrittenSyncJobs) != 1 || len(m.RemovedUnits) != 1 {
+		t.Errorf("recorded = %v / %v / %v, want one of each", m.WrittenMounts, m.WrittenSyncJobs, m.RemovedUnits)
+	}
+
+	m.WriteMountServiceErr = errors.New("disk full")
+	if _, err := m.WriteMountService(&models.MountConfig{ID: "c3d4e5f6"}); err == nil {
+		t.Error("WriteMountService() should return the configured error")
+	}
+}
+
+// TestGenerator_VerifyCommandQuotesArguments runs the verify script with a
+// fake rclone to check that paths with spaces and quotes stay one argument.
+func TestGenerator_VerifyCommandQuotesArguments(t *testing.T) {
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	rclone := filepath.Join(dir, "rclone")
+	script := "#!/bin/sh\nfor arg in \"$@\"; do echo \"$arg\"; done > " + argsFile + "\n"
+	if err := os.WriteFile(rclone, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	g := &Generator{systemdDir: dir, rclonePath: rclone, logDir: dir}
+
+	job := &models.SyncJobConfig{
+		ID:          "d4e5f6a7",
+		Name:        "quoted",
+		Source:      "gdrive:/My Photos",
+		Destination: "/backup/Bob's \"$pics\"",
+		SyncOptions: models.SyncOptions{Direction: "copy", ExcludePattern: "*.tmp", VerifyAfter: true},
+	}
+	if output, err := exec.Command("/bin/sh", "-c", g.verifyScript(job, "copy")).CombinedOutput(); err != nil {
+		t.Fatalf("verify script failed: %v: %s", err, output)
+	}
+	data, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"check", job.Source, job.Destination, "--one-way", "--exclude=*.tmp"}
+	if got := strings.Split(strings.TrimSpace(string(data)), "\n"); !reflect.DeepEqual(got, want) {
+		t.Errorf("rclone args = %q, want %q", got, want)
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, `ExecStartPost=/bin/sh -c '`+rclone+` check "gdrive:/My Photos" \'/backup/Bob\'\\\'\'s "$$pics"\'`) {
+		t.Errorf("verify step should quote the paths for sh and systemd:\n%s", content)
+	}
+}
+
+// TestGenerator_ResyncCommandQuotesArguments runs the resync script with a
+// fake rclone to check that paths with spaces stay one argument and that the
+// marker is left after the first run.
+func TestGenerator_ResyncCommandQuotesArguments(t *testing.T) {
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "args")
+	rclone := filepath.Join(dir, "rclone")
+	script := "#!/bin/sh\nfor arg in \"$@\"; do echo \"$arg\"; done >> " + argsFile + "\n"
+	if err := os.WriteFile(rclone, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	g := &Generator{systemdDir: dir, rclonePath: rclone, logDir: dir}
+
+	job := &models.SyncJobConfig{
+		ID:          "b1s2y3n4",
+		Name:        "quoted",
+		Source:      "gdrive:/My Work",
+		Destination: "/home/user/Bob's Work",
+		SyncOptions: models.SyncOptions{Direction: "bisync", ExcludePattern: "*.tmp", ResyncOnFirstRun: true},
+	}
+	resync, err := g.resyncScript(job, g.buildSyncOptions(&job.SyncOptions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for run := 0; run < 2; run++ {
+		if output, err := exec.Command("/bin/sh", "-c", resync).CombinedOutput(); err != nil {
+			t.Fatalf("resync script failed: %v: %s", err, output)
+		}
+	}
+	data, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"bisync", job.Source, job.Destination, "--exclude=*.tmp", "--create-empty-src-dirs", "--resync"}
+	if got := strings.Split(strings.TrimSpace(string(data)), "\n"); !reflect.DeepEqual(got, want) {
+		t.Errorf("rclone args = %q, want %q run once", got, want)
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, `ExecStartPre=/bin/sh -c 'test -e `+resyncMarkerFile(dir, job.ID)+` || { `+rclone+` bisync "gdrive:/My Work" "/home/user/Bob\'s Work"`) {
+		t.Errorf("resync step should quote the paths for sh and systemd:\n%s", content)
+	}
+}
+
+func TestShellExecLine(t *testing.T) {
+	got := shellExecLine(`echo 'a b' \ $HOME 50%`)
+	want := `/bin/sh -c 'echo \'a b\' \\ $$HOME 50%%'`
+	if got != want {
+		t.Errorf("shellExecLine() = %s, want %s", got, want)
+	}
+}
+
+// TestGenerator_GenerateSyncServiceWithVerifyAfter tests the chained rclone check step.
+func TestGenerator_GenerateSyncServiceWithVerifyAfter(t *testing.T) {
+	logDir := t.TempDir()
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     logDir,
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "d4e5f6a7",
+		Name:        "verified",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup/photos",
+		SyncOptions: models.SyncOptions{
+			Direction:      "copy",
+			ExcludePattern: "*.tmp",
+			VerifyAfter:    true,
+		},
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+
+	expected := []string{
+		"ExecStartPost=/bin/sh -c '/usr/bin/rclone check gdrive:/Photos /backup/photos",
+		"--config=/home/user/.config/rclone/rclone.conf",
+		"--one-way",
+		"--exclude=*.tmp",
+		"echo verified > " + verifyStateFile(logDir, "d4e5f6a7"),
+		"exit 1",
+	}
+	for _, want := range expected {
+		if !strings.Contains(content, want) {
+			t.Errorf("GenerateSyncService() missing %q in:\n%s", want, content)
+		}
+	}
+
+	job.SyncOptions.Direction = "sync"
+	content, _ = g.GenerateSyncService(job)
+	if strings.Contains(content, "--one-way") {
+		t.Error("sync jobs should be checked in both directions")
+	}
+
+	job.SyncOptions.VerifyAfter = false
+	content, _ = g.GenerateSyncService(job)
+	if strings.Contains(content, "ExecStartPost") {
+		t.Error("ExecStartPost should only be emitted when VerifyAfter is set")
+	}
+
+	job.SyncOptions.VerifyAfter = true
+	job.SyncOptions.Direction = "move"
+	if _, err := g.GenerateSyncService(job); err == nil {
+		t.Error("GenerateSyncService() should reject verify after move")
+	}
+}
+
+// TestGenerator_GenerateSyncServiceBisync tests the bisync ExecStart and
+// the first-run --resync step.
+func TestGenerator_GenerateSyncServiceBisync(t *testing.T) {
+	logDir := t.TempDir()
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     logDir,
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "b1s2y3n4",
+		Name:        "laptop",
+		Source:      "gdrive:/Work",
+		Destination: "/home/user/Work",
+		SyncOptions: models.SyncOptions{
+			Direction:      "bisync",
+			ExcludePattern: "*.tmp",
+		},
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if !strings.Contains(content, "ExecStart=/usr/bin/rclone bisync \\\n    gdrive:/Work \\\n    /home/user/Work") {
+		t.Errorf("ExecStart should run rclone bisync:\n%s", content)
+	}
+	if strings.Contains(content, "--resync") {
+		t.Error("--resync should only be emitted when ResyncOnFirstRun is set")
+	}
+
+	job.SyncOptions.ResyncOnFirstRun = true
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	marker := resyncMarkerFile(logDir, "b1s2y3n4")
+	expected := []string{
+		"ExecStartPre=/bin/sh -c 'test -e " + marker + " || { /usr/bin/rclone bisync gdrive:/Work /home/user/Work",
+		"--exclude=*.tmp",
+		"--resync && touch " + marker + "; }'",
+	}
+	for _, want := range expected {
+		if !strings.Contains(content, want) {
+			t.Errorf("GenerateSyncService() missing %q in:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "ExecStart=/usr/bin/rclone bisync --resync") || strings.Count(content, "--resync") != 1 {
+		t.Error("the regular ExecStart should not resync")
+	}
+
+	job.SyncOptions.Direction = "sync"
+	content, _ = g.GenerateSyncService(job)
+	if strings.Contains(content, "--resync") {
+		t.Error("ResyncOnFirstRun should be ignored for other directions")
+	}
+}
+
+// TestGenerator_GenerateMountServiceDefaultPermissions tests that
+// --default-permissions is rendered next to the other FUSE flags.
+func TestGenerator_GenerateMountServiceDefaultPermissions(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     t.TempDir(),
+	}
+
+	mount := &models.MountConfig{
+		ID:         "e5f6a7b8",
+		Name:       "shared",
+		Remote:     "gdrive",
+		RemotePath: "/",
+		MountPoint: "/mnt/shared",
+		MountOptions: models.MountOptions{
+			VFSCacheMode:       "writes",
+			AllowOther:         true,
+			DefaultPermissions: true,
+			Umask:              "002",
+		},
+	}
+
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if !strings.Contains(content, "--allow-other \\\n    --default-permissions \\\n    --umask=002") {
+		t.Errorf("GenerateMountService() should render --default-permissions with the FUSE flags:\n%s", content)
+	}
+
+	mount.MountOptions.DefaultPermissions = false
+	content, _ = g.GenerateMountService(mount)
+	if strings.Contains(content, "--default-permissions") {
+		t.Error("--default-permissions should only be rendered when enabled")
+	}
+}
+
+// Immutable mounts are rendered read-only and reject write-buffering options.
+func TestGenerator_GenerateMountServiceImmutable(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     t.TempDir(),
+	}
+
+	mount := &models.MountConfig{
+		ID:         "a1b2c3d4",
+		Name:       "archive",
+		Remote:     "b2",
+		RemotePath: "/archive",
+		MountPoint: "/mnt/archive",
+		MountOptions: models.MountOptions{
+			VFSCacheMode: "full",
+			ReadOnly:     true,
+			Immutable:    true,
+		},
+	}
+
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if n := strings.Count(content, "--read-only"); n != 1 {
+		t.Errorf("--read-only rendered %d times, want 1:\n%s", n, content)
+	}
+
+	mount.MountOptions.ReadOnly = false
+	content, _ = g.GenerateMountService(mount)
+	if !strings.Contains(content, "--read-only") {
+		t.Error("immutable mount should be rendered with --read-only")
+	}
+
+	mount.MountOptions.VFSCacheMode = "writes"
+	if _, err := g.GenerateMountService(mount); err == nil {
+		t.Error("GenerateMountService() should reject an immutable mount with cache mode writes")
+	}
+
+	mount.MountOptions.VFSCacheMode = "full"
+	mount.MountOptions.VFSWriteBack = "5s"
+	if _, err := g.GenerateMountService(mount); err == nil {
+		t.Error("GenerateMountService() should reject an immutable mount with write back")
+	}
+}
+
+// Default extra flags come before each item's own extra arguments.
+func TestGenerator_DefaultExtraArgs(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     t.TempDir(),
+	}
+	g.SetDefaultExtraArgs("--user-agent=rms", "--ca-cert=/etc/ca.pem")
+
+	mountArgs := g.buildMountOptions(&models.MountOptions{ExtraArgs: "--user-agent=item"})
+	if !strings.Contains(mountArgs, "--user-agent=rms --user-agent=item") {
+		t.Errorf("buildMountOptions() should put defaults before item flags:\n%s", mountArgs)
+	}
+	if strings.Contains(mountArgs, "--ca-cert") {
+		t.Error("sync defaults should not be added to mount units")
+	}
+
+	syncArgs := g.buildSyncOptions(&models.SyncOptions{})
+	if !strings.Contains(syncArgs, "--ca-cert=/etc/ca.pem") {
+		t.Errorf("buildSyncOptions() missing default flags:\n%s", syncArgs)
+	}
+
+	g.SetDefaultExtraArgs("--daemon", "")
+	mount := &models.MountConfig{ID: "a1b2c3d4", Name: "m", Remote: "r", MountPoint: "/mnt/m"}
+	if _, err := g.GenerateMountService(mount); err == nil {
+		t.Error("GenerateMountService() should reject conflicting default flags")
+	}
+}
+
+// % and $ in extra arguments are escaped so systemd does not expand them as
+// specifiers or environment variables.
+func TestGenerator_ExtraArgsEscapeSpecifiers(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	mountArgs := g.buildMountOptions(&models.MountOptions{ExtraArgs: "--exclude=*%d* --password-command=$X"})
+	if !strings.Contains(mountArgs, "--exclude=*%%d* --password-command=$$X") {
+		t.Errorf("buildMountOptions() should double %% and $:\n%s", mountArgs)
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "s1y2n3c4",
+		Name:        "photos",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup/photos",
+		SyncOptions: models.SyncOptions{ExtraArgs: `--exclude=*%d* "--password-command=$X y"`},
+		Schedule:    models.ScheduleConfig{Type: "manual"},
+	}
+	service, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if !strings.Contains(service, `--exclude=*%%d* "--password-command=$$X y"`) {
+		t.Errorf("sync service should double %% and $ in extra arguments:\n%s", service)
+	}
+
+	// A run outside the service gets the arguments systemd would pass
+	command, err := g.RunCommand(job, true)
+	if err != nil {
+		t.Fatalf("RunCommand() error = %v", err)
+	}
+	joined := strings.Join(command, "|")
+	if !strings.Contains(joined, "|--exclude=*%d*|--password-command=$X y|") {
+		t.Errorf("RunCommand() = %q, want the extra arguments unescaped", command)
+	}
+}
+
+// Required mounts order the sync after them; StopWithMount binds the service
+// and timer to them.
+func TestGenerator_SyncRequiresMounts(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:             "s1y2n3c4",
+		Name:           "photos",
+		Source:         "/mnt/gdrive/Photos",
+		Destination:    "/backup/photos",
+		Schedule:       models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+		RequiresMounts: []string{"a1b2c3d4"},
+	}
+
+	service, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if !strings.Contains(service, "After=rclone-mount-a1b2c3d4.service\nRequires=rclone-mount-a1b2c3d4.service\n") {
+		t.Errorf("service should require the mount:\n%s", service)
+	}
+	if strings.Contains(service, "BindsTo=") {
+		t.Error("BindsTo= should only be rendered with StopWithMount")
+	}
+	timer, _ := g.GenerateSyncTimer(job)
+	if strings.Contains(timer, "rclone-mount-") {
+		t.Errorf("timer should not depend on the mount without StopWithMount:\n%s", timer)
+	}
+
+	job.StopWithMount = true
+	service, _ = g.GenerateSyncService(job)
+	if !strings.Contains(service, "After=rclone-mount-a1b2c3d4.service\nBindsTo=rclone-mount-a1b2c3d4.service\n") {
+		t.Errorf("service should bind to the mount:\n%s", service)
+	}
+	timer, _ = g.GenerateSyncTimer(job)
+	if !strings.Contains(timer, "BindsTo=rclone-mount-a1b2c3d4.service") {
+		t.Errorf("timer should bind to the mount:\n%s", timer)
+	}
+}
+
+// Units take Description= from the item's description, then the first line
+// of its notes, then a default; a documentation URL goes before the man page.
+func TestGenerator_UnitDescription(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	mount := &models.MountConfig{ID: "a1b2c3d4", Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/gdrive"}
+	service, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if !strings.Contains(service, "Description=Rclone mount: gdrive\nDocumentation=man:rclone(1)\n") {
+		t.Errorf("mount without a description should use the defaults:\n%s", service)
+	}
+
+	mount.Notes = "Team share, 100% synced\nrotate creds quarterly"
+	mount.DocumentationURL = "https://wiki.example.com/gdrive"
+	service, _ = g.GenerateMountService(mount)
+	if !strings.Contains(service, "Description=Team share, 100%% synced\nDocumentation=https://wiki.example.com/gdrive man:rclone(1)\n") {
+		t.Errorf("mount should use the first line of its notes and the documentation URL:\n%s", service)
+	}
+
+	job := &models.SyncJobConfig{
+		ID:               "s1y2n3c4",
+		Name:             "photos",
+		Description:      "Nightly photo backup",
+		Notes:            "ignored while there is a description",
+		DocumentationURL: "man:rclone-sync(1)",
+		Source:           "gdrive:/Photos",
+		Destination:      "/backup/photos",
+		Schedule:         models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+	}
+	service, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if !strings.Contains(service, "Description=Nightly photo backup\nDocumentation=man:rclone-sync(1) man:rclone(1)\n") {
+		t.Errorf("sync service should use the job's description:\n%s", service)
+	}
+	timer, _ := g.GenerateSyncTimer(job)
+	if !strings.Contains(timer, "Description=Timer for Nightly photo backup\n") {
+		t.Errorf("timer should use the job's description:\n%s", timer)
+	}
+
+	job.DocumentationURL = "wiki/photos"
+	if _, err := g.GenerateSyncService(job); err == nil {
+		t.Error("GenerateSyncService() should reject a documentation URL without a scheme")
+	}
+}
+
+func TestMountCheckArgs(t *testing.T) {
+	mount := &models.MountConfig{
+		Remote:     "gdrive:",
+		RemotePath: "Photos",
+		MountOptions: models.MountOptions{
+			Config:         "/etc/rclone.conf",
+			VFSCacheMode:   "full",
+			AllowOther:     true,
+			ConnectTimeout: "30s",
+			Timeout:        "5m",
+		},
+	}
+
+	got := strings.Join(MountCheckArgs(mount), " ")
+	want := "lsd gdrive:/Photos --config=/etc/rclone.conf --connect-timeout=30s --timeout=5m"
+	if got != want {
+		t.Errorf("MountCheckArgs() = %q, want %q", got, want)
+	}
+}
+
+// SerializeWithSharedMount wraps the sync and its verification in one flock
+// per required mount, taken in a fixed order.
+func TestGenerator_SyncSerializeWithSharedMount(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:             "s1y2n3c4",
+		Name:           "photos",
+		Source:         "/mnt/gdrive/Photos",
+		Destination:    "/backup/photos",
+		SyncOptions:    models.SyncOptions{VerifyAfter: true},
+		Schedule:       models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+		RequiresMounts: []string{"e5f6a7b8", "a1b2c3d4"},
+	}
+
+	service, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if strings.Contains(service, "flock") {
+		t.Errorf("flock should only be used with SerializeWithSharedMount:\n%s", service)
+	}
+
+	job.SerializeWithSharedMount = true
+	service, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	locks := "/usr/bin/flock %t/rclone-mount-sync-a1b2c3d4.lock /usr/bin/flock %t/rclone-mount-sync-e5f6a7b8.lock "
+	if !strings.Contains(service, "ExecStart="+locks+"/usr/bin/rclone sync") {
+		t.Errorf("sync should hold the mount locks in order:\n%s", service)
+	}
+	if !strings.Contains(service, "ExecStartPost="+locks+"/bin/sh -c '/usr/bin/rclone check") {
+		t.Errorf("verification should hold the mount locks:\n%s", service)
+	}
+}
+
+// TestGenerator_NumericOptionsZeroValues tests that zero or negative counts
+// are left out of ExecStart so rclone's defaults apply, while positive values
+// are passed through.
+func TestGenerator_NumericOptionsZeroValues(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+
+	syncTests := []struct {
+		name    string
+		opts    models.SyncOptions
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "zero counts are omitted",
+			opts:    models.SyncOptions{Direction: "sync"},
+			notWant: []string{"--transfers", "--checkers"},
+		},
+		{
+			name:    "negative counts are omitted",
+			opts:    models.SyncOptions{Direction: "sync", Transfers: -1, Checkers: -4},
+			notWant: []string{"--transfers", "--checkers"},
+		},
+		{
+			name: "positive counts are passed",
+			opts: models.SyncOptions{Direction: "sync", Transfers: 1, Checkers: 16},
+			want: []string{"--transfers=1", "--checkers=16"},
+		},
+	}
+	for _, tt := range syncTests {
+		t.Run("sync "+tt.name, func(t *testing.T) {
+			job := &models.SyncJobConfig{
+				ID: "a1b2c3d4", Name: "docs", Source: "gdrive:/Docs", Destination: "/data/docs",
+				SyncOptions: tt.opts,
+			}
+			content, err := g.GenerateSyncService(job)
+			if err != nil {
+				t.Fatalf("GenerateSyncService() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(content, w) {
+					t.Errorf("ExecStart should contain %q:\n%s", w, content)
+				}
+			}
+			for _, nw := range tt.notWant {
+				if strings.Contains(content, nw) {
+					t.Errorf("ExecStart should not contain %q:\n%s", nw, content)
+				}
+			}
+		})
+	}
+
+	mount := &models.MountConfig{
+		ID: "e5f6a7b8", Name: "shared", Remote: "gdrive", RemotePath: "/", MountPoint: "/mnt/shared",
+	}
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if strings.Contains(content, "--uid") || strings.Contains(content, "--gid") {
+		t.Errorf("zero UID and GID should be omitted:\n%s", content)
+	}
+
+	mount.MountOptions.UID, mount.MountOptions.GID = 1000, 100
+	content, _ = g.GenerateMountService(mount)
+	if !strings.Contains(content, "--uid=1000") || !strings.Contains(content, "--gid=100") {
+		t.Errorf("positive UID and GID should be passed:\n%s", content)
+	}
+}
+
+func TestGenerator_MountPlatformOptions(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+	mount := &models.MountConfig{
+		ID:           "a1b2c3d4",
+		Name:         "share",
+		Remote:       "gdrive",
+		MountPoint:   "/mnt/share",
+		MountOptions: models.MountOptions{NetworkMode: true},
+	}
+
+	oldOS := hostOS
+	defer func() { hostOS = oldOS }()
+
+	hostOS = "linux"
+	if _, err := g.GenerateMountService(mount); err == nil || !strings.Contains(err.Error(), "--network-mode") {
+		t.Errorf("GenerateMountService() error = %v, want --network-mode rejected on linux", err)
+	}
+
+	hostOS = "windows"
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatalf("GenerateMountService() error = %v", err)
+	}
+	if !strings.Contains(content, "--network-mode") {
+		t.Errorf("unit should pass --network-mode on windows:\n%s", content)
+	}
+
+	mount.MountOptions.AllowOther = true
+	if _, err := g.GenerateMountService(mount); err == nil || !strings.Contains(err.Error(), "--allow-other") {
+		t.Errorf("GenerateMountService() error = %v, want --allow-other rejected on windows", err)
+	}
+}
+
+func TestGenerator_SyncWorkingDirectory(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+	job := &models.SyncJobConfig{
+		ID:          "a1b2c3d4",
+		Name:        "bisync",
+		Source:      "gdrive:/Docs",
+		Destination: "/data/docs",
+		SyncOptions: models.SyncOptions{Direction: "sync"},
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if strings.Contains(content, "WorkingDirectory=") {
+		t.Errorf("an empty working directory should be omitted:\n%s", content)
+	}
+
+	job.SyncOptions.WorkingDir = "/var/lib/bisync"
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	if !strings.Contains(content, "WorkingDirectory=/var/lib/bisync\n") {
+		t.Errorf("unit should set WorkingDirectory:\n%s", content)
+	}
+
+	job.SyncOptions.WorkingDir = "state"
+	if _, err := g.GenerateSyncService(job); err == nil {
+		t.Error("GenerateSyncService() should reject a relative working directory")
+	}
+}
+
+func TestGenerator_SyncPriority(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		logDir:     t.TempDir(),
+	}
+	job := &models.SyncJobConfig{
+		ID:          "a1b2c3d4",
+		Name:        "background",
+		Source:      "gdrive:/Docs",
+		Destination: "/data/docs",
+		SyncOptions: models.SyncOptions{Direction: "sync"},
+	}
+
+	content, err := g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	for _, directive := range []string{"Nice=", "IOSchedulingClass=", "IOSchedulingPriority="} {
+		if strings.Contains(content, directive) {
+			t.Errorf("zero priority settings should omit %s:\n%s", directive, content)
+		}
+	}
+
+	job.SyncOptions.Nice = 10
+	job.SyncOptions.IONiceClass = 2
+	job.SyncOptions.IONicePriority = 7
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatalf("GenerateSyncService() error = %v", err)
+	}
+	for _, directive := range []string{"Nice=10\n", "IOSchedulingClass=best-effort\n", "IOSchedulingPriority=7\n"} {
+		if !strings.Contains(content, directive) {
+			t.Errorf("unit should contain %q:\n%s", directive, content)
+		}
+	}
+}
+
+func TestValidateSyncPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    models.SyncOptions
+		wantErr bool
+	}{
+		{"unset", models.SyncOptions{}, false},
+		{"lowest priority", models.SyncOptions{Nice: 19, IONiceClass: 3}, false},
+		{"best-effort level", models.SyncOptions{IONiceClass: 2, IONicePriority: 4}, false},
+		{"level with default class", models.SyncOptions{IONicePriority: 4}, false},
+		{"negative nice", models.SyncOptions{Nice: -5}, true},
+		{"nice out of range", models.SyncOptions{Nice: 20}, true},
+		{"realtime class", models.SyncOptions{IONiceClass: 1}, true},
+		{"unknown class", models.SyncOptions{IONiceClass: 4}, true},
+		{"level out of range", models.SyncOptions{IONicePriority: 8}, true},
+		{"level with idle class", models.SyncOptions{IONiceClass: 3, IONicePriority: 7}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSyncPriority(&tt.opts); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSyncPriority() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestGenerator_PreviewMatchesWrittenUnits tests that previews show exactly
+// what gets written, without writing anything themselves.
+func TestGenerator_PreviewMatchesWrittenUnits(t *testing.T) {
+	dir := t.TempDir()
+	g := NewTestGenerator(dir)
+
+	mount := &models.MountConfig{ID: "a1b2c3d4", Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/gdrive"}
+	job := &models.SyncJobConfig{
+		ID:          "e5f6a7b8",
+		Name:        "photos",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup",
+		Schedule:    models.ScheduleConfig{Type: "timer", OnCalendar: "daily"},
+	}
+
+	preview, err := g.Preview(mount)
+	if err != nil {
+		t.Fatalf("Preview() error = %v", err)
+	}
+	service, timer, err := g.PreviewSync(job)
+	if err != nil {
+		t.Fatalf("PreviewSync() error = %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("previews should not write units, found %d files", len(entries))
+	}
+
+	mountPath, err := g.WriteMountService(mount)
+	if err != nil {
+		t.Fatalf("WriteMountService() error = %v", err)
+	}
+	servicePath, timerPath, err := g.WriteSyncUnits(job)
+	if err != nil {
+		t.Fatalf("WriteSyncUnits() error = %v", err)
+	}
+	for path, want := range map[string]string{mountPath: preview, servicePath: service, timerPath: timer} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("%s differs from its preview:\n%s\nwant:\n%s", filepath.Base(path), got, want)
+		}
+	}
+
+	job.Schedule = models.ScheduleConfig{Type: "manual"}
+	if _, timer, err := g.PreviewSync(job); err != nil || timer != "" {
+		t.Errorf("PreviewSync() of a manual job = %q, %v, want no timer", timer, err)
+	}
+}
+
+// TestGenerator_DryRunCommand tests the command used to dry run a sync job.
+func TestGenerator_DryRunCommand(t *testing.T) {
+	g := &Generator{
+		systemdDir: t.TempDir(),
+		rclonePath: "/usr/bin/rclone",
+		configPath: "/home/user/.config/rclone/rclone.conf",
+		logDir:     t.TempDir(),
+	}
+
+	job := &models.SyncJobConfig{
+		ID:          "d1r2y3r4",
+		Name:        "photos",
+		Source:      "gdrive:/Photos",
+		Destination: "/backup/photos",
+		SyncOptions: models.SyncOptions{Filters: []string{"- *.tmp"}},
+	}
+	command, err := g.DryRunCommand(job)
+	if err != nil {
+		t.Fatalf("DryRunCommand() error = %v", err)
+	}
+	want := []string{"/usr/bin/rclone", "sync", "gdrive:/Photos", "/backup/photos"}
+	if strings.Join(command[:4], " ") != strings.Join(want, " ") {
+		t.Errorf("DryRunCommand() starts with %q, want %q", command[:4], want)
+	}
+	joined := strings.Join(command, "|")
+	for _, arg := range []string{"--config=/home/user/.config/rclone/rclone.conf", "--filter=- *.tmp", "--dry-run"} {
+		if !strings.Contains(joined, "|"+arg) {
+			t.Errorf("DryRunCommand() = %q, missing %q", command, arg)
+		}
+	}
+
+	job.SyncOptions.DryRun = true
+	command, _ = g.DryRunCommand(job)
+	if strings.Count(strings.Join(command, " "), "--dry-run") != 1 {
+		t.Errorf("--dry-run should not be repeated: %q", command)
+	}
+
+	job.SyncOptions.DryRun = false
+	command, _ = g.RunCommand(job, false)
+	if strings.Contains(strings.Join(command, " "), "--dry-run") {
+		t.Errorf("RunCommand(job, false) should not add --dry-run: %q", command)
+	}
+
+	job.SyncOptions.ExtraArgs = "--daemon"
+	if _, err := g.DryRunCommand(job); err == nil {
+		t.Error("DryRunCommand() should validate the job like unit generation")
+	}
+}
+
+// TestGenerator_RunCommandMatchesService tests that a run outside the
+// service takes the service's locks and runs its resync and verify steps.
+func TestGenerator_RunCommandMatchesService(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+	argsFile := filepath.Join(dir, "args")
+	rclone := filepath.Join(dir, "rclone")
+	script := "#!/bin/sh\necho \"$*\" >> " + argsFile + "\n"
+	if err := os.WriteFile(rclone, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	g := &Generator{systemdDir: dir, rclonePath: rclone, logDir: dir}
+
+	job := &models.SyncJobConfig{
+		ID:                       "b1s2y3n4",
+		Name:                     "work",
+		Source:                   "gdrive:/Work",
+		Destination:              "/home/user/Work",
+		RequiresMounts:           []string{"m1"},
+		SerializeWithSharedMount: true,
+		SyncOptions:              models.SyncOptions{Direction: "bisync", ResyncOnFirstRun: true, VerifyAfter: true},
+	}
+
+	command, err := g.DryRunCommand(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lock := []string{flockPath, "/run/user/1000/rclone-mount-sync-m1.lock"}
+	if !reflect.DeepEqual(command[:2], lock) || command[2] != rclone {
+		t.Errorf("DryRunCommand() = %q, want rclone under %q", command, lock)
+	}
+	if joined := strings.Join(command, " "); !strings.HasSuffix(joined, "--dry-run --resync") {
+		t.Errorf("dry run of a bisync job not resynced yet should preview the resync: %q", command)
+	}
+
+	command, err = g.RunCommand(job, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(command[:2], lock) || command[2] != "/bin/sh" || command[3] != "-c" {
+		t.Fatalf("RunCommand() = %q, want a shell script under %q", command, lock)
+	}
+	if output, err := exec.Command(command[2], command[3:]...).CombinedOutput(); err != nil {
+		t.Fatalf("run script failed: %v: %s", err, output)
+	}
+	data, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(runs) != 3 || !strings.HasSuffix(runs[0], "--resync") || !strings.HasPrefix(runs[1], "bisync ") || !strings.HasPrefix(runs[2], "check ") {
+		t.Errorf("rclone runs = %q, want resync, bisync and check", runs)
+	}
+	if result, err := os.ReadFile(verifyStateFile(dir, job.ID)); err != nil || strings.TrimSpace(string(result)) != verifyPassed {
+		t.Errorf("verify state = %q, %v; want %s", result, err, verifyPassed)
+	}
+
+	// Once resynced, a dry run is a plain bisync
+	command, _ = g.DryRunCommand(job)
+	if strings.Contains(strings.Join(command, " "), "--resync") {
+		t.Errorf("dry run after the first resync should not resync: %q", command)
+	}
+}
+
+func TestGenerator_RunProperties(t *testing.T) {
+	g := NewTestGenerator(t.TempDir())
+	job := &models.SyncJobConfig{SyncOptions: models.SyncOptions{
+		WorkingDir: "/srv/work", Nice: 10, IONiceClass: 3, IONicePriority: 4,
+	}}
+
+	want := []string{"WorkingDirectory=/srv/work", "Nice=10", "IOSchedulingClass=idle", "IOSchedulingPriority=4"}
+	if got := g.RunProperties(job); !reflect.DeepEqual(got, want) {
+		t.Errorf("RunProperties() = %q, want %q", got, want)
+	}
+
+	t.Setenv("SUDO_USER", "alice")
+	g.scope = ScopeSystem
+	if got := g.RunProperties(&models.SyncJobConfig{}); !reflect.DeepEqual(got, []string{"User=alice"}) {
+		t.Errorf("system scope RunProperties() = %q, want the unit user", got)
+	}
+}
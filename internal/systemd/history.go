@@ -0,0 +1,126 @@
+package systemd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+// historyJournalEntries caps the journal entries read for a run history.
+const historyJournalEntries = 5000
+
+// Journal message IDs of the systemd messages that end a run.
+const (
+	msgUnitDeactivated = "7ad2d189f7e94e70a38c781354912448" // Deactivated successfully
+	msgJobDone         = "39f53479d3a045ac8e11786248231fbf" // Finished, for a oneshot service
+)
+
+// GetRunHistory returns up to runs of the most recent runs of a service,
+// newest first, read from the journal.
+func (m *Manager) GetRunHistory(name string, runs int) ([]models.RunRecord, error) {
+	journalctl, err := exec.LookPath("journalctl")
+	if err != nil {
+		journalctl = "/usr/bin/journalctl"
+	}
+	cmd := exec.Command(journalctl, m.scope.Args("-u", name, "-o", "json", "-n", strconv.Itoa(historyJournalEntries), "--no-pager", "-q")...)
+	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the journal of %s: %w", name, err)
+	}
+
+	records := ParseRunRecords(bytes.NewReader(output))
+	if len(records) > runs {
+		records = records[:runs]
+	}
+	return records, nil
+}
+
+// ParseRunRecords groups journal entries, as written by journalctl -o json,
+// by the invocation of the unit they belong to and returns a record of each
+// run, newest first. Entries that cannot be parsed or have no invocation ID
+// are skipped, and fields missing from the journal are left zero.
+func ParseRunRecords(r io.Reader) []models.RunRecord {
+	type run struct {
+		record models.RunRecord
+		last   time.Time
+	}
+	var order []string
+	runs := make(map[string]*run)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		// Fields are strings, except binary messages given as byte arrays,
+		// which are ignored
+		var entry map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		field := func(key string) string {
+			s, _ := entry[key].(string)
+			return s
+		}
+
+		id := field("INVOCATION_ID")
+		for _, key := range []string{"USER_INVOCATION_ID", "_SYSTEMD_INVOCATION_ID"} {
+			if id == "" {
+				id = field(key)
+			}
+		}
+		usec, err := strconv.ParseInt(field("__REALTIME_TIMESTAMP"), 10, 64)
+		if id == "" || err != nil {
+			continue
+		}
+		at := time.UnixMicro(usec)
+
+		r, ok := runs[id]
+		if !ok {
+			r = &run{record: models.RunRecord{Start: at}}
+			runs[id] = r
+			order = append(order, id)
+		}
+		r.last = at
+
+		if status, err := strconv.Atoi(field("EXIT_STATUS")); err == nil {
+			r.record.ExitStatus = &status
+		}
+		switch {
+		case field("UNIT_RESULT") != "":
+			r.record.Result = field("UNIT_RESULT")
+		case field("JOB_RESULT") == "failed" && r.record.Result == "":
+			r.record.Result = "failed"
+		case (field("MESSAGE_ID") == msgUnitDeactivated || field("MESSAGE_ID") == msgJobDone && field("JOB_RESULT") == "done") && r.record.Result == "":
+			r.record.Result = "success"
+		}
+		if message := field("MESSAGE"); strings.Contains(message, "Transferred:") {
+			if stats, ok := rclone.ParseStatsLog(message); ok {
+				r.record.Bytes = stats.Bytes
+			}
+		}
+	}
+
+	records := make([]models.RunRecord, 0, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		r := runs[order[i]]
+		if r.record.Result != "" {
+			r.record.Duration = r.last.Sub(r.record.Start)
+		}
+		if r.record.Result == "success" && r.record.ExitStatus == nil {
+			// systemd only logs the exit status of a failed process
+			zero := 0
+			r.record.ExitStatus = &zero
+		}
+		records = append(records, r.record)
+	}
+	return records
+}
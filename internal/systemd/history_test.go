@@ -0,0 +1,59 @@
+package systemd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRunRecords(t *testing.T) {
+	journal := strings.Join([]string{
+		// A successful run that logged its stats
+		`{"__REALTIME_TIMESTAMP":"1792141200000000","_SYSTEMD_INVOCATION_ID":"aaa","MESSAGE":"Starting rclone sync"}`,
+		`{"__REALTIME_TIMESTAMP":"1792141260000000","_SYSTEMD_INVOCATION_ID":"aaa","MESSAGE":"Transferred:   1.500 GiB / 1.500 GiB, 100%, 25.000 MiB/s, ETA 0s"}`,
+		`{"__REALTIME_TIMESTAMP":"1792141265000000","INVOCATION_ID":"aaa","MESSAGE_ID":"7ad2d189f7e94e70a38c781354912448","MESSAGE":"Deactivated successfully."}`,
+		// Lines that cannot be used
+		`not json`,
+		`{"_SYSTEMD_INVOCATION_ID":"bbb","MESSAGE":"no timestamp"}`,
+		`{"__REALTIME_TIMESTAMP":"1792144800000000","MESSAGE":"no invocation"}`,
+		// A failed run
+		`{"__REALTIME_TIMESTAMP":"1792144800000000","_SYSTEMD_INVOCATION_ID":"ccc","MESSAGE":[1,2,3]}`,
+		`{"__REALTIME_TIMESTAMP":"1792144830000000","INVOCATION_ID":"ccc","EXIT_STATUS":"1","MESSAGE":"Main process exited, code=exited, status=1/FAILURE"}`,
+		`{"__REALTIME_TIMESTAMP":"1792144830500000","INVOCATION_ID":"ccc","UNIT_RESULT":"exit-code","MESSAGE":"Failed with result 'exit-code'."}`,
+		// A run still going
+		`{"__REALTIME_TIMESTAMP":"1792148400000000","_SYSTEMD_INVOCATION_ID":"ddd","MESSAGE":"Starting rclone sync"}`,
+	}, "\n")
+
+	records := ParseRunRecords(strings.NewReader(journal))
+	if len(records) != 3 {
+		t.Fatalf("ParseRunRecords() returned %d records, want 3: %+v", len(records), records)
+	}
+
+	running, failed, success := records[0], records[1], records[2]
+	if running.Result != "" || running.Duration != 0 || running.ExitStatus != nil {
+		t.Errorf("running record = %+v, want no result", running)
+	}
+	if !running.Start.Equal(time.UnixMicro(1792148400000000)) {
+		t.Errorf("running start = %v", running.Start)
+	}
+
+	if failed.Result != "exit-code" || failed.ExitStatus == nil || *failed.ExitStatus != 1 {
+		t.Errorf("failed record = %+v, want exit-code with status 1", failed)
+	}
+	if failed.Duration != 30500*time.Millisecond {
+		t.Errorf("failed duration = %v, want 30.5s", failed.Duration)
+	}
+
+	if success.Result != "success" || success.ExitStatus == nil || *success.ExitStatus != 0 {
+		t.Errorf("success record = %+v, want success with status 0", success)
+	}
+	if success.Duration != 65*time.Second || success.Bytes != 1536<<20 {
+		t.Errorf("success record = %+v, want 65s and 1.5 GiB", success)
+	}
+}
+
+func TestParseRunRecordsEmpty(t *testing.T) {
+	if records := ParseRunRecords(strings.NewReader("")); len(records) != 0 {
+		t.Errorf("ParseRunRecords(\"\") = %+v, want none", records)
+	}
+}
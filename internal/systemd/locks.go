@@ -0,0 +1,52 @@
+package systemd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// flockPath is the util-linux flock binary that serializes sync jobs sharing
+// a mount.
+const flockPath = "/usr/bin/flock"
+
+// procDir is where WaitingForSharedMount looks up processes; tests override it.
+var procDir = "/proc"
+
+// sharedMountLocks returns the lock files for the given mounts, sorted so
+// that jobs sharing several mounts always take the locks in the same order.
+// %t is the user's runtime directory, so the locks vanish on logout.
+func sharedMountLocks(mountIDs []string) []string {
+	locks := make([]string, 0, len(mountIDs))
+	for _, id := range mountIDs {
+		locks = append(locks, fmt.Sprintf("%%t/rclone-mount-sync-%s.lock", id))
+	}
+	sort.Strings(locks)
+	return locks
+}
+
+// WaitingForSharedMount reports whether the sync started as pid is still
+// queued behind another job for a shared mount lock. ExecStart nests one
+// flock per lock, and flock only starts its command once it holds the lock,
+// so the job is waiting if the chain of flock processes ends without a child.
+func WaitingForSharedMount(pid int) bool {
+	for pid > 0 {
+		comm, err := os.ReadFile(filepath.Join(procDir, strconv.Itoa(pid), "comm"))
+		if err != nil || strings.TrimSpace(string(comm)) != "flock" {
+			return false
+		}
+		children, err := os.ReadFile(filepath.Join(procDir, strconv.Itoa(pid), "task", strconv.Itoa(pid), "children"))
+		if err != nil {
+			return false
+		}
+		fields := strings.Fields(string(children))
+		if len(fields) == 0 {
+			return true
+		}
+		pid, _ = strconv.Atoi(fields[0])
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package systemd
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// fakeProcess adds a process to a fake /proc with the given command name and
+// children.
+func fakeProcess(t *testing.T, dir string, pid int, comm, children string) {
+	t.Helper()
+	task := filepath.Join(dir, strconv.Itoa(pid), "task", strconv.Itoa(pid))
+	if err := os.MkdirAll(task, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, strconv.Itoa(pid), "comm"), []byte(comm+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(task, "children"), []byte(children), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitingForSharedMount(t *testing.T) {
+	dir := t.TempDir()
+	oldProcDir := procDir
+	defer func() { procDir = oldProcDir }()
+	procDir = dir
+
+	// 10 holds the first lock and waits on the second; 20 holds both and syncs.
+	fakeProcess(t, dir, 10, "flock", "11 ")
+	fakeProcess(t, dir, 11, "flock", "")
+	fakeProcess(t, dir, 20, "flock", "21 ")
+	fakeProcess(t, dir, 21, "flock", "22 ")
+	fakeProcess(t, dir, 22, "rclone", "")
+
+	tests := []struct {
+		pid  int
+		want bool
+	}{
+		{10, true},
+		{20, false},
+		{22, false}, // not started through flock
+		{99, false}, // gone
+		{0, false},
+	}
+	for _, tt := range tests {
+		if got := WaitingForSharedMount(tt.pid); got != tt.want {
+			t.Errorf("WaitingForSharedMount(%d) = %v, want %v", tt.pid, got, tt.want)
+		}
+	}
+}
@@ -1,30 +1,75 @@
-// Package systemd provides functionality for managing systemd user services.
+// Package systemd provides functionality for managing systemd user services,
+// or system services when configured for the system scope.
 package systemd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dtg01100/rclone-mount-sync/internal/actionlog"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
 )
 
-// Manager handles systemd user service operations.
+// Manager handles systemd service operations, on user units or, in system
+// scope, on system units.
 type Manager struct {
 	systemctlPath string
+	scope         Scope
 }
 
-// NewManager creates a new systemd manager.
+// NewManager creates a new systemd manager for user units.
 func NewManager() *Manager {
+	return NewManagerWithScope(ScopeUser)
+}
+
+// NewManagerWithScope creates a new systemd manager for units of scope.
+func NewManagerWithScope(scope Scope) *Manager {
 	systemctlPath, err := exec.LookPath("systemctl")
 	if err != nil {
 		// Return a manager with default path - operations will fail gracefully
-		return &Manager{systemctlPath: "/usr/bin/systemctl"}
+		systemctlPath = "/usr/bin/systemctl"
 	}
-	return &Manager{systemctlPath: systemctlPath}
+	return &Manager{systemctlPath: systemctlPath, scope: scope}
+}
+
+// systemctl returns a systemctl command in the manager's scope.
+func (m *Manager) systemctl(args ...string) *exec.Cmd {
+	return m.systemctlContext(context.Background(), args...)
+}
+
+// systemctlContext is systemctl with a context for cancellation.
+func (m *Manager) systemctlContext(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, m.systemctlPath, m.scope.Args(args...)...)
+	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	return cmd
+}
+
+// change runs a systemctl command that changes units, such as start or
+// enable, and records it in the action log. An empty name is for commands
+// that take no unit, such as daemon-reload.
+func (m *Manager) change(ctx context.Context, action, name string) error {
+	what := strings.TrimSpace(action + " " + name)
+	err := m.scope.checkRoot()
+	if err != nil {
+		err = fmt.Errorf("%s: %w", what, err)
+	} else {
+		args := []string{action}
+		if name != "" {
+			args = append(args, name)
+		}
+		if output, runErr := m.systemctlContext(ctx, args...).CombinedOutput(); runErr != nil {
+			err = fmt.Errorf("%s failed: %w, output: %s", what, runErr, string(output))
+		}
+	}
+	actionlog.Record(action, name, err)
+	return err
 }
 
 // ServiceStatus represents the status of a systemd service.
@@ -40,8 +85,7 @@ type ServiceStatus struct {
 // It uses is-system-running which returns success if the manager is running,
 // regardless of individual service states.
 func (m *Manager) IsSystemdAvailable() bool {
-	cmd := exec.Command(m.systemctlPath, "--user", "is-system-running")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	cmd := m.systemctl("is-system-running")
 	output, err := cmd.Output()
 	if err != nil {
 		return false
@@ -52,91 +96,48 @@ func (m *Manager) IsSystemdAvailable() bool {
 
 // DaemonReload reloads the systemd daemon to pick up unit file changes.
 func (m *Manager) DaemonReload() error {
-	cmd := exec.Command(m.systemctlPath, "--user", "daemon-reload")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("daemon-reload failed: %w, output: %s", err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "daemon-reload", "")
 }
 
-// Enable enables a systemd user unit.
+// Enable enables a systemd unit.
 func (m *Manager) Enable(name string) error {
-	cmd := exec.Command(m.systemctlPath, "--user", "enable", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("enable %s failed: %w, output: %s", name, err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "enable", name)
 }
 
-// Disable disables a systemd user unit.
+// Disable disables a systemd unit.
 func (m *Manager) Disable(name string) error {
-	cmd := exec.Command(m.systemctlPath, "--user", "disable", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("disable %s failed: %w, output: %s", name, err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "disable", name)
 }
 
-// Start starts a systemd user unit.
+// Start starts a systemd unit.
 func (m *Manager) Start(name string) error {
-	cmd := exec.Command(m.systemctlPath, "--user", "start", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("start %s failed: %w, output: %s", name, err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "start", name)
 }
 
-// Stop stops a systemd user unit.
+// Stop stops a systemd unit.
 func (m *Manager) Stop(name string) error {
-	cmd := exec.Command(m.systemctlPath, "--user", "stop", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("stop %s failed: %w, output: %s", name, err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "stop", name)
 }
 
 // ResetFailed resets the failed state of a unit.
 func (m *Manager) ResetFailed(name string) error {
-	cmd := exec.Command(m.systemctlPath, "--user", "reset-failed", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("reset-failed failed: %w, output: %s", err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "reset-failed", name)
 }
 
-// Restart restarts a systemd user unit.
+// Restart restarts a systemd unit.
 func (m *Manager) Restart(name string) error {
-	cmd := exec.Command(m.systemctlPath, "--user", "restart", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("restart %s failed: %w, output: %s", name, err, string(output))
-	}
-	return nil
+	return m.change(context.Background(), "restart", name)
 }
 
-// Status returns the status of a systemd user unit.
+// Status returns the status of a systemd unit.
 func (m *Manager) Status(name string) (*ServiceStatus, error) {
 	status := &ServiceStatus{
 		Name: name,
 	}
 
 	// Get active state
-	cmd := exec.Command(m.systemctlPath, "--user", "show", name,
+	cmd := m.systemctl("show", name,
 		"--property=ActiveState,SubState,LoadState")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get status for %s: %w", name, err)
@@ -170,8 +171,7 @@ func (m *Manager) Status(name string) (*ServiceStatus, error) {
 
 // IsEnabled checks if a unit is enabled.
 func (m *Manager) IsEnabled(name string) (bool, error) {
-	cmd := exec.Command(m.systemctlPath, "--user", "is-enabled", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	cmd := m.systemctl("is-enabled", name)
 	output, err := cmd.Output()
 	if err != nil {
 		return false, nil
@@ -179,10 +179,20 @@ func (m *Manager) IsEnabled(name string) (bool, error) {
 	return strings.TrimSpace(string(output)) == "enabled", nil
 }
 
+// NeedsDaemonReload reports whether a unit's file changed on disk since
+// systemd last loaded it.
+func (m *Manager) NeedsDaemonReload(name string) (bool, error) {
+	cmd := m.systemctl("show", name, "--property=NeedDaemonReload")
+	output, err := cmd.Output()
+	if err != nil {
+		return false, fmt.Errorf("failed to read reload state of %s: %w", name, err)
+	}
+	return strings.TrimSpace(string(output)) == "NeedDaemonReload=yes", nil
+}
+
 // IsActive checks if a unit is currently active.
 func (m *Manager) IsActive(name string) (bool, error) {
-	cmd := exec.Command(m.systemctlPath, "--user", "is-active", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	cmd := m.systemctl("is-active", name)
 	output, err := cmd.Output()
 	if err != nil {
 		return false, nil
@@ -211,12 +221,33 @@ func parseServiceListLine(line string) (name string, enabled bool, ok bool) {
 	return name, enabled, true
 }
 
+// ListUnits returns the names of the loaded units of a type, such as
+// "service" or "timer", in a state, such as "active" or "failed". An empty
+// type lists units of every type.
+func (m *Manager) ListUnits(unitType, state string) ([]string, error) {
+	args := []string{"list-units", "--state=" + state, "--no-legend", "--plain"}
+	if unitType != "" {
+		args = append(args, "--type="+unitType)
+	}
+	output, err := m.systemctl(args...).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			names = append(names, fields[0])
+		}
+	}
+	return names, nil
+}
+
 // ListServices lists all rclone services (mounts and sync jobs).
 func (m *Manager) ListServices() ([]ServiceStatus, error) {
 	// 1. Get all unit files (to find both enabled and disabled services)
-	cmd := exec.Command(m.systemctlPath, "--user", "list-unit-files",
+	cmd := m.systemctl("list-unit-files",
 		"--type=service", "--no-legend", "rclone-*.service")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
 	output, err := cmd.Output()
 	if err != nil {
 		// If command fails, it might be because no units match the pattern
@@ -249,9 +280,8 @@ func (m *Manager) ListServices() ([]ServiceStatus, error) {
 
 	// 2. Get active status for all rclone services in one go
 	// systemctl list-units only shows units that are currently loaded/active
-	cmd = exec.Command(m.systemctlPath, "--user", "list-units",
+	cmd = m.systemctl("list-units",
 		"--type=service", "--no-legend", "--all", "rclone-*.service")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
 	output, err = cmd.Output()
 	if err == nil {
 		lines = strings.Split(string(output), "\n")
@@ -292,9 +322,8 @@ func (m *Manager) ListServices() ([]ServiceStatus, error) {
 
 // GetLogs returns the last N lines of logs for a service.
 func (m *Manager) GetLogs(name string, lines int) (string, error) {
-	cmd := exec.Command(m.systemctlPath, "--user", "journalctl",
+	cmd := m.systemctl("journalctl",
 		"-u", name, "-n", strconv.Itoa(lines), "--no-pager")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
 	output, err := cmd.Output()
 	if err != nil {
 		return "", fmt.Errorf("failed to get logs for %s: %w", name, err)
@@ -302,6 +331,24 @@ func (m *Manager) GetLogs(name string, lines int) (string, error) {
 	return string(output), nil
 }
 
+// FollowLogs streams new journal lines of a service to out, like
+// journalctl -f, until ctx is cancelled. It returns nil once cancelled.
+func (m *Manager) FollowLogs(ctx context.Context, name string, out io.Writer) error {
+	journalctl, err := exec.LookPath("journalctl")
+	if err != nil {
+		journalctl = "/usr/bin/journalctl"
+	}
+
+	cmd := exec.CommandContext(ctx, journalctl, m.scope.Args("-u", name, "-f", "-n", "0", "--no-pager")...)
+	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := cmd.Run(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to follow logs for %s: %w", name, err)
+	}
+	return nil
+}
+
 // GetDetailedStatus returns detailed status information for a service.
 func (m *Manager) GetDetailedStatus(name string) (*models.ServiceStatus, error) {
 	status := &models.ServiceStatus{
@@ -316,9 +363,8 @@ func (m *Manager) GetDetailedStatus(name string) (*models.ServiceStatus, error)
 	}
 
 	// Get properties
-	cmd := exec.Command(m.systemctlPath, "--user", "show", name,
-		"--property=LoadState,ActiveState,SubState,MainPID,ExecMainStatus,ActiveEnterTimestamp,InactiveEnterTimestamp")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
+	cmd := m.systemctl("show", name,
+		"--property=LoadState,ActiveState,SubState,Result,MainPID,ExecMainStatus,ActiveEnterTimestamp,InactiveEnterTimestamp")
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get detailed status for %s: %w", name, err)
@@ -340,6 +386,8 @@ func (m *Manager) GetDetailedStatus(name string) (*models.ServiceStatus, error)
 			status.ActiveState = value
 		case "SubState":
 			status.SubState = value
+		case "Result":
+			status.Result = value
 		case "MainPID":
 			if pid, err := strconv.Atoi(value); err == nil {
 				status.MainPID = pid
@@ -384,9 +432,8 @@ func (m *Manager) GetDetailedStatus(name string) (*models.ServiceStatus, error)
 
 // GetTimerNextRun returns the next run time for a timer.
 func (m *Manager) GetTimerNextRun(timerName string) (time.Time, error) {
-	cmd := exec.Command(m.systemctlPath, "--user", "show", timerName,
+	cmd := m.systemctl("show", timerName,
 		"--property=NextElapseUSecMonotonic")
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
 	output, err := cmd.Output()
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to get timer info for %s: %w", timerName, err)
@@ -461,26 +508,65 @@ func (m *Manager) RunSyncNow(name string) error {
 	return m.Start(serviceName)
 }
 
-// StartContext starts a systemd user unit with context for cancellation.
-func (m *Manager) StartContext(ctx context.Context, name string) error {
-	cmd := exec.CommandContext(ctx, m.systemctlPath, "--user", "start", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
+// ExitError is returned by RunTransient when the command exits with a
+// non-zero status.
+type ExitError struct {
+	Unit string
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("%s exited with status %d", e.Unit, e.Code)
+}
+
+// RunTransient runs command as a transient service named unit, with the
+// given unit properties such as "Nice=10", and waits for it to finish,
+// copying its output to out. The unit is removed once it exits, and
+// stopped if ctx is cancelled first. A non-zero exit status is returned as
+// an *ExitError.
+func (m *Manager) RunTransient(ctx context.Context, unit string, properties, command []string, out io.Writer) error {
+	if err := m.scope.checkRoot(); err != nil {
+		err = fmt.Errorf("run %s: %w", unit, err)
+		actionlog.Record("run", unit, err)
+		return err
+	}
+	systemdRun, err := exec.LookPath("systemd-run")
 	if err != nil {
-		return fmt.Errorf("start %s failed: %w, output: %s", name, err, string(output))
+		systemdRun = "/usr/bin/systemd-run"
 	}
-	return nil
+
+	args := []string{"--wait", "--pipe", "--quiet", "--collect", "--unit=" + unit}
+	for _, property := range properties {
+		args = append(args, "--property="+property)
+	}
+	args = m.scope.Args(append(append(args, "--"), command...)...)
+	cmd := exec.CommandContext(ctx, systemdRun, args...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	// Killing systemd-run leaves the unit running, so stop the unit first
+	cmd.Cancel = func() error {
+		_ = m.change(context.Background(), "stop", unit)
+		return cmd.Process.Kill()
+	}
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil {
+		err = &ExitError{Unit: unit, Code: exitErr.ExitCode()}
+	} else if err != nil {
+		err = fmt.Errorf("failed to run %s: %w", unit, err)
+	}
+	actionlog.Record("run", unit, err)
+	return err
+}
+
+// StartContext starts a systemd unit with context for cancellation.
+func (m *Manager) StartContext(ctx context.Context, name string) error {
+	return m.change(ctx, "start", name)
 }
 
-// StopContext stops a systemd user unit with context for cancellation.
+// StopContext stops a systemd unit with context for cancellation.
 func (m *Manager) StopContext(ctx context.Context, name string) error {
-	cmd := exec.CommandContext(ctx, m.systemctlPath, "--user", "stop", name)
-	cmd.Env = append(cmd.Env, "LC_ALL=C")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("stop %s failed: %w, output: %s", name, err, string(output))
-	}
-	return nil
+	return m.change(ctx, "stop", name)
 }
 
 // ParseUnitID extracts the ID from a unit name like "rclone-mount-a1b2c3d4.service".
@@ -542,8 +628,12 @@ type ServiceManager interface {
 	Status(name string) (*ServiceStatus, error)
 	IsEnabled(name string) (bool, error)
 	IsActive(name string) (bool, error)
+	NeedsDaemonReload(name string) (bool, error)
 	ListServices() ([]ServiceStatus, error)
+	ListUnits(unitType, state string) ([]string, error)
 	GetLogs(name string, lines int) (string, error)
+	GetRunHistory(name string, runs int) ([]models.RunRecord, error)
+	FollowLogs(ctx context.Context, name string, out io.Writer) error
 	GetDetailedStatus(name string) (*models.ServiceStatus, error)
 	GetTimerNextRun(timerName string) (time.Time, error)
 	StartTimer(name string) error
@@ -551,6 +641,7 @@ type ServiceManager interface {
 	EnableTimer(name string) error
 	DisableTimer(name string) error
 	RunSyncNow(name string) error
+	RunTransient(ctx context.Context, unit string, properties, command []string, out io.Writer) error
 	ResetFailed(name string) error
 }
 
@@ -569,10 +660,18 @@ type MockManager struct {
 	IsEnabledErr             error
 	IsActiveResult           bool
 	IsActiveErr              error
+	NeedsDaemonReloadResult  bool
+	NeedsDaemonReloadErr     error
 	ListServicesResult       []ServiceStatus
 	ListServicesErr          error
+	ListUnitsResult          map[string][]string // Units by "type state", e.g. "timer active"
+	ListUnitsErr             error
 	GetLogsResult            string
 	GetLogsErr               error
+	GetRunHistoryResult      []models.RunRecord
+	GetRunHistoryErr         error
+	FollowLogsOutput         string // Written to out by FollowLogs
+	FollowLogsErr            error  // Returned by FollowLogs right after its output
 	GetDetailedStatusResult  *models.ServiceStatus
 	GetDetailedStatusErr     error
 	GetTimerNextRunResult    time.Time
@@ -582,105 +681,209 @@ type MockManager struct {
 	EnableTimerErr           error
 	DisableTimerErr          error
 	RunSyncNowErr            error
+	RunTransientOutput       string // Written to out by RunTransient
+	RunTransientErr          error
 	ResetFailedErr           error
+
+	TransientCommand    []string // Command of the last RunTransient call
+	TransientProperties []string // Properties of the last RunTransient call
+
+	mu    sync.Mutex
+	Calls []string // Recorded calls such as "Start rclone-mount-abc12345.service"
+}
+
+// record notes a call so tests can assert which operations were performed.
+func (m *MockManager) record(method, name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if name == "" {
+		m.Calls = append(m.Calls, method)
+		return
+	}
+	m.Calls = append(m.Calls, method+" "+name)
+}
+
+// Called reports whether method was called. An empty name matches a call
+// with any unit name.
+func (m *MockManager) Called(method, name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	want := method
+	if name != "" {
+		want = method + " " + name
+	}
+	for _, call := range m.Calls {
+		if call == want || (name == "" && strings.HasPrefix(call, method+" ")) {
+			return true
+		}
+	}
+	return false
 }
 
 // IsSystemdAvailable mocks the IsSystemdAvailable method.
 func (m *MockManager) IsSystemdAvailable() bool {
+	m.record("IsSystemdAvailable", "")
 	return m.IsSystemdAvailableResult
 }
 
 // DaemonReload mocks the DaemonReload method.
 func (m *MockManager) DaemonReload() error {
+	m.record("DaemonReload", "")
 	return m.DaemonReloadErr
 }
 
 // Enable mocks the Enable method.
 func (m *MockManager) Enable(name string) error {
+	m.record("Enable", name)
 	return m.EnableErr
 }
 
 // Disable mocks the Disable method.
 func (m *MockManager) Disable(name string) error {
+	m.record("Disable", name)
 	return m.DisableErr
 }
 
 // Start mocks the Start method.
 func (m *MockManager) Start(name string) error {
+	m.record("Start", name)
 	return m.StartErr
 }
 
 // Stop mocks the Stop method.
 func (m *MockManager) Stop(name string) error {
+	m.record("Stop", name)
 	return m.StopErr
 }
 
 // Restart mocks the Restart method.
 func (m *MockManager) Restart(name string) error {
+	m.record("Restart", name)
 	return m.RestartErr
 }
 
 // Status mocks the Status method.
 func (m *MockManager) Status(name string) (*ServiceStatus, error) {
+	m.record("Status", name)
 	return m.StatusResult, m.StatusErr
 }
 
 // IsEnabled mocks the IsEnabled method.
 func (m *MockManager) IsEnabled(name string) (bool, error) {
+	m.record("IsEnabled", name)
 	return m.IsEnabledResult, m.IsEnabledErr
 }
 
 // IsActive mocks the IsActive method.
 func (m *MockManager) IsActive(name string) (bool, error) {
+	m.record("IsActive", name)
 	return m.IsActiveResult, m.IsActiveErr
 }
 
+// NeedsDaemonReload mocks the NeedsDaemonReload method.
+func (m *MockManager) NeedsDaemonReload(name string) (bool, error) {
+	m.record("NeedsDaemonReload", name)
+	return m.NeedsDaemonReloadResult, m.NeedsDaemonReloadErr
+}
+
 // ListServices mocks the ListServices method.
 func (m *MockManager) ListServices() ([]ServiceStatus, error) {
+	m.record("ListServices", "")
 	return m.ListServicesResult, m.ListServicesErr
 }
 
+// ListUnits mocks the ListUnits method, returning the ListUnitsResult
+// entry for the type and state.
+func (m *MockManager) ListUnits(unitType, state string) ([]string, error) {
+	key := strings.TrimSpace(unitType + " " + state)
+	m.record("ListUnits", key)
+	return m.ListUnitsResult[key], m.ListUnitsErr
+}
+
+// FollowLogs mocks the FollowLogs method. It writes FollowLogsOutput, then
+// returns FollowLogsErr if set, or waits for ctx to be cancelled.
+func (m *MockManager) FollowLogs(ctx context.Context, name string, out io.Writer) error {
+	m.record("FollowLogs", name)
+	if m.FollowLogsOutput != "" {
+		io.WriteString(out, m.FollowLogsOutput)
+	}
+	if m.FollowLogsErr != nil {
+		return m.FollowLogsErr
+	}
+	<-ctx.Done()
+	return nil
+}
+
 // GetLogs mocks the GetLogs method.
 func (m *MockManager) GetLogs(name string, lines int) (string, error) {
+	m.record("GetLogs", name)
 	return m.GetLogsResult, m.GetLogsErr
 }
 
+// GetRunHistory mocks the GetRunHistory method.
+func (m *MockManager) GetRunHistory(name string, runs int) ([]models.RunRecord, error) {
+	m.record("GetRunHistory", name)
+	return m.GetRunHistoryResult, m.GetRunHistoryErr
+}
+
 // GetDetailedStatus mocks the GetDetailedStatus method.
 func (m *MockManager) GetDetailedStatus(name string) (*models.ServiceStatus, error) {
+	m.record("GetDetailedStatus", name)
 	return m.GetDetailedStatusResult, m.GetDetailedStatusErr
 }
 
 // GetTimerNextRun mocks the GetTimerNextRun method.
 func (m *MockManager) GetTimerNextRun(timerName string) (time.Time, error) {
+	m.record("GetTimerNextRun", timerName)
 	return m.GetTimerNextRunResult, m.GetTimerNextRunErr
 }
 
 // StartTimer mocks the StartTimer method.
 func (m *MockManager) StartTimer(name string) error {
+	m.record("StartTimer", name)
 	return m.StartTimerErr
 }
 
 // StopTimer mocks the StopTimer method.
 func (m *MockManager) StopTimer(name string) error {
+	m.record("StopTimer", name)
 	return m.StopTimerErr
 }
 
 // EnableTimer mocks the EnableTimer method.
 func (m *MockManager) EnableTimer(name string) error {
+	m.record("EnableTimer", name)
 	return m.EnableTimerErr
 }
 
 // DisableTimer mocks the DisableTimer method.
 func (m *MockManager) DisableTimer(name string) error {
+	m.record("DisableTimer", name)
 	return m.DisableTimerErr
 }
 
 // RunSyncNow mocks the RunSyncNow method.
 func (m *MockManager) RunSyncNow(name string) error {
+	m.record("RunSyncNow", name)
 	return m.RunSyncNowErr
 }
 
+// RunTransient mocks the RunTransient method, recording the command and
+// writing RunTransientOutput to out.
+func (m *MockManager) RunTransient(ctx context.Context, unit string, properties, command []string, out io.Writer) error {
+	m.record("RunTransient", unit)
+	m.mu.Lock()
+	m.TransientProperties = properties
+	m.TransientCommand = command
+	m.mu.Unlock()
+	if m.RunTransientOutput != "" {
+		io.WriteString(out, m.RunTransientOutput)
+	}
+	return m.RunTransientErr
+}
+
 // ResetFailed mocks the ResetFailed method.
 func (m *MockManager) ResetFailed(name string) error {
+	m.record("ResetFailed", name)
 	return m.ResetFailedErr
 }
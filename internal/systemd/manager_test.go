@@ -2,6 +2,8 @@ package systemd
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -1896,3 +1898,60 @@ func TestParseServiceListLine_SpecialCharactersInName(t *testing.T) {
 		})
 	}
 }
+
+// TestMockManager_RecordsCalls tests that the mock records calls for assertions.
+func TestMockManager_RecordsCalls(t *testing.T) {
+	m := &MockManager{}
+
+	_ = m.EnableTimer("rclone-sync-abc.timer")
+	_ = m.DaemonReload()
+
+	if !m.Called("EnableTimer", "rclone-sync-abc.timer") {
+		t.Errorf("Called(EnableTimer, name) = false, calls = %v", m.Calls)
+	}
+	if !m.Called("EnableTimer", "") {
+		t.Error("Called(EnableTimer, \"\") should match any unit name")
+	}
+	if !m.Called("DaemonReload", "") {
+		t.Error("Called(DaemonReload) = false")
+	}
+	if m.Called("StartTimer", "") {
+		t.Error("Called(StartTimer) = true, want false")
+	}
+}
+
+// TestRunTransientStopsUnitOnCancel tests that cancelling a transient run
+// stops its unit rather than only killing systemd-run.
+func TestRunTransientStopsUnitOnCancel(t *testing.T) {
+	systemctl, log := recordingSystemctl(t)
+	bin := t.TempDir()
+	started := filepath.Join(bin, "started")
+	script := "#!/bin/sh\ntouch " + started + "\nexec sleep 10\n"
+	if err := os.WriteFile(filepath.Join(bin, "systemd-run"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	m := &Manager{systemctlPath: systemctl, scope: ScopeUser}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for {
+			if _, err := os.Stat(started); err == nil {
+				cancel()
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	if err := m.RunTransient(ctx, "rclone-sync-a1b2c3d4-run", nil, []string{"rclone", "sync"}, &strings.Builder{}); err == nil {
+		t.Error("RunTransient() should fail when cancelled")
+	}
+	data, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "--user stop rclone-sync-a1b2c3d4-run") {
+		t.Errorf("cancelling should stop the unit, systemctl calls:\n%s", data)
+	}
+}
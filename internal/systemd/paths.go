@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // UserSystemdDir is the relative path to the user systemd directory.
@@ -51,6 +52,15 @@ func expandPath(path string) string {
 		if err != nil {
 			return path
 		}
+		return expandHome(path, home)
+	}
+	return path
+}
+
+// expandHome expands ~ to home, the home directory of the user the units
+// run as. An empty home leaves path as it is.
+func expandHome(path, home string) string {
+	if strings.HasPrefix(path, "~/") && home != "" {
 		return filepath.Join(home, path[2:])
 	}
 	return path
@@ -58,14 +68,20 @@ func expandPath(path string) string {
 
 // getRcloneConfigPath returns the path to the rclone config file.
 func getRcloneConfigPath() string {
+	home, _ := os.UserHomeDir()
+	return rcloneConfigPathIn(home)
+}
+
+// rcloneConfigPathIn returns the path to the rclone config file of the user
+// whose home directory is home.
+func rcloneConfigPathIn(home string) string {
 	// Check RCLONE_CONFIG environment variable
 	if configPath := os.Getenv("RCLONE_CONFIG"); configPath != "" {
 		return configPath
 	}
 
 	// Default location
-	home, err := os.UserHomeDir()
-	if err != nil {
+	if home == "" {
 		return filepath.Join("/home", os.Getenv("USER"), ".config", "rclone", "rclone.conf")
 	}
 	return filepath.Join(home, ".config", "rclone", "rclone.conf")
@@ -74,22 +90,82 @@ func getRcloneConfigPath() string {
 // getLogDir returns the directory for log files.
 func getLogDir() (string, error) {
 	// Use XDG_STATE_HOME if available, otherwise ~/.local/state
-	stateDir := os.Getenv("XDG_STATE_HOME")
-	if stateDir != "" {
-		logDir := filepath.Join(stateDir, "rclone-mount-sync")
-		if err := os.MkdirAll(logDir, 0755); err != nil {
-			return "", err
-		}
-		return logDir, nil
+	if os.Getenv("XDG_STATE_HOME") != "" {
+		return logDirIn("")
 	}
 
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return "", err
 	}
-	logDir := filepath.Join(home, ".local", "state", "rclone-mount-sync")
+	return logDirIn(home)
+}
+
+// logDirIn returns the directory for log files of the user whose home
+// directory is home, creating it if needed. XDG_STATE_HOME overrides home.
+func logDirIn(home string) (string, error) {
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		if home == "" {
+			return "", fmt.Errorf("no home directory for the log directory")
+		}
+		stateDir = filepath.Join(home, ".local", "state")
+	}
+
+	logDir := filepath.Join(stateDir, "rclone-mount-sync")
 	if err := os.MkdirAll(logDir, 0755); err != nil {
 		return "", err
 	}
 	return logDir, nil
 }
+
+// Outcomes written to a sync job's verify state file.
+const (
+	verifyPassed = "verified"
+	verifyFailed = "failed"
+)
+
+// verifyStateFile returns the file a sync job's verify step writes its outcome to.
+func verifyStateFile(logDir, jobID string) string {
+	return filepath.Join(logDir, fmt.Sprintf("rclone-sync-%s.verify", jobID))
+}
+
+// resyncMarkerFile returns the file recording that a bisync job's first
+// --resync run has completed.
+func resyncMarkerFile(logDir, jobID string) string {
+	return filepath.Join(logDir, fmt.Sprintf("rclone-sync-%s.resynced", jobID))
+}
+
+// VerifyResult is the outcome of the last verify-after-sync check.
+type VerifyResult struct {
+	Verified  bool
+	CheckedAt time.Time
+}
+
+// ReadVerifyResult reads the outcome of the last verify step for a sync job.
+// It returns nil without an error if the job has not been verified yet.
+func ReadVerifyResult(jobID string) (*VerifyResult, error) {
+	logDir, err := getLogDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log directory: %w", err)
+	}
+
+	path := verifyStateFile(logDir, jobID)
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyResult{
+		Verified:  strings.TrimSpace(string(data)) == verifyPassed,
+		CheckedAt: info.ModTime(),
+	}, nil
+}
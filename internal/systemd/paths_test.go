@@ -344,3 +344,33 @@ func TestExpandPath_TildeInMiddle(t *testing.T) {
 		t.Errorf("expandPath(%q) = %q, want %q (unchanged)", input, got, input)
 	}
 }
+
+func TestReadVerifyResult(t *testing.T) {
+	stateDir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateDir)
+	logDir := filepath.Join(stateDir, "rclone-mount-sync")
+
+	result, err := ReadVerifyResult("a1b2c3d4")
+	if err != nil || result != nil {
+		t.Fatalf("ReadVerifyResult() = %v, %v; want nil, nil before any run", result, err)
+	}
+
+	if err := os.WriteFile(verifyStateFile(logDir, "a1b2c3d4"), []byte("verified\n"), 0644); err != nil {
+		t.Fatalf("failed to write state file: %v", err)
+	}
+	result, err = ReadVerifyResult("a1b2c3d4")
+	if err != nil || result == nil || !result.Verified {
+		t.Fatalf("ReadVerifyResult() = %+v, %v; want verified", result, err)
+	}
+	if result.CheckedAt.IsZero() {
+		t.Error("CheckedAt should be set from the state file")
+	}
+
+	if err := os.WriteFile(verifyStateFile(logDir, "a1b2c3d4"), []byte("failed\n"), 0644); err != nil {
+		t.Fatalf("failed to write state file: %v", err)
+	}
+	result, err = ReadVerifyResult("a1b2c3d4")
+	if err != nil || result == nil || result.Verified {
+		t.Fatalf("ReadVerifyResult() = %+v, %v; want failed", result, err)
+	}
+}
@@ -39,11 +39,11 @@ type ReconciliationResult struct {
 // Reconciler detects orphaned and legacy unit files.
 type Reconciler struct {
 	generator *Generator
-	manager   *Manager
+	manager   ServiceManager
 }
 
 // NewReconciler creates a new reconciler.
-func NewReconciler(generator *Generator, manager *Manager) *Reconciler {
+func NewReconciler(generator *Generator, manager ServiceManager) *Reconciler {
 	return &Reconciler{
 		generator: generator,
 		manager:   manager,
@@ -352,11 +352,11 @@ func parseRcloneSyncCommand(execStart string) []string {
 		return nil
 	}
 
-	// Skip rclone binary and find sync/copy/move
+	// Skip rclone binary and find sync/copy/move/bisync
 	cmdIdx := -1
 	direction := ""
 	for i, field := range fields {
-		if field == "sync" || field == "copy" || field == "move" {
+		if field == "sync" || field == "copy" || field == "move" || field == "bisync" {
 			cmdIdx = i
 			direction = field
 			break
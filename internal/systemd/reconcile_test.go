@@ -796,6 +796,11 @@ func TestParseRcloneSyncCommand(t *testing.T) {
 			execStart: "/usr/bin/rclone move source:/path /dest/path",
 			want:      []string{"move", "source:/path", "/dest/path"},
 		},
+		{
+			name:      "bisync command",
+			execStart: "/usr/bin/rclone bisync source:/path /dest/path --create-empty-src-dirs",
+			want:      []string{"bisync", "source:/path", "/dest/path"},
+		},
 		{
 			name:      "invalid command",
 			execStart: "/usr/bin/rclone mount source dest",
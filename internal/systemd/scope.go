@@ -0,0 +1,161 @@
+package systemd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Scope selects whether units are user units, run by the user's systemd
+// instance while they are logged in, or system units, run by the system
+// instance from boot.
+type Scope string
+
+const (
+	// ScopeUser units live in ~/.config/systemd/user and are managed with
+	// systemctl --user. It is the default.
+	ScopeUser Scope = "user"
+	// ScopeSystem units live in SystemUnitDir and are managed with plain
+	// systemctl, which needs root.
+	ScopeSystem Scope = "system"
+)
+
+// SystemUnitDir is where system scope units are written.
+const SystemUnitDir = "/etc/systemd/system"
+
+// ErrNeedsRoot is returned when a system scope unit would be changed
+// without root privileges.
+var ErrNeedsRoot = errors.New("managing system units requires root; rerun with sudo or set the scope to user")
+
+// geteuid returns the effective user ID. It is injectable for testing.
+var geteuid = os.Geteuid
+
+// lookupUser looks up an account by name. It is injectable for testing.
+var lookupUser = user.Lookup
+
+// ParseScope returns the scope named s. An empty name is the user scope.
+func ParseScope(s string) (Scope, error) {
+	switch Scope(s) {
+	case "", ScopeUser:
+		return ScopeUser, nil
+	case ScopeSystem:
+		return ScopeSystem, nil
+	}
+	return "", fmt.Errorf("invalid scope %q (use user or system)", s)
+}
+
+// Args returns the arguments of a systemctl, journalctl or systemd-run
+// command in the scope: user scope commands get --user.
+func (s Scope) Args(args ...string) []string {
+	if s == ScopeSystem {
+		return args
+	}
+	return append([]string{"--user"}, args...)
+}
+
+// unitDir returns the directory the units of the scope are written to.
+func (s Scope) unitDir() (string, error) {
+	if s == ScopeSystem {
+		return SystemUnitDir, nil
+	}
+	return GetUserSystemdPath()
+}
+
+// installTarget returns the target services are enabled under: the user's
+// default target, or multi-user.target so system units start at boot.
+func (s Scope) installTarget() string {
+	if s == ScopeSystem {
+		return "multi-user.target"
+	}
+	return "default.target"
+}
+
+// checkRoot returns ErrNeedsRoot when changing units of the scope needs
+// root privileges that the process does not have.
+func (s Scope) checkRoot() error {
+	if s == ScopeSystem && geteuid() != 0 {
+		return ErrNeedsRoot
+	}
+	return nil
+}
+
+// unitUser returns the user system scope units run rclone as: the user who
+// invoked sudo, or else the current user.
+func unitUser() string {
+	if name := os.Getenv("SUDO_USER"); name != "" {
+		return name
+	}
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return ""
+}
+
+// unitAccount returns the account the units of the scope run as: the unit
+// user for system units, nil for user units, which run as the current user.
+func (s Scope) unitAccount() (*user.User, error) {
+	if s != ScopeSystem {
+		return nil, nil
+	}
+	name := unitUser()
+	if name == "" {
+		return nil, fmt.Errorf("no user to run system units as")
+	}
+	u, err := lookupUser(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up unit user %s: %w", name, err)
+	}
+	return u, nil
+}
+
+// homeDir returns the home directory of the account the units of the scope
+// run as. Under sudo this is the invoking user's home, not root's, so ~
+// paths, the rclone config and the state directory are the unit user's.
+func (s Scope) homeDir() (string, error) {
+	u, err := s.unitAccount()
+	if err != nil {
+		return "", err
+	}
+	if u != nil {
+		return u.HomeDir, nil
+	}
+	return os.UserHomeDir()
+}
+
+// chownToUnitUser gives dir, and the directories between home and dir, to
+// the unit user of a system scope generator run as root, so that the
+// services can write to the state directory root created for them.
+func (s Scope) chownToUnitUser(dir, home string) error {
+	if s != ScopeSystem || geteuid() != 0 || home == "" {
+		return nil
+	}
+	u, err := s.unitAccount()
+	if err != nil {
+		return err
+	}
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return fmt.Errorf("invalid uid %q of %s", u.Uid, u.Username)
+	}
+	gid, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return fmt.Errorf("invalid gid %q of %s", u.Gid, u.Username)
+	}
+
+	rel, err := filepath.Rel(home, dir)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return nil
+	}
+	path := home
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		path = filepath.Join(path, part)
+		if err := os.Chown(path, uid, gid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
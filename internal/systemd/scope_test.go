@@ -0,0 +1,202 @@
+package systemd
+
+import (
+	"errors"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Scope
+		wantErr bool
+	}{
+		{"", ScopeUser, false},
+		{"user", ScopeUser, false},
+		{"system", ScopeSystem, false},
+		{"global", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseScope(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseScope(%q) = %q, %v; want %q, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestScopeUnitDir(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/home/alice/.config")
+
+	if dir, err := ScopeUser.unitDir(); err != nil || dir != "/home/alice/.config/systemd/user" {
+		t.Errorf("user unitDir() = %q, %v", dir, err)
+	}
+	if dir, err := ScopeSystem.unitDir(); err != nil || dir != SystemUnitDir {
+		t.Errorf("system unitDir() = %q, %v; want %q", dir, err, SystemUnitDir)
+	}
+
+	gen, err := NewGeneratorWithScope(ScopeSystem)
+	if err != nil {
+		t.Fatalf("NewGeneratorWithScope() error = %v", err)
+	}
+	if gen.GetSystemdDir() != SystemUnitDir || gen.Scope() != ScopeSystem {
+		t.Errorf("system generator writes to %q in scope %q", gen.GetSystemdDir(), gen.Scope())
+	}
+}
+
+// recordingSystemctl returns a fake systemctl that appends its arguments to
+// the returned log file, one call per line.
+func recordingSystemctl(t *testing.T) (path, log string) {
+	t.Helper()
+	dir := t.TempDir()
+	path = filepath.Join(dir, "systemctl")
+	log = filepath.Join(dir, "calls")
+	script := "#!/bin/sh\necho \"$*\" >> " + log + "\n"
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path, log
+}
+
+func TestScopeSystemctlCommands(t *testing.T) {
+	oldGeteuid := geteuid
+	defer func() { geteuid = oldGeteuid }()
+	geteuid = func() int { return 0 }
+
+	tests := []struct {
+		scope Scope
+		want  string
+	}{
+		{ScopeUser, "--user enable rclone-mount-a1b2c3d4.service\n--user is-active rclone-mount-a1b2c3d4.service\n"},
+		{ScopeSystem, "enable rclone-mount-a1b2c3d4.service\nis-active rclone-mount-a1b2c3d4.service\n"},
+	}
+	for _, tt := range tests {
+		path, log := recordingSystemctl(t)
+		m := &Manager{systemctlPath: path, scope: tt.scope}
+		if err := m.Enable("rclone-mount-a1b2c3d4.service"); err != nil {
+			t.Fatalf("%s Enable() error = %v", tt.scope, err)
+		}
+		m.IsActive("rclone-mount-a1b2c3d4.service")
+
+		calls, _ := os.ReadFile(log)
+		if string(calls) != tt.want {
+			t.Errorf("%s scope ran\n%s\nwant\n%s", tt.scope, calls, tt.want)
+		}
+	}
+}
+
+func TestScopeSystemNeedsRoot(t *testing.T) {
+	oldGeteuid := geteuid
+	defer func() { geteuid = oldGeteuid }()
+	geteuid = func() int { return 1000 }
+
+	path, log := recordingSystemctl(t)
+	m := &Manager{systemctlPath: path, scope: ScopeSystem}
+	err := m.Start("rclone-mount-a1b2c3d4.service")
+	if !errors.Is(err, ErrNeedsRoot) {
+		t.Errorf("Start() error = %v, want ErrNeedsRoot", err)
+	}
+	if _, err := os.Stat(log); err == nil {
+		t.Error("systemctl should not run without root")
+	}
+
+	// User units need no root
+	m = &Manager{systemctlPath: path, scope: ScopeUser}
+	if err := m.Start("rclone-mount-a1b2c3d4.service"); err != nil {
+		t.Errorf("user Start() error = %v", err)
+	}
+}
+
+func TestGeneratorSystemScopeUnits(t *testing.T) {
+	t.Setenv("SUDO_USER", "alice")
+	mount := &models.MountConfig{ID: "a1b2c3d4", Name: "gdrive", Remote: "gdrive:", RemotePath: "/", MountPoint: "/mnt/gdrive"}
+
+	g := NewTestGenerator(t.TempDir())
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(content, "User=") || !strings.Contains(content, "WantedBy=default.target") {
+		t.Errorf("user unit should run as its user under default.target:\n%s", content)
+	}
+
+	g.scope = ScopeSystem
+	content, err = g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "User=alice\n") || !strings.Contains(content, "WantedBy=multi-user.target") {
+		t.Errorf("system unit should run as the sudo user under multi-user.target:\n%s", content)
+	}
+
+	job := &models.SyncJobConfig{ID: "e5f6g7h8", Name: "photos", Source: "gdrive:/Photos", Destination: "/data/photos"}
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(content, "User=alice\n") || !strings.Contains(content, "WantedBy=multi-user.target") {
+		t.Errorf("system sync unit should run as the sudo user under multi-user.target:\n%s", content)
+	}
+}
+
+func TestGeneratorSystemScopeUsesUnitUserHome(t *testing.T) {
+	home := filepath.Join(t.TempDir(), "alice")
+	t.Setenv("SUDO_USER", "alice")
+	t.Setenv("RCLONE_CONFIG", "")
+	t.Setenv("XDG_STATE_HOME", "")
+	oldLookup, oldGeteuid := lookupUser, geteuid
+	defer func() { lookupUser, geteuid = oldLookup, oldGeteuid }()
+	lookupUser = func(name string) (*user.User, error) {
+		if name != "alice" {
+			return nil, user.UnknownUserError(name)
+		}
+		return &user.User{Username: name, HomeDir: home, Uid: strconv.Itoa(os.Getuid()), Gid: strconv.Itoa(os.Getgid())}, nil
+	}
+	geteuid = func() int { return 0 }
+
+	g, err := NewGeneratorWithScope(ScopeSystem)
+	if err != nil {
+		t.Fatalf("NewGeneratorWithScope() error = %v", err)
+	}
+	stateDir := filepath.Join(home, ".local", "state", "rclone-mount-sync")
+	if g.configPath != filepath.Join(home, ".config", "rclone", "rclone.conf") || g.logDir != stateDir {
+		t.Errorf("config = %q, log dir = %q; want alice's", g.configPath, g.logDir)
+	}
+	if _, err := os.Stat(stateDir); err != nil {
+		t.Errorf("state dir not created: %v", err)
+	}
+
+	mount := &models.MountConfig{ID: "a1b2c3d4", Name: "gdrive", Remote: "gdrive:", RemotePath: "/", MountPoint: "~/gdrive"}
+	content, err := g.GenerateMountService(mount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"User=alice\n", home + "/gdrive", "--config=" + home + "/.config/rclone/rclone.conf"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("system mount unit should contain %q:\n%s", want, content)
+		}
+	}
+
+	job := &models.SyncJobConfig{
+		ID: "e5f6g7h8", Name: "photos", Source: "gdrive:/Photos", Destination: "~/photos",
+		SyncOptions: models.SyncOptions{Direction: "bisync", VerifyAfter: true, ResyncOnFirstRun: true},
+	}
+	content, err = g.GenerateSyncService(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{home + "/photos", verifyStateFile(stateDir, job.ID), resyncMarkerFile(stateDir, job.ID)} {
+		if !strings.Contains(content, want) {
+			t.Errorf("system sync unit should contain %q:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "/root/") {
+		t.Errorf("system sync unit should not use root's home:\n%s", content)
+	}
+}
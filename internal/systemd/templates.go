@@ -2,8 +2,8 @@ package systemd
 
 // MountServiceTemplate is the systemd service unit template for mounts.
 const MountServiceTemplate = `[Unit]
-Description=Rclone mount: {{.Name}}
-Documentation=man:rclone(1)
+Description={{if .Description}}{{.Description}}{{else}}Rclone mount: {{.Name}}{{end}}
+Documentation={{if .DocumentationURL}}{{.DocumentationURL}} {{end}}man:rclone(1)
 After=network-online.target
 Wants=network-online.target
 StartLimitIntervalSec=30
@@ -11,9 +11,10 @@ StartLimitBurst=5
 
 [Service]
 Type=notify
-ExecStartPre=/bin/mkdir -p {{.MountPoint}}
+{{if .User}}User={{.User}}
+{{end}}ExecStartPre=/bin/mkdir -p {{.MountPoint}}
 ExecStart={{.RclonePath}} mount \
-    {{.Remote}}{{.RemotePath}} \
+    {{.Source}} \
     {{.MountPoint}} \
     {{.MountOptions}}
 ExecStop=/bin/fusermount -u {{.MountPoint}}
@@ -23,37 +24,53 @@ RestartSec=5s
 Environment="PATH=/usr/local/bin:/usr/bin:/bin"
 
 [Install]
-WantedBy=default.target
+WantedBy={{.WantedBy}}
 `
 
 // SyncServiceTemplate is the systemd service unit template for sync jobs.
 const SyncServiceTemplate = `[Unit]
-Description=Rclone sync: {{.Name}}
-Documentation=man:rclone(1)
+Description={{if .Description}}{{.Description}}{{else}}Rclone sync: {{.Name}}{{end}}
+Documentation={{if .DocumentationURL}}{{.DocumentationURL}} {{end}}man:rclone(1)
 After=network-online.target
 Wants=network-online.target
-{{if .RequireACPower}}ConditionACPower=true
+{{range .MountUnits}}After={{.}}
+{{if $.StopWithMount}}BindsTo={{.}}{{else}}Requires={{.}}{{end}}
+{{end}}{{if .RequireACPower}}ConditionACPower=true
+{{end}}{{if .StartLimitBurst}}StartLimitIntervalSec={{.StartLimitSec}}
+StartLimitBurst={{.StartLimitBurst}}
 {{end}}
 [Service]
 Type=oneshot
-{{if .RequireUnmetered}}ExecCondition=/bin/sh -c 'test "$(dbus-send --system --print-reply=literal --dest=org.freedesktop.NetworkManager /org/freedesktop/NetworkManager org.freedesktop.DBus.Properties.Get string:org.freedesktop.NetworkManager string:Metered 2>/dev/null | grep -o "\"[0-9]*\"" | tr -d "\"")" != "4" || exit 0; exit 1'
-{{end}}ExecStart={{.RclonePath}} {{.Direction}} \
+{{if .User}}User={{.User}}
+{{end}}{{if .TimeoutStartSec}}TimeoutStartSec={{.TimeoutStartSec}}
+{{end}}{{if .WorkingDir}}WorkingDirectory={{.WorkingDir}}
+{{end}}{{if .Nice}}Nice={{.Nice}}
+{{end}}{{if .IOSchedulingClass}}IOSchedulingClass={{.IOSchedulingClass}}
+{{end}}{{if .IOSchedulingPriority}}IOSchedulingPriority={{.IOSchedulingPriority}}
+{{end}}{{if .RequireUnmetered}}ExecCondition=/bin/sh -c 'test "$(dbus-send --system --print-reply=literal --dest=org.freedesktop.NetworkManager /org/freedesktop/NetworkManager org.freedesktop.DBus.Properties.Get string:org.freedesktop.NetworkManager string:Metered 2>/dev/null | grep -o "\"[0-9]*\"" | tr -d "\"")" != "4" || exit 0; exit 1'
+{{end}}{{if .ResyncCommand}}ExecStartPre={{range .SharedMountLocks}}{{$.FlockPath}} {{.}} {{end}}{{.ResyncCommand}}
+{{end}}ExecStart={{range .SharedMountLocks}}{{$.FlockPath}} {{.}} {{end}}{{.RclonePath}} {{.Direction}} \
     {{.Source}} \
     {{.Destination}} \
     {{.SyncOptions}}
-Environment="PATH=/usr/local/bin:/usr/bin:/bin"
+{{if .VerifyCommand}}ExecStartPost={{range .SharedMountLocks}}{{$.FlockPath}} {{.}} {{end}}{{.VerifyCommand}}
+{{end}}{{if .RestartSec}}Restart=on-failure
+RestartSec={{.RestartSec}}
+{{end}}Environment="PATH=/usr/local/bin:/usr/bin:/bin"
 MemoryMax=1G
 CPUQuota=50%
 
 [Install]
-WantedBy=default.target
+WantedBy={{.WantedBy}}
 `
 
 // SyncTimerTemplate is the systemd timer unit template for sync jobs.
 const SyncTimerTemplate = `[Unit]
-Description=Timer for rclone sync: {{.Name}}
-Documentation=man:rclone(1)
-
+Description=Timer for {{if .Description}}{{.Description}}{{else}}rclone sync: {{.Name}}{{end}}
+Documentation={{if .DocumentationURL}}{{.DocumentationURL}} {{end}}man:rclone(1)
+{{range .MountUnits}}After={{.}}
+BindsTo={{.}}
+{{end}}
 [Timer]
 {{.TimerDirectives}}
 
@@ -63,35 +80,59 @@ WantedBy=timers.target
 
 // MountUnitData contains data for mount service unit generation.
 type MountUnitData struct {
-	Name         string
-	Remote       string
-	RemotePath   string
-	MountPoint   string
-	ConfigPath   string
-	MountOptions string
-	LogLevel     string
-	LogPath      string
-	RclonePath   string
-}
-
-// SyncUnitData contains data for sync service unit generation.
-type SyncUnitData struct {
 	Name             string
-	Source           string
-	Destination      string
-	Direction        string
+	Description      string // From the mount's description or notes; a default is used if empty
+	DocumentationURL string
+	Source           string // remote:path, see models.MountConfig.FullRemotePath
+	MountPoint       string
 	ConfigPath       string
-	SyncOptions      string
+	MountOptions     string
 	LogLevel         string
 	LogPath          string
 	RclonePath       string
-	RequireACPower   bool
-	RequireUnmetered bool
-	ExecCondition    string
+	User             string // User= of a system unit, omitted if empty
+	WantedBy         string // Target the unit is enabled under
+}
+
+// SyncUnitData contains data for sync service unit generation.
+type SyncUnitData struct {
+	Name                 string
+	Description          string // From the job's description or notes; a default is used if empty
+	DocumentationURL     string
+	Source               string
+	Destination          string
+	Direction            string
+	ConfigPath           string
+	SyncOptions          string
+	LogLevel             string
+	LogPath              string
+	RclonePath           string
+	RequireACPower       bool
+	RequireUnmetered     bool
+	ExecCondition        string
+	VerifyCommand        string
+	ResyncCommand        string   // Bisync --resync run before the first regular run, omitted if empty
+	WorkingDir           string   // WorkingDirectory of the service, omitted if empty
+	TimeoutStartSec      int      // Seconds before systemd stops a run, omitted if zero
+	Nice                 int      // Omitted if zero
+	IOSchedulingClass    string   // best-effort or idle, omitted if empty
+	IOSchedulingPriority int      // Omitted if zero
+	MountUnits           []string // Services of the mounts the job requires
+	StopWithMount        bool     // Bind to MountUnits instead of just requiring them
+	SharedMountLocks     []string // Lock files held while syncing and verifying, one per required mount
+	FlockPath            string
+	RestartSec           string // Delay before retrying a failed run; no retries if empty
+	StartLimitBurst      int    // Runs allowed within StartLimitSec: the first plus its retries
+	StartLimitSec        int    // StartLimitIntervalSec, long enough to span every attempt
+	User                 string // User= of a system unit, omitted if empty
+	WantedBy             string // Target the unit is enabled under
 }
 
 // TimerUnitData contains data for timer unit generation.
 type TimerUnitData struct {
-	Name            string
-	TimerDirectives string
+	Name             string
+	Description      string
+	DocumentationURL string
+	TimerDirectives  string
+	MountUnits       []string // Mount services the timer is bound to, if any
 }
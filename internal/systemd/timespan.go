@@ -0,0 +1,70 @@
+package systemd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// timeSpanUnits are the units systemd accepts in a time span and their
+// lengths, see systemd.time(7).
+var timeSpanUnits = map[string]time.Duration{
+	"usec": time.Microsecond, "us": time.Microsecond, "µs": time.Microsecond,
+	"msec": time.Millisecond, "ms": time.Millisecond,
+	"seconds": time.Second, "second": time.Second, "sec": time.Second, "s": time.Second,
+	"minutes": time.Minute, "minute": time.Minute, "min": time.Minute, "m": time.Minute,
+	"hours": time.Hour, "hour": time.Hour, "hr": time.Hour, "h": time.Hour,
+	"days": 24 * time.Hour, "day": 24 * time.Hour, "d": 24 * time.Hour,
+	"weeks": 7 * 24 * time.Hour, "week": 7 * 24 * time.Hour, "w": 7 * 24 * time.Hour,
+	"months": 2629800 * time.Second, "month": 2629800 * time.Second, "M": 2629800 * time.Second,
+	"years": 31557600 * time.Second, "year": 31557600 * time.Second, "y": 31557600 * time.Second,
+}
+
+// ValidateTimeSpan checks that s is a systemd time span, such as "30min",
+// "1h 30min" or "90" (seconds). An empty value is valid.
+func ValidateTimeSpan(s string) error {
+	_, err := parseTimeSpan(s)
+	return err
+}
+
+// parseTimeSpan parses a systemd time span, returning zero when it is
+// empty.
+func parseTimeSpan(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid time span %q (use e.g. 30min or 1h)", s)
+	var total time.Duration
+	rest := strings.TrimSpace(s)
+	for rest != "" {
+		// A number, possibly with a fraction
+		digits := strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' })
+		if digits == -1 {
+			digits = len(rest)
+		}
+		number := rest[:digits]
+		if number == "" || strings.Count(number, ".") > 1 || strings.HasPrefix(number, ".") || strings.HasSuffix(number, ".") {
+			return 0, invalid
+		}
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return 0, invalid
+		}
+		rest = strings.TrimLeft(rest[digits:], " ")
+
+		// An optional unit, seconds when left out
+		letters := strings.IndexFunc(rest, func(r rune) bool { return !unicode.IsLetter(r) })
+		if letters == -1 {
+			letters = len(rest)
+		}
+		unit := time.Second
+		if name := rest[:letters]; name != "" {
+			var ok bool
+			if unit, ok = timeSpanUnits[name]; !ok {
+				return 0, invalid
+			}
+		}
+		total += time.Duration(value * float64(unit))
+		rest = strings.TrimLeft(rest[letters:], " ")
+	}
+	return total, nil
+}
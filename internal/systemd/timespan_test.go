@@ -0,0 +1,60 @@
+package systemd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateTimeSpan(t *testing.T) {
+	tests := []struct {
+		span    string
+		wantErr bool
+	}{
+		{"", false},
+		{"30min", false},
+		{"1h", false},
+		{"1h 30min", false},
+		{"1h30min", false},
+		{"90", false},
+		{"2.5h", false},
+		{"500ms", false},
+		{"1 week", false},
+		{"10m", false},
+		{"1M", false},
+		{"half an hour", true},
+		{"30 minuten", true},
+		{"h", true},
+		{"-5min", true},
+		{"1..5h", true},
+		{"5min;", true},
+	}
+	for _, tt := range tests {
+		if err := ValidateTimeSpan(tt.span); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateTimeSpan(%q) error = %v, wantErr %v", tt.span, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseTimeSpan(t *testing.T) {
+	tests := []struct {
+		span string
+		want time.Duration
+	}{
+		{"", 0},
+		{"90", 90 * time.Second},
+		{"30s", 30 * time.Second},
+		{"1h 30min", 90 * time.Minute},
+		{"2.5min", 150 * time.Second},
+		{"500ms", 500 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		got, err := parseTimeSpan(tt.span)
+		if err != nil {
+			t.Errorf("parseTimeSpan(%q) error = %v", tt.span, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseTimeSpan(%q) = %v, want %v", tt.span, got, tt.want)
+		}
+	}
+}
@@ -2,8 +2,11 @@
 package tui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -27,6 +30,8 @@ const (
 	ScreenServices
 	ScreenSettings
 	ScreenHelp
+	ScreenBackups
+	ScreenRemotes
 )
 
 // String returns the string representation of a screen.
@@ -44,6 +49,10 @@ func (s Screen) String() string {
 		return "Settings"
 	case ScreenHelp:
 		return "Help"
+	case ScreenBackups:
+		return "Config Backups"
+	case ScreenRemotes:
+		return "Remotes"
 	default:
 		return "Unknown"
 	}
@@ -65,6 +74,21 @@ type ReconciliationMsg struct {
 	Result *systemd.ReconciliationResult
 }
 
+// configWatchDebounce is how long config.yaml must be quiet before an
+// external change to it is handled.
+const configWatchDebounce = 500 * time.Millisecond
+
+// ConfigFileChangedMsg is sent when the watched config file was written.
+type ConfigFileChangedMsg struct {
+	watcher *config.Watcher
+}
+
+// UnitsRegeneratedMsg is sent when the unit files have been rewritten after
+// an external config change.
+type UnitsRegeneratedMsg struct {
+	Err error
+}
+
 // App is the main TUI application model.
 type App struct {
 	currentScreen  Screen
@@ -85,6 +109,8 @@ type App struct {
 	syncJobs *screens.SyncJobsScreen
 	services *screens.ServicesScreen
 	settings *screens.SettingsScreen
+	backups  *screens.BackupsScreen
+	remotes  *screens.RemotesScreen
 
 	// Services
 	config    *config.Config
@@ -92,6 +118,26 @@ type App struct {
 	generator *systemd.Generator
 	manager   *systemd.Manager
 
+	// Active config file, shown in the header
+	configPath string
+
+	// Theme the styles were last switched to
+	theme string
+
+	// Instance marker for the active config, and a warning when another
+	// running copy appears to manage the same config and units
+	instance        *config.InstanceMarker
+	instanceWarning string
+
+	// Runtime config directory switching
+	configSwitchConfirm *components.ConfirmDialog
+	pendingConfigDir    string
+
+	// Watching config.yaml for external edits, when enabled in the settings
+	watcher             *config.Watcher
+	configChangeConfirm *components.ConfirmDialog
+	notice              string
+
 	// Orphan detection
 	orphans          *systemd.ReconciliationResult
 	showOrphanPrompt bool
@@ -110,6 +156,8 @@ func NewApp() *App {
 		syncJobs:       screens.NewSyncJobsScreen(),
 		services:       screens.NewServicesScreen(),
 		settings:       screens.NewSettingsScreen(),
+		backups:        screens.NewBackupsScreen(),
+		remotes:        screens.NewRemotesScreen(),
 	}
 }
 
@@ -129,49 +177,66 @@ func (a *App) initializeServices() tea.Msg {
 		return AppInitError{Err: err}
 	}
 	a.config = cfg
+	if path, err := config.ConfigPath(); err == nil {
+		a.configPath = path
+	}
+	a.claimInstance()
 
 	// Initialize rclone client
 	a.rclone = rclone.NewClient()
 
 	// Initialize systemd generator
-	gen, err := systemd.NewGenerator()
+	scope, err := systemd.ParseScope(cfg.Settings.Scope)
+	if err != nil {
+		return AppInitError{Err: err}
+	}
+	gen, err := systemd.NewGeneratorWithScope(scope)
 	if err != nil {
 		return AppInitError{Err: err}
 	}
 	a.generator = gen
+	a.applyDefaultExtraArgs()
+	a.applyTheme()
+	a.applyKeyBindings()
 
 	// Initialize systemd manager
-	a.manager = systemd.NewManager()
+	a.manager = systemd.NewManagerWithScope(scope)
 
 	// Pass services to screens
 	a.mounts.SetServices(cfg, a.rclone, gen, a.manager)
 	a.syncJobs.SetServices(cfg, a.rclone, gen, a.manager)
 	a.services.SetServices(cfg, a.manager, gen)
 	a.settings.SetConfig(cfg)
+	a.remotes.SetServices(a.rclone)
 
 	// Run reconciliation to detect orphaned units
-	reconciler := systemd.NewReconciler(gen, a.manager)
+	if result := a.scanOrphans(); result != nil {
+		return ReconciliationMsg{Result: result}
+	}
+
+	return AppInitDone{}
+}
+
+// scanOrphans looks for unit files that no mount or sync job in the config
+// owns. It returns nil if there are none or the scan fails.
+func (a *App) scanOrphans() *systemd.ReconciliationResult {
+	reconciler := systemd.NewReconciler(a.generator, a.manager)
 
 	// Build sets of valid IDs
 	mountIDs := make(map[string]bool)
-	for _, m := range cfg.Mounts {
+	for _, m := range a.config.Mounts {
 		mountIDs[m.ID] = true
 	}
 	syncIDs := make(map[string]bool)
-	for _, j := range cfg.SyncJobs {
+	for _, j := range a.config.SyncJobs {
 		syncIDs[j.ID] = true
 	}
 
 	result, err := reconciler.ScanForOrphans(mountIDs, syncIDs)
-	if err != nil {
-		return AppInitDone{}
-	}
-
-	if len(result.OrphanedUnits) > 0 {
-		return ReconciliationMsg{Result: result}
+	if err != nil || len(result.OrphanedUnits) == 0 {
+		return nil
 	}
-
-	return AppInitDone{}
+	return result
 }
 
 // AppInitError is sent when app initialization fails.
@@ -184,14 +249,63 @@ type AppInitDone struct{}
 
 // Update handles application updates.
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer a.stopAutoRefreshOnLeave(a.currentScreen)
+
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		a.notice = ""
+
+		if a.configSwitchConfirm != nil {
+			return a.updateConfigSwitchConfirm(msg)
+		}
+
+		if a.configChangeConfirm != nil {
+			return a.updateConfigChangeConfirm(msg)
+		}
+
 		if a.showOrphanPrompt {
 			return a.updateOrphanPrompt(msg)
 		}
 
+		// Let an in-place text edit receive q, esc and ? as typed keys
+		if a.currentScreen == ScreenSettings && a.settings.IsInlineEditing() && msg.String() != "ctrl+c" {
+			model, cmd := a.settings.Update(msg)
+			if m, ok := model.(*screens.SettingsScreen); ok {
+				a.settings = m
+			}
+			return a, cmd
+		}
+		if a.currentScreen == ScreenMounts && (a.mounts.IsBulkEditing() || a.mounts.IsSearching()) && msg.String() != "ctrl+c" {
+			model, cmd := a.mounts.Update(msg)
+			if m, ok := model.(*screens.MountsScreen); ok {
+				a.mounts = m
+			}
+			return a, cmd
+		}
+		if a.currentScreen == ScreenServices && a.services.IsSearchingLogs() && msg.String() != "ctrl+c" {
+			model, cmd := a.services.Update(msg)
+			if m, ok := model.(*screens.ServicesScreen); ok {
+				a.services = m
+			}
+			return a, cmd
+		}
+		if a.currentScreen == ScreenSyncJobs && (a.syncJobs.IsEnteringFetch() || a.syncJobs.IsBulkEditing() || a.syncJobs.IsSearching()) && msg.String() != "ctrl+c" {
+			model, cmd := a.syncJobs.Update(msg)
+			if m, ok := model.(*screens.SyncJobsScreen); ok {
+				a.syncJobs = m
+			}
+			return a, cmd
+		}
+		if a.currentScreen == ScreenRemotes && a.remotes.IsEditing() && msg.String() != "ctrl+c" {
+			model, cmd := a.remotes.Update(msg)
+			if m, ok := model.(*screens.RemotesScreen); ok {
+				a.remotes = m
+			}
+			return a, cmd
+		}
+
 		// Handle global keybindings
 		switch msg.String() {
 		case "ctrl+c":
@@ -231,6 +345,11 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.showHelp = false
 				return a, nil
 			}
+			// An open log viewer closes back to its list, and a filtered
+			// list clears its filter
+			if a.isViewingLogs() || a.hasListFilter() {
+				break
+			}
 			if a.currentScreen != ScreenMain {
 				a.currentScreen = ScreenMain
 				return a, nil
@@ -251,10 +370,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.height = msg.Height
 		// Propagate size to all screens
 		a.mainMenu.SetSize(a.width, a.height)
-		a.mounts.SetSize(a.width, a.height)
-		a.syncJobs.SetSize(a.width, a.height)
-		a.services.SetSize(a.width, a.height)
+		a.resizeListScreens()
 		a.settings.SetSize(a.width, a.height)
+		a.backups.SetSize(a.width, a.height)
+		a.remotes.SetSize(a.width, a.height)
 
 	case ScreenChangeMsg:
 		a.currentScreen = msg.Screen
@@ -268,10 +387,95 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ReconciliationMsg:
 		a.orphans = msg.Result
 		a.showOrphanPrompt = len(msg.Result.OrphanedUnits) > 0
-		cmds = append(cmds, a.mounts.Init(), a.syncJobs.Init(), a.services.Init())
+		a.resizeListScreens()
+		cmds = append(cmds, a.mounts.Init(), a.syncJobs.Init(), a.services.Init(), a.syncConfigWatcher())
 
 	case AppInitDone:
-		cmds = append(cmds, a.mounts.Init(), a.syncJobs.Init(), a.services.Init())
+		a.resizeListScreens()
+		cmds = append(cmds, a.mounts.Init(), a.syncJobs.Init(), a.services.Init(), a.syncConfigWatcher())
+
+	case ConfigFileChangedMsg:
+		// A message from a watcher that has since been replaced is stale
+		if msg.watcher != a.watcher {
+			return a, nil
+		}
+		cmds = append(cmds, waitForConfigChange(a.watcher))
+		if a.config != nil && a.configChangeConfirm == nil && a.config.ChangedOnDisk() {
+			cmds = append(cmds, a.reloadChangedConfig())
+		}
+		return a, tea.Batch(cmds...)
+
+	case UnitsRegeneratedMsg:
+		if msg.Err != nil {
+			a.notice = fmt.Sprintf("Failed to regenerate units: %v", msg.Err)
+		} else {
+			a.notice = "Unit files regenerated from config.yaml"
+		}
+		return a, func() tea.Msg {
+			if result := a.scanOrphans(); result != nil {
+				return ReconciliationMsg{Result: result}
+			}
+			return nil
+		}
+
+	case screens.BackupRestoredMsg:
+		// Pick up the restored config everywhere, not just on the backups screen
+		if msg.Err == nil && a.config != nil {
+			if err := a.config.Reload(); err != nil {
+				msg.Err = fmt.Errorf("restored, but failed to reload config: %w", err)
+			}
+			a.applyDefaultExtraArgs()
+			a.applyTheme()
+			a.applyKeyBindings()
+			a.resizeListScreens()
+			cmds = append(cmds, a.mounts.Init(), a.syncJobs.Init(), a.services.Init())
+		}
+		model, cmd := a.backups.Update(msg)
+		if m, ok := model.(*screens.BackupsScreen); ok {
+			a.backups = m
+		}
+		cmds = append(cmds, cmd)
+		return a, tea.Batch(cmds...)
+
+	case screens.FetchProgressMsg, screens.FetchDoneMsg, screens.SyncDryRunOutputMsg, screens.SyncDryRunDoneMsg:
+		// A fetch or dry run keeps reporting to the sync jobs screen while elsewhere
+		model, cmd := a.syncJobs.Update(msg)
+		if m, ok := model.(*screens.SyncJobsScreen); ok {
+			a.syncJobs = m
+		}
+		return a, cmd
+
+	case screens.RemoteAuthURLMsg, screens.RemoteCreatedMsg:
+		// An authorization keeps reporting to the remotes screen while elsewhere
+		model, cmd := a.remotes.Update(msg)
+		if m, ok := model.(*screens.RemotesScreen); ok {
+			a.remotes = m
+		}
+		return a, cmd
+
+	case screens.ConfigDirSelectedMsg:
+		if a.mounts.HasUnsavedChanges() || a.syncJobs.HasUnsavedChanges() {
+			a.pendingConfigDir = msg.Path
+			a.configSwitchConfirm = components.NewSimpleConfirmDialog(
+				"Switch Config Directory",
+				"A mount or sync job form has unsaved changes. Discard them and switch?",
+			)
+			a.configSwitchConfirm.SetSize(a.width, a.height)
+			return a, nil
+		}
+		return a, a.switchConfigDir(msg.Path)
+
+	case ConfigSwitchedMsg:
+		if msg.Err != nil {
+			a.settings.SetMessage(fmt.Sprintf("Failed to open config: %v", msg.Err), "error")
+		} else {
+			a.settings.SetMessage(fmt.Sprintf("Using config %s", components.ContractHome(a.configPath)), "success")
+		}
+		if msg.Next != nil {
+			next := msg.Next
+			cmds = append(cmds, func() tea.Msg { return next })
+		}
+		return a, tea.Batch(cmds...)
 
 	case OrphanActionMsg:
 		a.loading = false
@@ -320,6 +524,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.currentScreen = ScreenSyncJobs
 			case "services":
 				a.currentScreen = ScreenServices
+			case "backups":
+				a.currentScreen = ScreenBackups
+				cmds = append(cmds, a.backups.Init())
+			case "remotes":
+				a.currentScreen = ScreenRemotes
+				cmds = append(cmds, a.remotes.Init())
 			case "settings":
 				a.currentScreen = ScreenSettings
 			case "quit":
@@ -373,11 +583,44 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		cmds = append(cmds, cmd)
 
+		// Settings may have changed the default unit flags, fixed width,
+		// theme or config watching
+		a.applyDefaultExtraArgs()
+		a.applyTheme()
+		a.resizeListScreens()
+		cmds = append(cmds, a.syncConfigWatcher())
+
 		// Check if settings screen wants to go back
 		if a.settings.ShouldGoBack() {
 			a.settings.ResetGoBack()
 			a.currentScreen = ScreenMain
 		}
+
+	case ScreenBackups:
+		model, cmd := a.backups.Update(msg)
+		if m, ok := model.(*screens.BackupsScreen); ok {
+			a.backups = m
+		}
+		cmds = append(cmds, cmd)
+
+		// Check if backups screen wants to go back
+		if a.backups.ShouldGoBack() {
+			a.backups.ResetGoBack()
+			a.currentScreen = ScreenMain
+		}
+
+	case ScreenRemotes:
+		model, cmd := a.remotes.Update(msg)
+		if m, ok := model.(*screens.RemotesScreen); ok {
+			a.remotes = m
+		}
+		cmds = append(cmds, cmd)
+
+		// Check if remotes screen wants to go back
+		if a.remotes.ShouldGoBack() {
+			a.remotes.ResetGoBack()
+			a.currentScreen = ScreenMain
+		}
 	}
 
 	return a, tea.Batch(cmds...)
@@ -415,6 +658,10 @@ func (a *App) View() string {
 		content = a.services.View()
 	case ScreenSettings:
 		content = a.settings.View()
+	case ScreenBackups:
+		content = a.backups.View()
+	case ScreenRemotes:
+		content = a.remotes.View()
 	case ScreenHelp:
 		content = a.renderHelp()
 	}
@@ -435,6 +682,24 @@ func (a *App) View() string {
 		status,
 	)
 
+	// Show config switch confirmation if needed
+	if a.configSwitchConfirm != nil {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			header,
+			lipgloss.NewStyle().Width(a.width).Height(contentHeight).Render(a.configSwitchConfirm.View()),
+			status,
+		)
+	}
+
+	// Offer to regenerate units after an external config change
+	if a.configChangeConfirm != nil {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			header,
+			lipgloss.NewStyle().Width(a.width).Height(contentHeight).Render(a.configChangeConfirm.View()),
+			status,
+		)
+	}
+
 	// Show orphan prompt overlay if needed
 	if a.showOrphanPrompt && a.orphans != nil {
 		view = a.renderOrphanPrompt(view)
@@ -445,7 +710,11 @@ func (a *App) View() string {
 
 // renderHeader renders the top header bar.
 func (a *App) renderHeader() string {
-	return components.TitleBar(a.width, "Rclone Mount Sync", Version)
+	title := "Rclone Mount Sync"
+	if a.configPath != "" {
+		title += "  " + components.GetDisplayPath(a.configPath, a.width/2)
+	}
+	return components.TitleBar(a.width, title, Version)
 }
 
 // renderStatusBar renders the bottom status bar.
@@ -453,6 +722,10 @@ func (a *App) renderStatusBar() string {
 	var statusText string
 	if a.showHelp {
 		statusText = "Press Esc or q to close help"
+	} else if a.notice != "" {
+		statusText = fmt.Sprintf("%s | ?: Help | q: Quit", a.notice)
+	} else if a.instanceWarning != "" {
+		statusText = fmt.Sprintf("⚠ %s | ?: Help | q: Quit", a.instanceWarning)
 	} else {
 		statusText = fmt.Sprintf("Screen: %s | ?: Help | q: Quit", a.currentScreen.String())
 	}
@@ -494,6 +767,8 @@ func (a *App) renderHelp() string {
 		{Key: "M", Desc: "Mount Management"},
 		{Key: "S", Desc: "Sync Job Management"},
 		{Key: "V", Desc: "Service Status"},
+		{Key: "B", Desc: "Config Backups"},
+		{Key: "R", Desc: "Remotes"},
 		{Key: "T", Desc: "Settings"},
 	}
 
@@ -509,13 +784,18 @@ func (a *App) renderHelp() string {
 	// Mount screen keybindings
 	b.WriteString(components.Styles.Subtitle.Render("Mount Management") + "\n")
 	mountKeys := []components.HelpItem{
-		{Key: "a", Desc: "Add new mount"},
-		{Key: "e", Desc: "Edit selected mount"},
-		{Key: "d", Desc: "Delete selected mount"},
-		{Key: "s", Desc: "Start mount"},
-		{Key: "x", Desc: "Stop mount"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionAdd)), Desc: "Add new mount"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionEdit)), Desc: "Edit selected mount"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionDelete)), Desc: "Delete selected mount"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionStart)), Desc: "Start mount"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionStop)), Desc: "Stop mount"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionFavorite)), Desc: "Pin/unpin to top"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionMark)), Desc: "Mark/unmark for bulk edit"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionBulkEdit)), Desc: "Bulk edit marked mounts"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionLogs)), Desc: "View logs"},
 		{Key: "Enter", Desc: "View details"},
-		{Key: "r", Desc: "Refresh status"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionRefresh)), Desc: "Refresh status"},
+		{Key: keyLabel(screens.MountKeys.Key(screens.ActionAutoRefresh)), Desc: "Toggle auto-refresh"},
 	}
 
 	for _, item := range mountKeys {
@@ -530,11 +810,16 @@ func (a *App) renderHelp() string {
 	// Sync job screen keybindings
 	b.WriteString(components.Styles.Subtitle.Render("Sync Job Management") + "\n")
 	syncKeys := []components.HelpItem{
-		{Key: "a", Desc: "Add new sync job"},
-		{Key: "e", Desc: "Edit selected sync job"},
-		{Key: "d", Desc: "Delete selected sync job"},
-		{Key: "r", Desc: "Run sync job now"},
-		{Key: "t", Desc: "Toggle timer"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionAdd)), Desc: "Add new sync job"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionEdit)), Desc: "Edit selected sync job"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionDelete)), Desc: "Delete selected sync job"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionRun)), Desc: "Run sync job now"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionToggle)), Desc: "Toggle timer"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionFavorite)), Desc: "Pin/unpin to top"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionMark)), Desc: "Mark/unmark for bulk edit"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionBulkEdit)), Desc: "Bulk edit marked sync jobs"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionLogs)), Desc: "View logs"},
+		{Key: keyLabel(screens.SyncJobKeys.Key(screens.ActionFetch)), Desc: "Fetch a URL or remote path once"},
 	}
 
 	for _, item := range syncKeys {
@@ -549,12 +834,15 @@ func (a *App) renderHelp() string {
 	// Services screen keybindings
 	b.WriteString(components.Styles.Subtitle.Render("Service Status") + "\n")
 	serviceKeys := []components.HelpItem{
-		{Key: "s", Desc: "Start service"},
-		{Key: "x", Desc: "Stop service"},
-		{Key: "e", Desc: "Enable service"},
-		{Key: "d", Desc: "Disable service"},
-		{Key: "l", Desc: "View logs"},
-		{Key: "r", Desc: "Refresh status"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionStart)), Desc: "Start service"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionStop)), Desc: "Stop service"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionRestart)), Desc: "Restart service"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionEnable)), Desc: "Enable service"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionDisable)), Desc: "Disable service"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionLogs)), Desc: "View logs"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionRefresh)), Desc: "Refresh status"},
+		{Key: keyLabel(screens.ServiceKeys.Key(screens.ActionAutoRefresh)), Desc: "Toggle auto-refresh"},
+		{Key: "b", Desc: "Bulk start/restart"},
 	}
 
 	for _, item := range serviceKeys {
@@ -564,6 +852,40 @@ func (a *App) renderHelp() string {
 		b.WriteString(line + "\n")
 	}
 
+	b.WriteString("\n")
+
+	// Backups screen keybindings
+	b.WriteString(components.Styles.Subtitle.Render("Config Backups") + "\n")
+	backupKeys := []components.HelpItem{
+		{Key: "Enter", Desc: "Diff against current config"},
+		{Key: "r", Desc: "Restore backup"},
+		{Key: "d", Desc: "Delete backup"},
+		{Key: "R", Desc: "Refresh list"},
+	}
+
+	for _, item := range backupKeys {
+		line := fmt.Sprintf("  %s  %s",
+			components.Styles.MenuKey.Render(item.Key),
+			components.Styles.Normal.Render(item.Desc))
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n")
+
+	// Remotes screen keybindings
+	b.WriteString(components.Styles.Subtitle.Render("Remotes") + "\n")
+	remoteKeys := []components.HelpItem{
+		{Key: "n", Desc: "Create a remote"},
+		{Key: "R", Desc: "Refresh list"},
+	}
+
+	for _, item := range remoteKeys {
+		line := fmt.Sprintf("  %s  %s",
+			components.Styles.MenuKey.Render(item.Key),
+			components.Styles.Normal.Render(item.Desc))
+		b.WriteString(line + "\n")
+	}
+
 	// Get the full content
 	fullContent := b.String()
 	lines := strings.Split(fullContent, "\n")
@@ -656,6 +978,78 @@ func (a *App) renderInitError() string {
 	return b.String()
 }
 
+// applyDefaultExtraArgs passes the configured default unit flags to the
+// generator.
+func (a *App) applyDefaultExtraArgs() {
+	if a.generator == nil || a.config == nil {
+		return
+	}
+	a.generator.SetDefaultExtraArgs(a.config.Defaults.Mount.ExtraFlags, a.config.Defaults.Sync.ExtraFlags)
+}
+
+// applyTheme switches the styles to the configured theme when it changes.
+func (a *App) applyTheme() {
+	if a.config == nil {
+		return
+	}
+	if theme := components.ResolveTheme(a.config.Settings.Theme); theme != a.theme {
+		a.theme = components.ApplyTheme(theme)
+	}
+}
+
+// applyKeyBindings passes the configured key bindings to the screens,
+// noting any that had to be dropped.
+func (a *App) applyKeyBindings() {
+	if a.config == nil {
+		return
+	}
+	if err := screens.SetKeyBindings(a.config.Keybindings); err != nil {
+		a.notice = err.Error()
+	}
+}
+
+// keyLabel renders a key for the help screen.
+func keyLabel(key string) string {
+	if key == " " {
+		return "Space"
+	}
+	return key
+}
+
+// resizeListScreens passes the terminal size to the list screens, capped
+// by the fixed width setting.
+func (a *App) resizeListScreens() {
+	width := a.width
+	if a.config != nil {
+		width = a.config.Settings.RenderWidth(a.width)
+	}
+	a.mounts.SetSize(width, a.height)
+	a.syncJobs.SetSize(width, a.height)
+	a.services.SetSize(width, a.height)
+}
+
+// hasListFilter reports whether the current screen's list is filtered.
+func (a *App) hasListFilter() bool {
+	switch a.currentScreen {
+	case ScreenMounts:
+		return a.mounts.HasSearchFilter()
+	case ScreenSyncJobs:
+		return a.syncJobs.HasSearchFilter()
+	}
+	return false
+}
+
+// isViewingLogs reports whether the current screen has its log viewer open.
+func (a *App) isViewingLogs() bool {
+	switch a.currentScreen {
+	case ScreenMounts:
+		return a.mounts.IsViewingLogs()
+	case ScreenSyncJobs:
+		return a.syncJobs.IsViewingLogs()
+	}
+	return false
+}
+
 func (a *App) updateOrphanPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if a.loading {
 		return a, nil
@@ -854,7 +1248,7 @@ func (a *App) renderOrphanPrompt(baseView string) string {
 		Width(boxWidth).
 		Padding(1, 2).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("3")).
+		BorderForeground(components.ColorWarning).
 		Render(promptContent)
 
 	overlay := lipgloss.Place(a.width, a.height,
@@ -868,6 +1262,11 @@ func (a *App) renderOrphanPrompt(baseView string) string {
 
 // Run starts the TUI application.
 func Run() error {
+	// Cancelled on exit so ticks and fetches started by the screens stop
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	screens.SetRootContext(ctx)
+
 	app := NewApp()
 	p := tea.NewProgram(
 		app,
@@ -875,5 +1274,212 @@ func Run() error {
 		tea.WithMouseCellMotion(),
 	)
 	_, err := p.Run()
+	cancel()
+	if app.watcher != nil {
+		_ = app.watcher.Close()
+	}
+	_ = app.instance.Release()
 	return err
 }
+
+// claimInstance marks the active config as in use by this process and
+// records a warning if another live instance already holds it.
+func (a *App) claimInstance() {
+	_ = a.instance.Release()
+	a.instance = nil
+	a.instanceWarning = ""
+
+	marker, other, err := config.ClaimInstance()
+	if err != nil {
+		return
+	}
+	a.instance = marker
+	if other != nil {
+		a.instanceWarning = fmt.Sprintf("Another instance (%s) may be managing this config", other)
+	}
+}
+
+// stopAutoRefreshOnLeave stops the auto-refresh ticks of a status screen,
+// and any log follow, once it is no longer the current screen.
+func (a *App) stopAutoRefreshOnLeave(prev Screen) {
+	if prev == a.currentScreen {
+		return
+	}
+	switch prev {
+	case ScreenMounts:
+		a.mounts.StopAutoRefresh()
+	case ScreenServices:
+		a.services.StopAutoRefresh()
+		a.services.StopFollowingLogs()
+	}
+}
+
+// ConfigSwitchedMsg is sent when switching the config directory at runtime
+// has finished. Next carries the initialization result for the new config.
+type ConfigSwitchedMsg struct {
+	Path string
+	Next tea.Msg
+	Err  error
+}
+
+// updateConfigSwitchConfirm handles the unsaved changes confirmation shown
+// before switching the config directory.
+func (a *App) updateConfigSwitchConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	model, _ := a.configSwitchConfirm.Update(msg)
+	if d, ok := model.(*components.ConfirmDialog); ok {
+		a.configSwitchConfirm = d
+	}
+
+	if !a.configSwitchConfirm.IsDone() {
+		return a, nil
+	}
+
+	confirmed := a.configSwitchConfirm.GetSelectedAction() == 1
+	path := a.pendingConfigDir
+	a.configSwitchConfirm = nil
+	a.pendingConfigDir = ""
+
+	if !confirmed {
+		a.settings.SetMessage("Config switch cancelled", "info")
+		return a, nil
+	}
+	return a, a.switchConfigDir(path)
+}
+
+// syncConfigWatcher starts or stops watching config.yaml to match the
+// WatchConfig setting and the active config path. It returns the command
+// that waits for the first change when a watcher is started.
+func (a *App) syncConfigWatcher() tea.Cmd {
+	want := a.config != nil && a.config.Settings.WatchConfig && a.configPath != ""
+	if want && a.watcher != nil && a.watcher.Path() == a.configPath {
+		return nil
+	}
+	if a.watcher != nil {
+		_ = a.watcher.Close()
+		a.watcher = nil
+	}
+	if !want {
+		return nil
+	}
+
+	w, err := config.NewWatcher(a.configPath, configWatchDebounce)
+	if err != nil {
+		a.notice = fmt.Sprintf("Cannot watch config: %v", err)
+		return nil
+	}
+	a.watcher = w
+	return waitForConfigChange(w)
+}
+
+// waitForConfigChange waits for the next change reported by w. It returns no
+// message once w is closed.
+func waitForConfigChange(w *config.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		if _, ok := <-w.Changes(); !ok {
+			return nil
+		}
+		return ConfigFileChangedMsg{watcher: w}
+	}
+}
+
+// reloadChangedConfig picks up a config.yaml changed by another program and
+// asks whether to regenerate the unit files to match it.
+func (a *App) reloadChangedConfig() tea.Cmd {
+	if err := a.config.Reload(); err != nil {
+		a.notice = fmt.Sprintf("config.yaml changed but could not be reloaded: %v", err)
+		return nil
+	}
+	a.applyDefaultExtraArgs()
+	a.applyTheme()
+	a.applyKeyBindings()
+	a.settings.SetConfig(a.config)
+	a.resizeListScreens()
+
+	a.configChangeConfirm = components.NewSimpleConfirmDialog(
+		"Config Changed",
+		"config.yaml was changed outside the app and has been reloaded. Regenerate the unit files to match it?",
+	)
+	a.configChangeConfirm.SetSize(a.width, a.height)
+	return tea.Batch(a.mounts.Init(), a.syncJobs.Init(), a.services.Init())
+}
+
+// updateConfigChangeConfirm handles keys while the regenerate prompt is open.
+func (a *App) updateConfigChangeConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	model, _ := a.configChangeConfirm.Update(msg)
+	if d, ok := model.(*components.ConfirmDialog); ok {
+		a.configChangeConfirm = d
+	}
+
+	if !a.configChangeConfirm.IsDone() {
+		return a, nil
+	}
+
+	confirmed := a.configChangeConfirm.GetSelectedAction() == 1
+	a.configChangeConfirm = nil
+	if !confirmed {
+		a.notice = "Unit files left as they were; they may not match config.yaml"
+		return a, nil
+	}
+	return a, a.regenerateAllUnits
+}
+
+// regenerateAllUnits rewrites the unit files of every mount and sync job and
+// reloads systemd.
+func (a *App) regenerateAllUnits() tea.Msg {
+	if a.config == nil || a.generator == nil || a.manager == nil {
+		return UnitsRegeneratedMsg{Err: fmt.Errorf("services not initialized")}
+	}
+
+	var errs []error
+	for i := range a.config.Mounts {
+		if _, err := a.generator.WriteMountService(&a.config.Mounts[i]); err != nil {
+			errs = append(errs, fmt.Errorf("mount %s: %w", a.config.Mounts[i].Name, err))
+		}
+	}
+	for i := range a.config.SyncJobs {
+		if _, _, err := a.generator.WriteSyncUnits(&a.config.SyncJobs[i]); err != nil {
+			errs = append(errs, fmt.Errorf("sync job %s: %w", a.config.SyncJobs[i].Name, err))
+		}
+	}
+	if err := a.manager.DaemonReload(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to reload systemd daemon: %w", err))
+	}
+	return UnitsRegeneratedMsg{Err: errors.Join(errs...)}
+}
+
+// switchConfigDir reloads the config from a different directory and
+// reinitializes the generator, manager and screens for it. Open forms are
+// discarded. If the new config cannot be loaded, the previous directory is
+// restored.
+func (a *App) switchConfigDir(path string) tea.Cmd {
+	// Start the data screens afresh so no state from the old config leaks over
+	a.mounts = screens.NewMountsScreen()
+	a.syncJobs = screens.NewSyncJobsScreen()
+	a.services = screens.NewServicesScreen()
+	a.resizeListScreens()
+	a.orphans = nil
+	a.showOrphanPrompt = false
+
+	return func() tea.Msg {
+		previous := config.ConfigHome()
+		restore := func() tea.Msg {
+			config.SetConfigHome(previous)
+			return a.initializeServices()
+		}
+
+		if err := config.SetConfigHome(path); err != nil {
+			return ConfigSwitchedMsg{Path: path, Err: err}
+		}
+
+		if _, err := config.Load(); err != nil {
+			return ConfigSwitchedMsg{Path: path, Next: restore(), Err: err}
+		}
+
+		next := a.initializeServices()
+		if initErr, ok := next.(AppInitError); ok {
+			return ConfigSwitchedMsg{Path: path, Next: restore(), Err: initErr.Err}
+		}
+
+		return ConfigSwitchedMsg{Path: path, Next: next}
+	}
+}
@@ -7,29 +7,30 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Color palette - based on a professional dark theme
+// Colors of the active theme, set by ApplyTheme. They start out as the
+// dark palette.
 var (
 	// Primary colors
-	ColorPrimary       = lipgloss.Color("62")  // Muted blue
-	ColorPrimaryBright = lipgloss.Color("75")  // Brighter blue
-	ColorAccent        = lipgloss.Color("86")  // Cyan/teal
-	ColorBackground    = lipgloss.Color("235") // Dark gray background
-	ColorSurface       = lipgloss.Color("236") // Slightly lighter surface
+	ColorPrimary       = darkPalette.Primary
+	ColorPrimaryBright = darkPalette.PrimaryBright
+	ColorAccent        = darkPalette.Accent
+	ColorBackground    = darkPalette.Background
+	ColorSurface       = darkPalette.Surface
 
 	// Text colors
-	ColorText       = lipgloss.Color("252") // Light gray text
-	ColorTextMuted  = lipgloss.Color("243") // Muted gray
-	ColorTextBright = lipgloss.Color("15")  // White
+	ColorText       = darkPalette.Text
+	ColorTextMuted  = darkPalette.TextMuted
+	ColorTextBright = darkPalette.TextBright
 
 	// Semantic colors
-	ColorSuccess = lipgloss.Color("82")  // Green
-	ColorWarning = lipgloss.Color("214") // Orange
-	ColorError   = lipgloss.Color("196") // Red
-	ColorInfo    = lipgloss.Color("117") // Light blue
+	ColorSuccess = darkPalette.Success
+	ColorWarning = darkPalette.Warning
+	ColorError   = darkPalette.Error
+	ColorInfo    = darkPalette.Info
 )
 
-// Styles contains common styling for the TUI.
-var Styles = struct {
+// StyleSet contains the styles the screens render with.
+type StyleSet struct {
 	// Base styles
 	Title      lipgloss.Style
 	Subtitle   lipgloss.Style
@@ -68,101 +69,110 @@ var Styles = struct {
 	StatusActive   lipgloss.Style
 	StatusInactive lipgloss.Style
 	StatusError    lipgloss.Style
-}{
-	// Base styles
-	Title: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorTextBright).
-		Background(ColorPrimary).
-		Padding(0, 2),
-	Subtitle: lipgloss.NewStyle().
-		Italic(true).
-		Foreground(ColorTextMuted),
-	Normal: lipgloss.NewStyle().
-		Foreground(ColorText),
-	Selected: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorAccent),
-	Deselected: lipgloss.NewStyle().
-		Foreground(ColorTextMuted),
-
-	// Semantic styles
-	Error: lipgloss.NewStyle().
-		Foreground(ColorError),
-	Success: lipgloss.NewStyle().
-		Foreground(ColorSuccess),
-	Warning: lipgloss.NewStyle().
-		Foreground(ColorWarning),
-	Info: lipgloss.NewStyle().
-		Foreground(ColorInfo),
-
-	// UI element styles
-	Border: lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(ColorPrimary).
-		Padding(0, 1),
-	HelpText: lipgloss.NewStyle().
-		Italic(true).
-		Foreground(ColorTextMuted),
-	StatusLine: lipgloss.NewStyle().
-		Foreground(ColorTextBright).
-		Background(ColorSurface).
-		Padding(0, 1),
-	Header: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorTextBright).
-		Background(ColorPrimary).
-		Padding(0, 1),
-	Box: lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(ColorPrimary).
-		Padding(1, 2),
-
-	// Menu styles
-	MenuItem: lipgloss.NewStyle().
-		Foreground(ColorText),
-	MenuSelected: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorAccent),
-	MenuKey: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorPrimaryBright),
-
-	// Button styles
-	Button: lipgloss.NewStyle().
-		Foreground(ColorText).
-		Background(ColorSurface).
-		Padding(0, 2).
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(ColorTextMuted),
-	ButtonFocus: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorTextBright).
-		Background(ColorPrimary).
-		Padding(0, 2).
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(ColorAccent),
-
-	// Input styles
-	Input: lipgloss.NewStyle().
-		Foreground(ColorText).
-		Background(ColorSurface).
-		Padding(0, 1),
-	InputFocus: lipgloss.NewStyle().
-		Foreground(ColorTextBright).
-		Background(ColorPrimary).
-		Padding(0, 1),
-	InputLabel: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ColorText),
+}
 
-	// Status indicator styles
-	StatusActive: lipgloss.NewStyle().
-		Foreground(ColorSuccess),
-	StatusInactive: lipgloss.NewStyle().
-		Foreground(ColorTextMuted),
-	StatusError: lipgloss.NewStyle().
-		Foreground(ColorError),
+// Styles contains common styling for the TUI, in the active theme.
+var Styles = NewStyles(ThemeDark)
+
+// NewStyles returns the style set for a theme; see PaletteFor.
+func NewStyles(theme string) StyleSet {
+	p := PaletteFor(theme)
+	return StyleSet{
+		// Base styles
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.TextBright).
+			Background(p.Primary).
+			Padding(0, 2),
+		Subtitle: lipgloss.NewStyle().
+			Italic(true).
+			Foreground(p.TextMuted),
+		Normal: lipgloss.NewStyle().
+			Foreground(p.Text),
+		Selected: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.Accent),
+		Deselected: lipgloss.NewStyle().
+			Foreground(p.TextMuted),
+
+		// Semantic styles
+		Error: lipgloss.NewStyle().
+			Foreground(p.Error),
+		Success: lipgloss.NewStyle().
+			Foreground(p.Success),
+		Warning: lipgloss.NewStyle().
+			Foreground(p.Warning),
+		Info: lipgloss.NewStyle().
+			Foreground(p.Info),
+
+		// UI element styles
+		Border: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.Primary).
+			Padding(0, 1),
+		HelpText: lipgloss.NewStyle().
+			Italic(true).
+			Foreground(p.TextMuted),
+		StatusLine: lipgloss.NewStyle().
+			Foreground(p.TextBright).
+			Background(p.Surface).
+			Padding(0, 1),
+		Header: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.TextBright).
+			Background(p.Primary).
+			Padding(0, 1),
+		Box: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.Primary).
+			Padding(1, 2),
+
+		// Menu styles
+		MenuItem: lipgloss.NewStyle().
+			Foreground(p.Text),
+		MenuSelected: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.Accent),
+		MenuKey: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.PrimaryBright),
+
+		// Button styles
+		Button: lipgloss.NewStyle().
+			Foreground(p.Text).
+			Background(p.Surface).
+			Padding(0, 2).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.TextMuted),
+		ButtonFocus: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.TextBright).
+			Background(p.Primary).
+			Padding(0, 2).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.Accent),
+
+		// Input styles
+		Input: lipgloss.NewStyle().
+			Foreground(p.Text).
+			Background(p.Surface).
+			Padding(0, 1),
+		InputFocus: lipgloss.NewStyle().
+			Foreground(p.TextBright).
+			Background(p.Primary).
+			Padding(0, 1),
+		InputLabel: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.Text),
+
+		// Status indicator styles
+		StatusActive: lipgloss.NewStyle().
+			Foreground(p.Success),
+		StatusInactive: lipgloss.NewStyle().
+			Foreground(p.TextMuted),
+		StatusError: lipgloss.NewStyle().
+			Foreground(p.Error),
+	}
 }
 
 // MenuItem represents a menu item with label, description, and key binding.
@@ -408,8 +418,8 @@ func RenderInfo(text string) string {
 	return Styles.Info.Render("ℹ " + text)
 }
 
-// FilePickerStyles contains styling for the enhanced file picker.
-var FilePickerStyles = struct {
+// FilePickerStyleSet is the styling of the enhanced file picker.
+type FilePickerStyleSet struct {
 	// Breadcrumb styles
 	BreadcrumbBar lipgloss.Style
 	Breadcrumb    lipgloss.Style
@@ -438,66 +448,77 @@ var FilePickerStyles = struct {
 	// Status styles
 	StatusLine lipgloss.Style
 	HelpBar    lipgloss.Style
-}{
-	// Breadcrumb styles
-	BreadcrumbBar: lipgloss.NewStyle().
-		Background(ColorSurface).
-		Padding(0, 1),
-	Breadcrumb: lipgloss.NewStyle().
-		Foreground(ColorPrimaryBright).
-		Bold(true),
-	BreadcrumbSep: lipgloss.NewStyle().
-		Foreground(ColorTextMuted).
-		Padding(0, 1),
-
-	// Quick jump bar styles
-	QuickJumpBar: lipgloss.NewStyle().
-		Background(ColorBackground).
-		Padding(0, 1),
-	QuickJumpKey: lipgloss.NewStyle().
-		Foreground(ColorAccent).
-		Bold(true),
-	QuickJumpLabel: lipgloss.NewStyle().
-		Foreground(ColorTextMuted),
-
-	// Recent menu styles
-	RecentMenu: lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(ColorPrimary).
-		Background(ColorSurface).
-		Padding(0, 1),
-	RecentMenuHeader: lipgloss.NewStyle().
-		Foreground(ColorPrimaryBright).
-		Bold(true).
-		Padding(0, 0, 1, 0),
-	RecentMenuItem: lipgloss.NewStyle().
-		Foreground(ColorText),
-	RecentMenuItemSelected: lipgloss.NewStyle().
-		Foreground(ColorAccent).
-		Background(ColorPrimary).
-		Bold(true),
-
-	// File entry styles
-	FolderIcon: lipgloss.NewStyle().
-		Foreground(lipgloss.Color("81")), // Light blue
-	FileIcon: lipgloss.NewStyle().
-		Foreground(lipgloss.Color("248")), // Gray
-	ParentIcon: lipgloss.NewStyle().
-		Foreground(lipgloss.Color("75")), // Blue
-
-	// Selection styles
-	SelectedEntry: lipgloss.NewStyle().
-		Foreground(ColorTextBright).
-		Background(ColorPrimary),
-	Entry: lipgloss.NewStyle().
-		Foreground(ColorText),
+}
 
-	// Status styles
-	StatusLine: lipgloss.NewStyle().
-		Foreground(ColorText).
-		Background(ColorSurface).
-		Padding(0, 1),
-	HelpBar: lipgloss.NewStyle().
-		Foreground(ColorTextMuted).
-		Padding(0, 1),
+// FilePickerStyles contains styling for the enhanced file picker, in the
+// active theme.
+var FilePickerStyles = NewFilePickerStyles(ThemeDark)
+
+// NewFilePickerStyles returns the file picker styling for a theme; see
+// PaletteFor.
+func NewFilePickerStyles(theme string) FilePickerStyleSet {
+	p := PaletteFor(theme)
+	return FilePickerStyleSet{
+		// Breadcrumb styles
+		BreadcrumbBar: lipgloss.NewStyle().
+			Background(p.Surface).
+			Padding(0, 1),
+		Breadcrumb: lipgloss.NewStyle().
+			Foreground(p.PrimaryBright).
+			Bold(true),
+		BreadcrumbSep: lipgloss.NewStyle().
+			Foreground(p.TextMuted).
+			Padding(0, 1),
+
+		// Quick jump bar styles
+		QuickJumpBar: lipgloss.NewStyle().
+			Background(p.Background).
+			Padding(0, 1),
+		QuickJumpKey: lipgloss.NewStyle().
+			Foreground(p.Accent).
+			Bold(true),
+		QuickJumpLabel: lipgloss.NewStyle().
+			Foreground(p.TextMuted),
+
+		// Recent menu styles
+		RecentMenu: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.Primary).
+			Background(p.Surface).
+			Padding(0, 1),
+		RecentMenuHeader: lipgloss.NewStyle().
+			Foreground(p.PrimaryBright).
+			Bold(true).
+			Padding(0, 0, 1, 0),
+		RecentMenuItem: lipgloss.NewStyle().
+			Foreground(p.Text),
+		RecentMenuItemSelected: lipgloss.NewStyle().
+			Foreground(p.Accent).
+			Background(p.Primary).
+			Bold(true),
+
+		// File entry styles
+		FolderIcon: lipgloss.NewStyle().
+			Foreground(p.Info),
+		FileIcon: lipgloss.NewStyle().
+			Foreground(p.TextMuted),
+		ParentIcon: lipgloss.NewStyle().
+			Foreground(p.PrimaryBright),
+
+		// Selection styles
+		SelectedEntry: lipgloss.NewStyle().
+			Foreground(p.TextBright).
+			Background(p.Primary),
+		Entry: lipgloss.NewStyle().
+			Foreground(p.Text),
+
+		// Status styles
+		StatusLine: lipgloss.NewStyle().
+			Foreground(p.Text).
+			Background(p.Surface).
+			Padding(0, 1),
+		HelpBar: lipgloss.NewStyle().
+			Foreground(p.TextMuted).
+			Padding(0, 1),
+	}
 }
@@ -0,0 +1,75 @@
+package components
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CopyModal shows text for the user to copy by hand when the clipboard is
+// unavailable. The text is rendered unstyled so a terminal selection picks
+// up exactly what is shown, and mouse capture is released while it is open.
+type CopyModal struct {
+	title string
+	text  string
+	width int
+	done  bool
+}
+
+// NewCopyModal creates a modal showing text under title.
+func NewCopyModal(title, text string) *CopyModal {
+	return &CopyModal{title: title, text: text}
+}
+
+// SetSize sets the modal width.
+func (m *CopyModal) SetSize(width, height int) {
+	m.width = width
+}
+
+// Init releases mouse capture so the text can be selected.
+func (m *CopyModal) Init() tea.Cmd {
+	return tea.DisableMouse
+}
+
+// Update closes the modal on Enter, Esc or q, restoring mouse capture.
+func (m *CopyModal) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "enter", "esc", "q":
+			m.done = true
+			return m, tea.EnableMouseCellMotion
+		}
+	}
+	return m, nil
+}
+
+// IsDone returns true once the modal is closed.
+func (m *CopyModal) IsDone() bool {
+	return m.done
+}
+
+// Text returns the text shown for copying.
+func (m *CopyModal) Text() string {
+	return m.text
+}
+
+// View renders the modal.
+func (m *CopyModal) View() string {
+	var b strings.Builder
+
+	b.WriteString(lipgloss.NewStyle().
+		Width(m.width).
+		Align(lipgloss.Center).
+		Render(Styles.Title.Render(m.title)))
+	b.WriteString("\n\n")
+	b.WriteString(RenderWarning("The clipboard is not available here. Select the text below to copy it."))
+	b.WriteString("\n\n")
+	for _, line := range strings.Split(m.text, "\n") {
+		b.WriteString("    " + line + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(Styles.HelpText.Render("Enter/Esc: close"))
+
+	return b.String()
+}
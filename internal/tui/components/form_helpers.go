@@ -78,17 +78,85 @@ func ValidateUmask(value string) error {
 	return nil
 }
 
+// ValidateBandwidthLimit checks a --bwlimit value: a single rate such as
+// "10M", or a timetable of "[Day-]HH:MM,rate" entries separated by spaces,
+// such as "08:00,512k 19:00,10M 23:00,off". A rate is a number followed by
+// K, M or G, or "off" for no limit.
 func ValidateBandwidthLimit(value string) error {
 	if value == "" {
 		return nil
 	}
+	if !strings.Contains(value, ",") {
+		return validateBandwidthRate(value)
+	}
+
+	for _, entry := range strings.Fields(value) {
+		if err := validateBandwidthEntry(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FormatBandwidthLimit describes a --bwlimit value for details views,
+// listing a timetable as "512k from 08:00, 10M from 19:00, off from 23:00".
+func FormatBandwidthLimit(value string) string {
+	if !strings.Contains(value, ",") {
+		return value
+	}
+	var parts []string
+	for _, entry := range strings.Fields(value) {
+		at, rate, ok := strings.Cut(entry, ",")
+		if !ok {
+			return value
+		}
+		parts = append(parts, rate+" from "+strings.Replace(at, "-", " ", 1))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// bandwidthEntryPattern matches a timetable entry, capturing the optional
+// day, the hour, the minute and the rate.
+var bandwidthEntryPattern = regexp.MustCompile(`^(?:([A-Za-z]{3})-)?(\d{2}):(\d{2}),(.*)$`)
+
+// bandwidthDays are the day prefixes rclone accepts in a timetable.
+var bandwidthDays = map[string]bool{
+	"mon": true, "tue": true, "wed": true, "thu": true, "fri": true, "sat": true, "sun": true,
+}
+
+// validateBandwidthEntry checks one "[Day-]HH:MM,rate" timetable entry.
+func validateBandwidthEntry(entry string) error {
+	m := bandwidthEntryPattern.FindStringSubmatch(entry)
+	if m == nil {
+		return fmt.Errorf("invalid bandwidth timetable entry: %q (expected HH:MM,rate or Day-HH:MM,rate, e.g., \"08:00,512k\" or \"Sat-00:00,off\")", entry)
+	}
+	if m[1] != "" && !bandwidthDays[strings.ToLower(m[1])] {
+		return fmt.Errorf("invalid day in bandwidth timetable entry: %q (use Mon, Tue, Wed, Thu, Fri, Sat or Sun)", entry)
+	}
+	hour, _ := strconv.Atoi(m[2])
+	minute, _ := strconv.Atoi(m[3])
+	if hour > 23 || minute > 59 {
+		return fmt.Errorf("invalid time in bandwidth timetable entry: %q", entry)
+	}
+	if err := validateBandwidthRate(m[4]); err != nil {
+		return fmt.Errorf("in timetable entry %q: %w", entry, err)
+	}
+	return nil
+}
+
+// validateBandwidthRate checks a single rate: a number followed by K, M or
+// G, or "off".
+func validateBandwidthRate(value string) error {
+	if strings.EqualFold(value, "off") {
+		return nil
+	}
 
 	matched, err := regexp.MatchString(`(?i)^\d+[kmg]$`, value)
 	if err != nil {
 		return fmt.Errorf("validation error: %w", err)
 	}
 	if !matched {
-		return fmt.Errorf("invalid bandwidth limit format: %q (expected format: number followed by K, M, or G, e.g., \"10M\", \"1G\", or leave empty for unlimited)", value)
+		return fmt.Errorf("invalid bandwidth limit format: %q (expected format: number followed by K, M, or G, e.g., \"10M\", \"1G\", a timetable such as \"08:00,512k 19:00,off\", or leave empty for unlimited)", value)
 	}
 
 	numStr := value[:len(value)-1]
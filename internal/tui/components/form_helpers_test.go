@@ -460,6 +460,66 @@ func TestValidateBandwidthLimit(t *testing.T) {
 			value:   "   ",
 			wantErr: true,
 		},
+		{
+			name:    "off",
+			value:   "off",
+			wantErr: false,
+		},
+		{
+			name:    "timetable",
+			value:   "08:00,512k 19:00,10M 23:00,off",
+			wantErr: false,
+		},
+		{
+			name:    "timetable with one entry",
+			value:   "08:00,512k",
+			wantErr: false,
+		},
+		{
+			name:    "timetable with days",
+			value:   "Mon-08:00,512k Fri-19:00,10M sat-00:00,off",
+			wantErr: false,
+		},
+		{
+			name:    "timetable with bad time",
+			value:   "08:00,512k 24:00,off",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with bad minute",
+			value:   "08:60,512k",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with one-digit hour",
+			value:   "8:00,512k",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with bad day",
+			value:   "Xyz-08:00,512k",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with bad rate",
+			value:   "08:00,512k 19:00,fast",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with zero rate",
+			value:   "08:00,0M",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with missing time",
+			value:   "512k 19:00,off",
+			wantErr: true,
+		},
+		{
+			name:    "timetable with empty rate",
+			value:   "08:00,",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -472,6 +532,19 @@ func TestValidateBandwidthLimit(t *testing.T) {
 	}
 }
 
+func TestFormatBandwidthLimit(t *testing.T) {
+	tests := map[string]string{
+		"10M":                            "10M",
+		"08:00,512k 19:00,10M 23:00,off": "512k from 08:00, 10M from 19:00, off from 23:00",
+		"Sat-00:00,off":                  "off from Sat 00:00",
+	}
+	for value, want := range tests {
+		if got := FormatBandwidthLimit(value); got != want {
+			t.Errorf("FormatBandwidthLimit(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
 func TestGetRemotePathSuggestions(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,148 @@
+package components
+
+import (
+	"os"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Themes accepted by the theme setting.
+const (
+	ThemeDark  = "dark"
+	ThemeLight = "light"
+	ThemeNone  = "none" // No colors; also forced by NO_COLOR
+)
+
+// Themes lists the themes in the order the settings screen offers them.
+var Themes = []string{ThemeDark, ThemeLight, ThemeNone}
+
+// Palette is the set of colors a theme renders with.
+type Palette struct {
+	Primary       lipgloss.TerminalColor
+	PrimaryBright lipgloss.TerminalColor
+	Accent        lipgloss.TerminalColor
+	Background    lipgloss.TerminalColor
+	Surface       lipgloss.TerminalColor
+
+	Text       lipgloss.TerminalColor
+	TextMuted  lipgloss.TerminalColor
+	TextBright lipgloss.TerminalColor
+
+	Success lipgloss.TerminalColor
+	Warning lipgloss.TerminalColor
+	Error   lipgloss.TerminalColor
+	Info    lipgloss.TerminalColor
+}
+
+// darkPalette is made for terminals with a dark background.
+var darkPalette = Palette{
+	Primary:       lipgloss.Color("62"),  // Muted blue
+	PrimaryBright: lipgloss.Color("75"),  // Brighter blue
+	Accent:        lipgloss.Color("86"),  // Cyan/teal
+	Background:    lipgloss.Color("235"), // Dark gray background
+	Surface:       lipgloss.Color("236"), // Slightly lighter surface
+
+	Text:       lipgloss.Color("252"), // Light gray text
+	TextMuted:  lipgloss.Color("243"), // Muted gray
+	TextBright: lipgloss.Color("15"),  // White
+
+	Success: lipgloss.Color("82"),  // Green
+	Warning: lipgloss.Color("214"), // Orange
+	Error:   lipgloss.Color("196"), // Red
+	Info:    lipgloss.Color("117"), // Light blue
+}
+
+// lightPalette is made for terminals with a light background: darker text
+// and accents, and black text on the highlighted surfaces.
+var lightPalette = Palette{
+	Primary:       lipgloss.Color("111"), // Light blue
+	PrimaryBright: lipgloss.Color("25"),  // Deep blue
+	Accent:        lipgloss.Color("30"),  // Dark teal
+	Background:    lipgloss.Color("255"), // Near white background
+	Surface:       lipgloss.Color("252"), // Light gray surface
+
+	Text:       lipgloss.Color("235"), // Near black text
+	TextMuted:  lipgloss.Color("242"), // Mid gray
+	TextBright: lipgloss.Color("16"),  // Black
+
+	Success: lipgloss.Color("28"),  // Dark green
+	Warning: lipgloss.Color("130"), // Dark orange
+	Error:   lipgloss.Color("160"), // Dark red
+	Info:    lipgloss.Color("25"),  // Deep blue
+}
+
+// nonePalette leaves every color to the terminal.
+var nonePalette = Palette{
+	Primary:       lipgloss.NoColor{},
+	PrimaryBright: lipgloss.NoColor{},
+	Accent:        lipgloss.NoColor{},
+	Background:    lipgloss.NoColor{},
+	Surface:       lipgloss.NoColor{},
+
+	Text:       lipgloss.NoColor{},
+	TextMuted:  lipgloss.NoColor{},
+	TextBright: lipgloss.NoColor{},
+
+	Success: lipgloss.NoColor{},
+	Warning: lipgloss.NoColor{},
+	Error:   lipgloss.NoColor{},
+	Info:    lipgloss.NoColor{},
+}
+
+// PaletteFor returns the palette of a theme. Unknown and empty themes get
+// the dark palette.
+func PaletteFor(theme string) Palette {
+	switch theme {
+	case ThemeLight:
+		return lightPalette
+	case ThemeNone:
+		return nonePalette
+	default:
+		return darkPalette
+	}
+}
+
+// ResolveTheme returns the theme to render with for the theme setting:
+// none when the NO_COLOR environment variable is set, dark when the
+// setting is empty or unknown, and the setting otherwise.
+func ResolveTheme(theme string) string {
+	if os.Getenv("NO_COLOR") != "" {
+		return ThemeNone
+	}
+	switch theme {
+	case ThemeLight, ThemeNone:
+		return theme
+	default:
+		return ThemeDark
+	}
+}
+
+var (
+	profileOnce     sync.Once
+	detectedProfile termenv.Profile
+)
+
+// ApplyTheme switches Styles and the Color variables to the theme the
+// setting resolves to (see ResolveTheme) and returns it. The none theme
+// also turns off colors in the huh forms.
+func ApplyTheme(theme string) string {
+	theme = ResolveTheme(theme)
+
+	profileOnce.Do(func() { detectedProfile = lipgloss.ColorProfile() })
+	if theme == ThemeNone {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	} else {
+		lipgloss.SetColorProfile(detectedProfile)
+	}
+
+	p := PaletteFor(theme)
+	ColorPrimary, ColorPrimaryBright, ColorAccent = p.Primary, p.PrimaryBright, p.Accent
+	ColorBackground, ColorSurface = p.Background, p.Surface
+	ColorText, ColorTextMuted, ColorTextBright = p.Text, p.TextMuted, p.TextBright
+	ColorSuccess, ColorWarning, ColorError, ColorInfo = p.Success, p.Warning, p.Error, p.Info
+	Styles = NewStyles(theme)
+	FilePickerStyles = NewFilePickerStyles(theme)
+	return theme
+}
@@ -0,0 +1,80 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestNewStyles_ThemeChangesStyles(t *testing.T) {
+	dark := NewStyles(ThemeDark)
+	light := NewStyles(ThemeLight)
+	none := NewStyles(ThemeNone)
+
+	if dark.Error.GetForeground() == light.Error.GetForeground() {
+		t.Error("dark and light themes should color errors differently")
+	}
+	if dark.Title.GetBackground() == light.Title.GetBackground() {
+		t.Error("dark and light themes should color titles differently")
+	}
+	for name, style := range map[string]lipgloss.Style{
+		"Error":        none.Error,
+		"StatusActive": none.StatusActive,
+		"MenuSelected": none.MenuSelected,
+	} {
+		if _, ok := style.GetForeground().(lipgloss.NoColor); !ok {
+			t.Errorf("none theme %s foreground = %v, want no color", name, style.GetForeground())
+		}
+	}
+	if got := NewStyles("solarized").Error.GetForeground(); got != dark.Error.GetForeground() {
+		t.Errorf("unknown theme error color = %v, want the dark palette's", got)
+	}
+}
+
+func TestResolveTheme(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	tests := map[string]string{
+		"":          ThemeDark,
+		"dark":      ThemeDark,
+		"light":     ThemeLight,
+		"none":      ThemeNone,
+		"solarized": ThemeDark,
+	}
+	for setting, want := range tests {
+		if got := ResolveTheme(setting); got != want {
+			t.Errorf("ResolveTheme(%q) = %q, want %q", setting, got, want)
+		}
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if got := ResolveTheme(ThemeLight); got != ThemeNone {
+		t.Errorf("ResolveTheme() with NO_COLOR = %q, want none", got)
+	}
+}
+
+func TestApplyTheme(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Cleanup(func() { ApplyTheme(ThemeDark) })
+
+	if got := ApplyTheme(ThemeLight); got != ThemeLight {
+		t.Fatalf("ApplyTheme(light) = %q", got)
+	}
+	if Styles.Error.GetForeground() != lightPalette.Error || ColorError != lightPalette.Error {
+		t.Error("ApplyTheme(light) should switch Styles and the colors to the light palette")
+	}
+	if FilePickerStyles.FolderIcon.GetForeground() != lightPalette.Info ||
+		FilePickerStyles.Entry.GetForeground() != lightPalette.Text {
+		t.Error("ApplyTheme(light) should switch FilePickerStyles to the light palette")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if got := ApplyTheme(ThemeDark); got != ThemeNone {
+		t.Errorf("ApplyTheme(dark) with NO_COLOR = %q, want none", got)
+	}
+	if _, ok := Styles.Error.GetForeground().(lipgloss.NoColor); !ok {
+		t.Error("NO_COLOR should leave the styles without colors")
+	}
+	if _, ok := FilePickerStyles.ParentIcon.GetForeground().(lipgloss.NoColor); !ok {
+		t.Error("NO_COLOR should leave the file picker styles without colors")
+	}
+}
@@ -0,0 +1,96 @@
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+)
+
+// autoRefreshTick is how often the auto-refresh countdown advances.
+const autoRefreshTick = time.Second
+
+// AutoRefreshTickMsg advances the auto-refresh countdown of a screen.
+type AutoRefreshTickMsg struct {
+	Screen string
+	Gen    int
+}
+
+// autoRefresh drives periodic status refreshes for a screen. Each time it is
+// enabled or stopped the generation is bumped, so ticks still in flight from
+// an earlier run are ignored instead of starting a second tick loop.
+type autoRefresh struct {
+	screen    string
+	enabled   bool
+	interval  time.Duration
+	remaining time.Duration
+	gen       int
+}
+
+// autoRefreshInterval returns the configured auto-refresh interval.
+func autoRefreshInterval(cfg *config.Config) time.Duration {
+	if cfg == nil {
+		return config.DefaultAutoRefreshInterval
+	}
+	return cfg.Settings.AutoRefreshDuration()
+}
+
+// toggle turns auto-refresh on or off and returns the first tick when enabled.
+func (a *autoRefresh) toggle(interval time.Duration) tea.Cmd {
+	if a.enabled {
+		a.stop()
+		return nil
+	}
+	a.enabled = true
+	a.interval = interval
+	a.remaining = interval
+	a.gen++
+	return a.tick()
+}
+
+// stop turns auto-refresh off and invalidates any pending tick.
+func (a *autoRefresh) stop() {
+	a.enabled = false
+	a.remaining = 0
+	a.gen++
+}
+
+// tick schedules the next countdown step for the current generation. The
+// wait ends early, without a message, once the TUI exits.
+func (a *autoRefresh) tick() tea.Cmd {
+	screen, gen, ctx := a.screen, a.gen, rootCtx
+	return func() tea.Msg {
+		timer := time.NewTimer(autoRefreshTick)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return AutoRefreshTickMsg{Screen: screen, Gen: gen}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleTick advances the countdown. It reports whether a refresh is due and
+// returns the next tick, or nil when the tick is stale.
+func (a *autoRefresh) handleTick(msg AutoRefreshTickMsg) (bool, tea.Cmd) {
+	if !a.enabled || msg.Screen != a.screen || msg.Gen != a.gen {
+		return false, nil
+	}
+
+	a.remaining -= autoRefreshTick
+	if a.remaining > 0 {
+		return false, a.tick()
+	}
+	a.remaining = a.interval
+	return true, a.tick()
+}
+
+// status returns a short auto-refresh indicator for the screen footer.
+func (a *autoRefresh) status() string {
+	if !a.enabled {
+		return "Auto-refresh: off"
+	}
+	return fmt.Sprintf("Auto-refresh: every %s (next in %ds)", a.interval, int(a.remaining.Seconds()))
+}
@@ -0,0 +1,97 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+)
+
+func TestAutoRefresh_ToggleAndStop(t *testing.T) {
+	ar := autoRefresh{screen: "mounts"}
+
+	if cmd := ar.toggle(3 * time.Second); cmd == nil {
+		t.Fatal("toggle() on should return a tick command")
+	}
+	if !ar.enabled {
+		t.Error("enabled = false after toggling on")
+	}
+	if ar.remaining != 3*time.Second {
+		t.Errorf("remaining = %v, want 3s", ar.remaining)
+	}
+
+	if cmd := ar.toggle(3 * time.Second); cmd != nil {
+		t.Error("toggle() off should not return a command")
+	}
+	if ar.enabled {
+		t.Error("enabled = true after toggling off")
+	}
+}
+
+func TestAutoRefresh_HandleTickCountdown(t *testing.T) {
+	ar := autoRefresh{screen: "services"}
+	ar.toggle(2 * time.Second)
+	msg := AutoRefreshTickMsg{Screen: "services", Gen: ar.gen}
+
+	due, next := ar.handleTick(msg)
+	if due {
+		t.Error("refresh should not be due after the first tick")
+	}
+	if next == nil {
+		t.Error("handleTick() should schedule the next tick")
+	}
+
+	due, next = ar.handleTick(msg)
+	if !due {
+		t.Error("refresh should be due once the interval has elapsed")
+	}
+	if next == nil {
+		t.Error("handleTick() should keep ticking after a refresh")
+	}
+	if ar.remaining != 2*time.Second {
+		t.Errorf("remaining = %v, want countdown reset to 2s", ar.remaining)
+	}
+}
+
+func TestAutoRefresh_IgnoresStaleTicks(t *testing.T) {
+	ar := autoRefresh{screen: "mounts"}
+	ar.toggle(time.Second)
+	stale := AutoRefreshTickMsg{Screen: "mounts", Gen: ar.gen}
+
+	ar.stop()
+	if due, next := ar.handleTick(stale); due || next != nil {
+		t.Error("ticks after stop() should be dropped")
+	}
+
+	ar.toggle(time.Second)
+	if due, next := ar.handleTick(stale); due || next != nil {
+		t.Error("ticks from an earlier run should be dropped")
+	}
+	if due, next := ar.handleTick(AutoRefreshTickMsg{Screen: "services", Gen: ar.gen}); due || next != nil {
+		t.Error("ticks for another screen should be dropped")
+	}
+}
+
+func TestAutoRefresh_Status(t *testing.T) {
+	ar := autoRefresh{screen: "mounts"}
+	if got := ar.status(); got != "Auto-refresh: off" {
+		t.Errorf("status() = %q, want off", got)
+	}
+
+	ar.toggle(30 * time.Second)
+	if got := ar.status(); !strings.Contains(got, "every 30s") || !strings.Contains(got, "next in 30s") {
+		t.Errorf("status() = %q, want interval and countdown", got)
+	}
+}
+
+func TestAutoRefreshInterval(t *testing.T) {
+	if got := autoRefreshInterval(nil); got != config.DefaultAutoRefreshInterval {
+		t.Errorf("autoRefreshInterval(nil) = %v, want default", got)
+	}
+
+	cfg := &config.Config{Settings: config.Settings{AutoRefreshInterval: "5s"}}
+	if got := autoRefreshInterval(cfg); got != 5*time.Second {
+		t.Errorf("autoRefreshInterval() = %v, want 5s", got)
+	}
+}
@@ -0,0 +1,445 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// Screen modes for the backups screen
+const (
+	BackupsModeList    = "list"    // Backup list
+	BackupsModeConfirm = "confirm" // Restore or delete confirmation
+	BackupsModeDiff    = "diff"    // Diff against the current config
+)
+
+// Backup actions awaiting confirmation
+const (
+	backupActionRestore = "restore"
+	backupActionDelete  = "delete"
+)
+
+// Config backup operations, replaceable in tests.
+var (
+	listBackups   = config.ListBackups
+	restoreBackup = config.RestoreBackup
+	deleteBackup  = config.DeleteBackup
+	diffBackup    = config.DiffBackup
+)
+
+// BackupsLoadedMsg is sent when the backup list has been loaded.
+type BackupsLoadedMsg struct {
+	Backups []config.BackupInfo
+	Err     error
+}
+
+// BackupRestoredMsg is sent after a backup has been restored over the config.
+type BackupRestoredMsg struct {
+	Name string
+	Err  error
+}
+
+// BackupDeletedMsg is sent after a backup has been deleted.
+type BackupDeletedMsg struct {
+	Name string
+	Err  error
+}
+
+// BackupDiffMsg carries the diff of a backup against the current config.
+type BackupDiffMsg struct {
+	Name  string
+	Lines []string
+	Err   error
+}
+
+// BackupsScreen lists config backups and lets the user restore, delete or
+// diff them.
+type BackupsScreen struct {
+	backups []config.BackupInfo
+	cursor  int
+	mode    string
+	loading bool
+	width   int
+	height  int
+	goBack  bool
+
+	// Confirmation state
+	confirm       *components.ConfirmDialog
+	pendingAction string
+
+	// Diff view state
+	diffName    string
+	diffLines   []string
+	diffScrollY int
+
+	err     error
+	success string
+}
+
+// NewBackupsScreen creates a new backups screen.
+func NewBackupsScreen() *BackupsScreen {
+	return &BackupsScreen{
+		mode:    BackupsModeList,
+		loading: true,
+	}
+}
+
+// SetSize sets the screen dimensions.
+func (s *BackupsScreen) SetSize(width, height int) {
+	s.width = width
+	s.height = height
+	if s.confirm != nil {
+		s.confirm.SetSize(width, height)
+	}
+}
+
+// Init initializes the screen.
+func (s *BackupsScreen) Init() tea.Cmd {
+	s.mode = BackupsModeList
+	s.confirm = nil
+	s.loading = true
+	return s.loadBackups
+}
+
+// loadBackups reads the available config backups.
+func (s *BackupsScreen) loadBackups() tea.Msg {
+	backups, err := listBackups()
+	return BackupsLoadedMsg{Backups: backups, Err: err}
+}
+
+// Update handles screen updates.
+func (s *BackupsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case BackupsLoadedMsg:
+		s.loading = false
+		s.err = msg.Err
+		s.backups = msg.Backups
+		if s.cursor >= len(s.backups) {
+			s.cursor = max(len(s.backups)-1, 0)
+		}
+		return s, nil
+
+	case BackupRestoredMsg:
+		if msg.Err != nil {
+			s.err = fmt.Errorf("failed to restore %s: %w", msg.Name, msg.Err)
+			return s, nil
+		}
+		s.err = nil
+		s.success = fmt.Sprintf("Config restored from %s", msg.Name)
+		return s, s.loadBackups
+
+	case BackupDeletedMsg:
+		if msg.Err != nil {
+			s.err = fmt.Errorf("failed to delete %s: %w", msg.Name, msg.Err)
+			return s, nil
+		}
+		s.err = nil
+		s.success = fmt.Sprintf("Backup %s deleted", msg.Name)
+		return s, s.loadBackups
+
+	case BackupDiffMsg:
+		if msg.Err != nil {
+			s.err = fmt.Errorf("failed to diff %s: %w", msg.Name, msg.Err)
+			return s, nil
+		}
+		s.err = nil
+		s.diffName = msg.Name
+		s.diffLines = msg.Lines
+		s.diffScrollY = 0
+		s.mode = BackupsModeDiff
+		return s, nil
+
+	case tea.KeyMsg:
+		switch s.mode {
+		case BackupsModeConfirm:
+			return s.updateConfirm(msg)
+		case BackupsModeDiff:
+			return s.updateDiff(msg)
+		default:
+			return s.updateList(msg)
+		}
+	}
+
+	return s, nil
+}
+
+// updateList handles key presses in the backup list.
+func (s *BackupsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if s.cursor > 0 {
+			s.cursor--
+		}
+	case "down", "j":
+		if s.cursor < len(s.backups)-1 {
+			s.cursor++
+		}
+	case "R", "ctrl+r":
+		s.loading = true
+		return s, s.loadBackups
+	case "r":
+		if backup, ok := s.selected(); ok {
+			s.startConfirm(backupActionRestore, components.NewSimpleConfirmDialog(
+				"Restore Backup",
+				fmt.Sprintf("Replace the current config with '%s' (%s)? Unsaved differences will be lost.",
+					backup.Name, backup.ModTime.Format("2006-01-02 15:04:05")),
+			))
+		}
+	case "d", "delete":
+		if backup, ok := s.selected(); ok {
+			s.startConfirm(backupActionDelete, components.NewSimpleConfirmDialog(
+				"Delete Backup",
+				fmt.Sprintf("Delete backup '%s'? This cannot be undone.", backup.Name),
+			))
+		}
+	case "enter", "v":
+		if backup, ok := s.selected(); ok {
+			return s, func() tea.Msg {
+				lines, err := diffBackup(backup.Path)
+				return BackupDiffMsg{Name: backup.Name, Lines: lines, Err: err}
+			}
+		}
+	case "backspace":
+		s.goBack = true
+	}
+	return s, nil
+}
+
+// startConfirm shows a confirmation dialog for a destructive action.
+func (s *BackupsScreen) startConfirm(action string, dialog *components.ConfirmDialog) {
+	s.pendingAction = action
+	s.confirm = dialog
+	s.confirm.SetSize(s.width, s.height)
+	s.mode = BackupsModeConfirm
+}
+
+// updateConfirm handles the restore/delete confirmation dialog.
+func (s *BackupsScreen) updateConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	model, _ := s.confirm.Update(msg)
+	if d, ok := model.(*components.ConfirmDialog); ok {
+		s.confirm = d
+	}
+	if !s.confirm.IsDone() {
+		return s, nil
+	}
+
+	confirmed := s.confirm.GetSelectedAction() == 1
+	action := s.pendingAction
+	s.confirm = nil
+	s.pendingAction = ""
+	s.mode = BackupsModeList
+
+	backup, ok := s.selected()
+	if !confirmed || !ok {
+		return s, nil
+	}
+
+	switch action {
+	case backupActionRestore:
+		return s, func() tea.Msg {
+			return BackupRestoredMsg{Name: backup.Name, Err: restoreBackup(backup.Path)}
+		}
+	case backupActionDelete:
+		return s, func() tea.Msg {
+			return BackupDeletedMsg{Name: backup.Name, Err: deleteBackup(backup.Path)}
+		}
+	}
+	return s, nil
+}
+
+// updateDiff handles scrolling and closing the diff view.
+func (s *BackupsScreen) updateDiff(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if s.diffScrollY > 0 {
+			s.diffScrollY--
+		}
+	case "down", "j":
+		if s.diffScrollY < s.maxDiffScroll() {
+			s.diffScrollY++
+		}
+	case "enter", "v", "backspace":
+		s.mode = BackupsModeList
+		s.diffLines = nil
+	}
+	return s, nil
+}
+
+// diffHeight returns the number of diff lines that fit on screen.
+func (s *BackupsScreen) diffHeight() int {
+	return max(s.height-10, 1)
+}
+
+// maxDiffScroll returns the largest useful diff scroll offset.
+func (s *BackupsScreen) maxDiffScroll() int {
+	return max(len(s.diffLines)-s.diffHeight(), 0)
+}
+
+// selected returns the backup under the cursor.
+func (s *BackupsScreen) selected() (config.BackupInfo, bool) {
+	if s.cursor < 0 || s.cursor >= len(s.backups) {
+		return config.BackupInfo{}, false
+	}
+	return s.backups[s.cursor], true
+}
+
+// ShouldGoBack returns true if the screen should go back to the main menu.
+func (s *BackupsScreen) ShouldGoBack() bool {
+	return s.goBack
+}
+
+// ResetGoBack resets the go back state.
+func (s *BackupsScreen) ResetGoBack() {
+	s.goBack = false
+}
+
+// View renders the screen.
+func (s *BackupsScreen) View() string {
+	switch s.mode {
+	case BackupsModeConfirm:
+		if s.confirm != nil {
+			return s.confirm.View()
+		}
+	case BackupsModeDiff:
+		return s.renderDiff()
+	}
+	return s.renderList()
+}
+
+// renderList renders the backup list.
+func (s *BackupsScreen) renderList() string {
+	var b strings.Builder
+
+	title := components.Styles.Title.Render("Config Backups")
+	b.WriteString(lipgloss.NewStyle().
+		Width(s.width).
+		Align(lipgloss.Center).
+		Render(title))
+	b.WriteString("\n\n")
+
+	if s.err != nil {
+		b.WriteString(components.RenderError(s.err.Error()))
+		b.WriteString("\n\n")
+	}
+
+	if s.success != "" {
+		b.WriteString(components.RenderSuccess(s.success))
+		b.WriteString("\n\n")
+		s.success = ""
+	}
+
+	if s.loading {
+		b.WriteString(lipgloss.NewStyle().
+			Width(s.width).
+			Align(lipgloss.Center).
+			Render("Loading backups..."))
+	} else if len(s.backups) == 0 {
+		emptyMsg := components.Styles.Subtitle.Render("No config backups found.")
+		hint := components.Styles.HelpText.Render("A backup is written each time the config is saved.")
+
+		b.WriteString(lipgloss.NewStyle().
+			Width(s.width).
+			Align(lipgloss.Center).
+			Render(emptyMsg))
+		b.WriteString("\n")
+		b.WriteString(lipgloss.NewStyle().
+			Width(s.width).
+			Align(lipgloss.Center).
+			Render(hint))
+	} else {
+		header := fmt.Sprintf("  %-30s %-20s %10s", "Name", "Modified", "Size")
+		b.WriteString(components.Styles.Subtitle.Render(header) + "\n")
+		b.WriteString(components.Styles.Subtitle.Render(strings.Repeat("─", max(s.width-4, 0))) + "\n")
+
+		for i, backup := range s.backups {
+			modified := backup.ModTime.Format("2006-01-02 15:04:05")
+			size := formatSize(backup.Size)
+			if i == s.cursor {
+				b.WriteString(fmt.Sprintf("▸ %-30s %-20s %10s\n",
+					components.Styles.Selected.Render(backup.Name), modified, size))
+			} else {
+				b.WriteString(fmt.Sprintf("  %-30s %-20s %10s\n",
+					components.Styles.Normal.Render(backup.Name), modified, size))
+			}
+		}
+	}
+
+	b.WriteString("\n")
+	helpText := components.HelpBar(s.width, []components.HelpItem{
+		{Key: "↑/↓", Desc: "navigate"},
+		{Key: "Enter/v", Desc: "diff"},
+		{Key: "r", Desc: "restore"},
+		{Key: "d", Desc: "delete"},
+		{Key: "R", Desc: "refresh"},
+		{Key: "Esc", Desc: "back"},
+	})
+	b.WriteString(helpText)
+
+	return b.String()
+}
+
+// renderDiff renders the diff of a backup against the current config.
+func (s *BackupsScreen) renderDiff() string {
+	var b strings.Builder
+
+	b.WriteString(components.Styles.Title.Render(fmt.Sprintf("Diff: %s → current config", s.diffName)))
+	b.WriteString("\n\n")
+
+	changed := false
+	for _, line := range s.diffLines {
+		if !strings.HasPrefix(line, "  ") {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		b.WriteString(components.RenderInfo("Backup is identical to the current config."))
+		b.WriteString("\n")
+	} else {
+		end := min(s.diffScrollY+s.diffHeight(), len(s.diffLines))
+		for _, line := range s.diffLines[s.diffScrollY:end] {
+			switch {
+			case strings.HasPrefix(line, "- "):
+				b.WriteString(components.Styles.Error.Render(line))
+			case strings.HasPrefix(line, "+ "):
+				b.WriteString(components.Styles.Success.Render(line))
+			default:
+				b.WriteString(components.Styles.Normal.Render(line))
+			}
+			b.WriteString("\n")
+		}
+		if s.maxDiffScroll() > 0 {
+			b.WriteString(components.Styles.HelpText.Render(
+				fmt.Sprintf("[%d/%d] ↑/↓ to scroll", s.diffScrollY+1, s.maxDiffScroll()+1)))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	helpText := components.HelpBar(s.width, []components.HelpItem{
+		{Key: "↑/↓", Desc: "scroll"},
+		{Key: "Enter", Desc: "close"},
+		{Key: "Esc", Desc: "back to menu"},
+	})
+	b.WriteString(helpText)
+
+	return b.String()
+}
+
+// formatSize formats a file size, e.g. "512 B" or "1.5 KB".
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,248 @@
+package screens
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+)
+
+// stubBackupOps replaces the config backup operations for a test and records
+// which backup paths were restored or deleted.
+type stubBackupOps struct {
+	restored []string
+	deleted  []string
+}
+
+func newStubBackupOps(t *testing.T, backups []config.BackupInfo) *stubBackupOps {
+	t.Helper()
+	stub := &stubBackupOps{}
+
+	origList, origRestore, origDelete, origDiff := listBackups, restoreBackup, deleteBackup, diffBackup
+	listBackups = func() ([]config.BackupInfo, error) { return backups, nil }
+	restoreBackup = func(path string) error {
+		stub.restored = append(stub.restored, path)
+		return nil
+	}
+	deleteBackup = func(path string) error {
+		stub.deleted = append(stub.deleted, path)
+		return nil
+	}
+	diffBackup = func(path string) ([]string, error) {
+		return []string{"  version: 1", "- name: old", "+ name: new"}, nil
+	}
+	t.Cleanup(func() {
+		listBackups, restoreBackup, deleteBackup, diffBackup = origList, origRestore, origDelete, origDiff
+	})
+	return stub
+}
+
+func createTestBackups() []config.BackupInfo {
+	return []config.BackupInfo{
+		{Path: "/cfg/config.yaml.bak", Name: "config.yaml.bak", ModTime: time.Date(2024, 5, 2, 9, 0, 0, 0, time.Local), Size: 2048},
+		{Path: "/cfg/config.yaml.bak.1", Name: "config.yaml.bak.1", ModTime: time.Date(2024, 5, 1, 9, 0, 0, 0, time.Local), Size: 300},
+	}
+}
+
+// loadedBackupsScreen returns a backups screen with the test backups loaded.
+func loadedBackupsScreen(t *testing.T) (*BackupsScreen, *stubBackupOps) {
+	t.Helper()
+	stub := newStubBackupOps(t, createTestBackups())
+	screen := NewBackupsScreen()
+	screen.SetSize(100, 40)
+	screen.Update(screen.Init()())
+	return screen, stub
+}
+
+// runCmd executes cmd and feeds its message back into the screen.
+func runCmd(screen *BackupsScreen, cmd tea.Cmd) {
+	if cmd != nil {
+		screen.Update(cmd())
+	}
+}
+
+func TestBackupsScreen_LoadsBackups(t *testing.T) {
+	screen, _ := loadedBackupsScreen(t)
+
+	if screen.loading {
+		t.Error("loading should be false after BackupsLoadedMsg")
+	}
+	if len(screen.backups) != 2 {
+		t.Fatalf("backups = %d, want 2", len(screen.backups))
+	}
+
+	view := screen.View()
+	for _, want := range []string{"Config Backups", "config.yaml.bak.1", "2024-05-02 09:00:00", "2.0 KB", "300 B"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+}
+
+func TestBackupsScreen_EmptyState(t *testing.T) {
+	newStubBackupOps(t, nil)
+	screen := NewBackupsScreen()
+	screen.SetSize(100, 40)
+	screen.Update(screen.Init()())
+
+	if !strings.Contains(screen.View(), "No config backups found.") {
+		t.Error("View() should show the empty state")
+	}
+
+	// Actions are no-ops without a selection
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd != nil || screen.mode != BackupsModeList {
+		t.Error("restore without backups should do nothing")
+	}
+}
+
+func TestBackupsScreen_RestoreRequiresConfirmation(t *testing.T) {
+	screen, stub := loadedBackupsScreen(t)
+	screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if screen.mode != BackupsModeConfirm {
+		t.Fatalf("mode = %q, want confirm", screen.mode)
+	}
+	if !strings.Contains(screen.View(), "Restore Backup") {
+		t.Error("confirmation dialog should be shown")
+	}
+
+	// Default selection is "No"
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(screen, cmd)
+	if len(stub.restored) != 0 {
+		t.Fatalf("restore should not run when declined, got %v", stub.restored)
+	}
+	if screen.mode != BackupsModeList {
+		t.Errorf("mode = %q, want list after declining", screen.mode)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+	_, cmd = screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(screen, cmd)
+
+	if len(stub.restored) != 1 || stub.restored[0] != "/cfg/config.yaml.bak.1" {
+		t.Fatalf("restored = %v, want the selected backup", stub.restored)
+	}
+	if !strings.Contains(screen.View(), "Config restored from config.yaml.bak.1") {
+		t.Error("View() should report the restore")
+	}
+}
+
+func TestBackupsScreen_DeleteConfirmed(t *testing.T) {
+	screen, stub := loadedBackupsScreen(t)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if screen.mode != BackupsModeConfirm || screen.pendingAction != backupActionDelete {
+		t.Fatalf("mode = %q, action = %q; want delete confirmation", screen.mode, screen.pendingAction)
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(screen, cmd)
+
+	if len(stub.deleted) != 1 || stub.deleted[0] != "/cfg/config.yaml.bak" {
+		t.Errorf("deleted = %v, want the selected backup", stub.deleted)
+	}
+}
+
+func TestBackupsScreen_ActionErrors(t *testing.T) {
+	screen, _ := loadedBackupsScreen(t)
+
+	screen.Update(BackupRestoredMsg{Name: "config.yaml.bak", Err: errors.New("disk full")})
+	if screen.err == nil || !strings.Contains(screen.View(), "failed to restore config.yaml.bak: disk full") {
+		t.Error("restore error should be shown")
+	}
+
+	screen.Update(BackupDeletedMsg{Name: "config.yaml.bak", Err: errors.New("read-only")})
+	if !strings.Contains(screen.View(), "failed to delete config.yaml.bak: read-only") {
+		t.Error("delete error should be shown")
+	}
+}
+
+func TestBackupsScreen_Diff(t *testing.T) {
+	screen, _ := loadedBackupsScreen(t)
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	runCmd(screen, cmd)
+	if screen.mode != BackupsModeDiff {
+		t.Fatalf("mode = %q, want diff", screen.mode)
+	}
+
+	view := screen.View()
+	for _, want := range []string{"Diff: config.yaml.bak → current config", "- name: old", "+ name: new"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("diff view missing %q", want)
+		}
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.mode != BackupsModeList {
+		t.Errorf("mode = %q, want list after closing diff", screen.mode)
+	}
+}
+
+func TestBackupsScreen_DiffIdentical(t *testing.T) {
+	screen, _ := loadedBackupsScreen(t)
+	screen.Update(BackupDiffMsg{Name: "config.yaml.bak", Lines: []string{"  version: 1"}})
+
+	if !strings.Contains(screen.View(), "identical to the current config") {
+		t.Error("diff view should say the backup is identical")
+	}
+}
+
+func TestBackupsScreen_DiffScroll(t *testing.T) {
+	screen, _ := loadedBackupsScreen(t)
+	screen.SetSize(100, 15)
+
+	lines := make([]string, 30)
+	for i := range lines {
+		lines[i] = "+ line"
+	}
+	screen.Update(BackupDiffMsg{Name: "config.yaml.bak", Lines: lines})
+
+	for i := 0; i < 50; i++ {
+		screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if screen.diffScrollY != screen.maxDiffScroll() {
+		t.Errorf("diffScrollY = %d, want clamped to %d", screen.diffScrollY, screen.maxDiffScroll())
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if screen.diffScrollY != screen.maxDiffScroll()-1 {
+		t.Errorf("diffScrollY = %d after scrolling up", screen.diffScrollY)
+	}
+}
+
+func TestBackupsScreen_GoBack(t *testing.T) {
+	screen, _ := loadedBackupsScreen(t)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if !screen.ShouldGoBack() {
+		t.Error("backspace should go back")
+	}
+	screen.ResetGoBack()
+	if screen.ShouldGoBack() {
+		t.Error("ResetGoBack() should clear the flag")
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		size int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KB"},
+		{5 * 1024 * 1024, "5.0 MB"},
+	}
+	for _, tt := range tests {
+		if got := formatSize(tt.size); got != tt.want {
+			t.Errorf("formatSize(%d) = %q, want %q", tt.size, got, tt.want)
+		}
+	}
+}
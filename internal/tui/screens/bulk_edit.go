@@ -0,0 +1,328 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// bulkField is an option that bulk edit can set on several items at once.
+type bulkField struct {
+	key      string
+	label    string
+	options  []string           // Allowed values; free text when empty
+	validate func(string) error // Checks free-text values
+}
+
+var (
+	bulkLogLevels = []string{"ERROR", "NOTICE", "INFO", "DEBUG"}
+	bulkBools     = []string{"true", "false"}
+)
+
+// mountBulkFields are the mount options offered by bulk edit.
+var mountBulkFields = []bulkField{
+	{key: "log_level", label: "Log level", options: bulkLogLevels},
+	{key: "vfs_cache_mode", label: "VFS cache mode", options: []string{"off", "writes", "full"}},
+	{key: "buffer_size", label: "Buffer size", validate: components.ValidateBufferSize},
+	{key: "read_only", label: "Read only", options: bulkBools},
+	{key: "preset", label: "Preset", options: []string{"quota-friendly"}},
+}
+
+// syncJobBulkFields are the sync job options offered by bulk edit.
+var syncJobBulkFields = []bulkField{
+	{key: "log_level", label: "Log level", options: bulkLogLevels},
+	{key: "bandwidth_limit", label: "Bandwidth limit", validate: components.ValidateBandwidthLimit},
+	{key: "checksum", label: "Compare checksums", options: bulkBools},
+	{key: "require_ac_power", label: "Require AC power", options: bulkBools},
+}
+
+// setMountField sets the mount option identified by key.
+func setMountField(mount *models.MountConfig, key, value string) {
+	switch key {
+	case "log_level":
+		mount.MountOptions.LogLevel = value
+	case "vfs_cache_mode":
+		mount.MountOptions.VFSCacheMode = value
+	case "buffer_size":
+		mount.MountOptions.BufferSize = value
+	case "read_only":
+		mount.MountOptions.ReadOnly = value == "true"
+	case "preset":
+		if value == "quota-friendly" {
+			applyQuotaFriendlyPreset(&mount.MountOptions)
+		}
+	}
+}
+
+// setSyncJobField sets the sync job option identified by key.
+func setSyncJobField(job *models.SyncJobConfig, key, value string) {
+	switch key {
+	case "log_level":
+		job.SyncOptions.LogLevel = value
+	case "bandwidth_limit":
+		job.SyncOptions.BandwidthLimit = value
+	case "checksum":
+		job.SyncOptions.CheckSum = value == "true"
+	case "require_ac_power":
+		job.Schedule.RequireACPower = value == "true"
+	}
+}
+
+// BulkEditResult is the outcome of a bulk edit for one item.
+type BulkEditResult struct {
+	Name string
+	Err  error
+}
+
+// BulkEditDoneMsg is sent when a bulk edit has been applied to every
+// selected item.
+type BulkEditDoneMsg struct {
+	Results []BulkEditResult
+}
+
+// bulkEditStage is the step a bulk edit is at.
+type bulkEditStage int
+
+const (
+	bulkStageField bulkEditStage = iota
+	bulkStageValue
+	bulkStageApplying
+	bulkStageResults
+)
+
+// bulkEdit walks through picking an option and a value for the selected
+// items, applies it once confirmed and then shows the per-item results.
+type bulkEdit struct {
+	noun   string   // Plural item kind, e.g. "mounts"
+	names  []string // Selected items, for the confirmation
+	fields []bulkField
+	apply  func(field bulkField, value string) []BulkEditResult
+
+	stage    bulkEditStage
+	form     *huh.Form
+	fieldKey string
+	value    string
+	confirm  bool
+	results  []BulkEditResult
+	done     bool
+}
+
+// newBulkEdit starts a bulk edit of the named items. apply runs in the
+// background once the change is confirmed.
+func newBulkEdit(noun string, names []string, fields []bulkField, apply func(bulkField, string) []BulkEditResult) *bulkEdit {
+	b := &bulkEdit{noun: noun, names: names, fields: fields, apply: apply}
+
+	options := make([]huh.Option[string], len(fields))
+	for i, f := range fields {
+		options[i] = huh.NewOption(f.label, f.key)
+	}
+	b.fieldKey = fields[0].key
+	b.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Option to change").
+				Description(fmt.Sprintf("Applies to %d selected %s", len(names), noun)).
+				Options(options...).
+				Value(&b.fieldKey),
+		),
+	)
+	b.form.WithTheme(huh.ThemeBase16())
+	return b
+}
+
+// Init returns the command that initializes the first form.
+func (b *bulkEdit) Init() tea.Cmd {
+	return b.form.Init()
+}
+
+// field returns the chosen option.
+func (b *bulkEdit) field() bulkField {
+	for _, f := range b.fields {
+		if f.key == b.fieldKey {
+			return f
+		}
+	}
+	return b.fields[0]
+}
+
+// startValueForm asks for the new value and a confirmation.
+func (b *bulkEdit) startValueForm() tea.Cmd {
+	field := b.field()
+
+	var input huh.Field
+	if len(field.options) > 0 {
+		b.value = field.options[0]
+		input = huh.NewSelect[string]().
+			Title(field.label).
+			Options(huh.NewOptions(field.options...)...).
+			Value(&b.value)
+	} else {
+		b.value = ""
+		text := huh.NewInput().Title(field.label).Value(&b.value)
+		if field.validate != nil {
+			text.Validate(func(v string) error { return field.validate(strings.TrimSpace(v)) })
+		}
+		input = text
+	}
+
+	b.confirm = false
+	b.form = huh.NewForm(
+		huh.NewGroup(
+			input,
+			huh.NewConfirm().
+				Title(fmt.Sprintf("Change %s on %d %s?", strings.ToLower(field.label), len(b.names), b.noun)).
+				Description(strings.Join(b.names, ", ")).
+				Affirmative("Apply").
+				Negative("Cancel").
+				Value(&b.confirm),
+		),
+	)
+	b.form.WithTheme(huh.ThemeBase16())
+	b.stage = bulkStageValue
+	return b.form.Init()
+}
+
+// Update advances the bulk edit and returns the command to run next.
+func (b *bulkEdit) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case BulkEditDoneMsg:
+		b.results = msg.Results
+		b.stage = bulkStageResults
+		return nil
+	case tea.KeyMsg:
+		switch b.stage {
+		case bulkStageApplying:
+			return nil
+		case bulkStageResults:
+			b.done = true
+			return nil
+		}
+		if msg.String() == "esc" {
+			b.done = true
+			return nil
+		}
+	}
+
+	if b.form == nil {
+		return nil
+	}
+
+	form, cmd := b.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		b.form = f
+	}
+
+	switch b.form.State {
+	case huh.StateAborted:
+		b.done = true
+		return nil
+	case huh.StateCompleted:
+		if b.stage == bulkStageField {
+			return b.startValueForm()
+		}
+		if !b.confirm {
+			b.done = true
+			return nil
+		}
+		b.form = nil
+		b.stage = bulkStageApplying
+		field, value, apply := b.field(), strings.TrimSpace(b.value), b.apply
+		return func() tea.Msg {
+			return BulkEditDoneMsg{Results: apply(field, value)}
+		}
+	}
+
+	return cmd
+}
+
+// Applied reports whether the change was applied, successfully or not.
+func (b *bulkEdit) Applied() bool {
+	return b.results != nil
+}
+
+// View renders the current step of the bulk edit.
+func (b *bulkEdit) View(width int) string {
+	var sb strings.Builder
+	sb.WriteString(components.Styles.Title.Render(fmt.Sprintf("Bulk Edit (%d %s)", len(b.names), b.noun)))
+	sb.WriteString("\n\n")
+
+	switch b.stage {
+	case bulkStageApplying:
+		sb.WriteString(components.Styles.HelpText.Render("Applying changes..."))
+		sb.WriteString("\n")
+	case bulkStageResults:
+		sb.WriteString(b.renderResults())
+		sb.WriteString("\n")
+		sb.WriteString(components.HelpBar(width, []components.HelpItem{
+			{Key: "any key", Desc: "back"},
+		}))
+	default:
+		sb.WriteString(b.form.View())
+		sb.WriteString("\n")
+		sb.WriteString(components.HelpBar(width, []components.HelpItem{
+			{Key: "enter", Desc: "next/apply"},
+			{Key: "esc", Desc: "cancel"},
+		}))
+	}
+
+	return sb.String()
+}
+
+// renderResults lists the outcome of the bulk edit for each item.
+func (b *bulkEdit) renderResults() string {
+	var sb strings.Builder
+	failed := 0
+	for _, r := range b.results {
+		if r.Err != nil {
+			failed++
+			sb.WriteString(components.Styles.Error.Render(fmt.Sprintf("  ✗ %s: %v", r.Name, r.Err)))
+		} else {
+			sb.WriteString(components.Styles.Success.Render("  ✓ " + r.Name))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("  Updated %d of %d %s", len(b.results)-failed, len(b.results), b.noun))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// bulkFailAll reports the same error for every item.
+func bulkFailAll(names []string, err error) []BulkEditResult {
+	results := make([]BulkEditResult, len(names))
+	for i, name := range names {
+		results[i] = BulkEditResult{Name: name, Err: err}
+	}
+	return results
+}
+
+// regenerateUnits rewrites the units of each edited item with write and then
+// reloads systemd once, recording the outcome per item.
+func regenerateUnits(names []string, write func(i int) error, manager systemd.ServiceManager) []BulkEditResult {
+	results := make([]BulkEditResult, len(names))
+	written := 0
+	for i, name := range names {
+		results[i].Name = name
+		if err := write(i); err != nil {
+			results[i].Err = fmt.Errorf("failed to write unit files: %w", err)
+			continue
+		}
+		written++
+	}
+
+	if written > 0 {
+		if err := manager.DaemonReload(); err != nil {
+			for i := range results {
+				if results[i].Err == nil {
+					results[i].Err = fmt.Errorf("failed to reload systemd daemon: %w", err)
+				}
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,161 @@
+package screens
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+var spaceKey = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}
+
+func TestMountsScreen_MarkAndBulkEditNeedsMarks(t *testing.T) {
+	screen := NewMountsScreen()
+	screen.SetSize(120, 40)
+	screen.mounts = createTestMounts()
+	screen.loading = false
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if screen.IsBulkEditing() || screen.err == nil {
+		t.Error("bulk edit should not open without marked mounts")
+	}
+
+	screen.Update(spaceKey)
+	if !screen.marked["a1b2c3d4"] || screen.cursor != 1 {
+		t.Errorf("space should mark the mount and move down, marked=%v cursor=%d", screen.marked, screen.cursor)
+	}
+	if !strings.Contains(screen.View(), "✓ Google Drive") {
+		t.Error("view should show a check mark next to marked mounts")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if !screen.IsBulkEditing() {
+		t.Fatal("'b' should open bulk edit for marked mounts")
+	}
+	if !strings.Contains(screen.View(), "Bulk Edit (1 mounts)") {
+		t.Error("view should render the bulk edit form")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.IsBulkEditing() || !screen.marked["a1b2c3d4"] {
+		t.Error("esc should cancel bulk edit and keep the marks")
+	}
+}
+
+func TestMountsScreen_ApplyBulkEdit(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfig()
+	cfg.Mounts = createTestMounts()
+	gen := &systemd.MockGenerator{}
+	mgr := &systemd.MockManager{}
+	screen := NewMountsScreen()
+	screen.SetServices(cfg, nil, gen, mgr)
+
+	results := screen.applyBulkEdit(
+		[]string{"a1b2c3d4", "c3d4e5f6"}, []string{"Google Drive", "S3 Bucket"},
+		mountBulkFields[0], "DEBUG")
+
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("%s: unexpected error %v", r.Name, r.Err)
+		}
+	}
+	if cfg.Mounts[0].MountOptions.LogLevel != "DEBUG" || cfg.Mounts[2].MountOptions.LogLevel != "DEBUG" {
+		t.Error("log level should be set on every selected mount")
+	}
+	if cfg.Mounts[1].MountOptions.LogLevel == "DEBUG" {
+		t.Error("unselected mounts should not change")
+	}
+	if len(gen.WrittenMounts) != 2 {
+		t.Errorf("WrittenMounts = %v, want both selected mounts", gen.WrittenMounts)
+	}
+	if !mgr.Called("DaemonReload", "") {
+		t.Error("systemd should be reloaded after regenerating units")
+	}
+}
+
+func TestSyncJobsScreen_ApplyBulkEditReportsFailures(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfig()
+	cfg.SyncJobs = createTestSyncJobs()
+	gen := &systemd.MockGenerator{WriteSyncUnitsErr: errors.New("disk full")}
+	screen := NewSyncJobsScreen()
+	screen.SetServices(cfg, nil, gen, &systemd.MockManager{})
+
+	job := cfg.SyncJobs[0]
+	results := screen.applyBulkEdit([]string{job.ID}, []string{job.Name}, syncJobBulkFields[3], "true")
+
+	if len(results) != 1 || results[0].Err == nil || !strings.Contains(results[0].Err.Error(), "disk full") {
+		t.Errorf("results = %+v, want the write error for %s", results, job.Name)
+	}
+	if !cfg.SyncJobs[0].Schedule.RequireACPower {
+		t.Error("config should still hold the new value")
+	}
+}
+
+func TestRegenerateUnits_ReloadFailure(t *testing.T) {
+	mgr := &systemd.MockManager{DaemonReloadErr: errors.New("bus down")}
+
+	results := regenerateUnits([]string{"a", "b"}, func(i int) error {
+		if i == 1 {
+			return errors.New("write failed")
+		}
+		return nil
+	}, mgr)
+
+	if results[0].Err == nil || !strings.Contains(results[0].Err.Error(), "reload") {
+		t.Errorf("written item should report the reload failure, got %v", results[0].Err)
+	}
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "write failed") {
+		t.Errorf("failed item should keep its write error, got %v", results[1].Err)
+	}
+}
+
+func TestBulkEdit_ResultsView(t *testing.T) {
+	b := newBulkEdit("mounts", []string{"one", "two"}, mountBulkFields, nil)
+	b.stage = bulkStageApplying
+
+	b.Update(BulkEditDoneMsg{Results: []BulkEditResult{
+		{Name: "one"},
+		{Name: "two", Err: errors.New("boom")},
+	}})
+
+	view := b.View(80)
+	for _, want := range []string{"✓ one", "✗ two: boom", "Updated 1 of 2 mounts"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("results view should contain %q, got:\n%s", want, view)
+		}
+	}
+
+	b.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !b.done || !b.Applied() {
+		t.Error("any key should close the results")
+	}
+}
+
+func TestMountsScreen_ApplyBulkEditPreset(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfig()
+	cfg.Mounts = createTestMounts()
+	screen := NewMountsScreen()
+	screen.SetServices(cfg, nil, &systemd.MockGenerator{}, &systemd.MockManager{})
+
+	preset := mountBulkFields[len(mountBulkFields)-1]
+	if preset.key != "preset" {
+		t.Fatalf("last mount bulk field = %q, want preset", preset.key)
+	}
+	results := screen.applyBulkEdit([]string{"a1b2c3d4"}, []string{"Google Drive"}, preset, "quota-friendly")
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("results = %+v, want one success", results)
+	}
+
+	opts := cfg.Mounts[0].MountOptions
+	if opts.PollInterval != "5m" || opts.DirCacheTime != "1h" || opts.VFSCacheMode != "full" {
+		t.Errorf("preset options = poll %q, dir cache %q, cache mode %q", opts.PollInterval, opts.DirCacheTime, opts.VFSCacheMode)
+	}
+}
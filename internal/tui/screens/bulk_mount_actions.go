@@ -0,0 +1,136 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// MountsBulkActionMsg is sent after starting or stopping every listed mount.
+type MountsBulkActionMsg struct {
+	Action  systemd.BatchAction
+	Summary string // e.g. "3 started, 1 failed: Dropbox (permission denied)"
+	Failed  int
+}
+
+// bulkMountAction is a confirmed-before-running start or stop of several
+// mounts.
+type bulkMountAction struct {
+	action  systemd.BatchAction
+	mounts  []models.MountConfig
+	confirm *components.ConfirmDialog
+}
+
+// startBulkMountAction asks to confirm starting every enabled mount, or
+// stopping every listed mount.
+func (s *MountsScreen) startBulkMountAction(action systemd.BatchAction) (tea.Model, tea.Cmd) {
+	if s.generator == nil || s.manager == nil {
+		s.err = fmt.Errorf("systemd services not initialized")
+		return s, nil
+	}
+
+	var mounts []models.MountConfig
+	for _, m := range s.mounts {
+		if action == systemd.BatchStop || m.Enabled {
+			mounts = append(mounts, m)
+		}
+	}
+	if len(mounts) == 0 {
+		s.err = fmt.Errorf("no mounts to %s", action)
+		return s, nil
+	}
+
+	names := make([]string, len(mounts))
+	for i, m := range mounts {
+		names[i] = m.Name
+	}
+	verb := "Start"
+	noun := "enabled mount"
+	if action == systemd.BatchStop {
+		verb = "Stop"
+		noun = "mount"
+	}
+	if len(mounts) != 1 {
+		noun += "s"
+	}
+
+	confirm := components.NewConfirmDialog(components.ConfirmDialogConfig{
+		Title:       verb + " All Mounts",
+		Message:     fmt.Sprintf("%s %d %s?", verb, len(mounts), noun),
+		Description: strings.Join(names, ", "),
+		Options: []components.ConfirmDialogOption{
+			{Label: "Cancel", Action: 0},
+			{Label: verb, Action: 1, IsDestructive: action == systemd.BatchStop},
+		},
+	})
+	confirm.SetSize(s.width, s.height)
+	s.bulkAction = &bulkMountAction{action: action, mounts: mounts, confirm: confirm}
+	s.mode = MountsModeBulkAction
+	return s, nil
+}
+
+// updateBulkMountAction handles key presses in the bulk action confirmation
+// and runs the action once confirmed.
+func (s *MountsScreen) updateBulkMountAction(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	bulk := s.bulkAction
+	bulk.confirm.Update(msg)
+	if !bulk.confirm.IsDone() {
+		return s, nil
+	}
+	s.bulkAction = nil
+	s.mode = MountsModeList
+	if bulk.confirm.GetSelectedAction() != 1 {
+		return s, nil
+	}
+
+	s.loading = true
+	return s, s.runBulkMountAction(bulk.action, bulk.mounts)
+}
+
+// runBulkMountAction applies action to every mount's service. A failure on
+// one mount does not stop the rest.
+func (s *MountsScreen) runBulkMountAction(action systemd.BatchAction, mounts []models.MountConfig) tea.Cmd {
+	manager := s.manager
+	units := make([]string, len(mounts))
+	names := make(map[string]string, len(mounts))
+	for i, m := range mounts {
+		units[i] = s.generator.ServiceName(m.ID, "mount") + ".service"
+		names[units[i]] = m.Name
+	}
+
+	return func() tea.Msg {
+		results := systemd.RunBatch(manager, action, units)
+		_, failed := systemd.CountResults(results)
+		return MountsBulkActionMsg{
+			Action:  action,
+			Summary: bulkMountSummary(action, results, names),
+			Failed:  failed,
+		}
+	}
+}
+
+// bulkMountSummary describes the outcome of a bulk action, naming each
+// failed mount and its error, e.g. "3 started, 1 failed: Dropbox
+// (permission denied)".
+func bulkMountSummary(action systemd.BatchAction, results []systemd.ActionResult, names map[string]string) string {
+	succeeded, failed := systemd.CountResults(results)
+	summary := fmt.Sprintf("%d started", succeeded)
+	if action == systemd.BatchStop {
+		summary = fmt.Sprintf("%d stopped", succeeded)
+	}
+	if failed == 0 {
+		return summary
+	}
+
+	var failures []string
+	for _, r := range results {
+		if !r.OK() {
+			failures = append(failures, fmt.Sprintf("%s (%s)", names[r.Unit], r.Error))
+		}
+	}
+	return fmt.Sprintf("%s, %d failed: %s", summary, failed, strings.Join(failures, ", "))
+}
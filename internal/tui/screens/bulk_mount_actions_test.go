@@ -0,0 +1,98 @@
+package screens
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// failingStartManager fails to start one unit and starts the rest.
+type failingStartManager struct {
+	*systemd.MockManager
+	failUnit string
+}
+
+func (m *failingStartManager) Start(name string) error {
+	m.MockManager.Start(name)
+	if name == m.failUnit {
+		return errors.New("permission denied")
+	}
+	return nil
+}
+
+func newBulkMountsScreen(mgr systemd.ServiceManager) *MountsScreen {
+	screen := NewMountsScreen()
+	screen.SetSize(100, 30)
+	screen.loading = false
+	screen.mounts = createTestMounts()
+	screen.generator = &systemd.MockGenerator{}
+	screen.manager = mgr
+	return screen
+}
+
+func TestMountsScreen_StartAllMounts(t *testing.T) {
+	gen := &systemd.MockGenerator{}
+	mgr := &failingStartManager{MockManager: &systemd.MockManager{}, failUnit: gen.ServiceName("b2c3d4e5", "mount") + ".service"}
+	screen := newBulkMountsScreen(mgr)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	if screen.mode != MountsModeBulkAction {
+		t.Fatal("S should ask for confirmation")
+	}
+	view := screen.View()
+	if !strings.Contains(view, "Start 2 enabled mounts?") || !strings.Contains(view, "Google Drive, Dropbox") || strings.Contains(view, "S3 Bucket") {
+		t.Errorf("the confirmation should list only the enabled mounts:\n%s", view)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || screen.mode != MountsModeList {
+		t.Fatal("confirming should run the bulk start")
+	}
+	msg := cmd()
+	if len(mgr.Calls) != 2 {
+		t.Errorf("every enabled mount should be started despite a failure, calls = %v", mgr.Calls)
+	}
+
+	_, cmd = screen.Update(msg)
+	if screen.err == nil || screen.err.Error() != "1 started, 1 failed: Dropbox (permission denied)" {
+		t.Errorf("err = %v, want the summary", screen.err)
+	}
+	if cmd == nil {
+		t.Error("statuses should be reloaded after the bulk action")
+	}
+}
+
+func TestMountsScreen_StopAllMounts(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	screen := newBulkMountsScreen(mgr)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+	if !strings.Contains(screen.View(), "Stop 3 mounts?") {
+		t.Errorf("X should ask to stop every mount:\n%s", screen.View())
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	screen.Update(cmd())
+	if screen.err != nil || screen.success != "3 stopped" {
+		t.Errorf("success = %q, err = %v, want \"3 stopped\"", screen.success, screen.err)
+	}
+
+	// Cancelling runs nothing
+	mgr.Calls = nil
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+	if _, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || len(mgr.Calls) != 0 || screen.mode != MountsModeList {
+		t.Error("cancelling should return to the list without stopping anything")
+	}
+}
+
+func TestMountsScreen_BulkMountAction_NilServices(t *testing.T) {
+	screen := newBulkMountsScreen(nil)
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	if screen.mode != MountsModeList || screen.err == nil {
+		t.Error("a bulk action without systemd should report an error")
+	}
+}
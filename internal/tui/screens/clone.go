@@ -0,0 +1,86 @@
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// copyName returns name with " (copy)" appended, numbering later copies
+// ("gdrive (copy 2)") until taken reports the name is free.
+func copyName(name string, taken func(string) bool) string {
+	candidate := name + " (copy)"
+	for n := 2; taken(candidate); n++ {
+		candidate = fmt.Sprintf("%s (copy %d)", name, n)
+	}
+	return candidate
+}
+
+// cloneMount returns a copy of mount to pre-fill the create form with. The
+// copy has no ID, so saving it generates a new one, and gets a name and
+// mount point no other mount in cfg uses.
+func cloneMount(mount models.MountConfig, cfg *config.Config) models.MountConfig {
+	var existing []models.MountConfig
+	if cfg != nil {
+		existing = cfg.Mounts
+	}
+
+	clone := mount
+	clone.ID = ""
+	clone.Favorite = false
+	clone.CreatedAt = time.Time{}
+	clone.ModifiedAt = time.Time{}
+	clone.Name = copyName(mount.Name, func(name string) bool {
+		for _, m := range existing {
+			if m.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+
+	used := func(path string) bool {
+		for _, m := range existing {
+			if m.MountPoint == path {
+				return true
+			}
+		}
+		return false
+	}
+	clone.MountPoint = mount.MountPoint + "-copy"
+	for n := 2; used(clone.MountPoint); n++ {
+		clone.MountPoint = fmt.Sprintf("%s-copy%d", mount.MountPoint, n)
+	}
+	return clone
+}
+
+// cloneSyncJob returns a copy of job to pre-fill the create form with. The
+// copy has no ID or run history, so saving it generates a new ID, and gets a
+// name no other sync job in cfg uses.
+func cloneSyncJob(job models.SyncJobConfig, cfg *config.Config) models.SyncJobConfig {
+	var existing []models.SyncJobConfig
+	if cfg != nil {
+		existing = cfg.SyncJobs
+	}
+
+	clone := job
+	clone.ID = ""
+	clone.Favorite = false
+	clone.CreatedAt = time.Time{}
+	clone.ModifiedAt = time.Time{}
+	clone.LastRun = time.Time{}
+	clone.RunHistory = nil
+	clone.RequiresMounts = append([]string(nil), job.RequiresMounts...)
+	clone.SyncOptions.Filters = append([]string(nil), job.SyncOptions.Filters...)
+	clone.Name = copyName(job.Name, func(name string) bool {
+		for _, j := range existing {
+			if j.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+	return clone
+}
@@ -0,0 +1,105 @@
+package screens
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+func TestCopyName(t *testing.T) {
+	taken := map[string]bool{"gdrive": true, "gdrive (copy)": true, "gdrive (copy 2)": true}
+	if got := copyName("dropbox", func(name string) bool { return taken[name] }); got != "dropbox (copy)" {
+		t.Errorf("copyName() = %q, want %q", got, "dropbox (copy)")
+	}
+	if got := copyName("gdrive", func(name string) bool { return taken[name] }); got != "gdrive (copy 3)" {
+		t.Errorf("copyName() = %q, want %q", got, "gdrive (copy 3)")
+	}
+}
+
+func TestMountsScreen_CloneAndSave(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := createTestConfigWithMounts()
+	original := cfg.Mounts[0]
+
+	screen := NewMountsScreen()
+	screen.SetSize(100, 30)
+	screen.SetServices(cfg, &rclone.Client{}, createTestGenerator(t), createTestManager())
+	screen.mounts = cfg.Mounts
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	if screen.mode != MountsModeCreate || screen.form == nil || screen.form.isEdit {
+		t.Fatalf("C should open the create form, mode = %d, err = %v", screen.mode, screen.err)
+	}
+	if screen.form.name != "Google Drive (copy)" || screen.form.mountPoint != "/mnt/gdrive-copy" {
+		t.Errorf("clone got name %q and mount point %q", screen.form.name, screen.form.mountPoint)
+	}
+	if screen.form.remote != original.Remote || screen.form.vfsCacheMode != original.MountOptions.VFSCacheMode {
+		t.Error("the clone should keep the original's settings")
+	}
+	if err := screen.form.validateName(original.Name); err == nil {
+		t.Error("the original name should still be rejected as a duplicate")
+	}
+	if err := screen.form.validateName(screen.form.name); err != nil {
+		t.Errorf("the copy's name should be free: %v", err)
+	}
+
+	msg, ok := screen.form.submitForm().(MountCreatedMsg)
+	if !ok {
+		t.Fatal("expected MountCreatedMsg")
+	}
+	if len(cfg.Mounts) != 4 {
+		t.Fatalf("expected the clone to be added, got %d mounts", len(cfg.Mounts))
+	}
+	ids := map[string]bool{}
+	for _, m := range cfg.Mounts {
+		if ids[m.ID] {
+			t.Errorf("duplicate mount ID %q", m.ID)
+		}
+		ids[m.ID] = true
+	}
+	if msg.Mount.ID == "" || msg.Mount.ID == original.ID || cfg.Mounts[0].Name != original.Name {
+		t.Errorf("the clone should get a fresh ID and leave the original alone, got %q", msg.Mount.ID)
+	}
+}
+
+func TestSyncJobsScreen_CloneAndSave(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := createTestConfigWithSyncJobs()
+	cfg.SyncJobs[0].LastRun = time.Now()
+	cfg.SyncJobs[0].RunHistory = []models.RunOutcome{{FinishedAt: time.Now(), Success: true}}
+	original := cfg.SyncJobs[0]
+
+	screen := NewSyncJobsScreen()
+	screen.SetSize(100, 30)
+	screen.SetServices(cfg, &rclone.Client{}, createTestGenerator(t), createTestManager())
+	screen.jobs = cfg.SyncJobs
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	if screen.mode != SyncJobsModeCreate || screen.form == nil || screen.form.isEdit {
+		t.Fatalf("C should open the create form, mode = %d, err = %v", screen.mode, screen.err)
+	}
+	if screen.form.name != "Daily Backup (copy)" {
+		t.Errorf("clone got name %q", screen.form.name)
+	}
+
+	msg, ok := screen.form.submitForm().(SyncJobCreatedMsg)
+	if !ok {
+		t.Fatal("expected SyncJobCreatedMsg")
+	}
+	if len(cfg.SyncJobs) != 4 {
+		t.Fatalf("expected the clone to be added, got %d sync jobs", len(cfg.SyncJobs))
+	}
+	clone := msg.Job
+	if clone.ID == "" || clone.ID == original.ID {
+		t.Errorf("the clone should get a fresh ID, got %q", clone.ID)
+	}
+	if clone.Source != original.Source || clone.Destination != original.Destination {
+		t.Errorf("the clone should keep the paths, got %s → %s", clone.Source, clone.Destination)
+	}
+	if len(clone.RunHistory) != 0 || !clone.LastRun.IsZero() {
+		t.Error("the clone should not inherit the run history")
+	}
+}
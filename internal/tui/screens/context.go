@@ -0,0 +1,14 @@
+package screens
+
+import "context"
+
+// rootCtx is cancelled when the TUI exits. Background work started by the
+// screens, such as auto-refresh ticks and fetches, derives from it so none of
+// it outlives the program.
+var rootCtx = context.Background()
+
+// SetRootContext sets the context that background work of the screens
+// derives from. It must be called before the program starts.
+func SetRootContext(ctx context.Context) {
+	rootCtx = ctx
+}
@@ -0,0 +1,77 @@
+package screens
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+// cancelRootContext installs a root context for the test and returns its
+// cancel function. The background context is restored afterwards.
+func cancelRootContext(t *testing.T) context.CancelFunc {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	SetRootContext(ctx)
+	t.Cleanup(func() {
+		cancel()
+		SetRootContext(context.Background())
+	})
+	return cancel
+}
+
+// runCmdWithin runs fn and fails the test if it does not return in time.
+func runCmdWithin(t *testing.T, d time.Duration, fn func() any) any {
+	t.Helper()
+	result := make(chan any, 1)
+	go func() { result <- fn() }()
+	select {
+	case v := <-result:
+		return v
+	case <-time.After(d):
+		t.Fatalf("command did not return within %v", d)
+		return nil
+	}
+}
+
+func TestAutoRefreshTick_StopsOnExit(t *testing.T) {
+	cancel := cancelRootContext(t)
+
+	ar := autoRefresh{screen: "mounts"}
+	cmd := ar.toggle(time.Minute)
+	cancel()
+
+	if msg := runCmdWithin(t, autoRefreshTick/2, func() any { return cmd() }); msg != nil {
+		t.Errorf("tick after exit = %#v, want nil", msg)
+	}
+}
+
+func TestFetch_StopsOnExit(t *testing.T) {
+	cancel := cancelRootContext(t)
+
+	script := filepath.Join(t.TempDir(), "rclone")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho started\nexec sleep 30\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	screen := NewSyncJobsScreen()
+	screen.rclone = rclone.NewClientWithPath(script)
+	cmd := screen.runFetch("gdrive:/a", t.TempDir())
+	run := screen.fetch
+
+	// Wait for rclone to be running before the TUI exits
+	if msg, ok := cmd().(FetchProgressMsg); !ok || msg.Line != "started" {
+		t.Fatalf("first message = %#v, want the started line", msg)
+	}
+	cancel()
+
+	if msg := waitForFetch(run)(); msg != nil {
+		t.Errorf("waiting on a fetch after exit = %#v, want nil", msg)
+	}
+	if err := <-run.done; err == nil {
+		t.Error("rclone should have been stopped with an error")
+	}
+}
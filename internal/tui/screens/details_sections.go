@@ -0,0 +1,37 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// collapsedSections remembers which sections of the details views are
+// collapsed, keyed by view and section title. It lives for the session so a
+// reopened details view keeps the layout the user chose; every section starts
+// expanded.
+var collapsedSections = map[string]bool{}
+
+// sectionKey returns the title of the section toggled by key, a number from
+// 1 to len(titles).
+func sectionKey(key string, titles []string) (string, bool) {
+	n, err := strconv.Atoi(key)
+	if err != nil || n < 1 || n > len(titles) {
+		return "", false
+	}
+	return titles[n-1], true
+}
+
+// toggleSection collapses an expanded section of view or expands a collapsed one.
+func toggleSection(view, title string) {
+	key := view + "/" + title
+	collapsedSections[key] = !collapsedSections[key]
+}
+
+// renderSection renders a numbered section header followed by body, or only
+// the header if the section is collapsed.
+func renderSection(view string, n int, title, body string) string {
+	if collapsedSections[view+"/"+title] {
+		return fmt.Sprintf("\n  [%d] ▸ %s (collapsed)\n", n, title)
+	}
+	return fmt.Sprintf("\n  [%d] ▾ %s:\n%s", n, title, body)
+}
@@ -0,0 +1,198 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// SyncDryRunOutputMsg carries a line of output from a running dry run.
+type SyncDryRunOutputMsg struct {
+	Line string
+}
+
+// SyncDryRunDoneMsg is sent when a dry run finishes.
+type SyncDryRunDoneMsg struct {
+	Name string
+	Err  error
+}
+
+// dryRunRun tracks a dry run running in the background.
+type dryRunRun struct {
+	jobID string
+	name  string
+	lines chan string // Closed once the run has finished
+	done  chan error
+}
+
+// dryRunOutput is the output of a dry run shown in the details view.
+type dryRunOutput struct {
+	lines []string
+	done  bool
+	err   error
+}
+
+// dryRunWriter splits rclone output into lines. Unlike fetch progress every
+// line matters, so it waits for the screen instead of dropping lines, until
+// stop is closed.
+type dryRunWriter struct {
+	lines   chan<- string
+	stop    <-chan struct{}
+	partial string
+}
+
+func (w *dryRunWriter) Write(p []byte) (int, error) {
+	w.partial += string(p)
+	for {
+		i := strings.IndexAny(w.partial, "\r\n")
+		if i < 0 {
+			break
+		}
+		w.send(w.partial[:i])
+		w.partial = w.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// flush sends a last line that did not end in a newline.
+func (w *dryRunWriter) flush() {
+	w.send(w.partial)
+	w.partial = ""
+}
+
+func (w *dryRunWriter) send(line string) {
+	if line = strings.TrimSpace(line); line == "" {
+		return
+	}
+	select {
+	case w.lines <- line:
+	case <-w.stop:
+	}
+}
+
+// startDryRun runs the selected job once as a transient unit with --dry-run
+// added, and shows its output on the logs tab of the job's details. The
+// saved job, including its own dry run option, is left unchanged.
+func (s *SyncJobsScreen) startDryRun() (tea.Model, tea.Cmd) {
+	if s.generator == nil || s.manager == nil {
+		s.err = fmt.Errorf("systemd services not initialized")
+		return s, nil
+	}
+	if s.dryRun != nil {
+		s.err = fmt.Errorf("a dry run of '%s' is already running", s.dryRun.name)
+		return s, nil
+	}
+
+	job := s.jobs[s.cursor]
+	command, err := s.generator.DryRunCommand(&job)
+	if err != nil {
+		s.err = fmt.Errorf("cannot dry run '%s': %w", job.Name, err)
+		return s, nil
+	}
+
+	s.openDetails(job)
+	s.details.tab = 1
+	s.details.dryRun = &dryRunOutput{}
+	s.err = nil
+
+	run := &dryRunRun{
+		jobID: job.ID,
+		name:  job.Name,
+		lines: make(chan string, 64),
+		done:  make(chan error, 1),
+	}
+	s.dryRun = run
+
+	unit := s.generator.ServiceName(job.ID, "sync") + "-dry-run"
+	properties := s.generator.RunProperties(&job)
+	manager, ctx := s.manager, rootCtx
+	go func() {
+		out := &dryRunWriter{lines: run.lines, stop: ctx.Done()}
+		err := manager.RunTransient(ctx, unit, properties, command, out)
+		out.flush()
+		close(run.lines)
+		run.done <- err
+	}()
+
+	return s, waitForDryRun(run)
+}
+
+// waitForDryRun waits for the next output line or the end of a dry run. It
+// gives up without a message once the TUI exits.
+func waitForDryRun(run *dryRunRun) tea.Cmd {
+	ctx := rootCtx
+	return func() tea.Msg {
+		select {
+		case line, ok := <-run.lines:
+			if ok {
+				return SyncDryRunOutputMsg{Line: line}
+			}
+			return SyncDryRunDoneMsg{Name: run.name, Err: <-run.done}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleDryRunMsg adds dry run output to the details view while it shows the
+// job, or reports the result in the list once the view is closed.
+func (s *SyncJobsScreen) handleDryRunMsg(msg tea.Msg) tea.Cmd {
+	run := s.dryRun
+	if run == nil {
+		return nil
+	}
+
+	var output *dryRunOutput
+	if s.mode == SyncJobsModeDetails && s.details != nil && s.details.job.ID == run.jobID {
+		output = s.details.dryRun
+	}
+
+	switch msg := msg.(type) {
+	case SyncDryRunOutputMsg:
+		if output != nil {
+			output.lines = append(output.lines, msg.Line)
+		}
+		return waitForDryRun(run)
+	case SyncDryRunDoneMsg:
+		s.dryRun = nil
+		if output != nil {
+			output.done = true
+			output.err = msg.Err
+			return nil
+		}
+		if msg.Err != nil {
+			s.err = fmt.Errorf("dry run of '%s' failed: %w", msg.Name, msg.Err)
+			return nil
+		}
+		s.success = fmt.Sprintf("Dry run of '%s' finished; nothing was changed", msg.Name)
+	}
+	return nil
+}
+
+// renderDryRun renders the output of a dry run on the logs tab.
+func (d *SyncJobDetails) renderDryRun() string {
+	var b strings.Builder
+	b.WriteString(components.Styles.Warning.Render("  DRY RUN: rclone ran with --dry-run, nothing was changed"))
+	b.WriteString("\n\n")
+
+	lines := d.dryRun.lines
+	if len(lines) > 20 {
+		lines = lines[len(lines)-20:]
+	}
+	if len(lines) > 0 {
+		b.WriteString(components.Styles.Normal.Render(strings.Join(lines, "\n")))
+		b.WriteString("\n\n")
+	}
+
+	switch {
+	case !d.dryRun.done:
+		b.WriteString(components.Styles.HelpText.Render("  Dry run in progress..."))
+	case d.dryRun.err != nil:
+		b.WriteString(components.RenderError(fmt.Sprintf("  Dry run failed: %v", d.dryRun.err)))
+	default:
+		b.WriteString(components.RenderSuccess("  Dry run finished"))
+	}
+	return b.String()
+}
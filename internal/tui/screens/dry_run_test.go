@@ -0,0 +1,86 @@
+package screens
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+func TestSyncJobsScreen_DryRunNeedsServices(t *testing.T) {
+	screen := NewSyncJobsScreen()
+	screen.loading = false
+	screen.jobs = createTestSyncJobs()
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	if cmd != nil || screen.err == nil || screen.mode != SyncJobsModeList {
+		t.Error("dry run should report an error without systemd services")
+	}
+}
+
+func TestSyncJobsScreen_DryRun(t *testing.T) {
+	mgr := &systemd.MockManager{
+		GetDetailedStatusResult: &models.ServiceStatus{},
+		RunTransientOutput:      "NOTICE: a.txt: Skipped copy as --dry-run is set\nNOTICE: b.txt: Skipped delete as --dry-run is set",
+	}
+	screen := NewSyncJobsScreen()
+	screen.SetSize(80, 24)
+	screen.loading = false
+	screen.jobs = createTestSyncJobs()
+	screen.generator = &systemd.Generator{}
+	screen.manager = mgr
+	job := screen.jobs[0]
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	if cmd == nil {
+		t.Fatal("D should start a dry run")
+	}
+	if screen.mode != SyncJobsModeDetails || screen.details == nil || screen.details.tab != 1 {
+		t.Fatal("the dry run should be shown on the logs tab of the job's details")
+	}
+
+	// Feed the messages back until the run is done
+	for cmd != nil {
+		msg := cmd()
+		_, cmd = screen.Update(msg)
+		if _, done := msg.(SyncDryRunDoneMsg); done {
+			break
+		}
+	}
+
+	unit := "rclone-sync-" + job.ID + "-dry-run"
+	if !mgr.Called("RunTransient", unit) {
+		t.Fatalf("expected a transient run of %s, calls = %v", unit, mgr.Calls)
+	}
+	command := strings.Join(mgr.TransientCommand, " ")
+	if !strings.Contains(command, " sync "+job.Source+" "+job.Destination+" ") || !strings.HasSuffix(command, " --dry-run") {
+		t.Errorf("transient command = %q, want the job's sync with --dry-run", command)
+	}
+	if screen.jobs[0].SyncOptions.DryRun {
+		t.Error("the saved job must not be changed")
+	}
+
+	view := screen.View()
+	for _, want := range []string{"DRY RUN", "nothing was changed", "b.txt: Skipped delete", "Dry run finished"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view should contain %q, got:\n%s", want, view)
+		}
+	}
+	if screen.dryRun != nil {
+		t.Error("dry run should be cleared when done")
+	}
+}
+
+func TestSyncJobsScreen_DryRunResultAfterClosingDetails(t *testing.T) {
+	screen := NewSyncJobsScreen()
+	screen.loading = false
+	screen.dryRun = &dryRunRun{jobID: "e5f6g7h8", name: "Daily Backup"}
+
+	screen.Update(SyncDryRunDoneMsg{Name: "Daily Backup", Err: errors.New("exited with status 3")})
+	if screen.err == nil || !strings.Contains(screen.err.Error(), "dry run of 'Daily Backup' failed") {
+		t.Errorf("err = %v, want the failed dry run reported in the list", screen.err)
+	}
+}
@@ -0,0 +1,222 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// FetchProgressMsg carries the latest output line of a running fetch.
+type FetchProgressMsg struct {
+	Line string
+}
+
+// FetchDoneMsg is sent when a one-shot fetch finishes.
+type FetchDoneMsg struct {
+	Source      string
+	Destination string
+	Err         error
+}
+
+// fetchRun tracks a one-shot fetch running in the background.
+type fetchRun struct {
+	source string
+	dest   string
+	lines  chan string
+	done   chan error
+	last   string // Latest progress line
+}
+
+// fetchLineWriter splits rclone output into lines for the progress display.
+// Lines are dropped rather than blocking rclone when the screen falls behind.
+type fetchLineWriter struct {
+	lines   chan<- string
+	partial string
+}
+
+func (w *fetchLineWriter) Write(p []byte) (int, error) {
+	w.partial += string(p)
+	for {
+		i := strings.IndexAny(w.partial, "\r\n")
+		if i < 0 {
+			break
+		}
+		if line := strings.TrimSpace(w.partial[:i]); line != "" {
+			select {
+			case w.lines <- line:
+			default:
+			}
+		}
+		w.partial = w.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// startFetchForm asks for the source and destination of a one-shot fetch.
+func (s *SyncJobsScreen) startFetchForm() (tea.Model, tea.Cmd) {
+	if s.rclone == nil {
+		s.err = fmt.Errorf("rclone client not initialized - please ensure rclone is installed")
+		return s, nil
+	}
+	if s.fetch != nil {
+		s.err = fmt.Errorf("a fetch is already running")
+		return s, nil
+	}
+
+	s.fetchSource = ""
+	s.fetchDest = ""
+	s.fetchForm = huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Source").
+				Description("URL or remote path to fetch once (e.g., https://host/file.iso or gdrive:/Docs)").
+				Value(&s.fetchSource).
+				Validate(requiredString("source")),
+			huh.NewInput().
+				Title("Destination").
+				Description("Local or remote destination. For URLs, end with / to keep the file name").
+				Value(&s.fetchDest).
+				Validate(requiredString("destination")),
+		),
+	)
+	s.fetchForm.WithTheme(huh.ThemeBase16())
+	s.mode = SyncJobsModeFetch
+	s.err = nil
+	return s, s.fetchForm.Init()
+}
+
+// requiredString returns a validator rejecting blank input.
+func requiredString(field string) func(string) error {
+	return func(v string) error {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%s is required", field)
+		}
+		return nil
+	}
+}
+
+// updateFetchForm handles updates while the fetch form is open.
+func (s *SyncJobsScreen) updateFetchForm(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.String() == "esc" {
+		s.fetchForm = nil
+		s.mode = SyncJobsModeList
+		return s, nil
+	}
+
+	form, cmd := s.fetchForm.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		s.fetchForm = f
+	}
+
+	switch s.fetchForm.State {
+	case huh.StateCompleted:
+		s.fetchForm = nil
+		s.mode = SyncJobsModeList
+		return s, s.runFetch(strings.TrimSpace(s.fetchSource), strings.TrimSpace(s.fetchDest))
+	case huh.StateAborted:
+		s.fetchForm = nil
+		s.mode = SyncJobsModeList
+		return s, nil
+	}
+
+	return s, cmd
+}
+
+// runFetch starts a transient fetch and returns the command that reports
+// its progress. Nothing is written to the config, and rclone is stopped if
+// the TUI exits first.
+func (s *SyncJobsScreen) runFetch(source, dest string) tea.Cmd {
+	run := &fetchRun{
+		source: source,
+		dest:   dest,
+		lines:  make(chan string, 16),
+		done:   make(chan error, 1),
+	}
+	s.fetch = run
+
+	client, ctx := s.rclone, rootCtx
+	go func() {
+		out := &fetchLineWriter{lines: run.lines}
+		run.done <- client.Fetch(ctx, source, dest, out, "--stats=1s", "--stats-one-line", "-v")
+	}()
+
+	return waitForFetch(run)
+}
+
+// waitForFetch waits for the next progress line or the end of a fetch. It
+// gives up without a message once the TUI exits.
+func waitForFetch(run *fetchRun) tea.Cmd {
+	ctx := rootCtx
+	return func() tea.Msg {
+		if ctx.Err() != nil {
+			return nil
+		}
+		select {
+		case line := <-run.lines:
+			return FetchProgressMsg{Line: line}
+		case err := <-run.done:
+			return FetchDoneMsg{Source: run.source, Destination: run.dest, Err: err}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleFetchMsg updates the screen with fetch progress or its result.
+func (s *SyncJobsScreen) handleFetchMsg(msg tea.Msg) tea.Cmd {
+	if s.fetch == nil {
+		return nil
+	}
+
+	switch msg := msg.(type) {
+	case FetchProgressMsg:
+		s.fetch.last = msg.Line
+		return waitForFetch(s.fetch)
+	case FetchDoneMsg:
+		last := s.fetch.last
+		s.fetch = nil
+		if msg.Err != nil {
+			if last != "" {
+				s.err = fmt.Errorf("fetch of %s failed: %w (%s)", msg.Source, msg.Err, last)
+			} else {
+				s.err = fmt.Errorf("fetch of %s failed: %w", msg.Source, msg.Err)
+			}
+			return nil
+		}
+		s.success = fmt.Sprintf("Fetched %s to %s", msg.Source, msg.Destination)
+		s.err = nil
+	}
+	return nil
+}
+
+// IsEnteringFetch reports whether the fetch form is open, so typed keys
+// reach it instead of the global bindings.
+func (s *SyncJobsScreen) IsEnteringFetch() bool {
+	return s.mode == SyncJobsModeFetch && s.fetchForm != nil
+}
+
+// renderFetchForm renders the fetch form.
+func (s *SyncJobsScreen) renderFetchForm() string {
+	var b strings.Builder
+	b.WriteString(components.Styles.Title.Render("Fetch Once"))
+	b.WriteString("\n\n")
+	b.WriteString(s.fetchForm.View())
+	b.WriteString("\n")
+	b.WriteString(components.HelpBar(s.width, []components.HelpItem{
+		{Key: "enter", Desc: "next/start"},
+		{Key: "esc", Desc: "cancel"},
+	}))
+	return b.String()
+}
+
+// renderFetchProgress renders the status line of a running fetch.
+func (s *SyncJobsScreen) renderFetchProgress() string {
+	status := fmt.Sprintf("Fetching %s → %s", s.fetch.source, s.fetch.dest)
+	if s.fetch.last != "" {
+		status += "\n  " + s.fetch.last
+	}
+	return components.Styles.HelpText.Render(status)
+}
@@ -0,0 +1,79 @@
+package screens
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+func TestFetchLineWriter(t *testing.T) {
+	lines := make(chan string, 4)
+	w := &fetchLineWriter{lines: lines}
+
+	w.Write([]byte("Transferred: 1 MiB\rTransferred: 2 "))
+	w.Write([]byte("MiB\n\n"))
+	close(lines)
+
+	var got []string
+	for line := range lines {
+		got = append(got, line)
+	}
+	want := []string{"Transferred: 1 MiB", "Transferred: 2 MiB"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("lines = %q, want %q", got, want)
+	}
+}
+
+func TestSyncJobsScreen_FetchFormNeedsRclone(t *testing.T) {
+	screen := NewSyncJobsScreen()
+	screen.loading = false
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if screen.mode != SyncJobsModeList || screen.err == nil {
+		t.Error("fetch should report an error without an rclone client")
+	}
+}
+
+func TestSyncJobsScreen_FetchFormOpensAndCancels(t *testing.T) {
+	screen := NewSyncJobsScreen()
+	screen.loading = false
+	screen.rclone = rclone.NewClientWithPath("/nonexistent/rclone")
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if !screen.IsEnteringFetch() {
+		t.Fatal("'f' should open the fetch form")
+	}
+	if !strings.Contains(screen.View(), "Fetch Once") {
+		t.Error("View() should render the fetch form")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.IsEnteringFetch() || screen.mode != SyncJobsModeList {
+		t.Error("esc should close the fetch form")
+	}
+}
+
+func TestSyncJobsScreen_FetchProgressAndResult(t *testing.T) {
+	screen := NewSyncJobsScreen()
+	screen.loading = false
+	screen.fetch = &fetchRun{source: "gdrive:/a", dest: "/tmp", lines: make(chan string), done: make(chan error)}
+
+	_, cmd := screen.Update(FetchProgressMsg{Line: "Transferred: 50%"})
+	if cmd == nil {
+		t.Error("progress should keep waiting for the fetch")
+	}
+	if !strings.Contains(screen.View(), "Transferred: 50%") {
+		t.Error("View() should show the latest fetch progress")
+	}
+
+	screen.Update(FetchDoneMsg{Source: "gdrive:/a", Destination: "/tmp", Err: errors.New("rclone copy exited with status 3")})
+	if screen.fetch != nil {
+		t.Error("fetch should be cleared when done")
+	}
+	if screen.err == nil || !strings.Contains(screen.err.Error(), "status 3") {
+		t.Errorf("err = %v, want the exit status", screen.err)
+	}
+}
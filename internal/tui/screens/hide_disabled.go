@@ -0,0 +1,69 @@
+package screens
+
+import (
+	"fmt"
+
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+)
+
+// hideDisabled reports whether disabled items are left out of the lists.
+func hideDisabled(cfg *config.Config) bool {
+	return cfg != nil && cfg.Settings.HideDisabled
+}
+
+// toggleHideDisabled flips the hide disabled setting and saves it, restoring
+// the previous value if the config cannot be saved.
+func toggleHideDisabled(cfg *config.Config) error {
+	if cfg == nil {
+		return fmt.Errorf("config not initialized")
+	}
+	cfg.Settings.HideDisabled = !cfg.Settings.HideDisabled
+	if err := cfg.Save(); err != nil {
+		cfg.Settings.HideDisabled = !cfg.Settings.HideDisabled
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	return nil
+}
+
+// visibleMounts returns the mounts to list and how many disabled ones were
+// left out.
+func visibleMounts(mounts []models.MountConfig, hide bool) ([]models.MountConfig, int) {
+	if !hide {
+		return mounts, 0
+	}
+	visible := make([]models.MountConfig, 0, len(mounts))
+	for _, m := range mounts {
+		if m.Enabled {
+			visible = append(visible, m)
+		}
+	}
+	return visible, len(mounts) - len(visible)
+}
+
+// visibleSyncJobs returns the sync jobs to list and how many disabled ones
+// were left out.
+func visibleSyncJobs(jobs []models.SyncJobConfig, hide bool) ([]models.SyncJobConfig, int) {
+	if !hide {
+		return jobs, 0
+	}
+	visible := make([]models.SyncJobConfig, 0, len(jobs))
+	for _, j := range jobs {
+		if j.Enabled {
+			visible = append(visible, j)
+		}
+	}
+	return visible, len(jobs) - len(visible)
+}
+
+// hiddenLabel describes how many disabled items are hidden, e.g.
+// "2 disabled mounts hidden (H to show)", or "" when none are.
+func hiddenLabel(hidden int, noun string) string {
+	if hidden == 0 {
+		return ""
+	}
+	if hidden != 1 {
+		noun += "s"
+	}
+	return fmt.Sprintf("%d disabled %s hidden (H to show)", hidden, noun)
+}
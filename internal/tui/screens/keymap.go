@@ -0,0 +1,249 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Actions that the keybindings section of the config can move to another
+// key. An action keeps the same name on every screen that has it, so
+// binding "stop" moves stop on the mounts, mount details and services
+// screens alike.
+const (
+	ActionAdd          = "add"
+	ActionEdit         = "edit"
+	ActionDelete       = "delete"
+	ActionClone        = "clone"
+	ActionStart        = "start"
+	ActionStop         = "stop"
+	ActionRestart      = "restart"
+	ActionEnable       = "enable"
+	ActionDisable      = "disable"
+	ActionToggle       = "toggle"
+	ActionRun          = "run"
+	ActionDryRun       = "dry_run"
+	ActionTestSchedule = "test_schedule"
+	ActionStartAll     = "start_all"
+	ActionStopAll      = "stop_all"
+	ActionAutoStart    = "autostart"
+	ActionFavorite     = "favorite"
+	ActionMark         = "mark"
+	ActionBulkEdit     = "bulk_edit"
+	ActionLogs         = "logs"
+	ActionCheck        = "check"
+	ActionHealth       = "health"
+	ActionFetch        = "fetch"
+	ActionRefresh      = "refresh"
+	ActionAutoRefresh  = "auto_refresh"
+	ActionHideDisabled = "hide_disabled"
+)
+
+// KeyMap holds the default key of each action a screen offers. Key returns
+// the configured key instead when the action has been rebound.
+type KeyMap map[string]string
+
+// Key returns the key that triggers action, or "" when the screen has no
+// such action. A key press never matches "".
+func (m KeyMap) Key(action string) string {
+	def, ok := m[action]
+	if !ok {
+		return ""
+	}
+	if key, ok := keyBindings[action]; ok {
+		return key
+	}
+	return def
+}
+
+// Default key maps of the screens whose keys can be rebound. The same key
+// can mean different actions on different screens, e.g. r refreshes the
+// mounts but runs a sync job.
+var (
+	MountKeys = KeyMap{
+		ActionAdd:          "a",
+		ActionEdit:         "e",
+		ActionDelete:       "d",
+		ActionClone:        "C",
+		ActionToggle:       "t",
+		ActionStart:        "s",
+		ActionStop:         "x",
+		ActionStartAll:     "S",
+		ActionStopAll:      "X",
+		ActionFavorite:     "*",
+		ActionMark:         " ",
+		ActionBulkEdit:     "b",
+		ActionAutoStart:    "B",
+		ActionRefresh:      "r",
+		ActionHideDisabled: "H",
+		ActionAutoRefresh:  "A",
+		ActionLogs:         "l",
+		ActionCheck:        "c",
+		ActionHealth:       "h",
+	}
+
+	MountDetailKeys = KeyMap{
+		ActionStart:   "s",
+		ActionStop:    "x",
+		ActionEnable:  "e",
+		ActionDisable: "d",
+		ActionRefresh: "r",
+	}
+
+	SyncJobKeys = KeyMap{
+		ActionAdd:          "a",
+		ActionEdit:         "e",
+		ActionDelete:       "d",
+		ActionClone:        "C",
+		ActionRun:          "r",
+		ActionDryRun:       "D",
+		ActionTestSchedule: "T",
+		ActionToggle:       "t",
+		ActionFavorite:     "*",
+		ActionRefresh:      "R",
+		ActionHideDisabled: "H",
+		ActionLogs:         "l",
+		ActionFetch:        "f",
+		ActionMark:         " ",
+		ActionBulkEdit:     "b",
+	}
+
+	SyncJobDetailKeys = KeyMap{
+		ActionRun:     "r",
+		ActionToggle:  "t",
+		ActionEnable:  "e",
+		ActionDisable: "d",
+		ActionRefresh: "R",
+	}
+
+	ServiceKeys = KeyMap{
+		ActionStart:       "s",
+		ActionStop:        "x",
+		ActionRestart:     "r",
+		ActionEnable:      "e",
+		ActionDisable:     "d",
+		ActionLogs:        "l",
+		ActionRefresh:     "R",
+		ActionAutoRefresh: "A",
+	}
+)
+
+// screenKeys is the key map of a screen together with the keys the screen
+// handles itself before its key map, which no action on it can take.
+type screenKeys struct {
+	keys  KeyMap
+	fixed []string
+}
+
+// keyMaps are the key maps SetKeyBindings checks bindings against. The
+// details screens copy with y, switch tabs with tab and fold the sections
+// of their details tab with the number keys.
+var keyMaps = []screenKeys{
+	{keys: MountKeys},
+	{keys: MountDetailKeys, fixed: []string{"y", "tab", "1", "2", "3"}},
+	{keys: SyncJobKeys},
+	{keys: SyncJobDetailKeys, fixed: []string{"tab", "1", "2", "3", "4"}},
+	{keys: ServiceKeys},
+}
+
+// reservedKeys move the cursor, open and close screens and quit, so no
+// action can be bound to them.
+var reservedKeys = map[string]bool{
+	"up": true, "down": true, "k": true, "j": true,
+	"enter": true, "esc": true, "/": true, "?": true, "q": true, "ctrl+c": true,
+}
+
+// keyBindings are the configured keys, by action.
+var keyBindings = map[string]string{}
+
+// SetKeyBindings replaces the configured keys, by action name. Actions not
+// listed keep their default keys. Bindings to unknown actions or reserved
+// keys, and bindings that would give one screen two actions on the same
+// key, are dropped and reported in the returned error.
+func SetKeyBindings(bindings map[string]string) error {
+	actions := make([]string, 0, len(bindings))
+	for action := range bindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	var problems []string
+	valid := make(map[string]string, len(bindings))
+	for _, action := range actions {
+		key := bindings[action]
+		switch {
+		case !knownAction(action):
+			problems = append(problems, fmt.Sprintf("unknown action %q", action))
+		case key == "":
+			problems = append(problems, fmt.Sprintf("no key for %s", action))
+		case reservedKeys[key]:
+			problems = append(problems, fmt.Sprintf("%q is reserved and cannot be bound to %s", key, action))
+		default:
+			valid[action] = key
+		}
+	}
+
+	// Dropping a binding puts its default key back, which can clash with
+	// another binding, so check again until nothing clashes
+	for {
+		clash := clashingBinding(valid)
+		if clash == "" {
+			break
+		}
+		problems = append(problems, fmt.Sprintf("%q for %s clashes with another action", valid[clash], clash))
+		delete(valid, clash)
+	}
+
+	keyBindings = valid
+	if len(problems) > 0 {
+		return fmt.Errorf("key bindings: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// knownAction reports whether any screen offers action.
+func knownAction(action string) bool {
+	for _, screen := range keyMaps {
+		if _, ok := screen.keys[action]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// clashingBinding returns a configured action that shares its key with
+// another action on some screen, or "" if there is none.
+func clashingBinding(bindings map[string]string) string {
+	for _, screen := range keyMaps {
+		byKey := make(map[string][]string)
+		// A fixed key counts as an action of its own that is never bound
+		for _, key := range screen.fixed {
+			byKey[key] = append(byKey[key], "")
+		}
+		for action, def := range screen.keys {
+			key := def
+			if bound, ok := bindings[action]; ok {
+				key = bound
+			}
+			byKey[key] = append(byKey[key], action)
+		}
+		keys := make([]string, 0, len(byKey))
+		for key := range byKey {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			actions := byKey[key]
+			if len(actions) < 2 {
+				continue
+			}
+			sort.Strings(actions)
+			for _, action := range actions {
+				if _, ok := bindings[action]; ok {
+					return action
+				}
+			}
+		}
+	}
+	return ""
+}
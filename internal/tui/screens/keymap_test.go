@@ -0,0 +1,83 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetKeyBindings(t *testing.T) {
+	t.Cleanup(func() { SetKeyBindings(nil) })
+
+	if err := SetKeyBindings(map[string]string{ActionStop: "K", ActionRefresh: "ctrl+r"}); err != nil {
+		t.Fatalf("SetKeyBindings() error = %v", err)
+	}
+	if got := MountKeys.Key(ActionStop); got != "K" {
+		t.Errorf("mount stop key = %q, want K", got)
+	}
+	if got := ServiceKeys.Key(ActionStop); got != "K" {
+		t.Errorf("service stop key = %q, want K on every screen", got)
+	}
+	if got := SyncJobKeys.Key(ActionRefresh); got != "ctrl+r" {
+		t.Errorf("sync job refresh key = %q, want ctrl+r", got)
+	}
+	if got := MountKeys.Key(ActionStart); got != "s" {
+		t.Errorf("unbound start key = %q, want the default s", got)
+	}
+	if got := SyncJobKeys.Key(ActionStop); got != "" {
+		t.Errorf("sync jobs have no stop action, got key %q", got)
+	}
+
+	err := SetKeyBindings(map[string]string{
+		"launch":     "L",
+		ActionEdit:   "q",
+		ActionDelete: "",
+		ActionStop:   "s",
+		ActionLogs:   "L",
+	})
+	if err == nil {
+		t.Fatal("SetKeyBindings() should report the unusable bindings")
+	}
+	for _, want := range []string{`unknown action "launch"`, `"q" is reserved`, "no key for delete", `"s" for stop clashes`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error = %q, want it to mention %s", err, want)
+		}
+	}
+	if MountKeys.Key(ActionStop) != "x" || MountKeys.Key(ActionEdit) != "e" || MountKeys.Key(ActionDelete) != "d" {
+		t.Error("dropped bindings should fall back to the default keys")
+	}
+	if MountKeys.Key(ActionLogs) != "L" {
+		t.Error("the usable binding should still apply")
+	}
+
+	if err := SetKeyBindings(nil); err != nil || MountKeys.Key(ActionLogs) != "l" {
+		t.Errorf("SetKeyBindings(nil) = %v, want the defaults back", err)
+	}
+}
+
+func TestSetKeyBindings_DetailsScreenKeys(t *testing.T) {
+	t.Cleanup(func() { SetKeyBindings(nil) })
+
+	err := SetKeyBindings(map[string]string{ActionStart: "1", ActionRun: "tab", ActionStop: "y"})
+	if err == nil {
+		t.Fatal("SetKeyBindings() should reject keys the details screens handle themselves")
+	}
+	for _, want := range []string{`"1" for start clashes`, `"tab" for run clashes`, `"y" for stop clashes`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error = %q, want it to mention %s", err, want)
+		}
+	}
+	if MountDetailKeys.Key(ActionStart) != "s" || SyncJobDetailKeys.Key(ActionRun) != "r" || MountDetailKeys.Key(ActionStop) != "x" {
+		t.Error("rejected bindings should fall back to the default keys")
+	}
+}
+
+func TestSetKeyBindings_SwapKeys(t *testing.T) {
+	t.Cleanup(func() { SetKeyBindings(nil) })
+
+	if err := SetKeyBindings(map[string]string{ActionStart: "x", ActionStop: "s"}); err != nil {
+		t.Fatalf("swapping two keys should not clash: %v", err)
+	}
+	if MountKeys.Key(ActionStart) != "x" || MountKeys.Key(ActionStop) != "s" {
+		t.Error("start and stop should have swapped keys")
+	}
+}
@@ -0,0 +1,113 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// listSearch is the incremental filter of the mounts and sync jobs lists.
+// "/" opens the input and the list narrows as the query is typed; Enter
+// keeps the filter and Esc clears it.
+type listSearch struct {
+	input   textinput.Model
+	editing bool
+}
+
+// open starts editing the query, starting from the current one.
+func (l *listSearch) open() tea.Cmd {
+	query := l.query()
+	l.input = textinput.New()
+	l.input.Prompt = "/"
+	l.input.SetValue(query)
+	l.input.CursorEnd()
+	l.editing = true
+	return l.input.Focus()
+}
+
+// query returns the filter text, or "" when the list is not filtered.
+func (l *listSearch) query() string {
+	return strings.TrimSpace(l.input.Value())
+}
+
+// clear removes the filter and closes the input.
+func (l *listSearch) clear() {
+	l.input.SetValue("")
+	l.input.Blur()
+	l.editing = false
+}
+
+// update handles a key press while the query is edited.
+func (l *listSearch) update(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		l.clear()
+		return nil
+	case "enter":
+		l.input.Blur()
+		l.editing = false
+		return nil
+	}
+
+	var cmd tea.Cmd
+	l.input, cmd = l.input.Update(msg)
+	return cmd
+}
+
+// view renders the input while editing, the applied filter otherwise, or ""
+// when the list is not filtered.
+func (l *listSearch) view() string {
+	if l.editing {
+		return l.input.View()
+	}
+	if query := l.query(); query != "" {
+		return components.Styles.Subtitle.Render(fmt.Sprintf("Filter: %q (Esc to clear)", query))
+	}
+	return ""
+}
+
+// matchesQuery reports whether any field contains query, ignoring case. An
+// empty query matches everything.
+func matchesQuery(query string, fields ...string) bool {
+	query = strings.ToLower(query)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchMounts returns the mounts whose name, remote or mount point contain
+// query.
+func searchMounts(mounts []models.MountConfig, query string) []models.MountConfig {
+	if query == "" {
+		return mounts
+	}
+	var matched []models.MountConfig
+	for _, m := range mounts {
+		if matchesQuery(query, m.Name, m.FullRemotePath(), m.MountPoint) {
+			matched = append(matched, m)
+		}
+	}
+	return matched
+}
+
+// searchSyncJobs returns the sync jobs whose name, source or destination
+// contain query.
+func searchSyncJobs(jobs []models.SyncJobConfig, query string) []models.SyncJobConfig {
+	if query == "" {
+		return jobs
+	}
+	var matched []models.SyncJobConfig
+	for _, j := range jobs {
+		if matchesQuery(query, j.Name, j.Source, j.Destination) {
+			matched = append(matched, j)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,132 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/config"
+)
+
+func TestSearchMounts(t *testing.T) {
+	mounts := createTestMounts()
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"Google Drive", "Dropbox", "S3 Bucket"}},
+		{"drive", []string{"Google Drive"}},
+		{"DROPBOX", []string{"Dropbox"}},
+		{"s3:/backup", []string{"S3 Bucket"}},
+		{"/mnt/", []string{"Google Drive", "Dropbox", "S3 Bucket"}},
+		{"/photos", []string{"Dropbox"}},
+		{"onedrive", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, m := range searchMounts(mounts, tt.query) {
+			got = append(got, m.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("searchMounts(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSearchSyncJobs(t *testing.T) {
+	jobs := createTestSyncJobs()
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"Daily Backup", "Photo Sync", "Manual Sync"}},
+		{"sync", []string{"Photo Sync", "Manual Sync"}},
+		{"GDRIVE:", []string{"Daily Backup"}},
+		{"s3backup", []string{"Manual Sync"}},
+		{"nothing", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, j := range searchSyncJobs(jobs, tt.query) {
+			got = append(got, j.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("searchSyncJobs(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func typeKeys(screen tea.Model, keys string) {
+	for _, r := range keys {
+		screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestMountsScreen_SearchList(t *testing.T) {
+	screen := NewMountsScreen()
+	screen.SetSize(100, 30)
+	screen.loading = false
+	screen.config = &config.Config{Mounts: createTestMounts()}
+	screen.mounts, screen.hidden = screen.listedMounts()
+	screen.cursor = 2
+
+	typeKeys(screen, "/")
+	if !screen.IsSearching() {
+		t.Fatal("/ should open the filter")
+	}
+	typeKeys(screen, "d")
+	if len(screen.mounts) != 2 || screen.cursor != 1 {
+		t.Errorf("typing should narrow the list and clamp the cursor, got %d mounts, cursor %d", len(screen.mounts), screen.cursor)
+	}
+	typeKeys(screen, "ropbox")
+	if len(screen.mounts) != 1 || screen.mounts[0].Name != "Dropbox" || screen.cursor != 0 {
+		t.Errorf("got %d mounts, cursor %d, want only Dropbox selected", len(screen.mounts), screen.cursor)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.IsSearching() || !screen.HasSearchFilter() {
+		t.Error("enter should close the input and keep the filter")
+	}
+	if view := screen.View(); !strings.Contains(view, `Filter: "dropbox"`) || strings.Contains(view, "Google Drive") {
+		t.Errorf("the view should show the filtered list:\n%s", view)
+	}
+
+	// Esc clears the filter rather than leaving the screen
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.ShouldGoBack() || screen.HasSearchFilter() || len(screen.mounts) != 3 {
+		t.Errorf("esc should restore all mounts, got %d, goBack %v", len(screen.mounts), screen.ShouldGoBack())
+	}
+
+	// Esc while typing also clears it
+	typeKeys(screen, "/zzz")
+	if len(screen.mounts) != 0 || !strings.Contains(screen.View(), `No mounts match "zzz"`) {
+		t.Errorf("a query matching nothing should say so:\n%s", screen.View())
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.IsSearching() || len(screen.mounts) != 3 {
+		t.Errorf("esc should close the filter and restore all mounts, got %d", len(screen.mounts))
+	}
+}
+
+func TestSyncJobsScreen_SearchList(t *testing.T) {
+	screen := NewSyncJobsScreen()
+	screen.SetSize(100, 30)
+	screen.loading = false
+	screen.config = &config.Config{SyncJobs: createTestSyncJobs()}
+	screen.jobs, screen.hidden = screen.listedSyncJobs()
+	screen.cursor = 2
+
+	typeKeys(screen, "/PHOTO")
+	if len(screen.jobs) != 1 || screen.jobs[0].Name != "Photo Sync" || screen.cursor != 0 {
+		t.Errorf("got %d jobs, cursor %d, want only Photo Sync selected", len(screen.jobs), screen.cursor)
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.ShouldGoBack() || len(screen.jobs) != 3 {
+		t.Errorf("esc should restore all sync jobs, got %d", len(screen.jobs))
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !screen.ShouldGoBack() {
+		t.Error("esc without a filter should leave the screen")
+	}
+}
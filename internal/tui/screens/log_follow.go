@@ -0,0 +1,136 @@
+package screens
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// logFollowInterval is how often followed log lines are added to the view.
+const logFollowInterval = 500 * time.Millisecond
+
+// logFollowMaxLines is how many log lines are kept while following; older
+// lines are dropped.
+const logFollowMaxLines = 1000
+
+// ServiceLogsFollowMsg carries the log lines that arrived since the last
+// tick of a log follow.
+type ServiceLogsFollowMsg struct {
+	Gen   int
+	Lines []string
+	Done  bool // journalctl exited on its own
+	Err   error
+}
+
+// logFollow streams a unit's journal into the logs view. Like auto-refresh,
+// each start or stop bumps the generation so ticks still in flight from an
+// earlier follow are ignored.
+type logFollow struct {
+	enabled bool
+	gen     int
+	cancel  context.CancelFunc
+	buffer  *logFollowBuffer
+}
+
+// logFollowBuffer collects journalctl output between ticks.
+type logFollowBuffer struct {
+	mu      sync.Mutex
+	partial string
+	lines   []string
+	done    bool
+	err     error
+}
+
+func (b *logFollowBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.partial += string(p)
+	for {
+		i := strings.IndexByte(b.partial, '\n')
+		if i < 0 {
+			break
+		}
+		if line := strings.TrimRight(b.partial[:i], "\r"); line != "" {
+			b.lines = append(b.lines, line)
+		}
+		b.partial = b.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// finish records that journalctl has exited.
+func (b *logFollowBuffer) finish(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done = true
+	b.err = err
+}
+
+// take returns and clears the lines collected so far.
+func (b *logFollowBuffer) take() ([]string, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	lines := b.lines
+	b.lines = nil
+	return lines, b.done, b.err
+}
+
+// start follows unit's journal and returns the first tick. Any earlier
+// follow is stopped first.
+func (f *logFollow) start(manager systemd.ServiceManager, unit string) tea.Cmd {
+	f.stop()
+	ctx, cancel := context.WithCancel(rootCtx)
+	buffer := &logFollowBuffer{}
+	f.enabled = true
+	f.cancel = cancel
+	f.buffer = buffer
+
+	go func() {
+		buffer.finish(manager.FollowLogs(ctx, unit, buffer))
+	}()
+	return f.tick()
+}
+
+// stop kills the journalctl process, if any, and invalidates pending ticks.
+func (f *logFollow) stop() {
+	if f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+	}
+	f.enabled = false
+	f.buffer = nil
+	f.gen++
+}
+
+// tick waits for the next interval, then hands over the collected lines. The
+// wait ends early, without a message, once the TUI exits.
+func (f *logFollow) tick() tea.Cmd {
+	gen, buffer, ctx := f.gen, f.buffer, rootCtx
+	return func() tea.Msg {
+		timer := time.NewTimer(logFollowInterval)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil
+		}
+		lines, done, err := buffer.take()
+		return ServiceLogsFollowMsg{Gen: gen, Lines: lines, Done: done, Err: err}
+	}
+}
+
+// appendLogLines adds lines to logs, keeping at most logFollowMaxLines.
+func appendLogLines(logs string, lines []string) string {
+	all := lines
+	if logs = strings.TrimRight(logs, "\n"); logs != "" {
+		all = append(strings.Split(logs, "\n"), lines...)
+	}
+	if len(all) > logFollowMaxLines {
+		all = all[len(all)-logFollowMaxLines:]
+	}
+	return strings.Join(all, "\n")
+}
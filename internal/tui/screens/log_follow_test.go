@@ -0,0 +1,130 @@
+package screens
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+func TestLogFollowBuffer(t *testing.T) {
+	b := &logFollowBuffer{}
+	b.Write([]byte("first\r\nsec"))
+	b.Write([]byte("ond\n\n"))
+
+	lines, done, _ := b.take()
+	if strings.Join(lines, "|") != "first|second" || done {
+		t.Errorf("take() = %q, %v, want both lines and not done", lines, done)
+	}
+	if lines, _, _ := b.take(); len(lines) != 0 {
+		t.Errorf("take() should clear the lines, got %q", lines)
+	}
+}
+
+func TestAppendLogLines(t *testing.T) {
+	if got := appendLogLines("a\nb\n", []string{"c"}); got != "a\nb\nc" {
+		t.Errorf("appendLogLines() = %q", got)
+	}
+	if got := appendLogLines("", []string{"c"}); got != "c" {
+		t.Errorf("appendLogLines() to empty logs = %q", got)
+	}
+
+	many := make([]string, logFollowMaxLines+5)
+	for i := range many {
+		many[i] = fmt.Sprint(i)
+	}
+	got := strings.Split(appendLogLines("old", many), "\n")
+	if len(got) != logFollowMaxLines || got[0] != "5" {
+		t.Errorf("appendLogLines() kept %d lines starting at %q, want %d starting at 5", len(got), got[0], logFollowMaxLines)
+	}
+}
+
+func newFollowScreen(mgr *systemd.MockManager) *ServicesScreen {
+	screen := NewServicesScreen()
+	screen.SetSize(80, 40)
+	screen.manager = mgr
+	services := createTestServices()
+	screen.selectedService = &services[0]
+	screen.mode = ServicesModeLogs
+	screen.logs = "INFO earlier line"
+	return screen
+}
+
+func TestServicesScreen_FollowLogs(t *testing.T) {
+	mgr := &systemd.MockManager{FollowLogsOutput: "INFO new line\nERROR broken pipe\n"}
+	screen := newFollowScreen(mgr)
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	if cmd == nil || !screen.follow.enabled {
+		t.Fatal("F should start following the logs")
+	}
+	buffer := screen.follow.buffer
+
+	msg := cmd()
+	_, next := screen.Update(msg)
+	if next == nil {
+		t.Error("following should keep ticking")
+	}
+	if !mgr.Called("FollowLogs", screen.selectedService.Name+".service") {
+		t.Errorf("expected the unit's journal to be followed, calls = %v", mgr.Calls)
+	}
+	if screen.logs != "INFO earlier line\nINFO new line\nERROR broken pipe" {
+		t.Errorf("new lines should be appended, logs = %q", screen.logs)
+	}
+	if !strings.Contains(screen.View(), "Following") {
+		t.Error("the view should show that logs are followed")
+	}
+
+	screen.logFilter = "error"
+	view := screen.View()
+	if !strings.Contains(view, "broken pipe") || strings.Contains(view, "INFO new line") {
+		t.Errorf("followed lines should respect the log filter:\n%s", view)
+	}
+
+	// Leaving the logs stops journalctl and ignores ticks in flight
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.follow.enabled {
+		t.Error("esc should stop following")
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, done, _ := buffer.take(); done {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("journalctl should be stopped when leaving the logs")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	before := screen.logs
+	if _, cmd := screen.Update(msg); cmd != nil || screen.logs != before {
+		t.Error("a stale tick should be ignored")
+	}
+}
+
+func TestServicesScreen_FollowLogsToggleAndFailure(t *testing.T) {
+	screen := newFollowScreen(&systemd.MockManager{FollowLogsErr: errors.New("journalctl not found")})
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	screen.Update(cmd())
+	if screen.follow.enabled || screen.statusMessageType != "error" || !strings.Contains(screen.statusMessage, "journalctl not found") {
+		t.Errorf("a failed follow should stop and report %q, got %q", "journalctl not found", screen.statusMessage)
+	}
+
+	screen = newFollowScreen(&systemd.MockManager{})
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	if screen.follow.enabled {
+		t.Error("a second F should stop following")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	screen.StopFollowingLogs()
+	if screen.follow.enabled {
+		t.Error("StopFollowingLogs should stop following")
+	}
+}
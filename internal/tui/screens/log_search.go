@@ -0,0 +1,120 @@
+package screens
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// logSearch is the full-text search of the services logs view. "/" opens
+// the query input, Enter applies the query and n/N move between the
+// matching lines.
+type logSearch struct {
+	input   textinput.Model
+	editing bool
+	query   string
+	// match is the 1-based index of the selected matching line, 0 to show
+	// the newest lines.
+	match int
+}
+
+// open starts editing the query, starting from the current one.
+func (l *logSearch) open() tea.Cmd {
+	l.input = textinput.New()
+	l.input.Prompt = "/"
+	l.input.SetValue(l.query)
+	l.input.CursorEnd()
+	l.editing = true
+	return l.input.Focus()
+}
+
+// update handles a message while the query is edited. Enter applies the
+// query, an empty one showing all lines again, and Esc keeps the previous
+// query.
+func (l *logSearch) update(msg tea.Msg) tea.Cmd {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc":
+			l.editing = false
+			l.input.Blur()
+			return nil
+		case "enter":
+			l.query = strings.TrimSpace(l.input.Value())
+			l.match = 0
+			l.editing = false
+			l.input.Blur()
+			return nil
+		}
+	}
+
+	var cmd tea.Cmd
+	l.input, cmd = l.input.Update(msg)
+	return cmd
+}
+
+// step selects the next matching line, or the previous one when delta is
+// negative, wrapping around at either end. From the newest lines, n selects
+// the first match and N the last.
+func (l *logSearch) step(delta, matches int) {
+	if l.query == "" || matches == 0 {
+		l.match = 0
+		return
+	}
+	switch {
+	case l.match == 0 && delta > 0:
+		l.match = 1
+	case l.match == 0:
+		l.match = matches
+	default:
+		l.match = (l.match-1+delta+matches)%matches + 1
+	}
+}
+
+// filterLogsBySearch keeps the log lines containing query, ignoring case.
+// An empty query keeps every line.
+func filterLogsBySearch(logs, query string) string {
+	if query == "" || logs == "" {
+		return logs
+	}
+	query = strings.ToLower(query)
+
+	var matched []string
+	for _, line := range strings.Split(logs, "\n") {
+		if strings.Contains(strings.ToLower(line), query) {
+			matched = append(matched, line)
+		}
+	}
+	return strings.Join(matched, "\n")
+}
+
+// highlightLogMatches renders a log line in its level's style with each
+// occurrence of query highlighted.
+func highlightLogMatches(line, query string) string {
+	style := logLineStyle(line)
+	lower := strings.ToLower(line)
+	// Lowercasing some characters changes their length, so offsets into
+	// lower would not line up with line
+	if query == "" || len(lower) != len(line) {
+		return style.Render(line)
+	}
+	query = strings.ToLower(query)
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, query)
+		if i < 0 {
+			break
+		}
+		if i > 0 {
+			b.WriteString(style.Render(line[:i]))
+		}
+		b.WriteString(components.Styles.Selected.Reverse(true).Render(line[i : i+len(query)]))
+		line, lower = line[i+len(query):], lower[i+len(query):]
+	}
+	if line != "" {
+		b.WriteString(style.Render(line))
+	}
+	return b.String()
+}
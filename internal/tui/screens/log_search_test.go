@@ -0,0 +1,120 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const searchTestLogs = "INFO copied photos/a.jpg\nERROR failed to copy photos/b.jpg\nINFO copied docs/c.txt\nERROR failed to copy docs/d.txt"
+
+func TestFilterLogsBySearch(t *testing.T) {
+	tests := []struct {
+		name  string
+		level string
+		query string
+		want  string
+	}{
+		{"empty query keeps all lines", "all", "", searchTestLogs},
+		{"matches ignore case", "all", "PHOTOS", "INFO copied photos/a.jpg\nERROR failed to copy photos/b.jpg"},
+		{"composes with the level filter", "error", "photos", "ERROR failed to copy photos/b.jpg"},
+		{"empty query keeps the level filter", "error", "", "ERROR failed to copy photos/b.jpg\nERROR failed to copy docs/d.txt"},
+		{"no match", "all", "videos", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := NewServicesScreen()
+			screen.logs = searchTestLogs
+			screen.logFilter = tt.level
+			screen.search.query = tt.query
+			if got := screen.filterLogs(); got != tt.want {
+				t.Errorf("filterLogs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogSearch_Step(t *testing.T) {
+	l := logSearch{query: "x"}
+	l.step(1, 3)
+	if l.match != 1 {
+		t.Errorf("n from the newest lines should select the first match, got %d", l.match)
+	}
+	l.step(-1, 3)
+	if l.match != 3 {
+		t.Errorf("N should wrap to the last match, got %d", l.match)
+	}
+	l.step(1, 3)
+	if l.match != 1 {
+		t.Errorf("n should wrap to the first match, got %d", l.match)
+	}
+
+	l = logSearch{query: "x"}
+	l.step(-1, 3)
+	if l.match != 3 {
+		t.Errorf("N from the newest lines should select the last match, got %d", l.match)
+	}
+	l.step(1, 0)
+	if l.match != 0 {
+		t.Errorf("without matches nothing should be selected, got %d", l.match)
+	}
+}
+
+func TestHighlightLogMatches(t *testing.T) {
+	line := "ERROR failed to copy photos/b.jpg"
+	if got := highlightLogMatches(line, ""); got != renderLogLine(line) {
+		t.Errorf("an empty query should render the line as usual, got %q", got)
+	}
+	if got := highlightLogMatches(line, "Photos"); !strings.Contains(got, "photos") || !strings.Contains(got, "/b.jpg") {
+		t.Errorf("highlighting should keep the line's text, got %q", got)
+	}
+}
+
+func TestServicesScreen_SearchLogs(t *testing.T) {
+	screen := NewServicesScreen()
+	screen.SetSize(100, 40)
+	screen.mode = ServicesModeLogs
+	screen.logs = searchTestLogs
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	if !screen.IsSearchingLogs() {
+		t.Fatal("/ should open the search input")
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("docs")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.IsSearchingLogs() || screen.search.query != "docs" {
+		t.Fatalf("enter should apply the query, got %q", screen.search.query)
+	}
+
+	view := screen.View()
+	if !strings.Contains(view, `Search: "docs" (2 matches)`) || strings.Contains(view, "a.jpg") {
+		t.Errorf("only matching lines should be shown:\n%s", view)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if !strings.Contains(screen.View(), `(2/2)`) {
+		t.Errorf("n should move to the second match:\n%s", screen.View())
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	if !strings.Contains(screen.View(), `(1/2)`) {
+		t.Errorf("N should move back to the first match:\n%s", screen.View())
+	}
+
+	// Esc while typing keeps the applied query
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zzz")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.search.query != "docs" || screen.mode != ServicesModeLogs {
+		t.Errorf("esc should cancel the edit, got query %q in mode %s", screen.search.query, screen.mode)
+	}
+
+	// An empty query shows every line again
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	screen.search.input.SetValue("")
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.search.query != "" || screen.filterLogs() != searchTestLogs {
+		t.Errorf("an empty query should reset the search, got %q", screen.filterLogs())
+	}
+}
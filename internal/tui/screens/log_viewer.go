@@ -0,0 +1,254 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// logViewerLines is how many journal lines the log viewer fetches.
+const logViewerLines = 200
+
+// LogViewerLoadedMsg is sent when a log viewer has fetched its unit's logs.
+type LogViewerLoadedMsg struct {
+	Unit string
+	Logs string
+	Err  error
+}
+
+// LogViewer is a scrollable, level-filtered view of one unit's journal. The
+// mounts and sync jobs screens open it straight from their lists.
+type LogViewer struct {
+	title   string
+	unit    string
+	manager systemd.ServiceManager
+
+	logs    string
+	err     error
+	loading bool
+	filter  string
+	scrollY int
+
+	width  int
+	height int
+	done   bool
+}
+
+// NewLogViewer creates a log viewer for unit, e.g. "rclone-mount-abc.service".
+func NewLogViewer(title, unit string, manager systemd.ServiceManager) *LogViewer {
+	return &LogViewer{
+		title:   title,
+		unit:    unit,
+		manager: manager,
+		loading: true,
+		filter:  "all",
+	}
+}
+
+// Init starts loading the logs.
+func (v *LogViewer) Init() tea.Cmd {
+	return v.load
+}
+
+// load fetches the unit's logs.
+func (v *LogViewer) load() tea.Msg {
+	if v.manager == nil {
+		return LogViewerLoadedMsg{Unit: v.unit, Err: fmt.Errorf("systemd manager not initialized")}
+	}
+	logs, err := v.manager.GetLogs(v.unit, logViewerLines)
+	return LogViewerLoadedMsg{Unit: v.unit, Logs: logs, Err: err}
+}
+
+// SetSize sets the viewer dimensions.
+func (v *LogViewer) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.scrollY = min(v.scrollY, v.maxScroll())
+}
+
+// Update handles key presses and loaded logs.
+func (v *LogViewer) Update(msg tea.Msg) (*LogViewer, tea.Cmd) {
+	switch msg := msg.(type) {
+	case LogViewerLoadedMsg:
+		if msg.Unit != v.unit {
+			return v, nil
+		}
+		v.loading = false
+		v.logs = msg.Logs
+		v.err = msg.Err
+		// Start at the newest entries
+		v.scrollY = v.maxScroll()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if v.scrollY > 0 {
+				v.scrollY--
+			}
+		case "down", "j":
+			if v.scrollY < v.maxScroll() {
+				v.scrollY++
+			}
+		case "g":
+			v.scrollY = 0
+		case "G":
+			v.scrollY = v.maxScroll()
+		case "f":
+			v.filter = nextLogFilter(v.filter)
+			v.scrollY = v.maxScroll()
+		case "r":
+			v.loading = true
+			return v, v.load
+		case "esc", "backspace":
+			v.done = true
+		}
+	}
+	return v, nil
+}
+
+// IsDone returns true when the viewer should close.
+func (v *LogViewer) IsDone() bool {
+	return v.done
+}
+
+// lines returns the filtered log lines.
+func (v *LogViewer) lines() []string {
+	logs := filterLogsByLevel(v.logs, v.filter)
+	if logs == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimRight(logs, "\n"), "\n")
+}
+
+// visibleLines returns how many log lines fit on screen.
+func (v *LogViewer) visibleLines() int {
+	return max(v.height-10, 1)
+}
+
+// maxScroll returns the largest useful scroll offset.
+func (v *LogViewer) maxScroll() int {
+	return max(len(v.lines())-v.visibleLines(), 0)
+}
+
+// View renders the log viewer.
+func (v *LogViewer) View() string {
+	var b strings.Builder
+
+	b.WriteString(components.Styles.Title.Render(fmt.Sprintf("Logs - %s", v.title)))
+	b.WriteString("\n")
+	b.WriteString(components.Styles.Subtitle.Render(fmt.Sprintf("%s  Filter: %s", v.unit, strings.ToUpper(v.filter))))
+	b.WriteString("\n\n")
+
+	lines := v.lines()
+	switch {
+	case v.loading:
+		b.WriteString(components.Styles.Info.Render("Loading logs..."))
+		b.WriteString("\n")
+	case v.err != nil:
+		b.WriteString(components.RenderError(fmt.Sprintf("Failed to load logs: %v", v.err)))
+		b.WriteString("\n")
+	case len(lines) == 0:
+		b.WriteString(components.Styles.Subtitle.Render("No logs available"))
+		b.WriteString("\n")
+	default:
+		end := min(v.scrollY+v.visibleLines(), len(lines))
+		for _, line := range lines[v.scrollY:end] {
+			b.WriteString(renderLogLine(line))
+			b.WriteString("\n")
+		}
+		if v.maxScroll() > 0 {
+			b.WriteString(components.Styles.HelpText.Render(
+				fmt.Sprintf("[%d-%d/%d]", v.scrollY+1, end, len(lines))))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(components.HelpBar(v.width, []components.HelpItem{
+		{Key: "↑/↓", Desc: "scroll"},
+		{Key: "f", Desc: "filter level"},
+		{Key: "r", Desc: "reload"},
+		{Key: "Esc", Desc: "back"},
+	}))
+
+	return b.String()
+}
+
+// nextLogFilter returns the log level filter after filter.
+func nextLogFilter(filter string) string {
+	switch filter {
+	case "all":
+		return "error"
+	case "error":
+		return "warning"
+	case "warning":
+		return "info"
+	case "info":
+		return "debug"
+	default:
+		return "all"
+	}
+}
+
+// filterLogsByLevel keeps the log lines matching the level filter.
+func filterLogsByLevel(logs, filter string) string {
+	if filter == "all" || logs == "" {
+		return logs
+	}
+
+	lines := strings.Split(logs, "\n")
+	var filtered []string
+
+	levelKeywords := map[string][]string{
+		"error":   {"ERROR", "Err", "Failed", "failure"},
+		"warning": {"WARN", "Warning"},
+		"info":    {"INFO", "info"},
+		"debug":   {"DEBUG", "debug"},
+	}
+
+	keywords, ok := levelKeywords[filter]
+	if !ok {
+		return logs
+	}
+
+	for _, line := range lines {
+		lower := strings.ToLower(line)
+		for _, kw := range keywords {
+			if strings.Contains(lower, strings.ToLower(kw)) {
+				filtered = append(filtered, line)
+				break
+			}
+		}
+	}
+
+	return strings.Join(filtered, "\n")
+}
+
+// renderLogLine renders a single log line with basic syntax highlighting.
+func renderLogLine(line string) string {
+	return logLineStyle(line).Render(line)
+}
+
+// logLineStyle returns the style for a log line based on its level.
+func logLineStyle(line string) lipgloss.Style {
+	lower := strings.ToLower(line)
+
+	if strings.Contains(lower, "error") || strings.Contains(lower, "fail") || strings.Contains(lower, "critical") {
+		return components.Styles.Error
+	}
+	if strings.Contains(lower, "warn") {
+		return components.Styles.Warning
+	}
+	if strings.Contains(lower, "info") {
+		return components.Styles.Info
+	}
+	if strings.Contains(lower, "debug") {
+		return components.Styles.Subtitle
+	}
+
+	return components.Styles.Normal
+}
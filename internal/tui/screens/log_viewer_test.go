@@ -0,0 +1,135 @@
+package screens
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// loadedLogViewer returns a log viewer that has loaded logs from a mock.
+func loadedLogViewer(t *testing.T, logs string) (*LogViewer, *systemd.MockManager) {
+	t.Helper()
+	mgr := &systemd.MockManager{GetLogsResult: logs}
+	viewer := NewLogViewer("Google Drive", "rclone-mount-abc.service", mgr)
+	viewer.SetSize(100, 40)
+	viewer, _ = viewer.Update(viewer.Init()())
+	return viewer, mgr
+}
+
+func TestLogViewer_LoadsLogs(t *testing.T) {
+	viewer, mgr := loadedLogViewer(t, "INFO  mounted\nERROR failed to read")
+
+	if !mgr.Called("GetLogs", "rclone-mount-abc.service") {
+		t.Error("Init() should fetch the unit's logs")
+	}
+	view := viewer.View()
+	for _, want := range []string{"Logs - Google Drive", "rclone-mount-abc.service", "mounted", "failed to read"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+}
+
+func TestLogViewer_LoadError(t *testing.T) {
+	mgr := &systemd.MockManager{GetLogsErr: errors.New("journal unavailable")}
+	viewer := NewLogViewer("Job", "rclone-sync-x.service", mgr)
+	viewer, _ = viewer.Update(viewer.Init()())
+
+	if !strings.Contains(viewer.View(), "Failed to load logs: journal unavailable") {
+		t.Error("View() should show the load error")
+	}
+}
+
+func TestLogViewer_NilManager(t *testing.T) {
+	viewer := NewLogViewer("Job", "rclone-sync-x.service", nil)
+	viewer, _ = viewer.Update(viewer.Init()())
+
+	if !strings.Contains(viewer.View(), "systemd manager not initialized") {
+		t.Error("View() should report the missing manager")
+	}
+}
+
+func TestLogViewer_IgnoresOtherUnits(t *testing.T) {
+	viewer := NewLogViewer("Job", "rclone-sync-x.service", nil)
+	viewer, _ = viewer.Update(LogViewerLoadedMsg{Unit: "rclone-sync-y.service", Logs: "other"})
+
+	if !viewer.loading || viewer.logs != "" {
+		t.Error("logs for another unit should be ignored")
+	}
+}
+
+func TestLogViewer_Filter(t *testing.T) {
+	viewer, _ := loadedLogViewer(t, "INFO  mounted\nERROR failed to read")
+
+	viewer, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if viewer.filter != "error" {
+		t.Fatalf("filter = %q, want error", viewer.filter)
+	}
+	view := viewer.View()
+	if strings.Contains(view, "mounted") || !strings.Contains(view, "failed to read") {
+		t.Errorf("error filter should only show error lines, got:\n%s", view)
+	}
+}
+
+func TestLogViewer_ScrollStartsAtNewest(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	viewer, _ := loadedLogViewer(t, strings.Join(lines, "\n"))
+
+	if viewer.scrollY != viewer.maxScroll() || viewer.maxScroll() == 0 {
+		t.Fatalf("scrollY = %d, want %d", viewer.scrollY, viewer.maxScroll())
+	}
+	if !strings.Contains(viewer.View(), "line 99") {
+		t.Error("newest line should be visible after loading")
+	}
+
+	viewer, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if viewer.scrollY != 0 || !strings.Contains(viewer.View(), "line 0\n") {
+		t.Error("g should jump to the oldest line")
+	}
+	viewer, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if viewer.scrollY != 0 {
+		t.Error("scrolling up at the top should do nothing")
+	}
+	viewer, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if viewer.scrollY != 1 {
+		t.Errorf("scrollY = %d after scrolling down, want 1", viewer.scrollY)
+	}
+}
+
+func TestLogViewer_ReloadAndClose(t *testing.T) {
+	viewer, mgr := loadedLogViewer(t, "INFO  mounted")
+
+	viewer, cmd := viewer.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd == nil || !viewer.loading {
+		t.Fatal("r should reload the logs")
+	}
+	mgr.Calls = nil
+	viewer, _ = viewer.Update(cmd())
+	if !mgr.Called("GetLogs", "") || viewer.loading {
+		t.Error("reload should fetch the logs again")
+	}
+
+	viewer, _ = viewer.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !viewer.IsDone() {
+		t.Error("Esc should close the viewer")
+	}
+}
+
+func TestNextLogFilter(t *testing.T) {
+	filter := "all"
+	var seen []string
+	for i := 0; i < 5; i++ {
+		filter = nextLogFilter(filter)
+		seen = append(seen, filter)
+	}
+	if got := strings.Join(seen, ","); got != "error,warning,info,debug,all" {
+		t.Errorf("filter cycle = %s", got)
+	}
+}
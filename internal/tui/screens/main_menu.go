@@ -36,6 +36,16 @@ func NewMainMenuScreen() *MainMenuScreen {
 			Description: "View and control systemd services",
 			Key:         "V",
 		},
+		{
+			Label:       "Config Backups",
+			Description: "Restore, delete or diff config backups",
+			Key:         "B",
+		},
+		{
+			Label:       "Remotes",
+			Description: "List and create rclone remotes",
+			Key:         "R",
+		},
 		{
 			Label:       "Settings",
 			Description: "Application configuration",
@@ -86,6 +96,12 @@ func (s *MainMenuScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "v":
 			s.navigationTarget = "services"
 			s.navigate = true
+		case "b":
+			s.navigationTarget = "backups"
+			s.navigate = true
+		case "r":
+			s.navigationTarget = "remotes"
+			s.navigate = true
 		case "t":
 			s.navigationTarget = "settings"
 			s.navigate = true
@@ -111,6 +127,12 @@ func (s *MainMenuScreen) selectCurrent() {
 	case "V":
 		s.navigationTarget = "services"
 		s.navigate = true
+	case "B":
+		s.navigationTarget = "backups"
+		s.navigate = true
+	case "R":
+		s.navigationTarget = "remotes"
+		s.navigate = true
 	case "T":
 		s.navigationTarget = "settings"
 		s.navigate = true
@@ -166,7 +188,7 @@ func (s *MainMenuScreen) View() string {
 	helpText := components.HelpBar(s.width, []components.HelpItem{
 		{Key: "↑/↓", Desc: "navigate"},
 		{Key: "Enter", Desc: "select"},
-		{Key: "M/S/V/T", Desc: "quick jump"},
+		{Key: "M/S/V/B/T", Desc: "quick jump"},
 		{Key: "?", Desc: "help"},
 		{Key: "q", Desc: "quit"},
 	})
@@ -20,8 +20,8 @@ func TestNewMainMenuScreen(t *testing.T) {
 	}
 
 	// Verify menu items count
-	if len(screen.menu.Items) != 5 {
-		t.Errorf("menu items count = %d, want 5", len(screen.menu.Items))
+	if len(screen.menu.Items) != 7 {
+		t.Errorf("menu items count = %d, want 7", len(screen.menu.Items))
 	}
 
 	// Verify initial state
@@ -45,6 +45,8 @@ func TestMainMenuScreen_MenuItems(t *testing.T) {
 		{"Mount Management", "M"},
 		{"Sync Job Management", "S"},
 		{"Service Status", "V"},
+		{"Config Backups", "B"},
+		{"Remotes", "R"},
 		{"Settings", "T"},
 		{"Quit", "Q"},
 	}
@@ -156,8 +158,10 @@ func TestMainMenuScreen_EnterKeyNavigation(t *testing.T) {
 		{"Mount Management", 0, "mounts"},
 		{"Sync Job Management", 1, "sync_jobs"},
 		{"Service Status", 2, "services"},
-		{"Settings", 3, "settings"},
-		{"Quit", 4, "quit"},
+		{"Config Backups", 3, "backups"},
+		{"Remotes", 4, "remotes"},
+		{"Settings", 5, "settings"},
+		{"Quit", 6, "quit"},
 	}
 
 	for _, tt := range tests {
@@ -189,6 +193,8 @@ func TestMainMenuScreen_QuickJumpKeys(t *testing.T) {
 		{"m key -> mounts", "m", "mounts"},
 		{"s key -> sync_jobs", "s", "sync_jobs"},
 		{"v key -> services", "v", "services"},
+		{"b key -> backups", "b", "backups"},
+		{"r key -> remotes", "r", "remotes"},
 		{"t key -> settings", "t", "settings"},
 		{"q key -> quit", "q", "quit"},
 	}
@@ -281,6 +287,7 @@ func TestMainMenuScreen_View(t *testing.T) {
 		"Mount Management",
 		"Sync Job Management",
 		"Service Status",
+		"Config Backups",
 		"Settings",
 		"Quit",
 	}
@@ -411,8 +418,10 @@ func TestMainMenuScreen_EnterKeyOnEachItem(t *testing.T) {
 		{0, "mounts"},
 		{1, "sync_jobs"},
 		{2, "services"},
-		{3, "settings"},
-		{4, "quit"},
+		{3, "backups"},
+		{4, "remotes"},
+		{5, "settings"},
+		{6, "quit"},
 	}
 
 	for _, item := range items {
@@ -437,8 +446,10 @@ func TestMainMenuScreen_SpaceKeyOnEachItem(t *testing.T) {
 		{0, "mounts"},
 		{1, "sync_jobs"},
 		{2, "services"},
-		{3, "settings"},
-		{4, "quit"},
+		{3, "backups"},
+		{4, "remotes"},
+		{5, "settings"},
+		{6, "quit"},
 	}
 
 	for _, item := range items {
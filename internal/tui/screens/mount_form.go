@@ -35,13 +35,19 @@ type MountForm struct {
 
 	// Services
 	config       *config.Config
-	generator    *systemd.Generator
+	generator    systemd.UnitGenerator
 	manager      systemd.ServiceManager
 	rcloneClient *rclone.Client
 
 	// Available remotes
 	remotes []rclone.Remote
 
+	// Manual remote entry, used when there are no remotes to pick from
+	manualRemote  bool
+	rcloneMissing bool
+	remoteNotice  string
+	remoteHint    string
+
 	// Form data
 	name            string
 	remote          string
@@ -52,20 +58,31 @@ type MountForm struct {
 	vfsCacheMaxSize string
 	vfsWriteBack    string
 	bufferSize      string
+	dirCacheTime    string
+	attrTimeout     string
+	pollInterval    string
 	allowOther      bool
 	allowRoot       bool
 	umask           string
+	defaultPerms    bool
+	networkMode     bool
 	readOnly        bool
+	immutable       bool
 	noModtime       bool
 	noChecksum      bool
+	bandwidthLimit  string
 	logLevel        string
 	extraArgs       string
+	backendFlags    []string
+	notes           string
+	description     string
+	docURL          string
 	autoStart       bool
 	enabled         bool
 }
 
 // NewMountForm creates a new mount form.
-func NewMountForm(mount *models.MountConfig, remotes []rclone.Remote, cfg *config.Config, gen *systemd.Generator, mgr systemd.ServiceManager, rcloneClient *rclone.Client, isEdit bool) *MountForm {
+func NewMountForm(mount *models.MountConfig, remotes []rclone.Remote, cfg *config.Config, gen systemd.UnitGenerator, mgr systemd.ServiceManager, rcloneClient *rclone.Client, isEdit bool) *MountForm {
 	f := &MountForm{
 		mount:        mount,
 		isEdit:       isEdit,
@@ -79,6 +96,10 @@ func NewMountForm(mount *models.MountConfig, remotes []rclone.Remote, cfg *confi
 	// Set defaults from config
 	if cfg != nil {
 		f.vfsCacheMode = cfg.Defaults.Mount.VFSCacheMode
+		f.vfsCacheMaxSize = cfg.Defaults.Mount.VFSCacheMaxSize
+		f.vfsCacheMaxAge = cfg.Defaults.Mount.VFSCacheMaxAge
+		f.dirCacheTime = cfg.Defaults.Mount.DirCacheTime
+		f.attrTimeout = cfg.Defaults.Mount.AttrTimeout
 		f.bufferSize = cfg.Defaults.Mount.BufferSize
 		f.logLevel = cfg.Defaults.Mount.LogLevel
 	}
@@ -94,14 +115,24 @@ func NewMountForm(mount *models.MountConfig, remotes []rclone.Remote, cfg *confi
 		f.vfsCacheMaxSize = mount.MountOptions.VFSCacheMaxSize
 		f.vfsWriteBack = mount.MountOptions.VFSWriteBack
 		f.bufferSize = mount.MountOptions.BufferSize
+		f.dirCacheTime = mount.MountOptions.DirCacheTime
+		f.attrTimeout = mount.MountOptions.AttrTimeout
+		f.pollInterval = mount.MountOptions.PollInterval
 		f.allowOther = mount.MountOptions.AllowOther
 		f.allowRoot = mount.MountOptions.AllowRoot
 		f.umask = mount.MountOptions.Umask
+		f.defaultPerms = mount.MountOptions.DefaultPermissions
+		f.networkMode = mount.MountOptions.NetworkMode
 		f.readOnly = mount.MountOptions.ReadOnly
+		f.immutable = mount.MountOptions.Immutable
 		f.noModtime = mount.MountOptions.NoModTime
 		f.noChecksum = mount.MountOptions.NoChecksum
+		f.bandwidthLimit = mount.MountOptions.BandwidthLimit
 		f.logLevel = mount.MountOptions.LogLevel
 		f.extraArgs = mount.MountOptions.ExtraArgs
+		f.notes = mount.Notes
+		f.description = mount.Description
+		f.docURL = mount.DocumentationURL
 		f.autoStart = mount.AutoStart
 		f.enabled = mount.Enabled
 	}
@@ -120,20 +151,71 @@ func NewMountForm(mount *models.MountConfig, remotes []rclone.Remote, cfg *confi
 		f.remotePath = "/"
 	}
 
+	// Fall back to free-text remote entry when there is nothing to pick from
+	if len(remotes) == 0 {
+		f.manualRemote = true
+		f.remoteNotice, f.remoteHint, f.rcloneMissing = manualRemoteNotice(rcloneClient)
+	}
+
 	f.buildForm()
 	return f
 }
 
+// manualRemoteNotice describes why the remote must be typed manually.
+// It reuses the preflight rclone binary check so the form shows the same
+// message and suggestion as the startup checks. The returned bool reports
+// whether the rclone binary itself is missing.
+func manualRemoteNotice(client *rclone.Client) (notice, hint string, rcloneMissing bool) {
+	result := rclone.CheckBinary(client)
+	if !result.Passed {
+		hint = result.Message
+		if result.Suggestion != "" {
+			hint += "\n" + result.Suggestion
+		}
+		return "rclone not found — type remote manually", hint, true
+	}
+	return "No rclone remotes configured — type remote manually",
+		"Run 'rclone config' to create the remote before starting the service.", false
+}
+
+// backendFlagOptions returns the suggested backend flags for the types of
+// the named remotes, e.g. "gdrive:" or "s3", as multi-select options.
+func backendFlagOptions(remotes []rclone.Remote, names ...string) []huh.Option[string] {
+	var options []huh.Option[string]
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSuffix(strings.TrimSpace(name), ":")
+		for _, r := range remotes {
+			if r.Name != name || seen[r.Type] {
+				continue
+			}
+			seen[r.Type] = true
+			for _, flag := range rclone.BackendFlagHints(r.Type) {
+				options = append(options, huh.NewOption(flag.Flag+" — "+flag.Description, flag.Flag))
+			}
+		}
+	}
+	return options
+}
+
+// validateManualRemote validates a remote name typed in manually.
+func validateManualRemote(remote string) error {
+	remote = strings.TrimSuffix(strings.TrimSpace(remote), ":")
+	if remote == "" {
+		return fmt.Errorf("remote is required")
+	}
+	if strings.ContainsAny(remote, ":/") {
+		return fmt.Errorf("remote name must not contain ':' or '/'")
+	}
+	return nil
+}
+
 // buildForm builds the huh form.
 func (f *MountForm) buildForm() {
-	// Build remote options - handle empty remotes gracefully
-	remoteOptions := make([]huh.Option[string], 0)
-	if len(f.remotes) > 0 {
-		for _, r := range f.remotes {
-			remoteOptions = append(remoteOptions, huh.NewOption(r.Name+" ("+r.Type+")", r.Name+":"))
-		}
-	} else {
-		remoteOptions = append(remoteOptions, huh.NewOption("⚠ No remotes - run 'rclone config' first", ""))
+	// Build remote options
+	remoteOptions := make([]huh.Option[string], 0, len(f.remotes))
+	for _, r := range f.remotes {
+		remoteOptions = append(remoteOptions, huh.NewOption(r.Name+" ("+r.Type+")", r.Name+":"))
 	}
 
 	// VFS Cache Mode options
@@ -151,39 +233,63 @@ func (f *MountForm) buildForm() {
 		huh.NewOption("Debug", "DEBUG"),
 	}
 
-	// Build form groups
-	groups := []*huh.Group{
-		// Step 1: Basic Configuration
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Mount Name").
-				Description("A unique name for this mount").
-				Placeholder("e.g., Google Drive").
-				Value(&f.name).
-				Validate(f.validateName),
+	// Remote field - a picker when remotes are known, free text otherwise
+	var remoteFields []huh.Field
+	if f.manualRemote {
+		remoteFields = append(remoteFields,
+			huh.NewNote().
+				Title("⚠ "+f.remoteNotice).
+				Description(f.remoteHint),
 
+			huh.NewInput().
+				Title("Remote").
+				Description("Name of the rclone remote to mount").
+				Placeholder("e.g., gdrive").
+				Value(&f.remote).
+				Validate(validateManualRemote),
+		)
+	} else {
+		remoteFields = append(remoteFields,
 			huh.NewSelect[string]().
 				Title("Remote").
 				Description("Select the rclone remote to mount").
 				Options(remoteOptions...).
 				Value(&f.remote),
+		)
+	}
+
+	basicFields := []huh.Field{
+		huh.NewInput().
+			Title("Mount Name").
+			Description("A unique name for this mount").
+			Placeholder("e.g., Google Drive").
+			Value(&f.name).
+			Validate(f.validateName),
+	}
+	basicFields = append(basicFields, remoteFields...)
+	basicFields = append(basicFields,
+		huh.NewInput().
+			Title("Remote Path").
+			DescriptionFunc(f.remotePathDescription, []*string{&f.remote, &f.remotePath}).
+			Placeholder("/").
+			SuggestionsFunc(f.getRemotePathSuggestions, &f.remote).
+			Value(&f.remotePath).
+			Validate(validateRemotePath),
+
+		components.NewEnhancedFilePicker().
+			Title("Mount Point").
+			Description("Local directory where the remote will be mounted. Use quick jump keys: ~ (home), / (root), m (mnt), M (media), r (recent), Backspace (parent).").
+			DirAllowed(true).
+			FileAllowed(false).
+			CurrentDirectory(components.ExpandHome("~/mnt")).
+			Value(&f.mountPoint).
+			Validate(f.validateMountPoint),
+	)
 
-			huh.NewInput().
-				Title("Remote Path").
-				Description("Path on the remote (e.g., / or /Photos)").
-				Placeholder("/").
-				SuggestionsFunc(f.getRemotePathSuggestions, &f.remote).
-				Value(&f.remotePath),
-
-			components.NewEnhancedFilePicker().
-				Title("Mount Point").
-				Description("Local directory where the remote will be mounted. Use quick jump keys: ~ (home), / (root), m (mnt), M (media), r (recent), Backspace (parent).").
-				DirAllowed(true).
-				FileAllowed(false).
-				CurrentDirectory(components.ExpandHome("~/mnt")).
-				Value(&f.mountPoint).
-				Validate(f.validateMountPoint),
-		).Title("Step 1: Basic Configuration"),
+	// Build form groups
+	groups := []*huh.Group{
+		// Step 1: Basic Configuration
+		huh.NewGroup(basicFields...).Title("Step 1: Basic Configuration"),
 
 		// Step 2: VFS Options
 		huh.NewGroup(
@@ -240,19 +346,61 @@ func (f *MountForm) buildForm() {
 					}
 					return components.ValidateBufferSize(v)
 				}),
+
+			huh.NewInput().
+				Title("Dir Cache Time").
+				Description("How long directory listings are cached (e.g., 5m); empty uses the rclone default").
+				Placeholder("5m").
+				Value(&f.dirCacheTime).
+				Validate(func(v string) error {
+					if v == "" {
+						return nil
+					}
+					return components.ValidateDuration(v)
+				}),
+
+			huh.NewInput().
+				Title("Attr Timeout").
+				Description("How long the kernel caches file attributes (e.g., 10s); empty uses the rclone default").
+				Placeholder("1s").
+				Value(&f.attrTimeout).
+				Validate(func(v string) error {
+					if v == "" {
+						return nil
+					}
+					return components.ValidateDuration(v)
+				}),
+
+			huh.NewInput().
+				Title("Poll Interval").
+				Description("How often to ask the remote for changes (e.g., 5m); 0 disables polling, empty uses the rclone default").
+				Placeholder("1m").
+				Value(&f.pollInterval).
+				Validate(func(v string) error {
+					if v == "" || v == "0" {
+						return nil
+					}
+					return components.ValidateDuration(v)
+				}),
 		).Title("Step 2: VFS Options"),
 
 		// Step 3: FUSE Options
 		huh.NewGroup(
 			huh.NewConfirm().
 				Title("Allow Other").
-				Description("Allow other users to access the mount").
-				Value(&f.allowOther),
+				Description("Allow other users to access the mount (needs user_allow_other in /etc/fuse.conf)").
+				Value(&f.allowOther).
+				Validate(func(v bool) error {
+					return systemd.ValidateMountPlatform(&models.MountOptions{AllowOther: v})
+				}),
 
 			huh.NewConfirm().
 				Title("Allow Root").
 				Description("Allow root to access the mount").
-				Value(&f.allowRoot),
+				Value(&f.allowRoot).
+				Validate(func(v bool) error {
+					return systemd.ValidateMountPlatform(&models.MountOptions{AllowRoot: v})
+				}),
 
 			huh.NewInput().
 				Title("Umask").
@@ -263,13 +411,36 @@ func (f *MountForm) buildForm() {
 					if v == "" {
 						return nil
 					}
-					return components.ValidateUmask(v)
+					if err := components.ValidateUmask(v); err != nil {
+						return err
+					}
+					return systemd.ValidateMountPlatform(&models.MountOptions{Umask: v})
 				}),
 
 			huh.NewConfirm().
 				Title("Read Only").
 				Description("Mount the remote as read-only").
 				Value(&f.readOnly),
+
+			huh.NewConfirm().
+				Title("Immutable").
+				Description("Archive mount: always read-only, no write caching").
+				Value(&f.immutable).
+				Validate(f.validateImmutable),
+
+			huh.NewConfirm().
+				Title("Default Permissions").
+				Description("Let the kernel enforce file modes from umask/uid/gid").
+				Value(&f.defaultPerms).
+				Validate(f.validateDefaultPermissions),
+
+			huh.NewConfirm().
+				Title("Network Mode").
+				Description("Windows only: mount as a network drive instead of a fixed disk").
+				Value(&f.networkMode).
+				Validate(func(v bool) error {
+					return systemd.ValidateMountPlatform(&models.MountOptions{NetworkMode: v})
+				}),
 		).Title("Step 3: FUSE Options"),
 
 		// Step 4: Advanced Options
@@ -284,19 +455,61 @@ func (f *MountForm) buildForm() {
 				Description("Don't verify checksums").
 				Value(&f.noChecksum),
 
+			huh.NewInput().
+				Title("Bandwidth Limit").
+				Description("A rate (e.g., 10M) or a timetable (e.g., 08:00,512k 19:00,10M 23:00,off); empty for unlimited").
+				Value(&f.bandwidthLimit).
+				Validate(components.ValidateBandwidthLimit),
+
 			huh.NewSelect[string]().
 				Title("Log Level").
 				Description("Logging verbosity").
 				Options(logLevelOptions...).
 				Value(&f.logLevel),
 
-			huh.NewInput().
+			huh.NewText().
 				Title("Extra Arguments").
-				Description("Additional rclone arguments").
+				Description("Additional rclone arguments, separated by spaces or newlines; quote values with spaces").
 				Placeholder("--option value").
-				Value(&f.extraArgs),
+				Lines(3).
+				Value(&f.extraArgs).
+				Validate(func(s string) error {
+					if err := systemd.ValidateExtraArgs(s); err != nil {
+						return err
+					}
+					return systemd.ValidateManagedFlags(s, "mount")
+				}),
+
+			huh.NewInput().
+				Title("Description").
+				Description("Shown by systemctl status and the journal (defaults to the first line of the notes)").
+				Value(&f.description),
+
+			huh.NewInput().
+				Title("Documentation URL").
+				Description("Optional link added to the unit's Documentation=, e.g. a runbook").
+				Placeholder("https://").
+				Value(&f.docURL).
+				Validate(systemd.ValidateDocumentationURL),
+
+			huh.NewText().
+				Title("Notes").
+				Description("Operational notes for this mount (e.g., rotate creds quarterly)").
+				Value(&f.notes),
 		).Title("Step 4: Advanced Options"),
 
+		// Backend flags, only when the remote's type has suggestions
+		huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Backend Flags").
+				Description("Common flags for this remote's backend, added to the extra arguments").
+				OptionsFunc(func() []huh.Option[string] {
+					return backendFlagOptions(f.remotes, f.remote)
+				}, &f.remote).
+				Value(&f.backendFlags),
+		).Title("Step 4: Backend Flags").
+			WithHideFunc(func() bool { return len(backendFlagOptions(f.remotes, f.remote)) == 0 }),
+
 		// Step 5: Service Options
 		huh.NewGroup(
 			huh.NewConfirm().
@@ -334,6 +547,58 @@ func (f *MountForm) validateName(name string) error {
 	return nil
 }
 
+// validateRemotePath rejects remote paths that can't be written into the
+// unit or whose depth would be ambiguous once normalized.
+func validateRemotePath(path string) error {
+	if strings.ContainsAny(path, "\r\n") {
+		return fmt.Errorf("remote path must be a single line")
+	}
+	for _, part := range strings.Split(path, "/") {
+		if part == ".." {
+			return fmt.Errorf("remote path must not contain '..'")
+		}
+	}
+	return nil
+}
+
+// remotePathDescription shows the exact remote:path the unit will mount.
+func (f *MountForm) remotePathDescription() string {
+	desc := "Path on the remote (e.g., / or /Photos)"
+	if strings.TrimSpace(f.remote) == "" {
+		return desc
+	}
+	mount := models.MountConfig{Remote: f.remote, RemotePath: f.remotePath}
+	return desc + "\nMounts: " + mount.FullRemotePath()
+}
+
+// validateDefaultPermissions checks that --default-permissions fits the
+// other FUSE and VFS options. On a writable mount the enforced modes only
+// matter if rclone can actually serve writes, which needs a VFS cache mode of
+// writes or full. Like the other FUSE options it is rejected on platforms
+// where rclone does not support it.
+func (f *MountForm) validateDefaultPermissions(enabled bool) error {
+	if err := systemd.ValidateMountPlatform(&models.MountOptions{DefaultPermissions: enabled}); err != nil {
+		return err
+	}
+	if !enabled || f.readOnly || f.immutable {
+		return nil
+	}
+	if f.vfsCacheMode != "writes" && f.vfsCacheMode != "full" {
+		return fmt.Errorf("default permissions on a writable mount needs VFS cache mode \"writes\" or \"full\"")
+	}
+	return nil
+}
+
+// validateImmutable rejects an immutable mount whose VFS options are meant
+// for buffering writes.
+func (f *MountForm) validateImmutable(enabled bool) error {
+	return systemd.ValidateImmutable(&models.MountOptions{
+		Immutable:    enabled,
+		VFSCacheMode: f.vfsCacheMode,
+		VFSWriteBack: f.vfsWriteBack,
+	})
+}
+
 // validateMountPoint validates the mount point path.
 func (f *MountForm) validateMountPoint(path string) error {
 	if path == "" {
@@ -428,24 +693,34 @@ func (f *MountForm) submitForm() tea.Msg {
 
 	// Build the mount configuration
 	mount := models.MountConfig{
-		Name:       f.name,
-		Remote:     strings.TrimSuffix(f.remote, ":"),
-		RemotePath: f.remotePath,
-		MountPoint: f.mountPoint,
+		Name:             f.name,
+		Description:      strings.TrimSpace(f.description),
+		DocumentationURL: strings.TrimSpace(f.docURL),
+		Notes:            strings.TrimSpace(f.notes),
+		Remote:           strings.TrimSuffix(strings.TrimSpace(f.remote), ":"),
+		RemotePath:       models.NormalizeRemotePath(f.remotePath),
+		MountPoint:       f.mountPoint,
 		MountOptions: models.MountOptions{
-			VFSCacheMode:    f.vfsCacheMode,
-			VFSCacheMaxAge:  f.vfsCacheMaxAge,
-			VFSCacheMaxSize: f.vfsCacheMaxSize,
-			VFSWriteBack:    f.vfsWriteBack,
-			BufferSize:      f.bufferSize,
-			AllowOther:      f.allowOther,
-			AllowRoot:       f.allowRoot,
-			Umask:           f.umask,
-			ReadOnly:        f.readOnly,
-			NoModTime:       f.noModtime,
-			NoChecksum:      f.noChecksum,
-			LogLevel:        f.logLevel,
-			ExtraArgs:       f.extraArgs,
+			VFSCacheMode:       f.vfsCacheMode,
+			VFSCacheMaxAge:     f.vfsCacheMaxAge,
+			VFSCacheMaxSize:    f.vfsCacheMaxSize,
+			VFSWriteBack:       f.vfsWriteBack,
+			BufferSize:         f.bufferSize,
+			DirCacheTime:       f.dirCacheTime,
+			AttrTimeout:        f.attrTimeout,
+			PollInterval:       f.pollInterval,
+			AllowOther:         f.allowOther,
+			AllowRoot:          f.allowRoot,
+			Umask:              f.umask,
+			DefaultPermissions: f.defaultPerms,
+			NetworkMode:        f.networkMode,
+			ReadOnly:           f.readOnly,
+			Immutable:          f.immutable,
+			NoModTime:          f.noModtime,
+			NoChecksum:         f.noChecksum,
+			BandwidthLimit:     strings.Join(strings.Fields(f.bandwidthLimit), " "),
+			LogLevel:           f.logLevel,
+			ExtraArgs:          rclone.AddFlags(systemd.NormalizeExtraArgs(f.extraArgs), f.backendFlags),
 		},
 		AutoStart: f.autoStart,
 		Enabled:   f.enabled,
@@ -456,6 +731,9 @@ func (f *MountForm) submitForm() tea.Msg {
 	if f.isEdit && f.mount != nil {
 		mount.ID = f.mount.ID
 		mount.CreatedAt = f.mount.CreatedAt
+		mount.Favorite = f.mount.Favorite
+		mount.MountOptions.UID = f.mount.MountOptions.UID
+		mount.MountOptions.GID = f.mount.MountOptions.GID
 	} else {
 		mount.ID = uuid.New().String()[:8]
 		mount.CreatedAt = now
@@ -540,8 +818,9 @@ func (f *MountForm) submitForm() tea.Msg {
 		}
 	}
 
-	// Start service if auto-start is enabled
-	if mount.AutoStart {
+	// Start service if auto-start is enabled; a draft made without rclone
+	// installed cannot run yet, so leave it stopped
+	if mount.AutoStart && !f.rcloneMissing {
 		if err := f.manager.Start(serviceName); err != nil {
 			if f.config != nil {
 				rollbackMgr := NewRollbackManager(f.config, f.generator, f.manager)
@@ -490,6 +490,41 @@ func TestMountForm_DefaultValues(t *testing.T) {
 	}
 }
 
+func TestNewMountForm_VFSCacheLimitDefaults(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Defaults.Mount.VFSCacheMaxSize = "10G"
+	cfg.Defaults.Mount.VFSCacheMaxAge = "24h"
+
+	form := NewMountForm(nil, createTestRemotes(), cfg, nil, nil, nil, false)
+	if form.vfsCacheMaxSize != "10G" || form.vfsCacheMaxAge != "24h" {
+		t.Errorf("new mount cache limits = %q/%q, want the defaults 10G/24h", form.vfsCacheMaxSize, form.vfsCacheMaxAge)
+	}
+
+	mount := &models.MountConfig{Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/gdrive"}
+	form = NewMountForm(mount, createTestRemotes(), cfg, nil, nil, nil, true)
+	if form.vfsCacheMaxSize != "" || form.vfsCacheMaxAge != "" {
+		t.Errorf("editing keeps the mount's own limits, got %q/%q", form.vfsCacheMaxSize, form.vfsCacheMaxAge)
+	}
+}
+
+func TestNewMountForm_CacheTimeDefaults(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Defaults.Mount.DirCacheTime = "1h"
+	cfg.Defaults.Mount.AttrTimeout = "10s"
+
+	form := NewMountForm(nil, createTestRemotes(), cfg, nil, nil, nil, false)
+	if form.dirCacheTime != "1h" || form.attrTimeout != "10s" {
+		t.Errorf("new mount cache times = %q/%q, want the defaults 1h/10s", form.dirCacheTime, form.attrTimeout)
+	}
+
+	mount := &models.MountConfig{Name: "gdrive", Remote: "gdrive:", MountPoint: "/mnt/gdrive"}
+	mount.MountOptions.AttrTimeout = "1s"
+	form = NewMountForm(mount, createTestRemotes(), cfg, nil, nil, nil, true)
+	if form.dirCacheTime != "" || form.attrTimeout != "1s" {
+		t.Errorf("editing keeps the mount's own cache times, got %q/%q", form.dirCacheTime, form.attrTimeout)
+	}
+}
+
 func TestMountForm_RemoteOptions(t *testing.T) {
 	remotes := createTestRemotes()
 	form := NewMountForm(nil, remotes, nil, nil, nil, nil, false)
@@ -583,6 +618,99 @@ func TestMountForm_SubmitFormCreatesMountConfig(t *testing.T) {
 	}
 }
 
+func TestMountForm_SubmitNormalizesRemotePath(t *testing.T) {
+	form := NewMountForm(nil, createTestRemotes(), createTestConfig(), createTestGenerator(t), createTestManager(), nil, false)
+	form.name = "Photos"
+	form.remote = "gdrive:"
+	form.remotePath = "Photos//2024/"
+	form.mountPoint = "/mnt/photos"
+
+	createdMsg, ok := form.submitForm().(MountCreatedMsg)
+	if !ok {
+		t.Fatal("expected MountCreatedMsg")
+	}
+	if createdMsg.Mount.RemotePath != "/Photos/2024" {
+		t.Errorf("mount.RemotePath = %q, want %q", createdMsg.Mount.RemotePath, "/Photos/2024")
+	}
+	if got := createdMsg.Mount.FullRemotePath(); got != "gdrive:/Photos/2024" {
+		t.Errorf("FullRemotePath() = %q, want %q", got, "gdrive:/Photos/2024")
+	}
+}
+
+func TestMountForm_SubmitDirCacheTime(t *testing.T) {
+	form := NewMountForm(nil, createTestRemotes(), createTestConfig(), createTestGenerator(t), createTestManager(), nil, false)
+	form.name = "Listing Cache"
+	form.remote = "gdrive:"
+	form.mountPoint = "/mnt/listing"
+	form.dirCacheTime = "30m"
+
+	createdMsg, ok := form.submitForm().(MountCreatedMsg)
+	if !ok {
+		t.Fatal("expected MountCreatedMsg")
+	}
+	if got := createdMsg.Mount.MountOptions.DirCacheTime; got != "30m" {
+		t.Errorf("DirCacheTime = %q, want %q", got, "30m")
+	}
+}
+
+func TestMountForm_BackendFlags(t *testing.T) {
+	form := NewMountForm(nil, createTestRemotes(), createTestConfig(), createTestGenerator(t), createTestManager(), nil, false)
+	form.name = "Bucket"
+	form.remote = "s3:"
+	form.mountPoint = "/mnt/bucket"
+	form.extraArgs = "--s3-upload-concurrency=4"
+	form.backendFlags = []string{"--s3-upload-concurrency=8", "--s3-no-check-bucket"}
+
+	if options := backendFlagOptions(form.remotes, form.remote); len(options) == 0 {
+		t.Fatal("expected flag suggestions for an s3 remote")
+	}
+	if options := backendFlagOptions(form.remotes, "unknown:"); len(options) != 0 {
+		t.Errorf("expected no suggestions for an unknown remote, got %d", len(options))
+	}
+
+	createdMsg, ok := form.submitForm().(MountCreatedMsg)
+	if !ok {
+		t.Fatal("expected MountCreatedMsg")
+	}
+	if got, want := createdMsg.Mount.MountOptions.ExtraArgs, "--s3-upload-concurrency=4 --s3-no-check-bucket"; got != want {
+		t.Errorf("ExtraArgs = %q, want %q", got, want)
+	}
+}
+
+func TestMountForm_ValidateRemotePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{"", false},
+		{"/", false},
+		{"Photos//2024/", false},
+		{"/a..b", false},
+		{"/Photos/../Music", true},
+		{"..", true},
+		{"/Photos\n/Music", true},
+	}
+	for _, tt := range tests {
+		if err := validateRemotePath(tt.path); (err != nil) != tt.wantErr {
+			t.Errorf("validateRemotePath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMountForm_RemotePathDescription(t *testing.T) {
+	form := NewMountForm(nil, createTestRemotes(), createTestConfig(), nil, nil, nil, false)
+	form.remote = ""
+	if strings.Contains(form.remotePathDescription(), "Mounts:") {
+		t.Error("description should not show a full path before a remote is chosen")
+	}
+
+	form.remote = "gdrive:"
+	form.remotePath = "Music/"
+	if desc := form.remotePathDescription(); !strings.Contains(desc, "Mounts: gdrive:/Music") {
+		t.Errorf("description = %q, want it to show gdrive:/Music", desc)
+	}
+}
+
 func TestMountForm_SubmitFormEditMode(t *testing.T) {
 	cfg := createTestConfig()
 
@@ -593,6 +721,11 @@ func TestMountForm_SubmitFormEditMode(t *testing.T) {
 		Remote:     "gdrive",
 		RemotePath: "/",
 		MountPoint: "/mnt/old",
+		MountOptions: models.MountOptions{
+			UID:                1000,
+			GID:                100,
+			DefaultPermissions: true,
+		},
 		CreatedAt:  time.Now().Add(-24 * time.Hour),
 		ModifiedAt: time.Now().Add(-24 * time.Hour),
 	}
@@ -644,6 +777,88 @@ func TestMountForm_SubmitFormEditMode(t *testing.T) {
 	if mount.MountOptions.VFSCacheMode != "writes" {
 		t.Errorf("mount.VFSCacheMode = %q, want 'writes'", mount.MountOptions.VFSCacheMode)
 	}
+
+	// Verify permission options survive the edit
+	if !mount.MountOptions.DefaultPermissions {
+		t.Error("mount.DefaultPermissions should be preserved in edit mode")
+	}
+	if mount.MountOptions.UID != 1000 || mount.MountOptions.GID != 100 {
+		t.Errorf("mount UID/GID = %d/%d, want 1000/100", mount.MountOptions.UID, mount.MountOptions.GID)
+	}
+}
+
+func TestMountForm_ValidateDefaultPermissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      bool
+		vfsCacheMode string
+		readOnly     bool
+		wantErr      bool
+	}{
+		{"disabled", false, "off", false, false},
+		{"writes cache", true, "writes", false, false},
+		{"full cache", true, "full", false, false},
+		{"no cache on writable mount", true, "off", false, true},
+		{"no cache on read-only mount", true, "off", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := &MountForm{vfsCacheMode: tt.vfsCacheMode, readOnly: tt.readOnly}
+			err := form.validateDefaultPermissions(tt.enabled)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateDefaultPermissions(%v) error = %v, wantErr %v", tt.enabled, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMountForm_ValidateImmutable(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      bool
+		vfsCacheMode string
+		vfsWriteBack string
+		wantErr      bool
+	}{
+		{"disabled with writes cache", false, "writes", "5s", false},
+		{"full cache", true, "full", "", false},
+		{"no cache", true, "off", "", false},
+		{"writes cache", true, "writes", "", true},
+		{"write back", true, "full", "5s", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := &MountForm{vfsCacheMode: tt.vfsCacheMode, vfsWriteBack: tt.vfsWriteBack}
+			err := form.validateImmutable(tt.enabled)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateImmutable(%v) error = %v, wantErr %v", tt.enabled, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMountForm_ImmutableSkipsDefaultPermissionsCheck(t *testing.T) {
+	form := &MountForm{vfsCacheMode: "off", immutable: true}
+	if err := form.validateDefaultPermissions(true); err != nil {
+		t.Errorf("validateDefaultPermissions() on an immutable mount error = %v", err)
+	}
+}
+
+func TestMountForm_SubmitImmutable(t *testing.T) {
+	cfg := createTestConfig()
+	form := NewMountForm(nil, createTestRemotes(), cfg, createTestGenerator(t), createTestManager(), nil, false)
+	form.name = "Archive"
+	form.remote = "gdrive:"
+	form.mountPoint = "/mnt/archive"
+	form.immutable = true
+
+	form.submitForm()
+
+	if len(cfg.Mounts) != 1 || !cfg.Mounts[0].MountOptions.Immutable {
+		t.Fatal("submitted mount should be immutable")
+	}
 }
 
 func TestMountForm_ConfigIsUpdated(t *testing.T) {
@@ -815,6 +1030,7 @@ func TestMountForm_EditPreservesAdvancedOptions(t *testing.T) {
 			VFSCacheMaxSize: "5G",
 			VFSWriteBack:    "10s",
 			BufferSize:      "32M",
+			DirCacheTime:    "10m",
 			AllowOther:      true,
 			AllowRoot:       true,
 			Umask:           "077",
@@ -839,6 +1055,9 @@ func TestMountForm_EditPreservesAdvancedOptions(t *testing.T) {
 	if form.vfsWriteBack != "10s" {
 		t.Errorf("vfsWriteBack = %q, want '10s'", form.vfsWriteBack)
 	}
+	if form.dirCacheTime != "10m" {
+		t.Errorf("dirCacheTime = %q, want '10m'", form.dirCacheTime)
+	}
 	if form.allowRoot != true {
 		t.Error("allowRoot should be true")
 	}
@@ -928,3 +1147,73 @@ func TestMountForm_RollbackPreparationCreatesCopy(t *testing.T) {
 		t.Error("OriginalMounts should be independent copy")
 	}
 }
+
+func TestNewMountForm_NoRemotesFallsBackToManualEntry(t *testing.T) {
+	form := NewMountForm(nil, nil, createTestConfig(), nil, nil, nil, false)
+
+	if !form.manualRemote {
+		t.Error("manualRemote should be true when no remotes are available")
+	}
+	if !form.rcloneMissing {
+		t.Error("rcloneMissing should be true when there is no rclone client")
+	}
+	if !strings.Contains(form.remoteNotice, "rclone not found") {
+		t.Errorf("remoteNotice = %q, should contain 'rclone not found'", form.remoteNotice)
+	}
+	if form.remoteHint == "" {
+		t.Error("remoteHint should carry the preflight check message")
+	}
+}
+
+func TestNewMountForm_WithRemotesUsesPicker(t *testing.T) {
+	form := NewMountForm(nil, createTestRemotes(), createTestConfig(), nil, nil, nil, false)
+
+	if form.manualRemote {
+		t.Error("manualRemote should be false when remotes are available")
+	}
+}
+
+func TestValidateManualRemote(t *testing.T) {
+	tests := []struct {
+		remote  string
+		wantErr bool
+	}{
+		{"gdrive", false},
+		{"gdrive:", false},
+		{" my-remote ", false},
+		{"", true},
+		{":", true},
+		{"gdrive:/Photos", true},
+		{"a/b", true},
+	}
+
+	for _, tt := range tests {
+		err := validateManualRemote(tt.remote)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateManualRemote(%q) error = %v, wantErr %v", tt.remote, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMountForm_SubmitFormRcloneMissingSkipsStart(t *testing.T) {
+	cfg := createTestConfig()
+	gen := createTestGenerator(t)
+	mgr := createTestManager()
+	mgr.StartErr = os.ErrNotExist
+	form := NewMountForm(nil, nil, cfg, gen, mgr, &rclone.Client{}, false)
+
+	form.name = "Draft Mount"
+	form.remote = "gdrive:"
+	form.mountPoint = "/mnt/draft"
+	form.autoStart = true
+
+	msg := form.submitForm()
+
+	createdMsg, ok := msg.(MountCreatedMsg)
+	if !ok {
+		t.Fatalf("expected MountCreatedMsg, got %T (%v)", msg, msg)
+	}
+	if createdMsg.Mount.Remote != "gdrive" {
+		t.Errorf("mount.Remote = %q, want 'gdrive'", createdMsg.Mount.Remote)
+	}
+}
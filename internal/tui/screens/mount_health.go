@@ -0,0 +1,155 @@
+package screens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// MountHealth is the outcome of a mount health check.
+type MountHealth string
+
+const (
+	// MountHealthy means the remote answered and, if the mount is running,
+	// its mount point could be listed.
+	MountHealthy MountHealth = "healthy"
+	// MountStale means the service is running but the mount point is not
+	// mounted or does not answer, e.g. a FUSE mount whose rclone process
+	// is gone.
+	MountStale MountHealth = "stale"
+	// MountUnreachable means the remote could not be listed.
+	MountUnreachable MountHealth = "unreachable"
+)
+
+// mountHealthTimeout bounds each step of a health check, so an unresponsive
+// remote or mount point cannot leave the check running indefinitely.
+const mountHealthTimeout = 10 * time.Second
+
+// MountHealthCheckedMsg is sent when a mount health check finishes.
+type MountHealthCheckedMsg struct {
+	ID        string
+	Name      string
+	Health    MountHealth
+	Detail    string // Why the mount is not healthy, empty when it is
+	CheckedAt time.Time
+}
+
+// mountHealthResult is the last health check of a mount, kept per mount ID.
+type mountHealthResult struct {
+	health    MountHealth
+	detail    string
+	checkedAt time.Time
+}
+
+// checkMountHealth checks the selected mount in the background. The remote
+// is listed first, through the rclone client; when the mount's service is
+// running the mount point must also be mounted and list an entry.
+func (s *MountsScreen) checkMountHealth() (tea.Model, tea.Cmd) {
+	if s.rclone == nil {
+		s.err = fmt.Errorf("rclone client not initialized")
+		return s, nil
+	}
+
+	mount := s.mounts[s.cursor]
+	running := false
+	if status, ok := s.statuses[mount.Name]; ok {
+		running = status.Active
+	}
+	client, ctx := s.rclone, rootCtx
+	s.success = fmt.Sprintf("Checking health of mount '%s'...", mount.Name)
+	s.err = nil
+	return s, func() tea.Msg {
+		health, detail := mountHealth(ctx, client, &mount, running)
+		return MountHealthCheckedMsg{
+			ID:        mount.ID,
+			Name:      mount.Name,
+			Health:    health,
+			Detail:    detail,
+			CheckedAt: time.Now(),
+		}
+	}
+}
+
+// mountHealth runs the checks of checkMountHealth.
+func mountHealth(ctx context.Context, client *rclone.Client, mount *models.MountConfig, running bool) (MountHealth, string) {
+	probeCtx, cancel := context.WithTimeout(ctx, mountHealthTimeout)
+	defer cancel()
+	if err := client.Probe(probeCtx, systemd.MountCheckArgs(mount)...); err != nil {
+		if errors.Is(probeCtx.Err(), context.DeadlineExceeded) {
+			return MountUnreachable, fmt.Sprintf("no response from %s within %s", mount.FullRemotePath(), mountHealthTimeout)
+		}
+		return MountUnreachable, err.Error()
+	}
+	if !running {
+		return MountHealthy, ""
+	}
+
+	mountPoint := filepath.Clean(components.ExpandHome(mount.MountPoint))
+	if active, err := rclone.ListActiveMounts(); err == nil && !containsMountPoint(active, mountPoint) {
+		return MountStale, fmt.Sprintf("the service is running but %s is not mounted", mount.MountPoint)
+	}
+	if err := listOneEntry(mountPoint, mountHealthTimeout); err != nil {
+		return MountStale, err.Error()
+	}
+	return MountHealthy, ""
+}
+
+// containsMountPoint reports whether one of the active mounts is mounted at
+// path.
+func containsMountPoint(active []rclone.ActiveMount, path string) bool {
+	for _, m := range active {
+		if filepath.Clean(m.MountPoint) == path {
+			return true
+		}
+	}
+	return false
+}
+
+// listOneEntry reads at most one entry of dir. A stale FUSE mount can block
+// the read indefinitely, so it gives up after timeout and leaves the read
+// to finish, or not, on its own.
+func listOneEntry(dir string, timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		f, err := os.Open(dir)
+		if err != nil {
+			done <- err
+			return
+		}
+		defer f.Close()
+		if _, err := f.Readdirnames(1); err != nil && err != io.EOF {
+			done <- err
+			return
+		}
+		done <- nil
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("%s did not respond within %s", dir, timeout)
+	}
+}
+
+// label describes a mount's last health check for its details, e.g.
+// "unreachable: directory not found (checked 14:05:09)", or "not checked".
+func (r mountHealthResult) label() string {
+	if r.health == "" {
+		return "not checked"
+	}
+	if r.detail != "" {
+		return fmt.Sprintf("%s: %s (checked %s)", r.health, r.detail, r.checkedAt.Format("15:04:05"))
+	}
+	return fmt.Sprintf("%s (checked %s)", r.health, r.checkedAt.Format("15:04:05"))
+}
@@ -0,0 +1,82 @@
+package screens
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+// fakeRclone returns a client running a script in place of rclone.
+func fakeRclone(t *testing.T, body string) *rclone.Client {
+	t.Helper()
+	script := filepath.Join(t.TempDir(), "rclone")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\n"+body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return rclone.NewClientWithPath(script)
+}
+
+func TestMountsScreen_HealthCheckUnreachable(t *testing.T) {
+	screen := createTestMountsScreen()
+	screen.SetSize(100, 40)
+	screen.rclone = fakeRclone(t, "echo 'Failed to lsd: connection refused' >&2\nexit 1\n")
+	screen.mounts = createTestMounts()
+	screen.loading = false
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	if cmd == nil {
+		t.Fatal("checking health should return a command")
+	}
+	msg, ok := cmd().(MountHealthCheckedMsg)
+	if !ok {
+		t.Fatal("expected MountHealthCheckedMsg")
+	}
+	if msg.Health != MountUnreachable || !strings.Contains(msg.Detail, "connection refused") {
+		t.Errorf("health = %q (%s), want unreachable with the rclone error", msg.Health, msg.Detail)
+	}
+
+	screen.Update(msg)
+	if screen.err == nil || !strings.Contains(screen.err.Error(), "is unreachable") {
+		t.Errorf("err = %v, want the mount reported unreachable", screen.err)
+	}
+	cached := screen.health["a1b2c3d4"]
+	if cached.health != MountUnreachable || cached.checkedAt.IsZero() {
+		t.Errorf("cached health = %+v, want unreachable with a timestamp", cached)
+	}
+	if view := screen.View(); !strings.Contains(view, "Health: unreachable") {
+		t.Errorf("details should show the cached health:\n%s", view)
+	}
+}
+
+func TestMountHealth(t *testing.T) {
+	client := fakeRclone(t, "exit 0\n")
+	mount := createTestMounts()[0]
+	mount.MountPoint = t.TempDir()
+
+	if health, detail := mountHealth(context.Background(), client, &mount, false); health != MountHealthy {
+		t.Errorf("stopped mount with a reachable remote: health = %q (%s), want healthy", health, detail)
+	}
+
+	// Running, but nothing is mounted at the mount point
+	if _, err := rclone.ListActiveMounts(); err != nil {
+		t.Skipf("mount table not readable: %v", err)
+	}
+	if health, _ := mountHealth(context.Background(), client, &mount, true); health != MountStale {
+		t.Errorf("running mount that is not mounted: health = %q, want stale", health)
+	}
+}
+
+func TestListOneEntry(t *testing.T) {
+	dir := t.TempDir()
+	if err := listOneEntry(dir, mountHealthTimeout); err != nil {
+		t.Errorf("listOneEntry() on an empty directory error = %v", err)
+	}
+	if err := listOneEntry(filepath.Join(dir, "missing"), mountHealthTimeout); err == nil {
+		t.Error("listOneEntry() on a missing directory should fail")
+	}
+}
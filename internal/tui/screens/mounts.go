@@ -3,12 +3,16 @@ package screens
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/dtg01100/rclone-mount-sync/internal/clipboard"
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
 	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
@@ -25,6 +29,9 @@ const (
 	MountsModeEdit
 	MountsModeDelete
 	MountsModeDetails
+	MountsModeLogs
+	MountsModeBulkEdit
+	MountsModeBulkAction
 )
 
 // MountsScreen manages mount configurations.
@@ -37,35 +44,50 @@ type MountsScreen struct {
 	height   int
 	mode     MountsScreenMode
 	goBack   bool
+	marked   map[string]bool // IDs of mounts selected for bulk edit
+	hidden   int             // Disabled mounts left out of the list
+	search   listSearch      // Narrows the list as a query is typed
+
+	// Last health check of each mount, by mount ID
+	health map[string]mountHealthResult
 
 	// Sub-screens
 	form    *MountForm
 	details *MountDetails
+	logs    *LogViewer
 	delete  *DeleteConfirm
+	bulk    *bulkEdit
+
+	// Start or stop of all mounts awaiting confirmation
+	bulkAction *bulkMountAction
 
 	// Services
 	config    *config.Config
 	rclone    *rclone.Client
-	generator *systemd.Generator
+	generator systemd.UnitGenerator
 	manager   systemd.ServiceManager
 
 	// Messages
 	err     error
 	success string
 	loading bool
+
+	// Periodic status refresh
+	autoRefresh autoRefresh
 }
 
 // NewMountsScreen creates a new mounts screen.
 func NewMountsScreen() *MountsScreen {
 	return &MountsScreen{
-		mode:     MountsModeList,
-		loading:  true,
-		statuses: make(map[string]*systemd.ServiceStatus),
+		mode:        MountsModeList,
+		loading:     true,
+		statuses:    make(map[string]*systemd.ServiceStatus),
+		autoRefresh: autoRefresh{screen: "mounts"},
 	}
 }
 
 // SetServices sets the required services for the mounts screen.
-func (s *MountsScreen) SetServices(cfg *config.Config, rcloneClient *rclone.Client, gen *systemd.Generator, mgr systemd.ServiceManager) {
+func (s *MountsScreen) SetServices(cfg *config.Config, rcloneClient *rclone.Client, gen systemd.UnitGenerator, mgr systemd.ServiceManager) {
 	s.config = cfg
 	s.rclone = rcloneClient
 	s.generator = gen
@@ -79,6 +101,9 @@ func (s *MountsScreen) SetSize(width, height int) {
 	if s.form != nil {
 		s.form.SetSize(width, height)
 	}
+	if s.logs != nil {
+		s.logs.SetSize(width, height)
+	}
 }
 
 // Init initializes the screen.
@@ -97,8 +122,8 @@ func (s *MountsScreen) loadMounts() tea.Msg {
 		return MountsErrorMsg{Err: fmt.Errorf("failed to reload config: %w", err)}
 	}
 
-	// Load mounts from config
-	s.mounts = s.config.Mounts
+	// Load mounts from config, favorites first
+	s.mounts, s.hidden = s.listedMounts()
 
 	// Load statuses for each mount (only if generator and manager are available)
 	if s.generator != nil && s.manager != nil {
@@ -120,6 +145,34 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Handle screen-level messages first (even when in form mode)
 	switch msg := msg.(type) {
+	case AutoRefreshTickMsg:
+		due, next := s.autoRefresh.handleTick(msg)
+		if due && s.mode == MountsModeList {
+			return s, tea.Batch(next, s.loadMounts)
+		}
+		return s, next
+	case MountCheckedMsg:
+		if msg.Err != nil {
+			s.success = ""
+			s.err = fmt.Errorf("mount '%s' check failed: %w", msg.Name, msg.Err)
+		} else {
+			s.err = nil
+			s.success = fmt.Sprintf("Mount '%s' check passed: %s is reachable", msg.Name, msg.Source)
+		}
+		return s, nil
+	case MountHealthCheckedMsg:
+		if s.health == nil {
+			s.health = make(map[string]mountHealthResult)
+		}
+		s.health[msg.ID] = mountHealthResult{health: msg.Health, detail: msg.Detail, checkedAt: msg.CheckedAt}
+		if msg.Health == MountHealthy {
+			s.err = nil
+			s.success = fmt.Sprintf("Mount '%s' is healthy", msg.Name)
+		} else {
+			s.success = ""
+			s.err = fmt.Errorf("mount '%s' is %s: %s", msg.Name, msg.Health, msg.Detail)
+		}
+		return s, nil
 	case MountFormCancelMsg:
 		s.mode = MountsModeList
 		s.form = nil
@@ -129,7 +182,11 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Form submitted, handled by form
 		return s, nil
 	case MountCreatedMsg:
-		s.mounts = append(s.mounts, msg.Mount)
+		if !msg.Mount.Enabled && hideDisabled(s.config) {
+			s.hidden++
+		} else {
+			s.mounts = append(s.mounts, msg.Mount)
+		}
 		s.success = fmt.Sprintf("Mount '%s' created successfully", msg.Mount.Name)
 		s.mode = MountsModeList
 		s.err = nil
@@ -138,7 +195,13 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update the mount in the list
 		for i, m := range s.mounts {
 			if m.ID == msg.Mount.ID {
-				s.mounts[i] = msg.Mount
+				if !msg.Mount.Enabled && hideDisabled(s.config) {
+					s.mounts = append(s.mounts[:i], s.mounts[i+1:]...)
+					s.hidden++
+					s.clampCursor()
+				} else {
+					s.mounts[i] = msg.Mount
+				}
 				break
 			}
 		}
@@ -148,6 +211,10 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return s, nil
 	}
 
+	if s.mode == MountsModeBulkEdit && s.bulk != nil {
+		return s.updateBulkEdit(msg)
+	}
+
 	// Then handle form mode - pass remaining messages to form
 	if s.mode == MountsModeCreate || s.mode == MountsModeEdit {
 		if s.form != nil {
@@ -166,12 +233,40 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return s.updateDelete(msg)
 		case MountsModeDetails:
 			return s.updateDetails(msg)
+		case MountsModeLogs:
+			return s.updateLogs(msg)
+		case MountsModeBulkAction:
+			if s.bulkAction != nil {
+				return s.updateBulkMountAction(msg)
+			}
+			s.mode = MountsModeList
+		}
+
+	case LogViewerLoadedMsg:
+		if s.logs != nil {
+			s.logs, _ = s.logs.Update(msg)
 		}
 
 	case MountsLoadedMsg:
 		s.mounts = msg.Mounts
 		s.loading = false
 
+	case MountUsageLoadedMsg:
+		if s.details != nil {
+			s.details.Update(msg)
+		}
+
+	case MountsBulkActionMsg:
+		if msg.Failed > 0 {
+			s.success = ""
+			s.err = fmt.Errorf("%s", msg.Summary)
+		} else {
+			s.err = nil
+			s.success = msg.Summary
+		}
+		// Reload statuses now that the services have changed
+		cmds = append(cmds, s.loadMounts)
+
 	case MountDeletedMsg:
 		// Remove the mount from the list
 		for i, m := range s.mounts {
@@ -188,6 +283,9 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case MountStatusMsg:
 		s.statuses[msg.Name] = msg.Status
 
+	case MountAutoStartMsg:
+		s.setAutoStart(msg)
+
 	case MountsErrorMsg:
 		s.err = msg.Err
 		s.loading = false
@@ -198,7 +296,16 @@ func (s *MountsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // updateList handles updates when in list mode.
 func (s *MountsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if s.search.editing {
+		cmd := s.search.update(msg)
+		s.applySearch()
+		return s, cmd
+	}
+
 	switch msg.String() {
+	case "/":
+		// Filter the list as a query is typed
+		return s, s.search.open()
 	case "up", "k":
 		if s.cursor > 0 {
 			s.cursor--
@@ -207,15 +314,15 @@ func (s *MountsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if s.cursor < len(s.mounts)-1 {
 			s.cursor++
 		}
-	case "a":
+	case MountKeys.Key(ActionAdd):
 		// Add new mount
 		return s.startCreateForm()
-	case "e":
+	case MountKeys.Key(ActionEdit):
 		// Edit selected mount
 		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
 			return s.startEditForm()
 		}
-	case "d":
+	case MountKeys.Key(ActionDelete):
 		// Delete selected mount
 		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
 			s.mode = MountsModeDelete
@@ -229,33 +336,322 @@ func (s *MountsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
 			s.mode = MountsModeDetails
 			s.details = NewMountDetails(s.mounts[s.cursor], s.manager, s.generator)
+			if s.config != nil {
+				s.details.defaultExtraArgs = s.config.Defaults.Mount.ExtraFlags
+			}
+			s.details.rclone = s.rclone
+			return s, s.details.loadUsage()
 		}
-	case "t":
+	case MountKeys.Key(ActionToggle):
 		// Toggle mount service
 		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
 			return s.toggleMount()
 		}
-	case "s":
+	case MountKeys.Key(ActionStart):
 		// Start mount
 		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
 			return s.startMount()
 		}
-	case "x":
+	case MountKeys.Key(ActionStop):
 		// Stop mount
 		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
 			return s.stopMount()
 		}
-	case "r":
+	case MountKeys.Key(ActionStartAll):
+		// Start every enabled mount
+		return s.startBulkMountAction(systemd.BatchStart)
+	case MountKeys.Key(ActionStopAll):
+		// Stop every mount
+		return s.startBulkMountAction(systemd.BatchStop)
+	case MountKeys.Key(ActionFavorite):
+		// Pin or unpin the selected mount
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			return s.toggleFavorite()
+		}
+	case MountKeys.Key(ActionMark):
+		// Mark or unmark the selected mount for bulk edit
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			s.toggleMark()
+		}
+	case MountKeys.Key(ActionBulkEdit):
+		// Change an option on all marked mounts
+		return s.startBulkEdit()
+	case MountKeys.Key(ActionAutoStart):
+		// Enable or disable the selected mount at boot
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			return s.toggleAutoStart()
+		}
+	case MountKeys.Key(ActionRefresh):
 		// Refresh mount list
 		s.loading = true
 		return s, s.loadMounts
+	case MountKeys.Key(ActionHideDisabled):
+		// Hide or show disabled mounts
+		return s.toggleHideDisabled()
+	case MountKeys.Key(ActionAutoRefresh):
+		// Toggle periodic status refresh
+		return s, s.autoRefresh.toggle(autoRefreshInterval(s.config))
+	case MountKeys.Key(ActionLogs):
+		// Jump straight to the selected mount's logs
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			return s.openLogs()
+		}
+	case MountKeys.Key(ActionCheck):
+		// Check the selected mount would work without mounting it
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			return s.checkMount()
+		}
+	case MountKeys.Key(ActionHealth):
+		// Check the selected mount's remote and mount point respond
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			return s.checkMountHealth()
+		}
+	case MountKeys.Key(ActionClone):
+		// Create a new mount from a copy of the selected one
+		if len(s.mounts) > 0 && s.cursor < len(s.mounts) {
+			return s.startCloneForm()
+		}
 	case "esc":
+		// Clear the filter first, then leave
+		if s.search.query() != "" {
+			s.search.clear()
+			s.applySearch()
+			return s, nil
+		}
 		s.goBack = true
 	}
 
 	return s, nil
 }
 
+// toggleFavorite pins or unpins the selected mount and keeps the cursor on it.
+func (s *MountsScreen) toggleFavorite() (tea.Model, tea.Cmd) {
+	if s.config == nil {
+		s.err = fmt.Errorf("config not initialized")
+		return s, nil
+	}
+
+	mount := s.mounts[s.cursor]
+	favorite := !mount.Favorite
+
+	found := false
+	for i := range s.config.Mounts {
+		if s.config.Mounts[i].ID == mount.ID {
+			s.config.Mounts[i].Favorite = favorite
+			found = true
+			break
+		}
+	}
+	if !found {
+		s.err = fmt.Errorf("mount '%s' not found in config", mount.Name)
+		return s, nil
+	}
+
+	if err := s.config.Save(); err != nil {
+		for i := range s.config.Mounts {
+			if s.config.Mounts[i].ID == mount.ID {
+				s.config.Mounts[i].Favorite = !favorite
+				break
+			}
+		}
+		s.err = fmt.Errorf("failed to save config: %w", err)
+		return s, nil
+	}
+
+	s.mounts, s.hidden = s.listedMounts()
+	for i, m := range s.mounts {
+		if m.ID == mount.ID {
+			s.cursor = i
+			break
+		}
+	}
+
+	if favorite {
+		s.success = fmt.Sprintf("Mount '%s' pinned to top", mount.Name)
+	} else {
+		s.success = fmt.Sprintf("Mount '%s' unpinned", mount.Name)
+	}
+	s.err = nil
+	return s, nil
+}
+
+// toggleHideDisabled hides or shows disabled mounts, keeping the cursor on
+// the selected mount when it is still listed.
+func (s *MountsScreen) toggleHideDisabled() (tea.Model, tea.Cmd) {
+	if err := toggleHideDisabled(s.config); err != nil {
+		s.err = err
+		return s, nil
+	}
+
+	var selected string
+	if s.cursor < len(s.mounts) {
+		selected = s.mounts[s.cursor].ID
+	}
+	s.mounts, s.hidden = s.listedMounts()
+	s.cursor = 0
+	for i, m := range s.mounts {
+		if m.ID == selected {
+			s.cursor = i
+			break
+		}
+	}
+
+	if hideDisabled(s.config) {
+		s.success = "Disabled mounts hidden"
+	} else {
+		s.success = "Showing disabled mounts"
+	}
+	s.err = nil
+	return s, nil
+}
+
+// listedMounts returns the mounts to list, favorites first and narrowed
+// by the hide disabled setting and the search, and how many disabled ones
+// were hidden.
+func (s *MountsScreen) listedMounts() ([]models.MountConfig, int) {
+	mounts, hidden := visibleMounts(sortMountsByFavorite(s.config.Mounts), hideDisabled(s.config))
+	return searchMounts(mounts, s.search.query()), hidden
+}
+
+// applySearch relists the mounts after the search changed, keeping the cursor
+// within the narrowed list.
+func (s *MountsScreen) applySearch() {
+	if s.config != nil {
+		s.mounts, s.hidden = s.listedMounts()
+	}
+	s.clampCursor()
+}
+
+// clampCursor keeps the cursor within the list after items are removed.
+func (s *MountsScreen) clampCursor() {
+	if s.cursor >= len(s.mounts) {
+		s.cursor = max(len(s.mounts)-1, 0)
+	}
+}
+
+// toggleMark marks or unmarks the selected mount and moves to the next one.
+func (s *MountsScreen) toggleMark() {
+	id := s.mounts[s.cursor].ID
+	if s.marked == nil {
+		s.marked = make(map[string]bool)
+	}
+	if s.marked[id] {
+		delete(s.marked, id)
+	} else {
+		s.marked[id] = true
+	}
+	if s.cursor < len(s.mounts)-1 {
+		s.cursor++
+	}
+}
+
+// startBulkEdit opens bulk edit for the marked mounts.
+func (s *MountsScreen) startBulkEdit() (tea.Model, tea.Cmd) {
+	var ids, names []string
+	for _, m := range s.mounts {
+		if s.marked[m.ID] {
+			ids = append(ids, m.ID)
+			names = append(names, m.Name)
+		}
+	}
+	if len(ids) == 0 {
+		s.err = fmt.Errorf("no mounts marked - press space to mark mounts for bulk edit")
+		return s, nil
+	}
+
+	s.bulk = newBulkEdit("mounts", names, mountBulkFields, func(field bulkField, value string) []BulkEditResult {
+		return s.applyBulkEdit(ids, names, field, value)
+	})
+	s.mode = MountsModeBulkEdit
+	s.err = nil
+	return s, s.bulk.Init()
+}
+
+// updateBulkEdit handles updates while bulk edit is open.
+func (s *MountsScreen) updateBulkEdit(msg tea.Msg) (tea.Model, tea.Cmd) {
+	cmd := s.bulk.Update(msg)
+	if !s.bulk.done {
+		return s, cmd
+	}
+
+	applied := s.bulk.Applied()
+	s.bulk = nil
+	s.mode = MountsModeList
+	if !applied {
+		return s, nil
+	}
+	s.marked = nil
+	s.loading = true
+	return s, s.loadMounts
+}
+
+// applyBulkEdit sets field to value on the given mounts, saves the config
+// once and regenerates each mount's service.
+func (s *MountsScreen) applyBulkEdit(ids, names []string, field bulkField, value string) []BulkEditResult {
+	if s.config == nil || s.generator == nil || s.manager == nil {
+		return bulkFailAll(names, fmt.Errorf("services not initialized"))
+	}
+
+	previous := make([]models.MountConfig, len(s.config.Mounts))
+	copy(previous, s.config.Mounts)
+
+	now := time.Now()
+	indexes := make([]int, len(ids))
+	for n, id := range ids {
+		indexes[n] = -1
+		for i := range s.config.Mounts {
+			if s.config.Mounts[i].ID == id {
+				setMountField(&s.config.Mounts[i], field.key, value)
+				s.config.Mounts[i].ModifiedAt = now
+				indexes[n] = i
+				break
+			}
+		}
+	}
+
+	if err := s.config.Save(); err != nil {
+		s.config.Mounts = previous
+		return bulkFailAll(names, fmt.Errorf("failed to save config: %w", err))
+	}
+
+	return regenerateUnits(names, func(n int) error {
+		if indexes[n] < 0 {
+			return fmt.Errorf("mount not found in config")
+		}
+		_, err := s.generator.WriteMountService(&s.config.Mounts[indexes[n]])
+		return err
+	}, s.manager)
+}
+
+// IsBulkEditing reports whether bulk edit is open, so typed keys reach it
+// instead of the global bindings.
+func (s *MountsScreen) IsBulkEditing() bool {
+	return s.mode == MountsModeBulkEdit && s.bulk != nil
+}
+
+// IsSearching reports whether the list filter is being typed, so typed keys
+// reach it instead of the global bindings.
+func (s *MountsScreen) IsSearching() bool {
+	return s.mode == MountsModeList && s.search.editing
+}
+
+// HasSearchFilter reports whether the list is filtered, so Esc clears the
+// filter instead of leaving the screen.
+func (s *MountsScreen) HasSearchFilter() bool {
+	return s.mode == MountsModeList && s.search.query() != ""
+}
+
+// sortMountsByFavorite returns a copy of mounts with favorites floated to the
+// top. The existing order is kept within favorites and within the rest.
+func sortMountsByFavorite(mounts []models.MountConfig) []models.MountConfig {
+	sorted := make([]models.MountConfig, len(mounts))
+	copy(sorted, mounts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Favorite && !sorted[j].Favorite
+	})
+	return sorted
+}
+
 // updateForm handles updates when in form mode.
 func (s *MountsScreen) updateForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if s.form == nil {
@@ -298,6 +694,66 @@ func (s *MountsScreen) updateDelete(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return s, cmd
 }
 
+// checkMount validates the selected mount's options and lists its remote
+// path in the background, as the mount check command does.
+func (s *MountsScreen) checkMount() (tea.Model, tea.Cmd) {
+	if s.rclone == nil {
+		s.err = fmt.Errorf("rclone client not initialized")
+		return s, nil
+	}
+
+	mount := s.mounts[s.cursor]
+	client, ctx := s.rclone, rootCtx
+	s.success = fmt.Sprintf("Checking mount '%s'...", mount.Name)
+	s.err = nil
+	return s, func() tea.Msg {
+		err := systemd.ValidateMount(&mount)
+		if err == nil {
+			err = client.Probe(ctx, systemd.MountCheckArgs(&mount)...)
+		}
+		return MountCheckedMsg{Name: mount.Name, Source: mount.FullRemotePath(), Err: err}
+	}
+}
+
+// openLogs opens the log viewer for the selected mount.
+func (s *MountsScreen) openLogs() (tea.Model, tea.Cmd) {
+	if s.generator == nil || s.manager == nil {
+		s.err = fmt.Errorf("systemd services not initialized")
+		return s, nil
+	}
+
+	mount := s.mounts[s.cursor]
+	unit := s.generator.ServiceName(mount.ID, "mount") + ".service"
+	s.logs = NewLogViewer(mount.Name, unit, s.manager)
+	s.logs.SetSize(s.width, s.height)
+	s.mode = MountsModeLogs
+	return s, s.logs.Init()
+}
+
+// updateLogs handles updates when in logs mode.
+func (s *MountsScreen) updateLogs(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if s.logs == nil {
+		s.mode = MountsModeList
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	s.logs, cmd = s.logs.Update(msg)
+
+	if s.logs.IsDone() {
+		s.mode = MountsModeList
+		s.logs = nil
+	}
+
+	return s, cmd
+}
+
+// IsViewingLogs reports whether the log viewer is open, so Esc can close it
+// instead of leaving the screen.
+func (s *MountsScreen) IsViewingLogs() bool {
+	return s.mode == MountsModeLogs && s.logs != nil
+}
+
 // updateDetails handles updates when in details mode.
 func (s *MountsScreen) updateDetails(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if s.details == nil {
@@ -321,37 +777,54 @@ func (s *MountsScreen) updateDetails(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // startCreateForm starts the create mount form.
 func (s *MountsScreen) startCreateForm() (tea.Model, tea.Cmd) {
+	return s.openCreateForm(nil)
+}
+
+// startCloneForm opens the create form filled in from the selected mount,
+// under a new name and mount point.
+func (s *MountsScreen) startCloneForm() (tea.Model, tea.Cmd) {
+	clone := cloneMount(s.mounts[s.cursor], s.config)
+	return s.openCreateForm(&clone)
+}
+
+// openCreateForm opens the form for a new mount, pre-filled from mount if
+// it is not nil.
+func (s *MountsScreen) openCreateForm(mount *models.MountConfig) (tea.Model, tea.Cmd) {
 	// Check if rclone client is available
 	if s.rclone == nil {
 		s.err = fmt.Errorf("rclone client not initialized - please ensure rclone is installed")
 		return s, nil
 	}
 
-	// Check if rclone is installed
-	if !s.rclone.IsInstalled() {
-		s.err = fmt.Errorf("rclone binary not found - please install rclone first")
-		return s, nil
-	}
-
-	// Get available remotes
-	remotes, err := s.rclone.ListRemotes(context.Background())
+	// Get available remotes; without rclone or any remotes the form falls
+	// back to manual remote entry so a config can still be drafted
+	remotes, err := s.availableRemotes()
 	if err != nil {
-		s.err = fmt.Errorf("failed to list remotes: %w", err)
+		s.err = err
 		return s, nil
 	}
 
-	// Check if any remotes are configured
-	if len(remotes) == 0 {
-		s.err = fmt.Errorf("no rclone remotes configured - run 'rclone config' to set up a remote")
-		return s, nil
-	}
-
-	s.form = NewMountForm(nil, remotes, s.config, s.generator, s.manager, s.rclone, false)
+	s.form = NewMountForm(mount, remotes, s.config, s.generator, s.manager, s.rclone, false)
 	s.mode = MountsModeCreate
 	s.err = nil
 	return s, s.form.Init()
 }
 
+// availableRemotes lists the configured rclone remotes for the forms.
+// A missing rclone binary is not an error: it yields no remotes so the form
+// offers manual remote entry instead.
+func (s *MountsScreen) availableRemotes() ([]rclone.Remote, error) {
+	if !s.rclone.IsInstalled() {
+		return nil, nil
+	}
+
+	remotes, err := s.rclone.ListRemotes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+	return remotes, nil
+}
+
 // startEditForm starts the edit mount form.
 func (s *MountsScreen) startEditForm() (tea.Model, tea.Cmd) {
 	mount := s.mounts[s.cursor]
@@ -362,22 +835,11 @@ func (s *MountsScreen) startEditForm() (tea.Model, tea.Cmd) {
 		return s, nil
 	}
 
-	// Check if rclone is installed
-	if !s.rclone.IsInstalled() {
-		s.err = fmt.Errorf("rclone binary not found - please install rclone first")
-		return s, nil
-	}
-
-	// Get available remotes
-	remotes, err := s.rclone.ListRemotes(context.Background())
+	// Get available remotes; without rclone or any remotes the form falls
+	// back to manual remote entry so a config can still be drafted
+	remotes, err := s.availableRemotes()
 	if err != nil {
-		s.err = fmt.Errorf("failed to list remotes: %w", err)
-		return s, nil
-	}
-
-	// Check if any remotes are configured
-	if len(remotes) == 0 {
-		s.err = fmt.Errorf("no rclone remotes configured - run 'rclone config' to set up a remote")
+		s.err = err
 		return s, nil
 	}
 
@@ -466,6 +928,98 @@ func (s *MountsScreen) stopMount() (tea.Model, tea.Cmd) {
 	}
 }
 
+// toggleAutoStart enables or disables the selected mount's service at boot,
+// leaving it running or stopped as it is.
+func (s *MountsScreen) toggleAutoStart() (tea.Model, tea.Cmd) {
+	if s.generator == nil || s.manager == nil {
+		s.err = fmt.Errorf("systemd services not initialized")
+		return s, nil
+	}
+	if s.config == nil {
+		s.err = fmt.Errorf("config not initialized")
+		return s, nil
+	}
+
+	mount := s.mounts[s.cursor]
+	serviceName := s.generator.ServiceName(mount.ID, "mount") + ".service"
+	on := !mount.AutoStart
+
+	return s, func() tea.Msg {
+		if on {
+			if err := s.manager.Enable(serviceName); err != nil {
+				return MountsErrorMsg{Err: fmt.Errorf("failed to enable mount at boot: %w", err)}
+			}
+		} else if err := s.manager.Disable(serviceName); err != nil {
+			return MountsErrorMsg{Err: fmt.Errorf("failed to disable mount at boot: %w", err)}
+		}
+		return MountAutoStartMsg{ID: mount.ID, Name: mount.Name, AutoStart: on}
+	}
+}
+
+// setAutoStart records a mount's new boot state in the config and saves it.
+// Enabled follows AutoStart, as with config set-autostart, so the next
+// config apply keeps the unit enabled or disabled.
+func (s *MountsScreen) setAutoStart(msg MountAutoStartMsg) {
+	if s.config == nil {
+		return
+	}
+
+	var prev models.MountConfig
+	found := false
+	for i := range s.config.Mounts {
+		if m := &s.config.Mounts[i]; m.ID == msg.ID {
+			prev = *m
+			m.AutoStart, m.Enabled, m.ModifiedAt = msg.AutoStart, msg.AutoStart, time.Now()
+			found = true
+			break
+		}
+	}
+	if !found {
+		s.err = fmt.Errorf("mount '%s' not found in config", msg.Name)
+		return
+	}
+
+	if err := s.config.Save(); err != nil {
+		for i := range s.config.Mounts {
+			if s.config.Mounts[i].ID == msg.ID {
+				s.config.Mounts[i] = prev
+				break
+			}
+		}
+		s.err = fmt.Errorf("unit changed but failed to save config: %w", err)
+		return
+	}
+
+	if status, ok := s.statuses[msg.Name]; ok {
+		status.Enabled = msg.AutoStart
+	}
+	s.mounts, s.hidden = s.listedMounts()
+	for i, m := range s.mounts {
+		if m.ID == msg.ID {
+			s.cursor = i
+			break
+		}
+	}
+	s.clampCursor()
+
+	if msg.AutoStart {
+		s.success = fmt.Sprintf("Mount '%s' will start at boot", msg.Name)
+	} else {
+		s.success = fmt.Sprintf("Mount '%s' will no longer start at boot", msg.Name)
+	}
+	s.err = nil
+}
+
+// HasUnsavedChanges returns true while a create or edit form is open.
+func (s *MountsScreen) HasUnsavedChanges() bool {
+	return s.form != nil && (s.mode == MountsModeCreate || s.mode == MountsModeEdit)
+}
+
+// StopAutoRefresh turns off periodic status refresh, e.g. when leaving the screen.
+func (s *MountsScreen) StopAutoRefresh() {
+	s.autoRefresh.stop()
+}
+
 // ShouldGoBack returns true if the screen should go back to the main menu.
 func (s *MountsScreen) ShouldGoBack() bool {
 	return s.goBack
@@ -491,6 +1045,18 @@ func (s *MountsScreen) View() string {
 		if s.details != nil {
 			return s.details.View()
 		}
+	case MountsModeLogs:
+		if s.logs != nil {
+			return s.logs.View()
+		}
+	case MountsModeBulkEdit:
+		if s.bulk != nil {
+			return s.bulk.View(s.width)
+		}
+	case MountsModeBulkAction:
+		if s.bulkAction != nil {
+			return s.bulkAction.confirm.View()
+		}
 	}
 
 	return s.renderList()
@@ -521,15 +1087,26 @@ func (s *MountsScreen) renderList() string {
 		s.success = ""
 	}
 
+	if search := s.search.view(); search != "" {
+		b.WriteString(search)
+		b.WriteString("\n\n")
+	}
+
 	if s.loading {
 		b.WriteString(lipgloss.NewStyle().
 			Width(s.width).
 			Align(lipgloss.Center).
 			Render("Loading mounts..."))
+	} else if len(s.mounts) == 0 && s.search.query() != "" {
+		b.WriteString(components.Styles.Subtitle.Render(fmt.Sprintf("No mounts match %q.", s.search.query())))
 	} else if len(s.mounts) == 0 {
 		// Empty state
 		emptyMsg := components.Styles.Subtitle.Render("No mounts configured.")
 		addHint := components.Styles.HelpText.Render("Press 'a' to add a new mount.")
+		if s.hidden > 0 {
+			emptyMsg = components.Styles.Subtitle.Render(fmt.Sprintf("All %d mounts are disabled and hidden.", s.hidden))
+			addHint = components.Styles.HelpText.Render("Press 'H' to show them or 'a' to add a new mount.")
+		}
 
 		b.WriteString(lipgloss.NewStyle().
 			Width(s.width).
@@ -545,22 +1122,44 @@ func (s *MountsScreen) renderList() string {
 		b.WriteString(s.renderMountList())
 		b.WriteString("\n")
 
+		if label := hiddenLabel(s.hidden, "mount"); label != "" {
+			b.WriteString(components.Styles.HelpText.Render(label))
+			b.WriteString("\n")
+		}
+
 		// Selected item details
 		if s.cursor >= 0 && s.cursor < len(s.mounts) {
 			b.WriteString(s.renderMountDetails())
 		}
 	}
 
+	// Auto-refresh state
+	b.WriteString("\n")
+	b.WriteString(components.Styles.HelpText.Render(s.autoRefresh.status()))
+	b.WriteString("\n")
+
 	// Help bar
 	b.WriteString("\n")
 	helpText := components.HelpBar(s.width, []components.HelpItem{
 		{Key: "↑/↓", Desc: "navigate"},
+		{Key: "/", Desc: "filter"},
 		{Key: "r", Desc: "refresh"},
+		{Key: "A", Desc: "auto-refresh"},
 		{Key: "a", Desc: "add"},
 		{Key: "e", Desc: "edit"},
 		{Key: "d", Desc: "delete"},
 		{Key: "s", Desc: "start"},
 		{Key: "x", Desc: "stop"},
+		{Key: "S/X", Desc: "start/stop all"},
+		{Key: "*", Desc: "pin"},
+		{Key: "space", Desc: "mark"},
+		{Key: "b", Desc: "bulk edit"},
+		{Key: "B", Desc: "start at boot"},
+		{Key: "l", Desc: "logs"},
+		{Key: "c", Desc: "check"},
+		{Key: "h", Desc: "health"},
+		{Key: "C", Desc: "clone"},
+		{Key: "H", Desc: "hide disabled"},
 		{Key: "Enter", Desc: "details"},
 		{Key: "Esc", Desc: "back"},
 	})
@@ -583,17 +1182,18 @@ func (s *MountsScreen) renderMountList() string {
 	for i, mount := range s.mounts {
 		var line string
 		status := s.getMountStatus(&mount)
+		name := markedLabel(favoriteLabel(mount.Name, mount.Favorite), s.marked[mount.ID])
 
 		if i == s.cursor {
 			line = fmt.Sprintf("▸ %-20s %-20s %-25s %s",
-				components.Styles.Selected.Render(mount.Name),
-				components.Styles.Normal.Render(mount.Remote+mount.RemotePath),
+				components.Styles.Selected.Render(name),
+				components.Styles.Normal.Render(mount.FullRemotePath()),
 				components.Styles.Normal.Render(mount.MountPoint),
 				status)
 		} else {
 			line = fmt.Sprintf("  %-20s %-20s %-25s %s",
-				components.Styles.Normal.Render(mount.Name),
-				components.Styles.Normal.Render(mount.Remote+mount.RemotePath),
+				components.Styles.Normal.Render(name),
+				components.Styles.Normal.Render(mount.FullRemotePath()),
 				components.Styles.Normal.Render(mount.MountPoint),
 				status)
 		}
@@ -603,17 +1203,39 @@ func (s *MountsScreen) renderMountList() string {
 	return b.String()
 }
 
+// favoriteLabel prefixes a list name with a star when the item is pinned.
+func favoriteLabel(name string, favorite bool) string {
+	if favorite {
+		return "★ " + name
+	}
+	return name
+}
+
+// markedLabel prefixes a list name with a check mark when the item is
+// marked for bulk edit.
+func markedLabel(name string, marked bool) string {
+	if marked {
+		return "✓ " + name
+	}
+	return name
+}
+
 // getMountStatus returns a formatted status string for a mount.
 func (s *MountsScreen) getMountStatus(mount *models.MountConfig) string {
+	badge := ""
+	if mount.AutoStart {
+		badge = " " + components.Styles.HelpText.Render("auto")
+	}
+
 	status, ok := s.statuses[mount.Name]
 	if !ok {
-		return components.StatusIndicator("unknown") + " unknown"
+		return components.StatusIndicator("unknown") + " unknown" + badge
 	}
 
 	if status.Active {
-		return components.StatusIndicator("active") + " " + components.Styles.Success.Render("running")
+		return components.StatusIndicator("active") + " " + components.Styles.Success.Render("running") + badge
 	}
-	return components.StatusIndicator("inactive") + " " + components.Styles.StatusInactive.Render("stopped")
+	return components.StatusIndicator("inactive") + " " + components.Styles.StatusInactive.Render("stopped") + badge
 }
 
 // renderMountDetails renders the details of the selected mount.
@@ -635,12 +1257,14 @@ func (s *MountsScreen) renderMountDetails() string {
 
 	// Details box
 	details := fmt.Sprintf(
-		"  Selected: %s\n\n  Remote: %s\n  Remote Path: %s\n  Mount Point: %s\n  Status: %s\n  Enabled: %t\n\n  [E] Edit  [D] Delete  [S] Start  [X] Stop  [Enter] Details",
+		"  Selected: %s\n\n  Remote: %s\n  Remote Path: %s\n  Full Path: %s\n  Mount Point: %s\n  Status: %s\n  Health: %s\n  Enabled: %t\n\n  [E] Edit  [D] Delete  [S] Start  [X] Stop  [Enter] Details",
 		components.Styles.Selected.Render(mount.Name),
 		mount.Remote,
 		mount.RemotePath,
+		mount.FullRemotePath(),
 		mount.MountPoint,
 		statusStr,
+		s.health[mount.ID].label(),
 		mount.Enabled,
 	)
 
@@ -684,11 +1308,33 @@ type MountStatusMsg struct {
 	Status *systemd.ServiceStatus
 }
 
+// MountAutoStartMsg is sent when a mount's service was enabled or disabled
+// at boot.
+type MountAutoStartMsg struct {
+	ID        string
+	Name      string
+	AutoStart bool
+}
+
 // MountsErrorMsg is sent when an error occurs.
 type MountsErrorMsg struct {
 	Err error
 }
 
+// MountUsageLoadedMsg is sent when a mount's remote reported its usage.
+type MountUsageLoadedMsg struct {
+	ID    string
+	Usage *rclone.RemoteUsage
+	Err   error
+}
+
+// MountCheckedMsg is sent when a mount check finishes.
+type MountCheckedMsg struct {
+	Name   string
+	Source string
+	Err    error
+}
+
 // MountFormCancelMsg is sent when the form is cancelled.
 type MountFormCancelMsg struct{}
 
@@ -705,7 +1351,7 @@ type DeleteConfirm struct {
 	done       bool
 	deleteType int // 0: cancel, 1: service only, 2: service and config
 	manager    systemd.ServiceManager
-	generator  *systemd.Generator
+	generator  systemd.UnitGenerator
 	config     *config.Config
 	width      int
 }
@@ -720,7 +1366,7 @@ func NewDeleteConfirm(mount models.MountConfig) *DeleteConfirm {
 }
 
 // SetServices sets the services for the delete confirmation.
-func (d *DeleteConfirm) SetServices(mgr systemd.ServiceManager, gen *systemd.Generator, cfg *config.Config) {
+func (d *DeleteConfirm) SetServices(mgr systemd.ServiceManager, gen systemd.UnitGenerator, cfg *config.Config) {
 	d.manager = mgr
 	d.generator = gen
 	d.config = cfg
@@ -909,15 +1555,25 @@ type MountDetails struct {
 	status    *systemd.ServiceStatus
 	logs      string
 	manager   systemd.ServiceManager
-	generator *systemd.Generator
+	generator systemd.UnitGenerator
 	done      bool
 	width     int
 	height    int
-	tab       int // 0: details, 1: logs
+	tab       int // 0: details, 1: logs, 2: unit preview
+
+	defaultExtraArgs string // Config default flags added to every mount
+
+	// Storage usage of the remote, loaded in the background when an rclone
+	// client is set; empty without one
+	rclone *rclone.Client
+	usage  string
+
+	copyModal *components.CopyModal // Shown when the clipboard is unavailable
+	message   string                // Result of the last copy
 }
 
 // NewMountDetails creates a new mount details view.
-func NewMountDetails(mount models.MountConfig, manager systemd.ServiceManager, generator *systemd.Generator) *MountDetails {
+func NewMountDetails(mount models.MountConfig, manager systemd.ServiceManager, generator systemd.UnitGenerator) *MountDetails {
 	d := &MountDetails{
 		mount:     mount,
 		manager:   manager,
@@ -938,6 +1594,20 @@ func (d *MountDetails) loadStatus() {
 	}
 }
 
+// loadUsage asks the remote for its storage usage in the background.
+func (d *MountDetails) loadUsage() tea.Cmd {
+	if d.rclone == nil {
+		return nil
+	}
+	d.usage = "loading..."
+	client, id, remote := d.rclone, d.mount.ID, d.mount.FullRemotePath()
+	ctx := rootCtx
+	return func() tea.Msg {
+		usage, err := client.About(ctx, remote)
+		return MountUsageLoadedMsg{ID: id, Usage: usage, Err: err}
+	}
+}
+
 // loadLogs loads the service logs.
 func (d *MountDetails) loadLogs() {
 	serviceName := d.generator.ServiceName(d.mount.ID, "mount") + ".service"
@@ -962,43 +1632,78 @@ func (d *MountDetails) Init() tea.Cmd {
 
 // Update handles updates.
 func (d *MountDetails) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if d.copyModal != nil {
+		_, cmd := d.copyModal.Update(msg)
+		if d.copyModal.IsDone() {
+			d.copyModal = nil
+		}
+		return d, cmd
+	}
+
 	switch msg := msg.(type) {
+	case MountUsageLoadedMsg:
+		if msg.ID == d.mount.ID {
+			d.usage = formatRemoteUsage(msg.Usage, msg.Err)
+		}
 	case tea.KeyMsg:
 		switch msg.String() {
+		case "y":
+			// Copy the mount point
+			return d, d.copy("Mount point", d.mount.MountPoint)
 		case "esc", "q":
 			d.done = true
 		case "tab":
-			d.tab = (d.tab + 1) % 2
-		case "s":
+			d.tab = (d.tab + 1) % 3
+		case "1", "2", "3":
+			// Collapse or expand a section of the details tab
+			if title, ok := sectionKey(msg.String(), mountDetailSections); ok && d.tab == 0 {
+				toggleSection("mount", title)
+			}
+		case MountDetailKeys.Key(ActionStart):
 			// Start service
 			serviceName := d.generator.ServiceName(d.mount.ID, "mount") + ".service"
 			_ = d.manager.Start(serviceName)
 			d.loadStatus()
-		case "x":
+		case MountDetailKeys.Key(ActionStop):
 			// Stop service
 			serviceName := d.generator.ServiceName(d.mount.ID, "mount") + ".service"
 			_ = d.manager.Stop(serviceName)
 			d.loadStatus()
-		case "e":
+		case MountDetailKeys.Key(ActionEnable):
 			// Enable service
 			serviceName := d.generator.ServiceName(d.mount.ID, "mount") + ".service"
 			_ = d.manager.Enable(serviceName)
 			d.loadStatus()
-		case "d":
+		case MountDetailKeys.Key(ActionDisable):
 			// Disable service
 			serviceName := d.generator.ServiceName(d.mount.ID, "mount") + ".service"
 			_ = d.manager.Disable(serviceName)
 			d.loadStatus()
-		case "r":
+		case MountDetailKeys.Key(ActionRefresh):
 			// Refresh
 			d.loadStatus()
 			d.loadLogs()
+			return d, d.loadUsage()
 		}
 	}
 
 	return d, nil
 }
 
+// copy puts text on the clipboard, or opens a modal showing it when the
+// clipboard is unavailable.
+func (d *MountDetails) copy(what, text string) tea.Cmd {
+	result := copyToClipboard(text)
+	if result.Copied() {
+		d.message = fmt.Sprintf("%s: %s", what, result.Message())
+		return nil
+	}
+	d.message = ""
+	d.copyModal = components.NewCopyModal(what, result.Text)
+	d.copyModal.SetSize(d.width, d.height)
+	return d.copyModal.Init()
+}
+
 // IsDone returns true if the view is done.
 func (d *MountDetails) IsDone() bool {
 	return d.done
@@ -1006,6 +1711,10 @@ func (d *MountDetails) IsDone() bool {
 
 // View renders the view.
 func (d *MountDetails) View() string {
+	if d.copyModal != nil {
+		return d.copyModal.View()
+	}
+
 	var b strings.Builder
 
 	// Title
@@ -1017,7 +1726,7 @@ func (d *MountDetails) View() string {
 	b.WriteString("\n\n")
 
 	// Tabs
-	tabs := []string{"Details", "Logs"}
+	tabs := []string{"Details", "Logs", "Unit"}
 	var tabStrs []string
 	for i, tab := range tabs {
 		if i == d.tab {
@@ -1033,16 +1742,25 @@ func (d *MountDetails) View() string {
 	b.WriteString("\n\n")
 
 	// Content based on tab
-	if d.tab == 0 {
+	switch d.tab {
+	case 0:
 		b.WriteString(d.renderDetails())
-	} else {
+	case 1:
 		b.WriteString(d.renderLogs())
+	default:
+		b.WriteString(d.renderUnit())
+	}
+
+	if d.message != "" {
+		b.WriteString("\n" + components.RenderSuccess(d.message) + "\n")
 	}
 
 	// Help
 	b.WriteString("\n")
 	help := components.HelpBar(d.width, []components.HelpItem{
 		{Key: "Tab", Desc: "switch tab"},
+		{Key: "1-3", Desc: "collapse/expand"},
+		{Key: "y", Desc: "copy mount point"},
 		{Key: "s", Desc: "start"},
 		{Key: "x", Desc: "stop"},
 		{Key: "e", Desc: "enable"},
@@ -1055,41 +1773,255 @@ func (d *MountDetails) View() string {
 	return b.String()
 }
 
+// copyToClipboard is injectable for testing so copy actions don't touch the
+// terminal or run clipboard tools.
+var copyToClipboard = clipboard.Copy
+
+// mountDetailSections are the collapsible sections of the details tab, in
+// the order of their number keys.
+var mountDetailSections = []string{"Config", "Mount Options", "Service Status"}
+
+// formatRemoteUsage describes a remote's usage, e.g. "45.2 GB / 100.0 GB
+// (54.8 GB free)", or "not supported" for backends without usage figures.
+func formatRemoteUsage(usage *rclone.RemoteUsage, err error) string {
+	if errors.Is(err, rclone.ErrAboutNotSupported) {
+		return "not supported"
+	}
+	if err != nil {
+		return "unavailable: " + err.Error()
+	}
+
+	var s string
+	switch {
+	case usage.Used != nil && usage.Total != nil:
+		s = fmt.Sprintf("%s / %s", formatSize(*usage.Used), formatSize(*usage.Total))
+	case usage.Used != nil:
+		s = formatSize(*usage.Used) + " used"
+	case usage.Total != nil:
+		s = formatSize(*usage.Total) + " total"
+	}
+	if usage.Free != nil {
+		free := formatSize(*usage.Free) + " free"
+		if s == "" {
+			return free
+		}
+		return fmt.Sprintf("%s (%s)", s, free)
+	}
+	if s == "" {
+		return "not reported"
+	}
+	return s
+}
+
 // renderDetails renders the details tab.
 func (d *MountDetails) renderDetails() string {
 	var b strings.Builder
 
 	// Mount info
-	b.WriteString(fmt.Sprintf("  Name: %s\n", d.mount.Name))
-	b.WriteString(fmt.Sprintf("  Remote: %s\n", d.mount.Remote))
-	b.WriteString(fmt.Sprintf("  Remote Path: %s\n", d.mount.RemotePath))
-	b.WriteString(fmt.Sprintf("  Mount Point: %s\n", d.mount.MountPoint))
-	b.WriteString(fmt.Sprintf("  Auto Start: %t\n", d.mount.AutoStart))
-	b.WriteString(fmt.Sprintf("  Enabled: %t\n", d.mount.Enabled))
-
-	// Status
-	if d.status != nil {
-		b.WriteString("\n  Service Status:\n")
-		b.WriteString(fmt.Sprintf("    State: %s\n", d.status.State))
-		b.WriteString(fmt.Sprintf("    SubState: %s\n", d.status.SubState))
-		b.WriteString(fmt.Sprintf("    Enabled: %t\n", d.status.Enabled))
+	var config strings.Builder
+	config.WriteString(fmt.Sprintf("    Name: %s\n", d.mount.Name))
+	if d.mount.Description != "" {
+		config.WriteString(fmt.Sprintf("    Description: %s\n", d.mount.Description))
+	}
+	if d.mount.DocumentationURL != "" {
+		config.WriteString(fmt.Sprintf("    Documentation: %s\n", d.mount.DocumentationURL))
+	}
+	config.WriteString(fmt.Sprintf("    Remote: %s\n", d.mount.Remote))
+	config.WriteString(fmt.Sprintf("    Remote Path: %s\n", d.mount.RemotePath))
+	config.WriteString(fmt.Sprintf("    Full Path: %s\n", d.mount.FullRemotePath()))
+	config.WriteString(fmt.Sprintf("    Mount Point: %s\n", d.mount.MountPoint))
+	if d.usage != "" {
+		config.WriteString(fmt.Sprintf("    Usage: %s\n", d.usage))
+	}
+	config.WriteString(fmt.Sprintf("    Auto Start: %t\n", d.mount.AutoStart))
+	config.WriteString(fmt.Sprintf("    Enabled: %t\n", d.mount.Enabled))
+	if d.mount.Adopted {
+		config.WriteString("    Adopted: taken over from an external rclone mount\n")
 	}
 
 	// Mount options
-	b.WriteString("\n  Mount Options:\n")
+	var opts strings.Builder
 	if d.mount.MountOptions.VFSCacheMode != "" {
-		b.WriteString(fmt.Sprintf("    VFS Cache Mode: %s\n", d.mount.MountOptions.VFSCacheMode))
+		opts.WriteString(fmt.Sprintf("    VFS Cache Mode: %s\n", d.mount.MountOptions.VFSCacheMode))
+	}
+	if d.mount.MountOptions.VFSCacheMaxSize != "" {
+		opts.WriteString(fmt.Sprintf("    VFS Cache Max Size: %s\n", d.mount.MountOptions.VFSCacheMaxSize))
+	}
+	if d.mount.MountOptions.VFSCacheMaxAge != "" {
+		opts.WriteString(fmt.Sprintf("    VFS Cache Max Age: %s\n", d.mount.MountOptions.VFSCacheMaxAge))
 	}
 	if d.mount.MountOptions.BufferSize != "" {
-		b.WriteString(fmt.Sprintf("    Buffer Size: %s\n", d.mount.MountOptions.BufferSize))
+		opts.WriteString(fmt.Sprintf("    Buffer Size: %s\n", d.mount.MountOptions.BufferSize))
+	}
+	if d.mount.MountOptions.DirCacheTime != "" {
+		opts.WriteString(fmt.Sprintf("    Dir Cache Time: %s\n", d.mount.MountOptions.DirCacheTime))
+	}
+	if d.mount.MountOptions.AttrTimeout != "" {
+		opts.WriteString(fmt.Sprintf("    Attr Timeout: %s\n", d.mount.MountOptions.AttrTimeout))
+	}
+	if d.mount.MountOptions.PollInterval != "" {
+		opts.WriteString(fmt.Sprintf("    Poll Interval: %s\n", d.mount.MountOptions.PollInterval))
+	}
+	if d.mount.MountOptions.BandwidthLimit != "" {
+		opts.WriteString(fmt.Sprintf("    Bandwidth Limit: %s\n", components.FormatBandwidthLimit(d.mount.MountOptions.BandwidthLimit)))
 	}
 	if d.mount.MountOptions.ReadOnly {
-		b.WriteString("    Read Only: true\n")
+		opts.WriteString("    Read Only: true\n")
+	}
+	if d.mount.MountOptions.AllowOther {
+		opts.WriteString("    Allow Other: true\n")
+	}
+	if d.mount.MountOptions.Immutable {
+		opts.WriteString("    Immutable: true (read-only archive, writes are refused)\n")
+	}
+	opts.WriteString(fmt.Sprintf("    Permissions: %s\n", permissionModel(d.mount.MountOptions)))
+	estimate, warning := apiUsage(d.mount.MountOptions)
+	opts.WriteString(fmt.Sprintf("    API Usage: %s\n", estimate))
+	if warning != "" {
+		opts.WriteString(fmt.Sprintf("    ⚠ %s; try the quota-friendly preset in bulk edit\n", warning))
+	}
+	if extra := systemd.MergeExtraArgs(d.defaultExtraArgs, d.mount.MountOptions.ExtraArgs); extra != "" {
+		opts.WriteString(fmt.Sprintf("    Extra Flags: %s\n", extra))
+		if managed := systemd.ManagedFlags(extra, "mount"); len(managed) > 0 {
+			opts.WriteString(fmt.Sprintf("    ⚠ Extra flags repeat managed options: %s\n", strings.Join(managed, ", ")))
+		}
 	}
 
+	// Status
+	var status strings.Builder
+	if d.status != nil {
+		status.WriteString(fmt.Sprintf("    State: %s\n", d.status.State))
+		status.WriteString(fmt.Sprintf("    SubState: %s\n", d.status.SubState))
+		status.WriteString(fmt.Sprintf("    Enabled: %t\n", d.status.Enabled))
+	} else {
+		status.WriteString("    Not available\n")
+	}
+
+	for i, body := range []string{config.String(), opts.String(), status.String()} {
+		b.WriteString(renderSection("mount", i+1, mountDetailSections[i], body))
+	}
+
+	b.WriteString(renderNotes(d.mount.Notes))
+
+	return b.String()
+}
+
+// renderNotes renders the Notes section of a details view, keeping the
+// line breaks of multiline notes. It is empty when there are no notes.
+func renderNotes(notes string) string {
+	notes = strings.TrimSpace(notes)
+	if notes == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n  Notes:\n")
+	for _, line := range strings.Split(notes, "\n") {
+		b.WriteString("    " + strings.TrimRight(line, " \t\r") + "\n")
+	}
 	return b.String()
 }
 
+// permissionModel describes who can access a mount and how file modes are
+// enforced, e.g. "enforced by kernel, files 0664 / dirs 0775, all users".
+func permissionModel(opts models.MountOptions) string {
+	access := "owner only"
+	switch {
+	case opts.AllowOther:
+		access = "all users"
+	case opts.AllowRoot:
+		access = "owner and root"
+	}
+	if opts.UID > 0 || opts.GID > 0 {
+		access += fmt.Sprintf(" (uid %d, gid %d)", opts.UID, opts.GID)
+	}
+
+	if !opts.DefaultPermissions {
+		if opts.AllowOther && (opts.ReadOnly || opts.Immutable) {
+			return access + ", modes not enforced ⚠ any user can read"
+		}
+		if opts.AllowOther {
+			return access + ", modes not enforced ⚠ any user can read and write"
+		}
+		return access + ", modes not enforced"
+	}
+
+	modes := "modes from process umask"
+	if mask, err := strconv.ParseUint(opts.Umask, 8, 32); err == nil {
+		modes = fmt.Sprintf("files %04o / dirs %04o", 0666&^mask, 0777&^mask)
+	}
+	return fmt.Sprintf("enforced by kernel, %s, %s", modes, access)
+}
+
+// rclone's defaults for the options that drive a mount's API usage.
+const (
+	defaultPollInterval = time.Minute
+	defaultDirCacheTime = 5 * time.Minute
+)
+
+// apiUsage estimates how chatty a mount's options are towards the remote's
+// API, e.g. "moderate (~60 change polls/h, each open directory relisted up
+// to 12×/h, reads cached)", and warns when they are likely to hit rate
+// limits. It is guidance only: actual usage depends on how the mount is used.
+func apiUsage(opts models.MountOptions) (estimate, warning string) {
+	poll := parseMountDuration(opts.PollInterval, defaultPollInterval)
+	dirCache := parseMountDuration(opts.DirCacheTime, defaultDirCacheTime)
+
+	var polls, relists float64
+	if poll > 0 {
+		polls = float64(time.Hour) / float64(poll)
+	}
+	if dirCache > 0 {
+		relists = float64(time.Hour) / float64(dirCache)
+	}
+
+	reads := "reads cached"
+	if opts.VFSCacheMode == "" || opts.VFSCacheMode == "off" || opts.VFSCacheMode == "minimal" {
+		reads = "reads not cached"
+	}
+
+	level := "low"
+	switch calls := polls + relists; {
+	case calls > 120:
+		level = "high"
+	case calls > 30 || reads == "reads not cached":
+		level = "moderate"
+	}
+	estimate = fmt.Sprintf("%s (~%.0f change polls/h, each open directory relisted up to %.0f×/h, %s)", level, polls, relists, reads)
+
+	switch {
+	case poll > 0 && poll < 30*time.Second:
+		warning = fmt.Sprintf("Polling every %s is likely to hit API rate limits", poll)
+	case dirCache > 0 && dirCache < time.Minute:
+		warning = fmt.Sprintf("Caching directory listings for only %s is likely to hit API rate limits", dirCache)
+	case level == "high":
+		warning = "These options are likely to hit API rate limits"
+	}
+	return estimate, warning
+}
+
+// parseMountDuration parses a duration option such as "5m", using def when
+// the option is empty or invalid. "0" is zero, which turns the feature off.
+func parseMountDuration(value string, def time.Duration) time.Duration {
+	if value == "0" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// applyQuotaFriendlyPreset sets conservative options that keep a mount's API
+// usage low: infrequent change polls, long-lived directory listings and
+// cached reads.
+func applyQuotaFriendlyPreset(opts *models.MountOptions) {
+	opts.PollInterval = "5m"
+	opts.DirCacheTime = "1h"
+	opts.VFSCacheMode = "full"
+}
+
 // renderLogs renders the logs tab.
 func (d *MountDetails) renderLogs() string {
 	if d.logs == "" {
@@ -1105,6 +2037,18 @@ func (d *MountDetails) renderLogs() string {
 	return components.Styles.Normal.Render(strings.Join(lines, "\n"))
 }
 
+// renderUnit renders the unit preview tab: the service unit as it would be
+// written for the mount.
+func (d *MountDetails) renderUnit() string {
+	content, err := d.generator.Preview(&d.mount)
+	if err != nil {
+		return components.RenderError(fmt.Sprintf("  Cannot generate unit: %v", err))
+	}
+	name := d.generator.ServiceName(d.mount.ID, "mount") + ".service"
+	return components.Styles.Subtitle.Render("  # "+name) + "\n" +
+		components.Styles.Normal.Render(strings.TrimRight(content, "\n"))
+}
+
 // Helper function to get current time
 func now() time.Time {
 	return time.Now()
@@ -3,11 +3,14 @@ package screens
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/clipboard"
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
 	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
@@ -936,6 +939,69 @@ func TestNewMountDetails(t *testing.T) {
 	}
 }
 
+func TestMountDetails_ShowsFullRemotePath(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.Remote = "gdrive"
+	mount.RemotePath = "/Music"
+	details := NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+
+	if view := details.renderDetails(); !strings.Contains(view, "Full Path: gdrive:/Music") {
+		t.Errorf("details should show the full remote path, got:\n%s", view)
+	}
+}
+
+func TestMountDetails_ShowsDirCacheTime(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.MountOptions.DirCacheTime = "5m"
+	details := NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+
+	if view := details.renderDetails(); !strings.Contains(view, "Dir Cache Time: 5m") {
+		t.Errorf("details should show the dir cache time, got:\n%s", view)
+	}
+
+	mount.MountOptions.DirCacheTime = ""
+	details = NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+	if strings.Contains(details.renderDetails(), "Dir Cache Time") {
+		t.Error("details should omit an unset dir cache time")
+	}
+}
+
+func TestMountDetails_ShowsVFSCacheLimits(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.MountOptions.VFSCacheMaxSize = "10G"
+	mount.MountOptions.VFSCacheMaxAge = "24h"
+	details := NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+
+	view := details.renderDetails()
+	for _, want := range []string{"VFS Cache Max Size: 10G", "VFS Cache Max Age: 24h"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("details should show %q, got:\n%s", want, view)
+		}
+	}
+
+	mount.MountOptions.VFSCacheMaxSize = ""
+	mount.MountOptions.VFSCacheMaxAge = ""
+	details = NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+	if strings.Contains(details.renderDetails(), "VFS Cache Max") {
+		t.Error("details should omit unset cache limits")
+	}
+}
+
+func TestMountDetails_WarnsAboutManagedExtraFlags(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.MountOptions.ExtraArgs = "--fast-list --buffer-size 64M"
+	details := NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+	if view := details.renderDetails(); !strings.Contains(view, "⚠ Extra flags repeat managed options: --buffer-size") {
+		t.Errorf("details should warn about the managed flag, got:\n%s", view)
+	}
+
+	mount.MountOptions.ExtraArgs = "--fast-list"
+	details = NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+	if strings.Contains(details.renderDetails(), "repeat managed options") {
+		t.Error("details should not warn without managed flags")
+	}
+}
+
 func TestMountDetails_TabSwitching(t *testing.T) {
 	mount := createTestMounts()[0]
 	gen := &systemd.Generator{}
@@ -953,6 +1019,12 @@ func TestMountDetails_TabSwitching(t *testing.T) {
 		t.Errorf("tab after Tab = %d, want 1", details.tab)
 	}
 
+	// Press tab again to switch to the unit preview
+	details.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if details.tab != 2 {
+		t.Errorf("tab after second Tab = %d, want 2", details.tab)
+	}
+
 	// Press tab again to wrap around to Details
 	details.Update(tea.KeyMsg{Type: tea.KeyTab})
 	if details.tab != 0 {
@@ -960,6 +1032,27 @@ func TestMountDetails_TabSwitching(t *testing.T) {
 	}
 }
 
+func TestMountDetails_ViewUnitTab(t *testing.T) {
+	mount := createTestMounts()[0]
+	details := NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+	details.width = 80
+	details.tab = 2 // Unit tab
+
+	view := details.View()
+	for _, want := range []string{"# rclone-mount-" + mount.ID + ".service", "ExecStart=", mount.MountPoint} {
+		if !strings.Contains(view, want) {
+			t.Errorf("unit tab should contain %q, got:\n%s", want, view)
+		}
+	}
+
+	mount.MountOptions.ExtraArgs = "--daemon"
+	details = NewMountDetails(mount, &systemd.Manager{}, &systemd.Generator{})
+	details.tab = 2
+	if view := details.View(); !strings.Contains(view, "Cannot generate unit") {
+		t.Errorf("unit tab should report generation errors, got:\n%s", view)
+	}
+}
+
 func TestMountDetails_Escape(t *testing.T) {
 	mount := createTestMounts()[0]
 	gen := &systemd.Generator{}
@@ -1646,6 +1739,35 @@ func TestMountsScreen_StopMountKey(t *testing.T) {
 	}
 }
 
+func TestMountsScreen_RemappedKeys(t *testing.T) {
+	t.Cleanup(func() { SetKeyBindings(nil) })
+	if err := SetKeyBindings(map[string]string{ActionStop: "K", ActionDelete: "delete"}); err != nil {
+		t.Fatalf("SetKeyBindings() error = %v", err)
+	}
+
+	screen := NewMountsScreen()
+	screen.SetSize(80, 24)
+	screen.mounts = createTestMounts()
+	screen.generator = &systemd.Generator{}
+	screen.manager = &systemd.Manager{}
+
+	if _, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}); cmd != nil {
+		t.Error("x should no longer stop the mount")
+	}
+	if _, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")}); cmd == nil {
+		t.Error("K should stop the mount")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if screen.mode != MountsModeList {
+		t.Error("d should no longer delete the mount")
+	}
+	screen.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	if screen.mode != MountsModeDelete {
+		t.Errorf("mode = %d, want the delete key to ask to delete the mount", screen.mode)
+	}
+}
+
 func TestMountsScreen_ToggleMountKey(t *testing.T) {
 	screen := NewMountsScreen()
 	screen.SetSize(80, 24)
@@ -1952,17 +2074,23 @@ func TestMountsScreen_StartCreateForm_RcloneNotInstalled(t *testing.T) {
 
 	model, cmd := screen.startCreateForm()
 
-	if screen.mode != MountsModeList {
-		t.Errorf("mode = %d, want %d (MountsModeList)", screen.mode, MountsModeList)
+	if screen.mode != MountsModeCreate {
+		t.Errorf("mode = %d, want %d (MountsModeCreate)", screen.mode, MountsModeCreate)
 	}
-	if screen.err == nil {
-		t.Error("error should be set when rclone is not installed")
+	if screen.err != nil {
+		t.Errorf("unexpected error: %v", screen.err)
 	}
-	if !strings.Contains(screen.err.Error(), "rclone binary not found") {
-		t.Errorf("error = %q, should contain 'rclone binary not found'", screen.err.Error())
+	if screen.form == nil {
+		t.Fatal("form should be opened when rclone is not installed")
 	}
-	if cmd != nil {
-		t.Error("startCreateForm should return nil command when rclone is not installed")
+	if !screen.form.manualRemote || !screen.form.rcloneMissing {
+		t.Error("form should fall back to manual remote entry when rclone is not installed")
+	}
+	if !strings.Contains(screen.form.remoteNotice, "rclone not found") {
+		t.Errorf("remoteNotice = %q, should contain 'rclone not found'", screen.form.remoteNotice)
+	}
+	if cmd == nil {
+		t.Error("startCreateForm should return the form init command")
 	}
 	if model == nil {
 		t.Error("startCreateForm should return a model")
@@ -1978,17 +2106,23 @@ func TestMountsScreen_StartEditForm_RcloneNotInstalled(t *testing.T) {
 
 	model, cmd := screen.startEditForm()
 
-	if screen.mode != MountsModeList {
-		t.Errorf("mode = %d, want %d (MountsModeList)", screen.mode, MountsModeList)
+	if screen.mode != MountsModeEdit {
+		t.Errorf("mode = %d, want %d (MountsModeEdit)", screen.mode, MountsModeEdit)
 	}
-	if screen.err == nil {
-		t.Error("error should be set when rclone is not installed")
+	if screen.err != nil {
+		t.Errorf("unexpected error: %v", screen.err)
 	}
-	if !strings.Contains(screen.err.Error(), "rclone binary not found") {
-		t.Errorf("error = %q, should contain 'rclone binary not found'", screen.err.Error())
+	if screen.form == nil {
+		t.Fatal("form should be opened when rclone is not installed")
 	}
-	if cmd != nil {
-		t.Error("startEditForm should return nil command when rclone is not installed")
+	if !screen.form.manualRemote || !screen.form.rcloneMissing {
+		t.Error("form should fall back to manual remote entry when rclone is not installed")
+	}
+	if !strings.Contains(screen.form.remoteNotice, "rclone not found") {
+		t.Errorf("remoteNotice = %q, should contain 'rclone not found'", screen.form.remoteNotice)
+	}
+	if cmd == nil {
+		t.Error("startEditForm should return the form init command")
 	}
 	if model == nil {
 		t.Error("startEditForm should return a model")
@@ -2358,3 +2492,592 @@ func TestMountDetails_RenderLogs_Truncation(t *testing.T) {
 		t.Error("renderLogs should contain first log line")
 	}
 }
+
+// Tests for favorites
+
+func TestSortMountsByFavorite(t *testing.T) {
+	mounts := createTestMounts()
+	mounts[2].Favorite = true
+
+	sorted := sortMountsByFavorite(mounts)
+
+	wantOrder := []string{"S3 Bucket", "Google Drive", "Dropbox"}
+	for i, name := range wantOrder {
+		if sorted[i].Name != name {
+			t.Errorf("sorted[%d].Name = %q, want %q", i, sorted[i].Name, name)
+		}
+	}
+	if mounts[0].Name != "Google Drive" {
+		t.Error("sortMountsByFavorite should not reorder the input slice")
+	}
+}
+
+func TestMountsScreen_ToggleFavorite(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfig()
+	cfg.Mounts = createTestMounts()
+	screen := NewMountsScreen()
+	screen.SetSize(80, 24)
+	screen.SetServices(cfg, nil, nil, nil)
+	screen.mounts = sortMountsByFavorite(cfg.Mounts)
+	screen.loading = false
+	screen.cursor = 1
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+
+	if screen.err != nil {
+		t.Fatalf("unexpected error: %v", screen.err)
+	}
+	if screen.mounts[0].Name != "Dropbox" || !screen.mounts[0].Favorite {
+		t.Errorf("favorite mount should be first, got %q", screen.mounts[0].Name)
+	}
+	if screen.cursor != 0 {
+		t.Errorf("cursor = %d, want 0 (follows the pinned mount)", screen.cursor)
+	}
+	if !cfg.Mounts[1].Favorite {
+		t.Error("favorite flag should be persisted to config")
+	}
+	if !strings.Contains(screen.View(), "★ Dropbox") {
+		t.Error("view should show a star next to the favorite mount")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+
+	if cfg.Mounts[1].Favorite {
+		t.Error("second toggle should unpin the mount")
+	}
+	if screen.mounts[1].Name != "Dropbox" {
+		t.Errorf("unpinned mount should return to its config position, got %q", screen.mounts[1].Name)
+	}
+}
+
+func TestMountsScreen_HideDisabled(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfig()
+	cfg.Mounts = createTestMounts()
+	screen := NewMountsScreen()
+	screen.SetSize(100, 40)
+	screen.SetServices(cfg, nil, nil, nil)
+	screen.mounts = sortMountsByFavorite(cfg.Mounts)
+	screen.loading = false
+	screen.cursor = 1
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+
+	if !cfg.Settings.HideDisabled {
+		t.Error("the setting should be turned on")
+	}
+	if len(screen.mounts) != 2 || screen.hidden != 1 {
+		t.Fatalf("got %d mounts and %d hidden, want 2 and 1", len(screen.mounts), screen.hidden)
+	}
+	if screen.mounts[screen.cursor].Name != "Dropbox" {
+		t.Errorf("cursor should stay on Dropbox, got %q", screen.mounts[screen.cursor].Name)
+	}
+	if view := screen.View(); !strings.Contains(view, "1 disabled mount hidden (H to show)") || strings.Contains(view, "S3 Bucket") {
+		t.Errorf("view should hide S3 Bucket and say so:\n%s", view)
+	}
+
+	// The preference survives a reload
+	screen.Update(screen.loadMounts())
+	if len(screen.mounts) != 2 {
+		t.Errorf("got %d mounts after reload, want 2", len(screen.mounts))
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	if cfg.Settings.HideDisabled || len(screen.mounts) != 3 || screen.hidden != 0 {
+		t.Errorf("disabled mounts should be shown again, got %d mounts and %d hidden", len(screen.mounts), screen.hidden)
+	}
+}
+
+func TestMountsScreen_ToggleFavoriteNoConfig(t *testing.T) {
+	screen := NewMountsScreen()
+	screen.mounts = createTestMounts()
+
+	screen.toggleFavorite()
+
+	if screen.err == nil {
+		t.Error("expected error when config is not initialized")
+	}
+}
+
+func TestMountsScreen_HasUnsavedChanges(t *testing.T) {
+	screen := NewMountsScreen()
+
+	if screen.HasUnsavedChanges() {
+		t.Error("HasUnsavedChanges() = true with no form open")
+	}
+
+	screen.mode = MountsModeCreate
+	screen.form = NewMountForm(nil, []rclone.Remote{{Name: "gdrive", Type: "drive"}}, nil, nil, nil, nil, false)
+	if !screen.HasUnsavedChanges() {
+		t.Error("HasUnsavedChanges() = false with create form open")
+	}
+
+	screen.mode = MountsModeList
+	if screen.HasUnsavedChanges() {
+		t.Error("HasUnsavedChanges() = true in list mode")
+	}
+}
+
+func TestMountsScreen_AutoRefreshToggle(t *testing.T) {
+	screen := NewMountsScreen()
+	screen.loading = false
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	if cmd == nil {
+		t.Fatal("enabling auto-refresh should start a tick")
+	}
+	if !screen.autoRefresh.enabled {
+		t.Error("auto-refresh should be enabled after pressing A")
+	}
+	if !strings.Contains(screen.View(), "Auto-refresh: every") {
+		t.Error("View should show the auto-refresh countdown")
+	}
+
+	screen.StopAutoRefresh()
+	if screen.autoRefresh.enabled {
+		t.Error("StopAutoRefresh should disable auto-refresh")
+	}
+	if !strings.Contains(screen.View(), "Auto-refresh: off") {
+		t.Error("View should show auto-refresh as off")
+	}
+}
+
+func TestMountsScreen_StartMountCallsManager(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	screen := NewMountsScreen()
+	screen.mounts = createTestMounts()
+	screen.generator = &systemd.MockGenerator{}
+	screen.manager = mgr
+	screen.cursor = 1
+
+	_, cmd := screen.startMount()
+	if cmd == nil {
+		t.Fatal("startMount should return a command")
+	}
+	msg := cmd()
+
+	if !mgr.Called("Start", "rclone-mount-b2c3d4e5.service") {
+		t.Errorf("expected Start of the selected mount, calls = %v", mgr.Calls)
+	}
+	status, ok := msg.(MountStatusMsg)
+	if !ok || !status.Status.Active {
+		t.Errorf("expected active MountStatusMsg, got %#v", msg)
+	}
+}
+
+func TestDeleteConfirm_ServiceOnlyRemovesUnit(t *testing.T) {
+	mgr := &systemd.MockManager{}
+	gen := &systemd.MockGenerator{}
+	mount := createTestMounts()[0]
+
+	dialog := NewDeleteConfirm(mount)
+	dialog.SetServices(mgr, gen, nil)
+	dialog.cursor = 1 // Delete service only
+
+	_, cmd := dialog.confirmDelete()
+	if cmd == nil {
+		t.Fatal("confirmDelete should return a command")
+	}
+	if _, ok := cmd().(MountDeletedMsg); !ok {
+		t.Fatal("expected MountDeletedMsg")
+	}
+
+	unit := "rclone-mount-" + mount.ID + ".service"
+	for _, method := range []string{"Stop", "Disable"} {
+		if !mgr.Called(method, unit) {
+			t.Errorf("expected %s %s, calls = %v", method, unit, mgr.Calls)
+		}
+	}
+	if !mgr.Called("DaemonReload", "") {
+		t.Error("expected DaemonReload")
+	}
+	if len(gen.RemovedUnits) != 1 || gen.RemovedUnits[0] != unit {
+		t.Errorf("RemovedUnits = %v, want [%s]", gen.RemovedUnits, unit)
+	}
+}
+
+func TestPermissionModel(t *testing.T) {
+	tests := []struct {
+		name string
+		opts models.MountOptions
+		want string
+	}{
+		{
+			name: "defaults",
+			opts: models.MountOptions{},
+			want: "owner only, modes not enforced",
+		},
+		{
+			name: "allow other without default permissions",
+			opts: models.MountOptions{AllowOther: true},
+			want: "all users, modes not enforced ⚠ any user can read and write",
+		},
+		{
+			name: "allow other on immutable mount",
+			opts: models.MountOptions{AllowOther: true, Immutable: true},
+			want: "all users, modes not enforced ⚠ any user can read",
+		},
+		{
+			name: "enforced with umask and owner",
+			opts: models.MountOptions{AllowOther: true, DefaultPermissions: true, Umask: "002", UID: 1000, GID: 100},
+			want: "enforced by kernel, files 0664 / dirs 0775, all users (uid 1000, gid 100)",
+		},
+		{
+			name: "enforced without umask",
+			opts: models.MountOptions{AllowRoot: true, DefaultPermissions: true},
+			want: "enforced by kernel, modes from process umask, owner and root",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := permissionModel(tt.opts); got != tt.want {
+				t.Errorf("permissionModel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMountDetails_ShowsImmutable(t *testing.T) {
+	mount := createTestMounts()[0]
+	details := &MountDetails{mount: mount}
+	if strings.Contains(details.renderDetails(), "Immutable") {
+		t.Error("renderDetails() should not mention immutability for a normal mount")
+	}
+
+	details.mount.MountOptions.Immutable = true
+	if got := details.renderDetails(); !strings.Contains(got, "Immutable: true (read-only archive, writes are refused)") {
+		t.Errorf("renderDetails() should show the immutable flag, got:\n%s", got)
+	}
+}
+
+func TestMountDetails_ShowsPermissionModel(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.MountOptions.DefaultPermissions = true
+	mount.MountOptions.Umask = "022"
+
+	details := &MountDetails{mount: mount}
+	if got := details.renderDetails(); !strings.Contains(got, "Permissions: enforced by kernel, files 0644 / dirs 0755") {
+		t.Errorf("renderDetails() should show the permission model, got:\n%s", got)
+	}
+}
+
+func TestMountsScreen_OpenLogsFromList(t *testing.T) {
+	mgr := &systemd.MockManager{GetLogsResult: "INFO  mount ready"}
+	screen := createTestMountsScreen()
+	screen.SetSize(100, 40)
+	screen.generator = createTestGenerator(t)
+	screen.manager = mgr
+	screen.mounts = createTestMounts()
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if screen.mode != MountsModeLogs || !screen.IsViewingLogs() {
+		t.Fatalf("mode = %v, want logs", screen.mode)
+	}
+	if cmd == nil {
+		t.Fatal("opening logs should return a load command")
+	}
+	screen.Update(cmd())
+
+	if !mgr.Called("GetLogs", "rclone-mount-a1b2c3d4.service") {
+		t.Errorf("logs should be fetched for the selected mount, calls: %v", mgr.Calls)
+	}
+	if !strings.Contains(screen.View(), "mount ready") {
+		t.Error("View() should show the logs")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.mode != MountsModeList || screen.IsViewingLogs() {
+		t.Errorf("mode = %v, want list after Esc", screen.mode)
+	}
+	if screen.ShouldGoBack() {
+		t.Error("closing the logs should not leave the screen")
+	}
+}
+
+func TestMountsScreen_OpenLogsWithoutServices(t *testing.T) {
+	screen := createTestMountsScreen()
+	screen.mounts = createTestMounts()
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if screen.mode != MountsModeList || screen.err == nil {
+		t.Error("opening logs without systemd services should report an error")
+	}
+}
+
+func TestMountsScreen_CheckMount(t *testing.T) {
+	script := filepath.Join(t.TempDir(), "rclone")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\necho 'CRITICAL: directory not found' >&2\nexit 3\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	screen := createTestMountsScreen()
+	screen.rclone = rclone.NewClientWithPath(script)
+	screen.mounts = createTestMounts()
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if cmd == nil {
+		t.Fatal("checking a mount should return a command")
+	}
+	screen.Update(cmd())
+	if screen.err == nil || !strings.Contains(screen.err.Error(), "directory not found") {
+		t.Errorf("err = %v, want the rclone error", screen.err)
+	}
+
+	screen.Update(MountCheckedMsg{Name: "gdrive", Source: "gdrive:/"})
+	if screen.err != nil || !strings.Contains(screen.success, "check passed") {
+		t.Errorf("success = %q, err = %v after a passing check", screen.success, screen.err)
+	}
+}
+
+func TestMountDetails_CopyMountPoint(t *testing.T) {
+	oldCopy := copyToClipboard
+	defer func() { copyToClipboard = oldCopy }()
+	var copied string
+	copyToClipboard = func(text string) clipboard.Result {
+		copied = text
+		return clipboard.Result{Method: clipboard.Tool, Tool: "wl-copy", Text: text}
+	}
+
+	details := &MountDetails{mount: createTestMounts()[0]}
+	details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if copied != details.mount.MountPoint {
+		t.Errorf("copied %q, want the mount point %q", copied, details.mount.MountPoint)
+	}
+	if !strings.Contains(details.View(), "Copied to clipboard with wl-copy") {
+		t.Error("View() should report the copy")
+	}
+
+	copyToClipboard = func(text string) clipboard.Result {
+		return clipboard.Result{Method: clipboard.Manual, Text: text}
+	}
+	_, cmd := details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if details.copyModal == nil || cmd == nil {
+		t.Fatal("an unavailable clipboard should open the copy modal and release the mouse")
+	}
+	if !strings.Contains(details.View(), "    "+details.mount.MountPoint+"\n") {
+		t.Errorf("modal should show the text to copy, got:\n%s", details.View())
+	}
+
+	details.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if details.copyModal != nil || details.IsDone() {
+		t.Error("Esc should close the modal without leaving the details view")
+	}
+}
+
+func TestMountDetails_ShowsNotes(t *testing.T) {
+	mount := createTestMounts()[0]
+	details := &MountDetails{mount: mount}
+	if strings.Contains(details.renderDetails(), "Notes:") {
+		t.Error("renderDetails() should not show a Notes section without notes")
+	}
+
+	details.mount.Notes = "rotate creds quarterly\nask ops before resizing cache"
+	got := details.renderDetails()
+	if !strings.Contains(got, "  Notes:\n    rotate creds quarterly\n    ask ops before resizing cache\n") {
+		t.Errorf("renderDetails() should show each line of the notes, got:\n%s", got)
+	}
+}
+
+func TestMountDetails_CollapseSections(t *testing.T) {
+	t.Cleanup(func() { collapsedSections = map[string]bool{} })
+
+	details := NewMountDetails(createTestMounts()[0], &systemd.MockManager{}, &systemd.Generator{})
+	details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+
+	got := details.renderDetails()
+	if strings.Contains(got, "Mount Point:") || !strings.Contains(got, "[1] ▸ Config (collapsed)") {
+		t.Errorf("1 should collapse Config, got:\n%s", got)
+	}
+	if !strings.Contains(got, "Permissions:") {
+		t.Errorf("Mount Options should stay expanded, got:\n%s", got)
+	}
+
+	// Number keys only apply to the details tab
+	details.Update(tea.KeyMsg{Type: tea.KeyTab})
+	details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if !strings.Contains(details.renderDetails(), "(collapsed)") {
+		t.Error("1 on the logs tab should not expand Config")
+	}
+}
+
+func TestAPIUsage(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        models.MountOptions
+		wantLevel   string
+		wantWarning string
+	}{
+		{"rclone defaults", models.MountOptions{VFSCacheMode: "full"}, "moderate (~60 change polls/h, each open directory relisted up to 12×/h", ""},
+		{"polling off", models.MountOptions{VFSCacheMode: "full", PollInterval: "0", DirCacheTime: "1h"}, "low (~0 change polls/h", ""},
+		{"uncached reads", models.MountOptions{VFSCacheMode: "off", PollInterval: "10m", DirCacheTime: "1h"}, "moderate", ""},
+		{"aggressive polling", models.MountOptions{VFSCacheMode: "full", PollInterval: "10s"}, "high", "Polling every 10s"},
+		{"short dir cache", models.MountOptions{VFSCacheMode: "full", PollInterval: "5m", DirCacheTime: "30s"}, "high", "only 30s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimate, warning := apiUsage(tt.opts)
+			if !strings.HasPrefix(estimate, tt.wantLevel) {
+				t.Errorf("estimate = %q, want prefix %q", estimate, tt.wantLevel)
+			}
+			if tt.wantWarning == "" && warning != "" || !strings.Contains(warning, tt.wantWarning) {
+				t.Errorf("warning = %q, want %q", warning, tt.wantWarning)
+			}
+		})
+	}
+
+	opts := models.MountOptions{VFSCacheMode: "off", PollInterval: "10s", DirCacheTime: "30s"}
+	applyQuotaFriendlyPreset(&opts)
+	if estimate, warning := apiUsage(opts); !strings.HasPrefix(estimate, "low") || warning != "" {
+		t.Errorf("quota-friendly preset should be low usage, got %q, warning %q", estimate, warning)
+	}
+}
+
+func TestMountDetails_ShowsAPIUsage(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.MountOptions.PollInterval = "10s"
+	details := NewMountDetails(mount, &systemd.MockManager{}, &systemd.Generator{})
+
+	got := details.renderDetails()
+	if !strings.Contains(got, "Poll Interval: 10s") || !strings.Contains(got, "API Usage: high") {
+		t.Errorf("details should show the poll interval and API usage, got:\n%s", got)
+	}
+	if !strings.Contains(got, "⚠ Polling every 10s is likely to hit API rate limits; try the quota-friendly preset") {
+		t.Errorf("details should warn about rate limits, got:\n%s", got)
+	}
+}
+
+func TestMountDetails_ShowsBandwidthTimetable(t *testing.T) {
+	mount := createTestMounts()[0]
+	mount.MountOptions.BandwidthLimit = "08:00,512k 23:00,off"
+	details := NewMountDetails(mount, &systemd.MockManager{}, &systemd.Generator{})
+
+	if got := details.renderDetails(); !strings.Contains(got, "Bandwidth Limit: 512k from 08:00, off from 23:00") {
+		t.Errorf("details should show the bandwidth timetable, got:\n%s", got)
+	}
+}
+
+func TestMountDetails_Usage(t *testing.T) {
+	screen := createTestMountsScreen()
+	screen.SetSize(100, 60)
+	screen.rclone = fakeRclone(t, `echo '{"total":107374182400,"used":48533130035,"free":58841052365}'`+"\n")
+	screen.generator = &systemd.MockGenerator{}
+	screen.manager = &systemd.MockManager{}
+	screen.mounts = createTestMounts()
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("opening details should load the usage")
+	}
+	if view := screen.View(); !strings.Contains(view, "Usage: loading...") {
+		t.Errorf("details should show the usage is loading:\n%s", view)
+	}
+	screen.Update(cmd())
+	if view := screen.View(); !strings.Contains(view, "Usage: 45.2 GB / 100.0 GB (54.8 GB free)") {
+		t.Errorf("details should show the usage:\n%s", view)
+	}
+}
+
+func TestFormatRemoteUsage(t *testing.T) {
+	n := func(v int64) *int64 { return &v }
+	tests := []struct {
+		usage *rclone.RemoteUsage
+		err   error
+		want  string
+	}{
+		{&rclone.RemoteUsage{Total: n(1 << 30), Used: n(512 << 20), Free: n(512 << 20)}, nil, "512.0 MB / 1.0 GB (512.0 MB free)"},
+		{&rclone.RemoteUsage{Used: n(2048)}, nil, "2.0 KB used"},
+		{&rclone.RemoteUsage{Free: n(1 << 20)}, nil, "1.0 MB free"},
+		{&rclone.RemoteUsage{}, nil, "not reported"},
+		{nil, rclone.ErrAboutNotSupported, "not supported"},
+		{nil, fmt.Errorf("rclone about failed: timeout"), "unavailable: rclone about failed: timeout"},
+	}
+	for _, tt := range tests {
+		if got := formatRemoteUsage(tt.usage, tt.err); got != tt.want {
+			t.Errorf("formatRemoteUsage() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMountsScreen_ToggleAutoStart(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfig()
+	cfg.Mounts = createTestMounts()
+	mgr := &systemd.MockManager{}
+	screen := NewMountsScreen()
+	screen.SetSize(100, 40)
+	screen.SetServices(cfg, nil, &systemd.MockGenerator{}, mgr)
+	screen.mounts = sortMountsByFavorite(cfg.Mounts)
+	screen.loading = false
+	screen.cursor = 1 // Dropbox, not started at boot
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+	if cmd == nil {
+		t.Fatal("B should return a command")
+	}
+	screen.Update(cmd())
+
+	if !mgr.Called("Enable", "rclone-mount-b2c3d4e5.service") {
+		t.Errorf("expected Enable of the selected mount, calls = %v", mgr.Calls)
+	}
+	if mgr.Called("Start", "") || mgr.Called("Stop", "") {
+		t.Error("toggling boot should not start or stop the mount")
+	}
+	if !cfg.Mounts[1].AutoStart || !cfg.Mounts[1].Enabled {
+		t.Error("AutoStart should be turned on in the config")
+	}
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(saved.Mounts) != 3 || !saved.Mounts[1].AutoStart {
+		t.Error("AutoStart should be saved")
+	}
+	if !strings.Contains(screen.getMountStatus(&screen.mounts[1]), "auto") {
+		t.Error("the status should show an auto badge")
+	}
+
+	_, cmd = screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+	screen.Update(cmd())
+
+	if !mgr.Called("Disable", "rclone-mount-b2c3d4e5.service") {
+		t.Errorf("expected Disable of the selected mount, calls = %v", mgr.Calls)
+	}
+	if cfg.Mounts[1].AutoStart {
+		t.Error("AutoStart should be turned off in the config")
+	}
+	if strings.Contains(screen.getMountStatus(&screen.mounts[1]), "auto") {
+		t.Error("the auto badge should be gone")
+	}
+}
+
+func TestMountsScreen_ToggleAutoStartFailureKeepsConfig(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Mounts = createTestMounts()
+	screen := NewMountsScreen()
+	screen.SetServices(cfg, nil, &systemd.MockGenerator{}, &systemd.MockManager{DisableErr: errors.New("access denied")})
+	screen.mounts = cfg.Mounts
+	screen.loading = false
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("B")})
+	screen.Update(cmd())
+
+	if screen.err == nil || !strings.Contains(screen.err.Error(), "failed to disable mount at boot") {
+		t.Errorf("err = %v, want the disable failure", screen.err)
+	}
+	if !cfg.Mounts[0].AutoStart {
+		t.Error("AutoStart should be kept when disabling fails")
+	}
+}
+
+func TestMountsScreen_ToggleAutoStartNoServices(t *testing.T) {
+	screen := NewMountsScreen()
+	screen.mounts = createTestMounts()
+
+	if _, cmd := screen.toggleAutoStart(); cmd != nil || screen.err == nil {
+		t.Error("expected an error without systemd services")
+	}
+}
@@ -0,0 +1,479 @@
+package screens
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+)
+
+// Screen modes for the remotes screen
+const (
+	RemotesModeList      = "list"      // Remote list
+	RemotesModeCreate    = "create"    // New remote wizard
+	RemotesModeAuthorize = "authorize" // Waiting for an OAuth login
+)
+
+// RemotesLoadedMsg is sent when the remote list has been loaded.
+type RemotesLoadedMsg struct {
+	Remotes []rclone.Remote
+	Err     error
+}
+
+// RemoteAuthURLMsg carries the address of a running OAuth login, for when
+// rclone cannot open a browser.
+type RemoteAuthURLMsg struct {
+	URL string
+}
+
+// RemoteCreatedMsg is sent when creating a remote has finished.
+type RemoteCreatedMsg struct {
+	Name string
+	Err  error
+}
+
+// remoteCreation tracks a remote being created in the background, which
+// for OAuth backends waits for the login in the browser.
+type remoteCreation struct {
+	name   string
+	urls   chan string
+	done   chan error
+	cancel context.CancelFunc
+}
+
+// RemotesScreen lists the rclone remotes and creates new ones.
+type RemotesScreen struct {
+	rclone  *rclone.Client
+	remotes []rclone.Remote
+	cursor  int
+	mode    string
+	loading bool
+	width   int
+	height  int
+	goBack  bool
+
+	// New remote wizard: the name and type first, then the settings of
+	// the chosen type
+	form        *huh.Form
+	wizardStep  int
+	newName     string
+	newType     string
+	fieldValues map[string]*string
+
+	// Remote being created
+	creation *remoteCreation
+	authURL  string
+
+	err     error
+	success string
+}
+
+// NewRemotesScreen creates a new remotes screen.
+func NewRemotesScreen() *RemotesScreen {
+	return &RemotesScreen{
+		mode:    RemotesModeList,
+		loading: true,
+	}
+}
+
+// SetServices sets the rclone client used to list and create remotes.
+func (s *RemotesScreen) SetServices(client *rclone.Client) {
+	s.rclone = client
+}
+
+// SetSize sets the screen dimensions.
+func (s *RemotesScreen) SetSize(width, height int) {
+	s.width = width
+	s.height = height
+}
+
+// Init initializes the screen.
+func (s *RemotesScreen) Init() tea.Cmd {
+	if s.creation != nil {
+		return nil
+	}
+	s.mode = RemotesModeList
+	s.form = nil
+	s.loading = true
+	return s.loadRemotes
+}
+
+// loadRemotes lists the configured rclone remotes.
+func (s *RemotesScreen) loadRemotes() tea.Msg {
+	if s.rclone == nil {
+		return RemotesLoadedMsg{Err: fmt.Errorf("rclone client not initialized - please ensure rclone is installed")}
+	}
+	remotes, err := s.rclone.ListRemotes(rootCtx)
+	return RemotesLoadedMsg{Remotes: remotes, Err: err}
+}
+
+// Update handles screen updates.
+func (s *RemotesScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case RemotesLoadedMsg:
+		s.loading = false
+		s.err = msg.Err
+		s.remotes = msg.Remotes
+		if s.cursor >= len(s.remotes) {
+			s.cursor = max(len(s.remotes)-1, 0)
+		}
+		return s, nil
+
+	case RemoteAuthURLMsg:
+		if s.creation == nil {
+			return s, nil
+		}
+		s.authURL = msg.URL
+		return s, waitForRemoteCreation(s.creation)
+
+	case RemoteCreatedMsg:
+		if s.creation == nil {
+			return s, nil
+		}
+		s.creation = nil
+		s.authURL = ""
+		s.mode = RemotesModeList
+		if msg.Err != nil {
+			s.err = msg.Err
+			return s, nil
+		}
+		s.err = nil
+		s.success = fmt.Sprintf("Remote '%s' created", msg.Name)
+		// List the remotes again so the new one is ready for the forms
+		s.loading = true
+		return s, s.loadRemotes
+	}
+
+	switch s.mode {
+	case RemotesModeCreate:
+		return s.updateWizard(msg)
+	case RemotesModeAuthorize:
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "esc" && s.creation != nil {
+			s.creation.cancel()
+		}
+		return s, nil
+	}
+
+	if key, ok := msg.(tea.KeyMsg); ok {
+		return s.updateList(key)
+	}
+	return s, nil
+}
+
+// updateList handles key presses in the remote list.
+func (s *RemotesScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if s.cursor > 0 {
+			s.cursor--
+		}
+	case "down", "j":
+		if s.cursor < len(s.remotes)-1 {
+			s.cursor++
+		}
+	case "R", "ctrl+r":
+		s.loading = true
+		return s, s.loadRemotes
+	case "n", "a":
+		return s, s.startWizard()
+	case "backspace":
+		s.goBack = true
+	}
+	return s, nil
+}
+
+// startWizard opens the first step of the new remote wizard: its name and
+// type.
+func (s *RemotesScreen) startWizard() tea.Cmd {
+	if s.rclone == nil {
+		s.err = fmt.Errorf("rclone client not initialized - please ensure rclone is installed")
+		return nil
+	}
+
+	s.newName = ""
+	s.newType = rclone.Backends[0].Type
+	s.fieldValues = nil
+	options := make([]huh.Option[string], len(rclone.Backends))
+	for i, b := range rclone.Backends {
+		options[i] = huh.NewOption(fmt.Sprintf("%s (%s)", b.Description, b.Type), b.Type)
+	}
+
+	s.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Name").
+				Description("Name of the remote, used as name: in paths").
+				Value(&s.newName).
+				Validate(s.validateNewName),
+			huh.NewSelect[string]().
+				Title("Type").
+				Options(options...).
+				Value(&s.newType),
+		).Title("New Remote"),
+	)
+	s.form.WithTheme(huh.ThemeBase16())
+	s.wizardStep = 0
+	s.mode = RemotesModeCreate
+	s.err = nil
+	return s.form.Init()
+}
+
+// validateNewName rejects names rclone does not accept and names in use.
+func (s *RemotesScreen) validateNewName(name string) error {
+	if err := rclone.ValidateRemoteName(strings.TrimSpace(name)); err != nil {
+		return err
+	}
+	for _, r := range s.remotes {
+		if r.Name == strings.TrimSpace(name) {
+			return fmt.Errorf("remote %q already exists", r.Name)
+		}
+	}
+	return nil
+}
+
+// settingsForm builds the second step of the wizard: the common settings
+// of the chosen backend.
+func (s *RemotesScreen) settingsForm(backend rclone.Backend) *huh.Form {
+	s.fieldValues = make(map[string]*string, len(backend.Fields))
+	fields := make([]huh.Field, 0, len(backend.Fields))
+	for _, field := range backend.Fields {
+		value := field.Default
+		s.fieldValues[field.Key] = &value
+
+		input := huh.NewInput().
+			Title(field.Label).
+			Description(field.Help).
+			Value(&value)
+		if field.Secret {
+			input = input.EchoMode(huh.EchoModePassword)
+		}
+		if field.Required {
+			input = input.Validate(requiredString(strings.ToLower(field.Label)))
+		}
+		fields = append(fields, input)
+	}
+
+	title := backend.Description
+	if backend.OAuth {
+		title += " — you will log in through your browser next"
+	}
+	form := huh.NewForm(huh.NewGroup(fields...).Title(title))
+	form.WithTheme(huh.ThemeBase16())
+	return form
+}
+
+// updateWizard handles updates while the new remote wizard is open.
+func (s *RemotesScreen) updateWizard(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.String() == "esc" {
+		s.form = nil
+		s.mode = RemotesModeList
+		return s, nil
+	}
+
+	form, cmd := s.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		s.form = f
+	}
+
+	switch s.form.State {
+	case huh.StateAborted:
+		s.form = nil
+		s.mode = RemotesModeList
+		return s, nil
+	case huh.StateCompleted:
+		backend, _ := rclone.FindBackend(s.newType)
+		if s.wizardStep == 0 && len(backend.Fields) > 0 {
+			s.wizardStep = 1
+			s.form = s.settingsForm(backend)
+			return s, s.form.Init()
+		}
+		s.form = nil
+		params := make(map[string]string, len(s.fieldValues))
+		for key, value := range s.fieldValues {
+			params[key] = strings.TrimSpace(*value)
+		}
+		return s, s.createRemote(strings.TrimSpace(s.newName), backend, params)
+	}
+
+	return s, cmd
+}
+
+// createRemote creates the remote in the background, first running the
+// OAuth login for backends that need one. The returned command reports the
+// login address, if any, and the result.
+func (s *RemotesScreen) createRemote(name string, backend rclone.Backend, params map[string]string) tea.Cmd {
+	ctx, cancel := context.WithCancel(rootCtx)
+	creation := &remoteCreation{
+		name:   name,
+		urls:   make(chan string, 1),
+		done:   make(chan error, 1),
+		cancel: cancel,
+	}
+	s.creation = creation
+	s.authURL = ""
+	s.mode = RemotesModeAuthorize
+
+	client := s.rclone
+	go func() {
+		defer cancel()
+		// Store typed passwords in the obscured form rclone expects
+		for _, field := range backend.Fields {
+			if field.Obscured && params[field.Key] != "" {
+				obscured, err := client.Obscure(params[field.Key])
+				if err != nil {
+					creation.done <- err
+					return
+				}
+				params[field.Key] = obscured
+			}
+		}
+		if backend.OAuth && params["token"] == "" {
+			token, err := client.Authorize(ctx, backend.Type, params, func(url string) {
+				creation.urls <- url
+			})
+			if err != nil {
+				creation.done <- err
+				return
+			}
+			params["token"] = token
+		}
+		creation.done <- client.CreateRemote(ctx, name, backend.Type, params, true)
+	}()
+
+	return waitForRemoteCreation(creation)
+}
+
+// waitForRemoteCreation waits for the login address or the end of a remote
+// creation. It gives up without a message once the TUI exits.
+func waitForRemoteCreation(creation *remoteCreation) tea.Cmd {
+	ctx := rootCtx
+	return func() tea.Msg {
+		select {
+		case url := <-creation.urls:
+			return RemoteAuthURLMsg{URL: url}
+		case err := <-creation.done:
+			return RemoteCreatedMsg{Name: creation.name, Err: err}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// IsEditing reports whether the wizard or a login is in progress, so typed
+// keys reach the screen instead of the global bindings.
+func (s *RemotesScreen) IsEditing() bool {
+	return s.mode != RemotesModeList
+}
+
+// ShouldGoBack returns true if the screen should go back to the main menu.
+func (s *RemotesScreen) ShouldGoBack() bool {
+	return s.goBack
+}
+
+// ResetGoBack resets the go back state.
+func (s *RemotesScreen) ResetGoBack() {
+	s.goBack = false
+}
+
+// View renders the screen.
+func (s *RemotesScreen) View() string {
+	switch s.mode {
+	case RemotesModeCreate:
+		if s.form != nil {
+			return components.Styles.Title.Render("New Remote") + "\n\n" + s.form.View() +
+				"\n" + components.HelpBar(s.width, []components.HelpItem{{Key: "Esc", Desc: "cancel"}})
+		}
+	case RemotesModeAuthorize:
+		return s.renderAuthorize()
+	}
+	return s.renderList()
+}
+
+// renderAuthorize renders the wait for a remote to be created.
+func (s *RemotesScreen) renderAuthorize() string {
+	var b strings.Builder
+	b.WriteString(components.Styles.Title.Render("New Remote: "+s.newName) + "\n\n")
+	if s.authURL != "" {
+		b.WriteString(components.Styles.Normal.Render("Log in through the browser window rclone opened.") + "\n")
+		b.WriteString(components.Styles.Normal.Render("If none opened, go to:") + "\n\n")
+		b.WriteString("  " + components.Styles.Info.Render(s.authURL) + "\n\n")
+		b.WriteString(components.Styles.HelpText.Render("Waiting for the login to complete..."))
+	} else {
+		b.WriteString(components.Styles.HelpText.Render("Creating the remote..."))
+	}
+	b.WriteString("\n\n" + components.HelpBar(s.width, []components.HelpItem{{Key: "Esc", Desc: "cancel"}}))
+	return b.String()
+}
+
+// renderList renders the remote list.
+func (s *RemotesScreen) renderList() string {
+	var b strings.Builder
+
+	title := components.Styles.Title.Render("Remotes")
+	b.WriteString(lipgloss.NewStyle().
+		Width(s.width).
+		Align(lipgloss.Center).
+		Render(title))
+	b.WriteString("\n\n")
+
+	if s.err != nil {
+		b.WriteString(components.RenderError(s.err.Error()))
+		b.WriteString("\n\n")
+	}
+
+	if s.success != "" {
+		b.WriteString(components.RenderSuccess(s.success))
+		b.WriteString("\n\n")
+		s.success = ""
+	}
+
+	if s.loading {
+		b.WriteString(lipgloss.NewStyle().
+			Width(s.width).
+			Align(lipgloss.Center).
+			Render("Loading remotes..."))
+	} else if len(s.remotes) == 0 {
+		emptyMsg := components.Styles.Subtitle.Render("No rclone remotes configured.")
+		hint := components.Styles.HelpText.Render("Press 'n' to create one.")
+
+		b.WriteString(lipgloss.NewStyle().
+			Width(s.width).
+			Align(lipgloss.Center).
+			Render(emptyMsg))
+		b.WriteString("\n")
+		b.WriteString(lipgloss.NewStyle().
+			Width(s.width).
+			Align(lipgloss.Center).
+			Render(hint))
+	} else {
+		header := fmt.Sprintf("  %-30s %-15s", "Name", "Type")
+		b.WriteString(components.Styles.Subtitle.Render(header) + "\n")
+		b.WriteString(components.Styles.Subtitle.Render(strings.Repeat("─", max(s.width-4, 0))) + "\n")
+
+		for i, remote := range s.remotes {
+			if i == s.cursor {
+				b.WriteString(fmt.Sprintf("▸ %-30s %-15s\n",
+					components.Styles.Selected.Render(remote.Name), remote.Type))
+			} else {
+				b.WriteString(fmt.Sprintf("  %-30s %-15s\n",
+					components.Styles.Normal.Render(remote.Name), remote.Type))
+			}
+		}
+	}
+
+	b.WriteString("\n")
+	helpText := components.HelpBar(s.width, []components.HelpItem{
+		{Key: "↑/↓", Desc: "navigate"},
+		{Key: "n", Desc: "new remote"},
+		{Key: "R", Desc: "refresh"},
+		{Key: "Esc", Desc: "back"},
+	})
+	b.WriteString(helpText)
+
+	return b.String()
+}
@@ -0,0 +1,190 @@
+package screens
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+)
+
+// fakeRemotesRclone returns a client for a fake rclone that keeps its
+// remotes as "name type" lines in a file, logs config create commands to
+// another, whose obscure prints "obscured-" and the password's length, and
+// whose authorize prints a login address and a token.
+func fakeRemotesRclone(t *testing.T) (*rclone.Client, string) {
+	t.Helper()
+	dir := t.TempDir()
+	list := filepath.Join(dir, "remotes")
+	if err := os.WriteFile(list, []byte("existing s3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	created := filepath.Join(dir, "created")
+	client := fakeRclone(t, fmt.Sprintf(`case "$1 $2" in
+listremotes*) sed 's/ .*/:/' %[1]q ;;
+"config show") echo "[$3]"; grep "^$3 " %[1]q | sed 's/.* /type = /' ;;
+"config create") echo "$3 $4" >> %[1]q; echo "$*" >> %[2]q ;;
+"obscure -") read -r plain; echo "obscured-${#plain}" ;;
+authorize*)
+	echo "If your browser doesn't open, go to: http://127.0.0.1:53682/auth?state=abc"
+	echo "Paste the following into your remote machine --->"
+	echo '{"access_token":"secret"}'
+	echo "<---End paste" ;;
+esac
+`, list, created))
+	return client, created
+}
+
+func TestRemotesScreen_Load(t *testing.T) {
+	client, _ := fakeRemotesRclone(t)
+	screen := NewRemotesScreen()
+	screen.SetSize(80, 24)
+	screen.SetServices(client)
+
+	cmd := screen.Init()
+	screen.Update(cmd())
+
+	if screen.loading || screen.err != nil {
+		t.Fatalf("loading = %v, err = %v after the remotes loaded", screen.loading, screen.err)
+	}
+	if len(screen.remotes) != 1 || screen.remotes[0].Name != "existing" || screen.remotes[0].Type != "s3" {
+		t.Errorf("remotes = %+v, want existing", screen.remotes)
+	}
+	if !strings.Contains(screen.View(), "existing") {
+		t.Error("view should list the remote")
+	}
+}
+
+func TestRemotesScreen_WizardOpensAndCancels(t *testing.T) {
+	client, _ := fakeRemotesRclone(t)
+	screen := NewRemotesScreen()
+	screen.SetSize(80, 24)
+	screen.SetServices(client)
+	screen.loading = false
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if screen.mode != RemotesModeCreate || screen.form == nil || !screen.IsEditing() {
+		t.Fatal("n should open the new remote wizard")
+	}
+	if !strings.Contains(screen.View(), "New Remote") {
+		t.Error("view should show the wizard")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.mode != RemotesModeList || screen.form != nil || screen.IsEditing() {
+		t.Error("esc should close the wizard")
+	}
+}
+
+func TestRemotesScreen_WizardNeedsClient(t *testing.T) {
+	screen := NewRemotesScreen()
+	screen.loading = false
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if screen.mode != RemotesModeList || screen.err == nil {
+		t.Error("the wizard should not open without an rclone client")
+	}
+}
+
+func TestRemotesScreen_ValidateNewName(t *testing.T) {
+	screen := NewRemotesScreen()
+	screen.remotes = []rclone.Remote{{Name: "existing"}}
+
+	if err := screen.validateNewName("existing"); err == nil {
+		t.Error("a name in use should be rejected")
+	}
+	if err := screen.validateNewName("-bad"); err == nil {
+		t.Error("a name starting with - should be rejected")
+	}
+	if err := screen.validateNewName("backup"); err != nil {
+		t.Errorf("validateNewName(backup) = %v", err)
+	}
+}
+
+func TestRemotesScreen_CreateOAuthRemote(t *testing.T) {
+	client, created := fakeRemotesRclone(t)
+	screen := NewRemotesScreen()
+	screen.SetSize(80, 24)
+	screen.SetServices(client)
+	screen.loading = false
+	screen.newName = "gdrive"
+
+	backend, _ := rclone.FindBackend("drive")
+	cmd := screen.createRemote("gdrive", backend, map[string]string{"scope": "drive"})
+	if screen.mode != RemotesModeAuthorize || !screen.IsEditing() {
+		t.Fatal("creating a remote should wait for the login")
+	}
+
+	msg := cmd()
+	urlMsg, ok := msg.(RemoteAuthURLMsg)
+	if !ok {
+		t.Fatalf("first message = %T, want RemoteAuthURLMsg", msg)
+	}
+	_, cmd = screen.Update(urlMsg)
+	if !strings.Contains(screen.View(), "http://127.0.0.1:53682/auth?state=abc") {
+		t.Error("view should show the login address")
+	}
+
+	_, cmd = screen.Update(cmd())
+	if screen.mode != RemotesModeList || screen.err != nil {
+		t.Fatalf("mode = %q, err = %v after creating the remote", screen.mode, screen.err)
+	}
+	if cmd == nil {
+		t.Fatal("the remotes should be listed again after creating one")
+	}
+	screen.Update(cmd())
+
+	if len(screen.remotes) != 2 || screen.remotes[1].Name != "gdrive" || screen.remotes[1].Type != "drive" {
+		t.Errorf("remotes = %+v, want existing and gdrive", screen.remotes)
+	}
+	data, err := os.ReadFile(created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `token={"access_token":"secret"}`) {
+		t.Errorf("config create = %q, want the authorized token", data)
+	}
+}
+
+func TestRemotesScreen_CreateObscuresPassword(t *testing.T) {
+	client, created := fakeRemotesRclone(t)
+	screen := NewRemotesScreen()
+	screen.SetServices(client)
+	screen.loading = false
+
+	backend, _ := rclone.FindBackend("sftp")
+	cmd := screen.createRemote("nas", backend, map[string]string{"host": "nas.local", "pass": "hunter2"})
+	screen.Update(cmd())
+	if screen.err != nil {
+		t.Fatalf("err = %v after creating the remote", screen.err)
+	}
+
+	data, err := os.ReadFile(created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "pass=obscured-7") || strings.Contains(string(data), "hunter2") {
+		t.Errorf("config create = %q, want the password obscured", data)
+	}
+}
+
+func TestRemotesScreen_CreateFailure(t *testing.T) {
+	screen := NewRemotesScreen()
+	screen.SetSize(80, 24)
+	screen.SetServices(fakeRclone(t, "echo 'Failed to create: bad key' >&2\nexit 1\n"))
+	screen.loading = false
+
+	backend, _ := rclone.FindBackend("sftp")
+	cmd := screen.createRemote("nas", backend, map[string]string{"host": "nas.local"})
+	_, cmd = screen.Update(cmd())
+
+	if cmd != nil || screen.mode != RemotesModeList {
+		t.Error("a failed creation should return to the list without reloading it")
+	}
+	if screen.err == nil || !strings.Contains(screen.View(), "bad key") {
+		t.Errorf("err = %v, want rclone's message shown", screen.err)
+	}
+}
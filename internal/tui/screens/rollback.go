@@ -33,11 +33,11 @@ type SyncJobRollbackData struct {
 
 type RollbackManager struct {
 	config    *config.Config
-	generator *systemd.Generator
+	generator systemd.UnitGenerator
 	manager   systemd.ServiceManager
 }
 
-func NewRollbackManager(cfg *config.Config, gen *systemd.Generator, mgr systemd.ServiceManager) *RollbackManager {
+func NewRollbackManager(cfg *config.Config, gen systemd.UnitGenerator, mgr systemd.ServiceManager) *RollbackManager {
 	return &RollbackManager{
 		config:    cfg,
 		generator: gen,
@@ -88,7 +88,7 @@ func (r *RollbackManager) RollbackMount(data MountRollbackData, systemdFailed bo
 		}
 	}
 
-	if err := config.RestoreFromBackup(); err == nil {
+	if err := config.RestoreFromBackup(0); err == nil {
 		r.config.Mounts = data.OriginalMounts
 		return nil
 	}
@@ -135,7 +135,7 @@ func (r *RollbackManager) RollbackSyncJob(data SyncJobRollbackData, systemdFaile
 		}
 	}
 
-	if err := config.RestoreFromBackup(); err == nil {
+	if err := config.RestoreFromBackup(0); err == nil {
 		r.config.SyncJobs = data.OriginalJobs
 		return nil
 	}
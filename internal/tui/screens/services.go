@@ -3,7 +3,6 @@ package screens
 
 import (
 	"fmt"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
@@ -22,8 +21,14 @@ const (
 	ServicesModeDetails = "details" // Service details
 	ServicesModeLogs    = "logs"    // Log viewer
 	ServicesModeActions = "actions" // Action menu
+	ServicesModeBulk    = "bulk"    // Bulk operations menu
+	ServicesModeResults = "results" // Bulk operation results
+	ServicesModeConfirm = "confirm" // Confirming a bulk auto-start change
 )
 
+// bulkActions are the entries of the bulk operations menu.
+var bulkActions = []string{"Start All Mounts", "Restart Failed", "Disable All Autostart", "Enable All Autostart", "Back"}
+
 // Service filter types
 const (
 	FilterAll      = "all"
@@ -34,6 +39,12 @@ const (
 	FilterSyncJobs = "sync"
 )
 
+// Service sort orders, applied within each group when grouping by type
+const (
+	SortByName   = "name"
+	SortByStatus = "status"
+)
+
 // ServicesScreen handles service status and management.
 type ServicesScreen struct {
 	// Services
@@ -42,7 +53,7 @@ type ServicesScreen struct {
 
 	// Systemd manager and generator
 	manager   systemd.ServiceManager
-	generator *systemd.Generator
+	generator systemd.UnitGenerator
 
 	// Config for service types
 	cfg *config.Config
@@ -54,8 +65,10 @@ type ServicesScreen struct {
 	height int
 	goBack bool
 
-	// Filter
-	filter string
+	// Filter, sort order and grouping
+	filter      string
+	sortBy      string
+	groupByType bool
 
 	// Details view
 	selectedService *ServiceInfo
@@ -65,6 +78,8 @@ type ServicesScreen struct {
 	logs        string
 	logsLoading bool
 	logFilter   string // error, warning, info, debug, all
+	follow      logFollow
+	search      logSearch
 
 	// Action menu
 	showActions  bool
@@ -73,6 +88,14 @@ type ServicesScreen struct {
 	// Bulk operations
 	showBulkMenu bool
 	bulkCursor   int
+	bulkRunning  bool
+	bulkAction   systemd.BatchAction
+	bulkResults  []systemd.ActionResult
+	bulkScrollY  int
+
+	// Confirmation of a bulk auto-start change
+	autostartConfirm *components.ConfirmDialog
+	autostartOn      bool
 
 	// Status messages
 	statusMessage     string
@@ -83,6 +106,9 @@ type ServicesScreen struct {
 
 	// Systemd status panel
 	systemdStatus SystemdStatus
+
+	// Periodic status refresh
+	autoRefresh autoRefresh
 }
 
 // SystemdStatus holds overall systemd user manager status.
@@ -132,6 +158,14 @@ type ServiceActionResultMsg struct {
 	Error   string
 }
 
+// BulkActionResultMsg is sent after a bulk operation has run on every unit.
+type BulkActionResultMsg struct {
+	Action  systemd.BatchAction
+	Results []systemd.ActionResult
+	Summary string // Shown as the status message, if set
+	Err     error
+}
+
 // ServiceLogsMsg is sent to request logs for a service.
 type ServiceLogsMsg struct {
 	Name string
@@ -158,13 +192,15 @@ func NewServicesScreen() *ServicesScreen {
 		filteredServices:  []ServiceInfo{},
 		mode:              ServicesModeList,
 		filter:            FilterAll,
+		sortBy:            SortByName,
 		logFilter:         "all",
 		statusMessageType: "info",
+		autoRefresh:       autoRefresh{screen: "services"},
 	}
 }
 
 // SetServices sets the required services for the screen.
-func (s *ServicesScreen) SetServices(cfg *config.Config, manager systemd.ServiceManager, generator *systemd.Generator) {
+func (s *ServicesScreen) SetServices(cfg *config.Config, manager systemd.ServiceManager, generator systemd.UnitGenerator) {
 	s.cfg = cfg
 	s.manager = manager
 	s.generator = generator
@@ -280,46 +316,32 @@ func (s *ServicesScreen) loadSystemdStatus() SystemdStatus {
 		return status
 	}
 
-	// Get failed units count
-	cmd := exec.Command("systemctl", "--user", "list-units", "--state=failed", "--no-legend")
-	output, err := cmd.Output()
-	if err == nil {
-		lines := strings.Split(string(output), "\n")
-		count := 0
-		for _, line := range lines {
-			if strings.TrimSpace(line) != "" {
-				count++
-			}
-		}
-		status.FailedUnits = count
+	// Count units in the manager's scope: user units, or system units
+	// when the units are system scope
+	if failed, err := s.manager.ListUnits("", "failed"); err == nil {
+		status.FailedUnits = len(failed)
 	}
-
-	// Count active services and timers
-	cmd = exec.Command("systemctl", "--user", "list-units", "--type=service", "--state=active", "--no-legend")
-	output, err = cmd.Output()
-	if err == nil {
-		serviceLines := strings.Split(string(output), "\n")
-		for _, line := range serviceLines {
-			if strings.Contains(line, "rclone-") {
-				status.ActiveServices++
-			}
-		}
+	if services, err := s.manager.ListUnits("service", "active"); err == nil {
+		status.ActiveServices = countRcloneUnits(services)
 	}
-
-	cmd = exec.Command("systemctl", "--user", "list-units", "--type=timer", "--state=active", "--no-legend")
-	output, err = cmd.Output()
-	if err == nil {
-		timerLines := strings.Split(string(output), "\n")
-		for _, line := range timerLines {
-			if strings.Contains(line, "rclone-") {
-				status.ActiveTimers++
-			}
-		}
+	if timers, err := s.manager.ListUnits("timer", "active"); err == nil {
+		status.ActiveTimers = countRcloneUnits(timers)
 	}
 
 	return status
 }
 
+// countRcloneUnits counts the units this tool manages among names.
+func countRcloneUnits(names []string) int {
+	count := 0
+	for _, name := range names {
+		if strings.HasPrefix(name, "rclone-") {
+			count++
+		}
+	}
+	return count
+}
+
 // SetSize sets the screen dimensions.
 func (s *ServicesScreen) SetSize(width, height int) {
 	s.width = width
@@ -345,6 +367,13 @@ func (s *ServicesScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		s.loading = true
 		return s, s.loadServices
 
+	case AutoRefreshTickMsg:
+		due, next := s.autoRefresh.handleTick(msg)
+		if due {
+			return s, tea.Batch(next, s.loadServices)
+		}
+		return s, next
+
 	case ServiceActionResultMsg:
 		if msg.Success {
 			s.statusMessage = fmt.Sprintf("%s: %s completed successfully", msg.Name, msg.Action)
@@ -356,10 +385,43 @@ func (s *ServicesScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Refresh services after action
 		cmds = append(cmds, s.loadServices)
 
+	case BulkActionResultMsg:
+		s.bulkRunning = false
+		if msg.Err != nil {
+			s.statusMessage = fmt.Sprintf("Bulk %s failed: %v", msg.Action, msg.Err)
+			s.statusMessageType = "error"
+			s.mode = ServicesModeList
+			break
+		}
+		s.bulkAction = msg.Action
+		s.bulkResults = msg.Results
+		s.bulkScrollY = 0
+		if msg.Summary != "" {
+			s.statusMessage = msg.Summary
+			s.statusMessageType = "success"
+		}
+		s.mode = ServicesModeResults
+		cmds = append(cmds, s.loadServices)
+
 	case ServiceLogsLoadedMsg:
 		s.logs = msg.Logs
 		s.logsLoading = false
 
+	case ServiceLogsFollowMsg:
+		if !s.follow.enabled || msg.Gen != s.follow.gen {
+			break
+		}
+		s.logs = appendLogLines(s.logs, msg.Lines)
+		if msg.Done {
+			s.follow.stop()
+			if msg.Err != nil {
+				s.statusMessage = msg.Err.Error()
+				s.statusMessageType = "error"
+			}
+			break
+		}
+		cmds = append(cmds, s.follow.tick())
+
 	case tea.KeyMsg:
 		switch s.mode {
 		case ServicesModeList:
@@ -367,9 +429,19 @@ func (s *ServicesScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case ServicesModeDetails:
 			cmds = append(cmds, s.handleDetailsKeyPress(msg)...)
 		case ServicesModeLogs:
+			if s.search.editing {
+				cmds = append(cmds, s.search.update(msg))
+				break
+			}
 			cmds = append(cmds, s.handleLogsKeyPress(msg)...)
 		case ServicesModeActions:
 			cmds = append(cmds, s.handleActionsKeyPress(msg)...)
+		case ServicesModeBulk:
+			cmds = append(cmds, s.handleBulkKeyPress(msg)...)
+		case ServicesModeResults:
+			s.handleResultsKeyPress(msg)
+		case ServicesModeConfirm:
+			cmds = append(cmds, s.handleAutostartConfirm(msg)...)
 		}
 	}
 
@@ -396,25 +468,25 @@ func (s *ServicesScreen) handleListKeyPress(msg tea.KeyMsg) []tea.Cmd {
 			s.mode = ServicesModeDetails
 			s.loadDetailedStatus()
 		}
-	case "s":
+	case ServiceKeys.Key(ActionStart):
 		// Start service
 		if len(s.filteredServices) > 0 {
 			service := s.filteredServices[s.cursor]
 			cmds = append(cmds, s.doServiceAction(service.Name+".service", "start"))
 		}
-	case "x":
+	case ServiceKeys.Key(ActionStop):
 		// Stop service
 		if len(s.filteredServices) > 0 {
 			service := s.filteredServices[s.cursor]
 			cmds = append(cmds, s.doServiceAction(service.Name+".service", "stop"))
 		}
-	case "r":
+	case ServiceKeys.Key(ActionRestart):
 		// Restart service
 		if len(s.filteredServices) > 0 {
 			service := s.filteredServices[s.cursor]
 			cmds = append(cmds, s.doServiceAction(service.Name+".service", "restart"))
 		}
-	case "e":
+	case ServiceKeys.Key(ActionEnable):
 		// Enable service
 		if len(s.filteredServices) > 0 {
 			service := s.filteredServices[s.cursor]
@@ -426,7 +498,7 @@ func (s *ServicesScreen) handleListKeyPress(msg tea.KeyMsg) []tea.Cmd {
 			}
 			cmds = append(cmds, s.doServiceAction(unitName, "enable"))
 		}
-	case "d":
+	case ServiceKeys.Key(ActionDisable):
 		// Disable service
 		if len(s.filteredServices) > 0 {
 			service := s.filteredServices[s.cursor]
@@ -438,12 +510,13 @@ func (s *ServicesScreen) handleListKeyPress(msg tea.KeyMsg) []tea.Cmd {
 			}
 			cmds = append(cmds, s.doServiceAction(unitName, "disable"))
 		}
-	case "l":
+	case ServiceKeys.Key(ActionLogs):
 		// View logs
 		if len(s.filteredServices) > 0 {
 			service := s.filteredServices[s.cursor]
 			s.mode = ServicesModeLogs
 			s.logsLoading = true
+			s.search = logSearch{}
 			cmds = append(cmds, s.loadServiceLogs(service.Name+".service"))
 		}
 	case "a":
@@ -457,10 +530,30 @@ func (s *ServicesScreen) handleListKeyPress(msg tea.KeyMsg) []tea.Cmd {
 	case "f":
 		// Cycle through filters
 		s.cycleFilter()
-	case "ctrl+r", "R":
+	case "g":
+		// Toggle grouping by type
+		s.groupByType = !s.groupByType
+		s.applyFilter()
+	case "o":
+		// Toggle sorting by name or status
+		if s.sortBy == SortByStatus {
+			s.sortBy = SortByName
+		} else {
+			s.sortBy = SortByStatus
+		}
+		s.applyFilter()
+	case "b":
+		// Show bulk operations menu
+		s.showBulkMenu = true
+		s.bulkCursor = 0
+		s.mode = ServicesModeBulk
+	case ServiceKeys.Key(ActionRefresh), "ctrl+r":
 		// Refresh
 		s.loading = true
 		cmds = append(cmds, s.loadServices)
+	case ServiceKeys.Key(ActionAutoRefresh):
+		// Toggle periodic status refresh
+		cmds = append(cmds, s.autoRefresh.toggle(autoRefreshInterval(s.cfg)))
 	case "esc":
 		s.goBack = true
 	}
@@ -473,22 +566,22 @@ func (s *ServicesScreen) handleDetailsKeyPress(msg tea.KeyMsg) []tea.Cmd {
 	var cmds []tea.Cmd
 
 	switch msg.String() {
-	case "s":
+	case ServiceKeys.Key(ActionStart):
 		// Start service
 		if s.selectedService != nil {
 			cmds = append(cmds, s.doServiceAction(s.selectedService.Name+".service", "start"))
 		}
-	case "x":
+	case ServiceKeys.Key(ActionStop):
 		// Stop service
 		if s.selectedService != nil {
 			cmds = append(cmds, s.doServiceAction(s.selectedService.Name+".service", "stop"))
 		}
-	case "r":
+	case ServiceKeys.Key(ActionRestart):
 		// Restart service
 		if s.selectedService != nil {
 			cmds = append(cmds, s.doServiceAction(s.selectedService.Name+".service", "restart"))
 		}
-	case "e":
+	case ServiceKeys.Key(ActionEnable):
 		// Enable service
 		if s.selectedService != nil {
 			unitName := s.selectedService.Name
@@ -499,7 +592,7 @@ func (s *ServicesScreen) handleDetailsKeyPress(msg tea.KeyMsg) []tea.Cmd {
 			}
 			cmds = append(cmds, s.doServiceAction(unitName, "enable"))
 		}
-	case "d":
+	case ServiceKeys.Key(ActionDisable):
 		// Disable service
 		if s.selectedService != nil {
 			unitName := s.selectedService.Name
@@ -510,13 +603,13 @@ func (s *ServicesScreen) handleDetailsKeyPress(msg tea.KeyMsg) []tea.Cmd {
 			}
 			cmds = append(cmds, s.doServiceAction(unitName, "disable"))
 		}
-	case "l":
+	case ServiceKeys.Key(ActionLogs):
 		// View logs
 		if s.selectedService != nil {
 			s.logsLoading = true
 			cmds = append(cmds, s.loadServiceLogs(s.selectedService.Name+".service"))
 		}
-	case "ctrl+r", "R":
+	case ServiceKeys.Key(ActionRefresh), "ctrl+r":
 		// Refresh
 		s.loading = true
 		cmds = append(cmds, s.loadServices)
@@ -534,10 +627,31 @@ func (s *ServicesScreen) handleLogsKeyPress(msg tea.KeyMsg) []tea.Cmd {
 	switch msg.String() {
 	case "esc":
 		// Go back to details
+		s.follow.stop()
 		s.mode = ServicesModeDetails
+	case "F":
+		// Follow new log lines as they arrive, or stop following
+		if s.follow.enabled {
+			s.follow.stop()
+			return nil
+		}
+		if s.manager == nil || s.selectedService == nil {
+			s.statusMessage = "Systemd manager not initialized"
+			s.statusMessageType = "error"
+			return nil
+		}
+		return []tea.Cmd{s.follow.start(s.manager, s.selectedService.Name+".service")}
+	case "/":
+		// Search the logs
+		return []tea.Cmd{s.search.open()}
+	case "n":
+		s.search.step(1, s.logMatchCount())
+	case "N":
+		s.search.step(-1, s.logMatchCount())
 	case "f":
 		// Cycle log filter
 		s.cycleLogFilter()
+		s.search.match = 0
 		// Reload logs with filter
 		if s.selectedService != nil {
 			s.logsLoading = true
@@ -605,6 +719,159 @@ func (s *ServicesScreen) handleActionsKeyPress(msg tea.KeyMsg) []tea.Cmd {
 	return cmds
 }
 
+// handleBulkKeyPress handles key presses in the bulk operations menu.
+func (s *ServicesScreen) handleBulkKeyPress(msg tea.KeyMsg) []tea.Cmd {
+	if s.bulkRunning {
+		return nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if s.bulkCursor > 0 {
+			s.bulkCursor--
+		}
+	case "down", "j":
+		if s.bulkCursor < len(bulkActions)-1 {
+			s.bulkCursor++
+		}
+	case "enter":
+		switch bulkActions[s.bulkCursor] {
+		case "Start All Mounts":
+			s.bulkRunning = true
+			return []tea.Cmd{s.doBulkAction(systemd.BatchStart, systemd.InactiveMountUnits)}
+		case "Restart Failed":
+			s.bulkRunning = true
+			return []tea.Cmd{s.doBulkAction(systemd.BatchRestart, systemd.FailedUnits)}
+		case "Disable All Autostart", "Enable All Autostart":
+			s.confirmAutostart(bulkActions[s.bulkCursor] == "Enable All Autostart")
+		default:
+			s.showBulkMenu = false
+			s.mode = ServicesModeList
+		}
+	case "esc", "backspace":
+		s.showBulkMenu = false
+		s.mode = ServicesModeList
+	}
+
+	return nil
+}
+
+// confirmAutostart asks before turning auto-start on or off for every item.
+func (s *ServicesScreen) confirmAutostart(on bool) {
+	if s.cfg == nil {
+		s.statusMessage = "Config not initialized"
+		s.statusMessageType = "error"
+		s.mode = ServicesModeList
+		return
+	}
+	verb := "Disable"
+	detail := "Their units are disabled so nothing starts on the next login. Running services are left alone."
+	if on {
+		verb = "Enable"
+		detail = "Their units are enabled so everything starts on the next login."
+	}
+	s.autostartOn = on
+	s.autostartConfirm = components.NewConfirmDialog(components.ConfirmDialogConfig{
+		Title:       verb + " All Autostart",
+		Message:     fmt.Sprintf("%s auto-start for all %d mounts and %d sync jobs?", verb, len(s.cfg.Mounts), len(s.cfg.SyncJobs)),
+		Description: detail,
+		Options: []components.ConfirmDialogOption{
+			{Label: "Cancel", Action: 0},
+			{Label: verb, Action: 1, IsDestructive: !on},
+		},
+	})
+	s.autostartConfirm.SetSize(s.width, s.height)
+	s.mode = ServicesModeConfirm
+}
+
+// handleAutostartConfirm handles key presses in the auto-start confirmation.
+func (s *ServicesScreen) handleAutostartConfirm(msg tea.KeyMsg) []tea.Cmd {
+	s.autostartConfirm.Update(msg)
+	if !s.autostartConfirm.IsDone() {
+		return nil
+	}
+	confirmed := s.autostartConfirm.GetSelectedAction() == 1
+	s.autostartConfirm = nil
+	s.mode = ServicesModeBulk
+	if !confirmed {
+		return nil
+	}
+	s.bulkRunning = true
+	return []tea.Cmd{s.doSetAutostart(s.autostartOn)}
+}
+
+// doSetAutostart turns auto-start on or off for every item, saves the
+// config and enables or disables their units.
+func (s *ServicesScreen) doSetAutostart(on bool) tea.Cmd {
+	action := systemd.BatchDisable
+	if on {
+		action = systemd.BatchEnable
+	}
+	return func() tea.Msg {
+		if s.manager == nil || s.generator == nil {
+			return BulkActionResultMsg{Action: action, Err: fmt.Errorf("systemd services not initialized")}
+		}
+
+		mounts, syncJobs := s.cfg.SetAllAutoStart(on)
+		if err := s.cfg.Save(); err != nil {
+			return BulkActionResultMsg{Action: action, Err: fmt.Errorf("failed to save config: %w", err)}
+		}
+		return BulkActionResultMsg{
+			Action:  action,
+			Results: systemd.RunBatch(s.manager, action, systemd.AutoStartUnits(s.generator, s.cfg.Mounts, s.cfg.SyncJobs)),
+			Summary: fmt.Sprintf("Auto-start %sd: %d mounts and %d sync jobs changed", action, mounts, syncJobs),
+		}
+	}
+}
+
+// handleResultsKeyPress scrolls and closes the bulk operation results.
+func (s *ServicesScreen) handleResultsKeyPress(msg tea.KeyMsg) {
+	switch msg.String() {
+	case "up", "k":
+		if s.bulkScrollY > 0 {
+			s.bulkScrollY--
+		}
+	case "down", "j":
+		if s.bulkScrollY < s.maxBulkScroll() {
+			s.bulkScrollY++
+		}
+	case "enter", "esc", "backspace":
+		s.showBulkMenu = false
+		s.bulkResults = nil
+		s.mode = ServicesModeList
+	}
+}
+
+// bulkResultsHeight returns how many result rows fit on screen.
+func (s *ServicesScreen) bulkResultsHeight() int {
+	return max(s.height-12, 1)
+}
+
+// maxBulkScroll returns the largest useful results scroll offset.
+func (s *ServicesScreen) maxBulkScroll() int {
+	return max(len(s.bulkResults)-s.bulkResultsHeight(), 0)
+}
+
+// doBulkAction runs action on the units chosen by selectUnits from a fresh
+// service listing.
+func (s *ServicesScreen) doBulkAction(action systemd.BatchAction, selectUnits func([]systemd.ServiceStatus) []string) tea.Cmd {
+	return func() tea.Msg {
+		if s.manager == nil {
+			return BulkActionResultMsg{Action: action, Err: fmt.Errorf("systemd manager not initialized")}
+		}
+
+		services, err := s.manager.ListServices()
+		if err != nil {
+			return BulkActionResultMsg{Action: action, Err: fmt.Errorf("failed to list services: %w", err)}
+		}
+
+		return BulkActionResultMsg{
+			Action:  action,
+			Results: systemd.RunBatch(s.manager, action, selectUnits(services)),
+		}
+	}
+}
+
 // doServiceAction performs an action on a service.
 func (s *ServicesScreen) doServiceAction(name, action string) tea.Cmd {
 	return func() tea.Msg {
@@ -687,8 +954,13 @@ func (s *ServicesScreen) loadDetailedStatus() {
 	}
 }
 
-// applyFilter applies the current filter to the services list.
+// applyFilter applies the current filter, sort order and grouping to the
+// services list. The cursor stays on the selected service if it still matches.
 func (s *ServicesScreen) applyFilter() {
+	var selected string
+	if s.cursor < len(s.filteredServices) {
+		selected = s.filteredServices[s.cursor].Name
+	}
 	s.filteredServices = []ServiceInfo{}
 
 	for _, service := range s.services {
@@ -717,6 +989,14 @@ func (s *ServicesScreen) applyFilter() {
 			s.filteredServices = append(s.filteredServices, service)
 		}
 	}
+	s.sortServices()
+
+	for i, service := range s.filteredServices {
+		if service.Name == selected {
+			s.cursor = i
+			break
+		}
+	}
 
 	// Reset cursor if out of bounds
 	if s.cursor >= len(s.filteredServices) {
@@ -727,6 +1007,48 @@ func (s *ServicesScreen) applyFilter() {
 	}
 }
 
+// sortServices orders the filtered services by status when sorting by
+// status, with mounts ahead of sync jobs when grouping by type. Otherwise
+// services keep their order from loading, which is by name.
+func (s *ServicesScreen) sortServices() {
+	sort.SliceStable(s.filteredServices, func(i, j int) bool {
+		a, b := s.filteredServices[i], s.filteredServices[j]
+		if s.groupByType && a.Type != b.Type {
+			return a.Type == "mount"
+		}
+		if s.sortBy == SortByStatus {
+			return statusRank(a.Status) < statusRank(b.Status)
+		}
+		return false
+	})
+}
+
+// statusRank orders service states for sorting by status, problems first.
+func statusRank(status string) int {
+	switch status {
+	case "failed":
+		return 0
+	case "activating", "deactivating":
+		return 1
+	case "active":
+		return 2
+	case "inactive":
+		return 3
+	case "not-found":
+		return 4
+	default:
+		return 5
+	}
+}
+
+// serviceGroupTitle returns the group header for a service type.
+func serviceGroupTitle(serviceType string) string {
+	if serviceType == "mount" {
+		return "Mounts"
+	}
+	return "Sync Jobs"
+}
+
 // cycleFilter cycles through the available filters.
 func (s *ServicesScreen) cycleFilter() {
 	switch s.filter {
@@ -748,52 +1070,21 @@ func (s *ServicesScreen) cycleFilter() {
 
 // cycleLogFilter cycles through log level filters.
 func (s *ServicesScreen) cycleLogFilter() {
-	switch s.logFilter {
-	case "all":
-		s.logFilter = "error"
-	case "error":
-		s.logFilter = "warning"
-	case "warning":
-		s.logFilter = "info"
-	case "info":
-		s.logFilter = "debug"
-	case "debug":
-		s.logFilter = "all"
-	}
+	s.logFilter = nextLogFilter(s.logFilter)
 }
 
-// filterLogs filters the logs based on the current log filter.
+// filterLogs filters the logs based on the current log filter and search.
 func (s *ServicesScreen) filterLogs() string {
-	if s.logFilter == "all" || s.logs == "" {
-		return s.logs
-	}
-
-	lines := strings.Split(s.logs, "\n")
-	var filtered []string
-
-	levelKeywords := map[string][]string{
-		"error":   {"ERROR", "Err", "Failed", "failure"},
-		"warning": {"WARN", "Warning"},
-		"info":    {"INFO", "info"},
-		"debug":   {"DEBUG", "debug"},
-	}
-
-	keywords, ok := levelKeywords[s.logFilter]
-	if !ok {
-		return s.logs
-	}
+	return filterLogsBySearch(filterLogsByLevel(s.logs, s.logFilter), s.search.query)
+}
 
-	for _, line := range lines {
-		lower := strings.ToLower(line)
-		for _, kw := range keywords {
-			if strings.Contains(lower, strings.ToLower(kw)) {
-				filtered = append(filtered, line)
-				break
-			}
-		}
+// logMatchCount returns how many log lines match the search and log filter.
+func (s *ServicesScreen) logMatchCount() int {
+	logs := s.filterLogs()
+	if logs == "" {
+		return 0
 	}
-
-	return strings.Join(filtered, "\n")
+	return len(strings.Split(logs, "\n"))
 }
 
 // ShouldGoBack returns true if the screen should go back to the main menu.
@@ -806,6 +1097,23 @@ func (s *ServicesScreen) ResetGoBack() {
 	s.goBack = false
 }
 
+// StopAutoRefresh turns off periodic status refresh, e.g. when leaving the screen.
+func (s *ServicesScreen) StopAutoRefresh() {
+	s.autoRefresh.stop()
+}
+
+// IsSearchingLogs reports whether a log search is being typed, so typed
+// keys reach it instead of the global bindings.
+func (s *ServicesScreen) IsSearchingLogs() bool {
+	return s.mode == ServicesModeLogs && s.search.editing
+}
+
+// StopFollowingLogs stops following a unit's logs and kills journalctl,
+// e.g. when leaving the screen.
+func (s *ServicesScreen) StopFollowingLogs() {
+	s.follow.stop()
+}
+
 // View renders the screen.
 func (s *ServicesScreen) View() string {
 	switch s.mode {
@@ -817,6 +1125,15 @@ func (s *ServicesScreen) View() string {
 		return s.renderLogsView()
 	case ServicesModeActions:
 		return s.renderActionsView()
+	case ServicesModeBulk:
+		return s.renderBulkMenu()
+	case ServicesModeResults:
+		return s.renderBulkResults()
+	case ServicesModeConfirm:
+		if s.autostartConfirm != nil {
+			return s.autostartConfirm.View()
+		}
+		return s.renderBulkMenu()
 	default:
 		return s.renderListView()
 	}
@@ -828,6 +1145,12 @@ func (s *ServicesScreen) renderListView() string {
 
 	// Title with filter indicator
 	filterDesc := getFilterDescription(s.filter)
+	if s.sortBy == SortByStatus {
+		filterDesc += ", by status"
+	}
+	if s.groupByType {
+		filterDesc += ", grouped"
+	}
 	title := fmt.Sprintf("Service Status [%s]", filterDesc)
 	b.WriteString(components.Styles.Title.Render(title))
 	b.WriteString("\n\n")
@@ -875,6 +1198,11 @@ func (s *ServicesScreen) renderListView() string {
 		b.WriteString(s.renderServiceList())
 	}
 
+	// Auto-refresh state
+	b.WriteString("\n")
+	b.WriteString(components.Styles.HelpText.Render(s.autoRefresh.status()))
+	b.WriteString("\n")
+
 	// Help bar
 	b.WriteString("\n")
 	helpText := components.HelpBar(s.width, []components.HelpItem{
@@ -888,7 +1216,11 @@ func (s *ServicesScreen) renderListView() string {
 		{Key: "l", Desc: "logs"},
 		{Key: "a", Desc: "actions"},
 		{Key: "f", Desc: "filter"},
+		{Key: "o", Desc: "sort"},
+		{Key: "g", Desc: "group"},
+		{Key: "b", Desc: "bulk"},
 		{Key: "Ctrl+R", Desc: "refresh"},
+		{Key: "A", Desc: "auto-refresh"},
 		{Key: "Esc", Desc: "back"},
 	})
 	b.WriteString(helpText)
@@ -970,8 +1302,22 @@ func (s *ServicesScreen) renderServiceList() string {
 	b.WriteString(components.Styles.Subtitle.Render(header) + "\n")
 	b.WriteString(components.Styles.Subtitle.Render(strings.Repeat("─", s.width-4)) + "\n")
 
-	// Services
+	// Services, under a header per type when grouped. Headers are not part of
+	// filteredServices, so the cursor never lands on them.
 	for i, service := range s.filteredServices {
+		if s.groupByType && (i == 0 || s.filteredServices[i-1].Type != service.Type) {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			count := 0
+			for _, other := range s.filteredServices {
+				if other.Type == service.Type {
+					count++
+				}
+			}
+			b.WriteString(components.Styles.Subtitle.Render(fmt.Sprintf("%s (%d)", serviceGroupTitle(service.Type), count)) + "\n")
+		}
+
 		var line string
 		status := components.StatusIndicator(service.Status)
 		enabled := "no"
@@ -1150,8 +1496,24 @@ func (s *ServicesScreen) renderLogsView() string {
 	b.WriteString("\n\n")
 
 	// Filter indicator
-	b.WriteString(components.Styles.Subtitle.Render(fmt.Sprintf("Filter: %s", strings.ToUpper(s.logFilter))))
-	b.WriteString("\n\n")
+	indicator := fmt.Sprintf("Filter: %s", strings.ToUpper(s.logFilter))
+	if s.follow.enabled {
+		indicator += "  Following new lines"
+	}
+	matches := s.logMatchCount()
+	if s.search.query != "" {
+		if s.search.match > 0 && s.search.match <= matches {
+			indicator += fmt.Sprintf("  Search: %q (%d/%d)", s.search.query, s.search.match, matches)
+		} else {
+			indicator += fmt.Sprintf("  Search: %q (%d matches)", s.search.query, matches)
+		}
+	}
+	b.WriteString(components.Styles.Subtitle.Render(indicator))
+	b.WriteString("\n")
+	if s.search.editing {
+		b.WriteString(s.search.input.View())
+	}
+	b.WriteString("\n")
 
 	if s.logsLoading {
 		b.WriteString(components.Styles.Info.Render("Loading logs..."))
@@ -1161,49 +1523,59 @@ func (s *ServicesScreen) renderLogsView() string {
 	// Apply log filter
 	logs := s.filterLogs()
 
-	// Render logs with some basic highlighting
-	lines := strings.Split(logs, "\n")
-	logHeight := s.height - 12
+	if logs == "" && s.search.query != "" {
+		b.WriteString(components.Styles.Subtitle.Render("No matching lines"))
+		b.WriteString("\n")
+	}
 
-	if len(lines) > logHeight {
-		lines = lines[len(lines)-logHeight:]
+	// Render logs with some basic highlighting, keeping the selected match
+	// in view or else the newest lines
+	var lines []string
+	if logs != "" {
+		lines = strings.Split(logs, "\n")
+	}
+	logHeight := max(s.height-12, 1)
+	start := max(len(lines)-logHeight, 0)
+	selected := s.search.match - 1
+	if selected >= 0 && selected < len(lines) {
+		start = min(max(selected-logHeight/2, 0), start)
 	}
+	end := min(start+logHeight, len(lines))
 
-	for _, line := range lines {
-		rendered := s.renderLogLine(line)
-		b.WriteString(rendered)
+	for i, line := range lines[start:end] {
+		if start+i == selected {
+			b.WriteString(components.Styles.Selected.Render("▸ "))
+		}
+		b.WriteString(s.renderLogLine(line))
 		b.WriteString("\n")
 	}
 
 	// Help bar
 	b.WriteString("\n")
-	helpText := components.HelpBar(s.width, []components.HelpItem{
-		{Key: "f", Desc: "filter level"},
-		{Key: "Esc", Desc: "back"},
-	})
+	helpItems := []components.HelpItem{
+		{Key: "/", Desc: "search"},
+	}
+	if s.search.query != "" {
+		helpItems = append(helpItems, components.HelpItem{Key: "n/N", Desc: "next/prev match"})
+	}
+	helpItems = append(helpItems,
+		components.HelpItem{Key: "f", Desc: "filter level"},
+		components.HelpItem{Key: "F", Desc: "follow"},
+		components.HelpItem{Key: "Esc", Desc: "back"},
+	)
+	helpText := components.HelpBar(s.width, helpItems)
 	b.WriteString(helpText)
 
 	return b.String()
 }
 
 // renderLogLine renders a single log line with basic syntax highlighting.
+// Occurrences of the search query are highlighted.
 func (s *ServicesScreen) renderLogLine(line string) string {
-	lower := strings.ToLower(line)
-
-	if strings.Contains(lower, "error") || strings.Contains(lower, "fail") || strings.Contains(lower, "critical") {
-		return components.Styles.Error.Render(line)
+	if s.search.query != "" {
+		return highlightLogMatches(line, s.search.query)
 	}
-	if strings.Contains(lower, "warn") {
-		return components.Styles.Warning.Render(line)
-	}
-	if strings.Contains(lower, "info") {
-		return components.Styles.Info.Render(line)
-	}
-	if strings.Contains(lower, "debug") {
-		return components.Styles.Subtitle.Render(line)
-	}
-
-	return components.Styles.Normal.Render(line)
+	return renderLogLine(line)
 }
 
 // renderActionsView renders the actions menu.
@@ -1240,3 +1612,78 @@ func (s *ServicesScreen) renderActionsView() string {
 
 	return b.String()
 }
+
+// renderBulkMenu renders the bulk operations menu.
+func (s *ServicesScreen) renderBulkMenu() string {
+	var b strings.Builder
+
+	b.WriteString(components.Styles.Title.Render("Bulk Operations"))
+	b.WriteString("\n\n")
+
+	for i, action := range bulkActions {
+		if i == s.bulkCursor {
+			b.WriteString(components.Styles.MenuSelected.Render("▸ " + action))
+		} else {
+			b.WriteString(components.Styles.MenuItem.Render("  " + action))
+		}
+		b.WriteString("\n")
+	}
+
+	if s.bulkRunning {
+		b.WriteString("\n")
+		b.WriteString(components.Styles.Info.Render("Running..."))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpText := components.HelpBar(s.width, []components.HelpItem{
+		{Key: "↑/↓", Desc: "navigate"},
+		{Key: "Enter", Desc: "run"},
+		{Key: "Backspace", Desc: "cancel"},
+	})
+	b.WriteString(helpText)
+
+	return b.String()
+}
+
+// renderBulkResults renders the per-unit outcome of a bulk operation.
+func (s *ServicesScreen) renderBulkResults() string {
+	var b strings.Builder
+
+	succeeded, failed := systemd.CountResults(s.bulkResults)
+	b.WriteString(components.Styles.Title.Render(fmt.Sprintf("Bulk %s: %d succeeded, %d failed", s.bulkAction, succeeded, failed)))
+	b.WriteString("\n\n")
+
+	if len(s.bulkResults) == 0 {
+		b.WriteString(components.RenderInfo("No services needed this action."))
+		b.WriteString("\n")
+	} else {
+		end := min(s.bulkScrollY+s.bulkResultsHeight(), len(s.bulkResults))
+		for _, r := range s.bulkResults[s.bulkScrollY:end] {
+			retried := ""
+			if r.Attempts > 1 {
+				retried = " (retried)"
+			}
+			if r.OK() {
+				b.WriteString(components.Styles.Success.Render(fmt.Sprintf("✓ %s%s", r.Unit, retried)))
+			} else {
+				b.WriteString(components.Styles.Error.Render(fmt.Sprintf("✗ %s%s: %s", r.Unit, retried, r.Error)))
+			}
+			b.WriteString("\n")
+		}
+		if s.maxBulkScroll() > 0 {
+			b.WriteString(components.Styles.HelpText.Render(
+				fmt.Sprintf("[%d/%d] ↑/↓ to scroll", s.bulkScrollY+1, s.maxBulkScroll()+1)))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	helpText := components.HelpBar(s.width, []components.HelpItem{
+		{Key: "↑/↓", Desc: "scroll"},
+		{Key: "Enter", Desc: "close"},
+	})
+	b.WriteString(helpText)
+
+	return b.String()
+}
@@ -2,6 +2,7 @@ package screens
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -299,6 +300,7 @@ func TestServicesScreen_LoadServices(t *testing.T) {
 	cfg := createTestConfigForServices()
 	screen.cfg = cfg
 	screen.manager = &systemd.Manager{}
+	screen.generator = &systemd.MockGenerator{}
 
 	// Call loadServices
 	msg := screen.loadServices()
@@ -1848,3 +1850,258 @@ func TestServicesScreen_SelectedServiceAfterFilter(t *testing.T) {
 		t.Errorf("selectedService Type = %q, want 'mount'", screen.selectedService.Type)
 	}
 }
+
+func TestServicesScreen_AutoRefreshTick(t *testing.T) {
+	screen := NewServicesScreen()
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	if !screen.autoRefresh.enabled {
+		t.Fatal("auto-refresh should be enabled after pressing A")
+	}
+
+	// Force the countdown to the last second so the next tick refreshes
+	screen.autoRefresh.remaining = autoRefreshTick
+	_, cmd := screen.Update(AutoRefreshTickMsg{Screen: "services", Gen: screen.autoRefresh.gen})
+	if cmd == nil {
+		t.Error("a due tick should return the refresh and next tick")
+	}
+
+	screen.StopAutoRefresh()
+	_, cmd = screen.Update(AutoRefreshTickMsg{Screen: "services", Gen: screen.autoRefresh.gen - 1})
+	if cmd != nil {
+		t.Error("stale ticks should not schedule more work")
+	}
+}
+
+func TestServicesScreen_BulkMenu(t *testing.T) {
+	screen := createTestServicesScreen()
+	screen.SetSize(100, 40)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if screen.mode != ServicesModeBulk {
+		t.Fatalf("mode = %q, want bulk", screen.mode)
+	}
+	if !strings.Contains(screen.View(), "Restart Failed") {
+		t.Error("bulk menu should list its actions")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if screen.mode != ServicesModeList {
+		t.Errorf("mode = %q, want list after cancelling", screen.mode)
+	}
+}
+
+func TestServicesScreen_BulkRestartFailed(t *testing.T) {
+	mock := &systemd.MockManager{
+		ListServicesResult: []systemd.ServiceStatus{
+			{Name: "rclone-mount-a", State: "failed"},
+			{Name: "rclone-sync-b", State: "failed"},
+			{Name: "rclone-mount-c", State: "active", Active: true},
+		},
+	}
+	screen := createTestServicesScreen()
+	screen.SetSize(100, 40)
+	screen.manager = mock
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("selecting Restart Failed should return a command")
+	}
+	screen.Update(cmd())
+
+	if screen.mode != ServicesModeResults {
+		t.Fatalf("mode = %q, want results", screen.mode)
+	}
+	if !mock.Called("Restart", "rclone-mount-a.service") || !mock.Called("Restart", "rclone-sync-b.service") {
+		t.Errorf("failed units should be restarted, calls: %v", mock.Calls)
+	}
+	if mock.Called("Restart", "rclone-mount-c.service") {
+		t.Error("active units should not be restarted")
+	}
+	view := screen.View()
+	for _, want := range []string{"Bulk restart: 2 succeeded, 0 failed", "✓ rclone-mount-a.service"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.mode != ServicesModeList {
+		t.Errorf("mode = %q, want list after closing results", screen.mode)
+	}
+}
+
+func TestServicesScreen_BulkDisableAutostart(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	mock := &systemd.MockManager{}
+	screen := createTestServicesScreen()
+	screen.SetSize(100, 40)
+	screen.manager = mock
+	screen.generator = &systemd.Generator{}
+	screen.cfg = &config.Config{
+		Mounts: []models.MountConfig{{ID: "a1b2c3d4", Name: "gdrive", AutoStart: true, Enabled: true}},
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.mode != ServicesModeConfirm || !strings.Contains(screen.View(), "Disable auto-start for all 1 mounts and 0 sync jobs?") {
+		t.Fatalf("mode = %q, want a confirmation, view:\n%s", screen.mode, screen.View())
+	}
+
+	// Cancel is selected first
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.mode != ServicesModeBulk || len(mock.Calls) != 0 || !screen.cfg.Mounts[0].AutoStart {
+		t.Fatalf("cancelling should change nothing, mode = %q, calls: %v", screen.mode, mock.Calls)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("confirming should return a command")
+	}
+	screen.Update(cmd())
+
+	if screen.cfg.Mounts[0].AutoStart || !screen.cfg.Mounts[0].Enabled {
+		t.Error("auto-start should be off in the config, with the mount still enabled")
+	}
+	if !mock.Called("Disable", "rclone-mount-a1b2c3d4.service") {
+		t.Errorf("the mount service should be disabled, calls: %v", mock.Calls)
+	}
+	if screen.mode != ServicesModeResults || !strings.Contains(screen.statusMessage, "1 mounts and 0 sync jobs changed") {
+		t.Errorf("mode = %q, status = %q", screen.mode, screen.statusMessage)
+	}
+}
+
+func TestServicesScreen_BulkResultsPartialFailure(t *testing.T) {
+	screen := createTestServicesScreen()
+	screen.SetSize(100, 40)
+
+	screen.Update(BulkActionResultMsg{
+		Action: systemd.BatchStart,
+		Results: []systemd.ActionResult{
+			{Unit: "rclone-mount-a.service", Action: systemd.BatchStart, Attempts: 2},
+			{Unit: "rclone-mount-b.service", Action: systemd.BatchStart, Attempts: 1, Err: errors.New("boom"), Error: "boom"},
+		},
+	})
+
+	view := screen.View()
+	for _, want := range []string{"1 succeeded, 1 failed", "✓ rclone-mount-a.service (retried)", "✗ rclone-mount-b.service: boom"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+}
+
+func TestServicesScreen_BulkResultsScroll(t *testing.T) {
+	screen := createTestServicesScreen()
+	screen.SetSize(100, 20)
+
+	results := make([]systemd.ActionResult, 30)
+	for i := range results {
+		results[i] = systemd.ActionResult{Unit: fmt.Sprintf("rclone-mount-%d.service", i), Attempts: 1}
+	}
+	screen.Update(BulkActionResultMsg{Action: systemd.BatchStart, Results: results})
+
+	for i := 0; i < 50; i++ {
+		screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if screen.bulkScrollY != screen.maxBulkScroll() {
+		t.Errorf("bulkScrollY = %d, want clamped to %d", screen.bulkScrollY, screen.maxBulkScroll())
+	}
+	if !strings.Contains(screen.View(), "rclone-mount-29.service") {
+		t.Error("last result should be visible when scrolled to the end")
+	}
+}
+
+func TestServicesScreen_BulkListError(t *testing.T) {
+	screen := createTestServicesScreen()
+	screen.manager = &systemd.MockManager{ListServicesErr: errors.New("no bus")}
+
+	msg := screen.doBulkAction(systemd.BatchStart, systemd.InactiveMountUnits)()
+	screen.Update(msg)
+
+	if screen.mode != ServicesModeList || screen.statusMessageType != "error" {
+		t.Errorf("mode = %q, status type = %q; want list with an error", screen.mode, screen.statusMessageType)
+	}
+}
+
+// serviceNames returns the display names of the filtered services in order.
+func serviceNames(s *ServicesScreen) []string {
+	names := make([]string, len(s.filteredServices))
+	for i, service := range s.filteredServices {
+		names[i] = service.DisplayName
+	}
+	return names
+}
+
+func TestServicesScreen_GroupAndSort(t *testing.T) {
+	screen := NewServicesScreen()
+	screen.SetSize(100, 40)
+	screen.services = []ServiceInfo{
+		{Name: "rclone-sync-backup", DisplayName: "backup", Type: "sync", Status: "active"},
+		{Name: "rclone-mount-dropbox", DisplayName: "dropbox", Type: "mount", Status: "inactive"},
+		{Name: "rclone-mount-gdrive", DisplayName: "gdrive", Type: "mount", Status: "failed"},
+		{Name: "rclone-sync-photos", DisplayName: "photos", Type: "sync", Status: "failed"},
+	}
+	screen.applyFilter()
+
+	if got := strings.Join(serviceNames(screen), ","); got != "backup,dropbox,gdrive,photos" {
+		t.Errorf("flat view = %s, want the loaded order", got)
+	}
+	if strings.Contains(screen.View(), "Mounts (2)") {
+		t.Error("flat view should not show group headers")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if got := strings.Join(serviceNames(screen), ","); got != "dropbox,gdrive,backup,photos" {
+		t.Errorf("grouped view = %s, want mounts then sync jobs", got)
+	}
+	view := screen.View()
+	mounts, syncJobs := strings.Index(view, "Mounts (2)"), strings.Index(view, "Sync Jobs (2)")
+	if mounts < 0 || syncJobs < mounts {
+		t.Errorf("grouped view should show the Mounts header before the Sync Jobs header:\n%s", view)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if got := strings.Join(serviceNames(screen), ","); got != "gdrive,dropbox,photos,backup" {
+		t.Errorf("grouped by status = %s, want failed first within each group", got)
+	}
+	if !strings.Contains(screen.View(), "by status, grouped") {
+		t.Error("title should show the sort order and grouping")
+	}
+
+	// Moving down crosses from the last mount to the first sync job without
+	// stopping on the header in between
+	screen.cursor = 1
+	screen.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if screen.filteredServices[screen.cursor].DisplayName != "photos" {
+		t.Errorf("cursor after down = %q, want photos", screen.filteredServices[screen.cursor].DisplayName)
+	}
+
+	// The selected service stays selected when the grouping changes
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if screen.filteredServices[screen.cursor].DisplayName != "photos" {
+		t.Errorf("cursor after ungrouping = %q, want photos", screen.filteredServices[screen.cursor].DisplayName)
+	}
+}
+
+func TestServicesScreen_LoadSystemdStatusUsesManager(t *testing.T) {
+	screen := NewServicesScreen()
+	screen.manager = &systemd.MockManager{
+		IsSystemdAvailableResult: true,
+		ListUnitsResult: map[string][]string{
+			"failed":         {"rclone-mount-a1b2c3d4.service", "other.service"},
+			"service active": {"rclone-mount-a1b2c3d4.service", "dbus.service"},
+			"timer active":   {"rclone-sync-e5f6g7h8.timer", "rclone-sync-f6g7h8i9.timer"},
+		},
+	}
+
+	status := screen.loadSystemdStatus()
+	if status.FailedUnits != 2 || status.ActiveServices != 1 || status.ActiveTimers != 2 {
+		t.Errorf("status = %+v, want 2 failed, 1 rclone service and 2 rclone timers", status)
+	}
+}
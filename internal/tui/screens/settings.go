@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
 	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
 )
 
@@ -30,6 +33,10 @@ type SettingsScreen struct {
 	message     string
 	messageType string // "success" or "error"
 
+	// Inline edit state for string settings
+	inlineEditing bool
+	inlineInput   textinput.Model
+
 	// Action handling state
 	showingActions    bool
 	actionCursor      int
@@ -39,7 +46,17 @@ type SettingsScreen struct {
 	showingConfirm    bool
 	showingFilePicker bool
 	pendingImportPath string
+	pendingReset      bool
 	exportPath        string
+	exportPortable    bool
+	pickingConfigDir  bool
+	pendingConfigDir  string
+}
+
+// ConfigDirSelectedMsg is sent when a different config directory is chosen.
+// The app handles it by reloading config and services from the new location.
+type ConfigDirSelectedMsg struct {
+	Path string
 }
 
 // ActionItem represents an action item in settings.
@@ -98,14 +115,14 @@ func NewSettingsScreen() *SettingsScreen {
 			},
 			{
 				Name:        "Default Transfers",
-				Description: "Number of parallel transfers for sync jobs",
+				Description: "Number of parallel transfers for sync jobs (0 uses rclone's default)",
 				Key:         "t",
 				settingType: "int",
 				configKey:   "defaults.sync.transfers",
 			},
 			{
 				Name:        "Default Checkers",
-				Description: "Number of checkers for sync jobs",
+				Description: "Number of checkers for sync jobs (0 uses rclone's default)",
 				Key:         "c",
 				settingType: "int",
 				configKey:   "defaults.sync.checkers",
@@ -131,6 +148,108 @@ func NewSettingsScreen() *SettingsScreen {
 				settingType: "string",
 				configKey:   "settings.editor",
 			},
+			{
+				Name:        "Auto-Refresh Interval",
+				Description: "How often status screens refresh when auto-refresh is on (e.g., 30s)",
+				Key:         "ar",
+				settingType: "string",
+				configKey:   "settings.auto_refresh_interval",
+			},
+			{
+				Name:        "Fixed Width",
+				Description: "Cap the width of list screens to stop jitter (0 for terminal width)",
+				Key:         "fw",
+				settingType: "int",
+				configKey:   "settings.fixed_width",
+			},
+			{
+				Name:        "Watch Config File",
+				Description: "Reload config.yaml when edited elsewhere and offer to regenerate units",
+				Key:         "wc",
+				settingType: "select",
+				selectOpts:  []string{"off", "on"},
+				configKey:   "settings.watch_config",
+			},
+			{
+				Name:        "Action Log",
+				Description: "Record config saves, unit writes and service actions to actions.log",
+				Key:         "al",
+				settingType: "select",
+				selectOpts:  []string{"off", "on"},
+				configKey:   "settings.action_log",
+			},
+			{
+				Name:        "Config Backups",
+				Description: "How many previous versions of config.yaml to keep",
+				Key:         "bk",
+				settingType: "int",
+				configKey:   "settings.backup_count",
+			},
+			{
+				Name:        "Remote Check Timeout",
+				Description: "How long startup checks and doctor wait for each remote (e.g., 10s)",
+				Key:         "rt",
+				settingType: "string",
+				configKey:   "settings.remote_check_timeout",
+			},
+			{
+				Name:        "Unit Scope",
+				Description: "Install units for this user, or system-wide (needs root; applies on restart)",
+				Key:         "us",
+				settingType: "select",
+				selectOpts:  []string{"user", "system"},
+				configKey:   "settings.scope",
+			},
+			{
+				Name:        "Theme",
+				Description: "Colors for dark or light terminals, or none (NO_COLOR forces none)",
+				Key:         "th",
+				settingType: "select",
+				selectOpts:  components.Themes,
+				configKey:   "settings.theme",
+			},
+			{
+				Name:        "Mount Extra Flags",
+				Description: "Flags added to every mount before its own (e.g., --user-agent=x)",
+				Key:         "mf",
+				settingType: "string",
+				configKey:   "defaults.mount.extra_flags",
+			},
+			{
+				Name:        "Sync Extra Flags",
+				Description: "Flags added to every sync job before its own",
+				Key:         "sf",
+				settingType: "string",
+				configKey:   "defaults.sync.extra_flags",
+			},
+			{
+				Name:        "Default VFS Cache Max Size",
+				Description: "Cache size limit for new mounts (e.g., 10G, empty for rclone's default)",
+				Key:         "cs",
+				settingType: "string",
+				configKey:   "defaults.mount.vfs_cache_max_size",
+			},
+			{
+				Name:        "Default VFS Cache Max Age",
+				Description: "Cache age limit for new mounts (e.g., 24h, empty for rclone's default)",
+				Key:         "ca",
+				settingType: "string",
+				configKey:   "defaults.mount.vfs_cache_max_age",
+			},
+			{
+				Name:        "Default Dir Cache Time",
+				Description: "How long new mounts cache directory listings (e.g., 1h, empty for rclone's default)",
+				Key:         "dc",
+				settingType: "string",
+				configKey:   "defaults.mount.dir_cache_time",
+			},
+			{
+				Name:        "Default Attr Timeout",
+				Description: "How long new mounts cache file attributes (e.g., 10s, empty for rclone's default)",
+				Key:         "at",
+				settingType: "string",
+				configKey:   "defaults.mount.attr_timeout",
+			},
 		},
 		actions: []ActionItem{
 			{
@@ -139,12 +258,30 @@ func NewSettingsScreen() *SettingsScreen {
 				Key:         "x",
 				actionType:  "export",
 			},
+			{
+				Name:        "Export Portable Template",
+				Description: "Export with $HOME paths and no machine-specific settings",
+				Key:         "X",
+				actionType:  "export_portable",
+			},
 			{
 				Name:        "Import Configuration",
 				Description: "Load mounts and sync jobs from a file",
 				Key:         "i",
 				actionType:  "import",
 			},
+			{
+				Name:        "Open Config Directory",
+				Description: "Switch to a different config for this session",
+				Key:         "o",
+				actionType:  "switch_config",
+			},
+			{
+				Name:        "Reset to Defaults",
+				Description: "Restore the built-in settings; mounts and sync jobs are kept",
+				Key:         "ctrl+d",
+				actionType:  "reset_defaults",
+			},
 		},
 	}
 }
@@ -191,6 +328,38 @@ func (s *SettingsScreen) getConfigValue(key string) string {
 		return s.config.Settings.DefaultMountDir
 	case "settings.editor":
 		return s.config.Settings.Editor
+	case "settings.auto_refresh_interval":
+		return s.config.Settings.AutoRefreshInterval
+	case "settings.fixed_width":
+		return fmt.Sprintf("%d", s.config.Settings.FixedWidth)
+	case "settings.watch_config":
+		return onOff(s.config.Settings.WatchConfig)
+	case "settings.action_log":
+		return onOff(s.config.Settings.ActionLog)
+	case "settings.backup_count":
+		return fmt.Sprintf("%d", s.config.Settings.BackupDepth())
+	case "settings.remote_check_timeout":
+		return s.config.Settings.RemoteCheckDuration().String()
+	case "settings.scope":
+		scope, _ := systemd.ParseScope(s.config.Settings.Scope)
+		return string(scope)
+	case "settings.theme":
+		if s.config.Settings.Theme == "" {
+			return components.ThemeDark
+		}
+		return s.config.Settings.Theme
+	case "defaults.mount.extra_flags":
+		return s.config.Defaults.Mount.ExtraFlags
+	case "defaults.sync.extra_flags":
+		return s.config.Defaults.Sync.ExtraFlags
+	case "defaults.mount.vfs_cache_max_size":
+		return s.config.Defaults.Mount.VFSCacheMaxSize
+	case "defaults.mount.vfs_cache_max_age":
+		return s.config.Defaults.Mount.VFSCacheMaxAge
+	case "defaults.mount.dir_cache_time":
+		return s.config.Defaults.Mount.DirCacheTime
+	case "defaults.mount.attr_timeout":
+		return s.config.Defaults.Mount.AttrTimeout
 	default:
 		return ""
 	}
@@ -229,6 +398,109 @@ func (s *SettingsScreen) setConfigValue(key, value string) error {
 		s.config.Settings.DefaultMountDir = value
 	case "settings.editor":
 		s.config.Settings.Editor = value
+	case "settings.auto_refresh_interval":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration: %w", err)
+		}
+		if d < time.Second {
+			return fmt.Errorf("interval must be at least 1s")
+		}
+		s.config.Settings.AutoRefreshInterval = value
+	case "settings.fixed_width":
+		var width int
+		if _, err := fmt.Sscanf(value, "%d", &width); err != nil {
+			return fmt.Errorf("invalid number: %w", err)
+		}
+		if width < 0 {
+			return fmt.Errorf("width cannot be negative")
+		}
+		s.config.Settings.FixedWidth = width
+	case "settings.watch_config":
+		on, err := parseOnOff(value)
+		if err != nil {
+			return err
+		}
+		s.config.Settings.WatchConfig = on
+	case "settings.action_log":
+		on, err := parseOnOff(value)
+		if err != nil {
+			return err
+		}
+		s.config.Settings.ActionLog = on
+	case "settings.backup_count":
+		var count int
+		if _, err := fmt.Sscanf(value, "%d", &count); err != nil {
+			return fmt.Errorf("invalid number: %w", err)
+		}
+		if count < 1 {
+			return fmt.Errorf("at least one backup must be kept")
+		}
+		s.config.Settings.BackupCount = count
+	case "settings.remote_check_timeout":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration: %w", err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("timeout must be greater than 0")
+		}
+		s.config.Settings.RemoteCheckTimeout = value
+	case "settings.scope":
+		scope, err := systemd.ParseScope(value)
+		if err != nil {
+			return err
+		}
+		s.config.Settings.Scope = string(scope)
+	case "settings.theme":
+		switch value {
+		case components.ThemeDark, components.ThemeLight, components.ThemeNone:
+			s.config.Settings.Theme = value
+		default:
+			return fmt.Errorf("invalid theme %q (use %s)", value, strings.Join(components.Themes, ", "))
+		}
+	case "defaults.mount.extra_flags":
+		if err := systemd.ValidateExtraArgs(value); err != nil {
+			return err
+		}
+		s.config.Defaults.Mount.ExtraFlags = strings.TrimSpace(value)
+	case "defaults.sync.extra_flags":
+		if err := systemd.ValidateExtraArgs(value); err != nil {
+			return err
+		}
+		s.config.Defaults.Sync.ExtraFlags = strings.TrimSpace(value)
+	case "defaults.mount.vfs_cache_max_size":
+		value = strings.TrimSpace(value)
+		if value != "" {
+			if err := components.ValidateBufferSize(value); err != nil {
+				return err
+			}
+		}
+		s.config.Defaults.Mount.VFSCacheMaxSize = value
+	case "defaults.mount.vfs_cache_max_age":
+		value = strings.TrimSpace(value)
+		if value != "" {
+			if err := components.ValidateDuration(value); err != nil {
+				return err
+			}
+		}
+		s.config.Defaults.Mount.VFSCacheMaxAge = value
+	case "defaults.mount.dir_cache_time":
+		value = strings.TrimSpace(value)
+		if value != "" {
+			if err := components.ValidateDuration(value); err != nil {
+				return err
+			}
+		}
+		s.config.Defaults.Mount.DirCacheTime = value
+	case "defaults.mount.attr_timeout":
+		value = strings.TrimSpace(value)
+		if value != "" {
+			if err := components.ValidateDuration(value); err != nil {
+				return err
+			}
+		}
+		s.config.Defaults.Mount.AttrTimeout = value
 	default:
 		return fmt.Errorf("unknown config key: %s", key)
 	}
@@ -236,6 +508,25 @@ func (s *SettingsScreen) setConfigValue(key, value string) error {
 	return nil
 }
 
+// onOff renders a boolean setting.
+func onOff(v bool) string {
+	if v {
+		return "on"
+	}
+	return "off"
+}
+
+// parseOnOff parses a boolean setting rendered by onOff.
+func parseOnOff(value string) (bool, error) {
+	switch value {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid value %q: must be on or off", value)
+}
+
 // SetSize sets the screen dimensions.
 func (s *SettingsScreen) SetSize(width, height int) {
 	s.width = width
@@ -265,6 +556,10 @@ func (s *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return s.updateForm(msg)
 	}
 
+	if s.inlineEditing {
+		return s.updateInlineEdit(msg)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -302,10 +597,20 @@ func (s *SettingsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return s.executeAction()
 			}
 			return s.startEditing()
+		case "e":
+			if !s.showingActions {
+				return s.startInlineEdit()
+			}
 		case "x":
 			return s.startExport()
+		case "X":
+			return s.startPortableExport()
 		case "i":
 			return s.startImport()
+		case "o":
+			return s.startConfigSwitch()
+		case "ctrl+d":
+			return s.showResetConfirm()
 		case "esc":
 			if s.showingActions {
 				s.showingActions = false
@@ -409,37 +714,108 @@ func (s *SettingsScreen) startEditing() (tea.Model, tea.Cmd) {
 
 // submitForm submits the form and saves the setting.
 func (s *SettingsScreen) submitForm() (tea.Model, tea.Cmd) {
-	setting := s.settings[s.editIndex]
+	s.commitSetting(s.editIndex)
+
+	s.editing = false
+	s.form = nil
+	return s, nil
+}
+
+// commitSetting validates and saves the current value of a setting,
+// reporting the outcome in the status message.
+func (s *SettingsScreen) commitSetting(index int) error {
+	setting := s.settings[index]
 
 	// Update the config
 	if err := s.setConfigValue(setting.configKey, setting.Value); err != nil {
 		s.message = fmt.Sprintf("Error: %v", err)
 		s.messageType = "error"
-	} else {
-		// Save the config
-		if s.config != nil {
-			if err := s.config.Save(); err != nil {
-				s.message = fmt.Sprintf("Failed to save config: %v", err)
-				s.messageType = "error"
-			} else {
-				s.message = fmt.Sprintf("Setting '%s' updated to '%s'", setting.Name, setting.Value)
-				s.messageType = "success"
+		return err
+	}
+
+	// Save the config
+	if s.config != nil {
+		if err := s.config.Save(); err != nil {
+			s.message = fmt.Sprintf("Failed to save config: %v", err)
+			s.messageType = "error"
+			return err
+		}
+		s.message = fmt.Sprintf("Setting '%s' updated to '%s'", setting.Name, setting.Value)
+		s.messageType = "success"
+	}
+
+	return nil
+}
+
+// startInlineEdit edits a string setting in place in the list row.
+// Other setting types open the full form instead.
+func (s *SettingsScreen) startInlineEdit() (tea.Model, tea.Cmd) {
+	if s.cursor < 0 || s.cursor >= len(s.settings) {
+		return s, nil
+	}
+
+	setting := s.settings[s.cursor]
+	if setting.settingType != "string" {
+		return s.startEditing()
+	}
+
+	input := textinput.New()
+	input.Prompt = ""
+	input.SetValue(setting.Value)
+	input.CursorEnd()
+
+	s.inlineInput = input
+	s.inlineEditing = true
+	s.editIndex = s.cursor
+	return s, s.inlineInput.Focus()
+}
+
+// updateInlineEdit handles key presses while a setting is edited in place.
+// Enter commits the value, Esc restores the previous one.
+func (s *SettingsScreen) updateInlineEdit(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc":
+			s.inlineEditing = false
+			s.inlineInput.Blur()
+			return s, nil
+		case "enter":
+			previous := s.settings[s.editIndex].Value
+			s.settings[s.editIndex].Value = s.inlineInput.Value()
+			if err := s.commitSetting(s.editIndex); err != nil {
+				// Keep editing so the value can be corrected
+				s.settings[s.editIndex].Value = previous
+				return s, nil
 			}
+			s.inlineEditing = false
+			s.inlineInput.Blur()
+			return s, nil
 		}
 	}
 
-	s.editing = false
-	s.form = nil
-	return s, nil
+	var cmd tea.Cmd
+	s.inlineInput, cmd = s.inlineInput.Update(msg)
+	return s, cmd
 }
 
 // startExport initiates the export configuration flow.
 func (s *SettingsScreen) startExport() (tea.Model, tea.Cmd) {
+	return s.openExportPicker("Export Configuration", false)
+}
+
+// startPortableExport initiates exporting a portable config template.
+func (s *SettingsScreen) startPortableExport() (tea.Model, tea.Cmd) {
+	return s.openExportPicker("Export Portable Template", true)
+}
+
+// openExportPicker shows the file picker for choosing an export file.
+func (s *SettingsScreen) openExportPicker(title string, portable bool) (tea.Model, tea.Cmd) {
 	s.exportPath = ""
+	s.exportPortable = portable
 	s.form = huh.NewForm(
 		huh.NewGroup(
 			components.NewEnhancedFilePicker().
-				Title("Export Configuration").
+				Title(title).
 				Description("Select a directory and enter filename with .yaml or .json extension. Use quick jump keys: ~ (home), / (root), m (mnt), M (media), r (recent), Backspace (parent).").
 				DirAllowed(true).
 				FileAllowed(true).
@@ -471,6 +847,26 @@ func (s *SettingsScreen) startImport() (tea.Model, tea.Cmd) {
 	return s, s.form.Init()
 }
 
+// startConfigSwitch initiates picking a different config directory.
+func (s *SettingsScreen) startConfigSwitch() (tea.Model, tea.Cmd) {
+	s.pendingConfigDir = ""
+	s.form = huh.NewForm(
+		huh.NewGroup(
+			components.NewEnhancedFilePicker().
+				Title("Open Config Directory").
+				Description("Select a config directory, or a file inside it. The config is read from <dir>/rclone-mount-sync/config.yaml. Use quick jump keys: ~ (home), / (root), m (mnt), M (media), r (recent), Backspace (parent).").
+				DirAllowed(true).
+				FileAllowed(true).
+				CurrentDirectory(components.ExpandHome("~")).
+				Value(&s.pendingConfigDir),
+		),
+	)
+	s.form.WithTheme(huh.ThemeBase16())
+	s.showingFilePicker = true
+	s.pickingConfigDir = true
+	return s, s.form.Init()
+}
+
 // updateFilePicker handles file picker updates.
 func (s *SettingsScreen) updateFilePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -479,7 +875,10 @@ func (s *SettingsScreen) updateFilePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 			s.form = nil
 			s.showingFilePicker = false
 			s.exportPath = ""
+			s.exportPortable = false
 			s.pendingImportPath = ""
+			s.pickingConfigDir = false
+			s.pendingConfigDir = ""
 			return s, nil
 		}
 	}
@@ -489,6 +888,16 @@ func (s *SettingsScreen) updateFilePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	if s.form.State == huh.StateCompleted {
 		s.showingFilePicker = false
+		if s.pickingConfigDir {
+			path := components.ExpandHome(s.pendingConfigDir)
+			s.pickingConfigDir = false
+			s.pendingConfigDir = ""
+			s.form = nil
+			if path == "" {
+				return s, nil
+			}
+			return s, func() tea.Msg { return ConfigDirSelectedMsg{Path: path} }
+		}
 		if s.exportPath != "" {
 			exportPath := s.exportPath
 			s.exportPath = ""
@@ -512,15 +921,21 @@ func (s *SettingsScreen) completeExport(filePath string) (tea.Model, tea.Cmd) {
 		return s, nil
 	}
 
-	if err := s.config.ExportConfig(filePath); err != nil {
+	export, what := s.config.ExportConfig, "Configuration"
+	if s.exportPortable {
+		export, what = s.config.ExportPortable, "Portable template"
+	}
+
+	if err := export(filePath); err != nil {
 		s.message = fmt.Sprintf("Export failed: %v", err)
 		s.messageType = "error"
 	} else {
-		s.message = fmt.Sprintf("Configuration exported to %s", filePath)
+		s.message = fmt.Sprintf("%s exported to %s", what, filePath)
 		s.messageType = "success"
 	}
 
 	s.exportPath = ""
+	s.exportPortable = false
 	return s, nil
 }
 
@@ -573,23 +988,56 @@ func (s *SettingsScreen) updateImportModeForm(msg tea.Msg) (tea.Model, tea.Cmd)
 
 	if s.form.State == huh.StateCompleted {
 		s.showingImportMode = false
-		if s.importMode == "replace" {
-			return s.showReplaceConfirm()
-		}
-		return s.executeImport()
+		return s.previewImport()
 	}
 
 	return s, cmd
 }
 
-// showReplaceConfirm shows a confirmation dialog for replace mode.
-func (s *SettingsScreen) showReplaceConfirm() (tea.Model, tea.Cmd) {
+// previewImport works out what the pending import would change and asks
+// the user to confirm it.
+func (s *SettingsScreen) previewImport() (tea.Model, tea.Cmd) {
+	if s.config == nil {
+		s.message = "No configuration to import into"
+		s.messageType = "error"
+		s.pendingImportPath = ""
+		return s, nil
+	}
+
+	plan, err := s.config.PreviewImport(s.pendingImportPath, s.selectedImportMode())
+	if err != nil {
+		s.message = fmt.Sprintf("Import failed: %v", err)
+		s.messageType = "error"
+		s.pendingImportPath = ""
+		return s, nil
+	}
+	return s.showImportConfirm(plan)
+}
+
+// selectedImportMode returns the import mode chosen in the mode form.
+func (s *SettingsScreen) selectedImportMode() config.ImportMode {
+	if s.importMode == "replace" {
+		return config.ImportModeReplace
+	}
+	return config.ImportModeMerge
+}
+
+// showImportConfirm shows the changes an import would make and asks for
+// confirmation.
+func (s *SettingsScreen) showImportConfirm(plan *config.ImportPlan) (tea.Model, tea.Cmd) {
+	title := "Merge Configuration?"
+	description := importPlanSummary(plan)
+	if plan.Mode == config.ImportModeReplace {
+		title = "Replace Configuration?"
+		description += "\n\nThis action cannot be undone."
+	}
+
 	confirm := false
 	s.confirmDialog = huh.NewForm(
 		huh.NewGroup(
 			huh.NewConfirm().
-				Title("Replace Configuration?").
-				Description("This will replace ALL existing mounts and sync jobs. This action cannot be undone.").
+				Title(title).
+				Description(description).
 				Value(&confirm),
 		),
 	)
@@ -606,6 +1054,7 @@ func (s *SettingsScreen) updateConfirmDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
 			s.showingConfirm = false
 			s.confirmDialog = nil
 			s.pendingImportPath = ""
+			s.pendingReset = false
 			return s, nil
 		}
 	}
@@ -617,6 +1066,15 @@ func (s *SettingsScreen) updateConfirmDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
 		s.showingConfirm = false
 		confirm := s.confirmDialog.GetBool("confirm")
 		s.confirmDialog = nil
+		if s.pendingReset {
+			s.pendingReset = false
+			if confirm {
+				return s.executeReset()
+			}
+			s.message = "Reset cancelled"
+			s.messageType = "info"
+			return s, nil
+		}
 		if confirm {
 			return s.executeImport()
 		}
@@ -629,6 +1087,72 @@ func (s *SettingsScreen) updateConfirmDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return s, cmd
 }
 
+// showResetConfirm asks before resetting every setting to its default.
+func (s *SettingsScreen) showResetConfirm() (tea.Model, tea.Cmd) {
+	if s.config == nil {
+		s.message = "No configuration to reset"
+		s.messageType = "error"
+		return s, nil
+	}
+
+	confirm := false
+	s.confirmDialog = huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Key("confirm").
+				Title("Reset Settings to Defaults?").
+				Description("Every setting and default on this screen returns to its built-in value.\nMounts and sync jobs are kept; a backup of the config is saved.").
+				Value(&confirm),
+		),
+	)
+	s.confirmDialog.WithTheme(huh.ThemeBase16())
+	s.showingConfirm = true
+	s.pendingReset = true
+	return s, s.confirmDialog.Init()
+}
+
+// executeReset resets the settings and defaults, saves the config and
+// shows the restored values.
+func (s *SettingsScreen) executeReset() (tea.Model, tea.Cmd) {
+	s.config.ResetToDefaults()
+	s.updateSettingValues()
+	if err := s.config.Save(); err != nil {
+		s.message = fmt.Sprintf("Reset but failed to save: %v", err)
+		s.messageType = "error"
+		return s, nil
+	}
+	s.message = "Settings reset to defaults"
+	s.messageType = "success"
+	return s, nil
+}
+
+// importPlanSummary lists the mounts and sync jobs an import adds, skips and
+// removes, one line per kind of change.
+func importPlanSummary(plan *config.ImportPlan) string {
+	var lines []string
+	describe := func(verb string, entries []config.ImportEntry) {
+		if len(entries) == 0 {
+			return
+		}
+		names := make([]string, len(entries))
+		for i, e := range entries {
+			label := e.Kind
+			if e.Reason != "" {
+				label += ", " + e.Reason
+			}
+			names[i] = fmt.Sprintf("%s (%s)", e.Name, label)
+		}
+		lines = append(lines, fmt.Sprintf("%s %d: %s", verb, len(entries), strings.Join(names, ", ")))
+	}
+	describe("Remove", plan.Removed)
+	describe("Add", plan.Added)
+	describe("Skip", plan.Skipped)
+	if len(lines) == 0 {
+		return "No mounts or sync jobs would change."
+	}
+	return strings.Join(lines, "\n")
+}
+
 // executeImport executes the import operation.
 func (s *SettingsScreen) executeImport() (tea.Model, tea.Cmd) {
 	if s.config == nil {
@@ -638,14 +1162,7 @@ func (s *SettingsScreen) executeImport() (tea.Model, tea.Cmd) {
 		return s, nil
 	}
 
-	var mode config.ImportMode
-	if s.importMode == "replace" {
-		mode = config.ImportModeReplace
-	} else {
-		mode = config.ImportModeMerge
-	}
-
-	if err := s.config.ImportConfig(s.pendingImportPath, mode); err != nil {
+	if err := s.config.ImportConfig(s.pendingImportPath, s.selectedImportMode()); err != nil {
 		s.message = fmt.Sprintf("Import failed: %v", err)
 		s.messageType = "error"
 	} else {
@@ -675,13 +1192,31 @@ func (s *SettingsScreen) executeAction() (tea.Model, tea.Cmd) {
 	switch action.actionType {
 	case "export":
 		return s.startExport()
+	case "export_portable":
+		return s.startPortableExport()
 	case "import":
 		return s.startImport()
+	case "switch_config":
+		return s.startConfigSwitch()
+	case "reset_defaults":
+		return s.showResetConfirm()
 	}
 
 	return s, nil
 }
 
+// IsInlineEditing reports whether a setting is being edited in place.
+func (s *SettingsScreen) IsInlineEditing() bool {
+	return s.inlineEditing
+}
+
+// SetMessage shows a status message on the settings screen.
+// messageType is "success", "info" or "error".
+func (s *SettingsScreen) SetMessage(message, messageType string) {
+	s.message = message
+	s.messageType = messageType
+}
+
 // ShouldGoBack returns true if the screen should go back to the main menu.
 func (s *SettingsScreen) ShouldGoBack() bool {
 	return s.goBack
@@ -721,10 +1256,13 @@ func (s *SettingsScreen) View() string {
 
 	if s.message != "" {
 		var msgStyle lipgloss.Style
-		if s.messageType == "success" {
-			msgStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
-		} else {
-			msgStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+		switch s.messageType {
+		case "success":
+			msgStyle = components.Styles.Success
+		case "info":
+			msgStyle = components.Styles.Info
+		default:
+			msgStyle = components.Styles.Error
 		}
 		msg := msgStyle.Render(s.message)
 		b.WriteString(lipgloss.NewStyle().
@@ -757,13 +1295,22 @@ func (s *SettingsScreen) View() string {
 	helpItems := []components.HelpItem{
 		{Key: "↑/↓", Desc: "navigate"},
 		{Key: "Enter", Desc: "edit/action"},
+		{Key: "e", Desc: "quick edit"},
 	}
 	if rightWidth > 0 {
 		helpItems = append(helpItems, components.HelpItem{Key: "←/→", Desc: "switch panel"})
 	}
 	helpItems = append(helpItems, components.HelpItem{Key: "x", Desc: "export"})
+	helpItems = append(helpItems, components.HelpItem{Key: "X", Desc: "portable export"})
 	helpItems = append(helpItems, components.HelpItem{Key: "i", Desc: "import"})
+	helpItems = append(helpItems, components.HelpItem{Key: "o", Desc: "open config"})
 	helpItems = append(helpItems, components.HelpItem{Key: "Esc", Desc: "back"})
+	if s.inlineEditing {
+		helpItems = []components.HelpItem{
+			{Key: "Enter", Desc: "save"},
+			{Key: "Esc", Desc: "cancel"},
+		}
+	}
 	helpText := components.HelpBar(s.width, helpItems)
 	b.WriteString(helpText)
 
@@ -820,7 +1367,11 @@ func (s *SettingsScreen) renderImportModeForm() string {
 func (s *SettingsScreen) renderConfirmDialog() string {
 	var b strings.Builder
 
-	title := components.Styles.Title.Render("Confirm Import")
+	heading := "Confirm Import"
+	if s.pendingReset {
+		heading = "Confirm Reset"
+	}
+	title := components.Styles.Title.Render(heading)
 	b.WriteString(lipgloss.NewStyle().
 		Width(s.width).
 		Align(lipgloss.Center).
@@ -932,7 +1483,11 @@ func (s *SettingsScreen) renderSettingsListCompact(width int) string {
 			value = value[:maxValueLen-3] + "..."
 		}
 
-		if !s.showingActions && i == s.cursor {
+		if s.inlineEditing && i == s.editIndex {
+			s.inlineInput.Width = maxValueLen
+			line := fmt.Sprintf("▸ %-*s %s", maxNameLen, components.Styles.Selected.Render(name), s.inlineInput.View())
+			b.WriteString(line + "\n")
+		} else if !s.showingActions && i == s.cursor {
 			line := fmt.Sprintf("▸ %-*s %s", maxNameLen, components.Styles.Selected.Render(name), value)
 			b.WriteString(line + "\n")
 		} else {
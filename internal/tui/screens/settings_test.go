@@ -2,6 +2,7 @@ package screens
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -55,6 +56,7 @@ func TestSettingsScreen_SettingItems(t *testing.T) {
 		{"Rclone Binary Path", "r", "string", "settings.rclone_binary_path"},
 		{"Default Mount Directory", "m", "string", "settings.default_mount_dir"},
 		{"Editor", "e", "string", "settings.editor"},
+		{"Auto-Refresh Interval", "ar", "string", "settings.auto_refresh_interval"},
 	}
 
 	for i, expected := range expectedSettings {
@@ -148,6 +150,30 @@ func TestSettingsScreen_GetConfigValue(t *testing.T) {
 			setupConfig:   func(c *config.Config) {},
 			expectedValue: "",
 		},
+		{
+			name:          "Theme",
+			configKey:     "settings.theme",
+			setupConfig:   func(c *config.Config) { c.Settings.Theme = "light" },
+			expectedValue: "light",
+		},
+		{
+			name:          "Theme unset",
+			configKey:     "settings.theme",
+			setupConfig:   func(c *config.Config) {},
+			expectedValue: "dark",
+		},
+		{
+			name:          "Dir Cache Time",
+			configKey:     "defaults.mount.dir_cache_time",
+			setupConfig:   func(c *config.Config) { c.Defaults.Mount.DirCacheTime = "1h" },
+			expectedValue: "1h",
+		},
+		{
+			name:          "Attr Timeout",
+			configKey:     "defaults.mount.attr_timeout",
+			setupConfig:   func(c *config.Config) { c.Defaults.Mount.AttrTimeout = "10s" },
+			expectedValue: "10s",
+		},
 	}
 
 	for _, tt := range tests {
@@ -293,6 +319,64 @@ func TestSettingsScreen_SetConfigValue(t *testing.T) {
 			value:       "xyz",
 			expectError: true,
 		},
+		{
+			name:      "Set Dir Cache Time",
+			configKey: "defaults.mount.dir_cache_time",
+			value:     " 1h ",
+			checkConfig: func(t *testing.T, c *config.Config) {
+				if c.Defaults.Mount.DirCacheTime != "1h" {
+					t.Errorf("DirCacheTime = %q, want '1h'", c.Defaults.Mount.DirCacheTime)
+				}
+			},
+		},
+		{
+			name:      "Set Attr Timeout",
+			configKey: "defaults.mount.attr_timeout",
+			value:     "10s",
+			checkConfig: func(t *testing.T, c *config.Config) {
+				if c.Defaults.Mount.AttrTimeout != "10s" {
+					t.Errorf("AttrTimeout = %q, want '10s'", c.Defaults.Mount.AttrTimeout)
+				}
+			},
+		},
+		{
+			name:      "Clear Attr Timeout",
+			configKey: "defaults.mount.attr_timeout",
+			value:     "",
+			checkConfig: func(t *testing.T, c *config.Config) {
+				if c.Defaults.Mount.AttrTimeout != "" {
+					t.Errorf("AttrTimeout = %q, want empty", c.Defaults.Mount.AttrTimeout)
+				}
+			},
+		},
+		{
+			name:      "Set Theme",
+			configKey: "settings.theme",
+			value:     "none",
+			checkConfig: func(t *testing.T, c *config.Config) {
+				if c.Settings.Theme != "none" {
+					t.Errorf("Theme = %q, want 'none'", c.Settings.Theme)
+				}
+			},
+		},
+		{
+			name:        "Invalid Theme",
+			configKey:   "settings.theme",
+			value:       "solarized",
+			expectError: true,
+		},
+		{
+			name:        "Invalid Dir Cache Time",
+			configKey:   "defaults.mount.dir_cache_time",
+			value:       "an hour",
+			expectError: true,
+		},
+		{
+			name:        "Invalid Attr Timeout",
+			configKey:   "defaults.mount.attr_timeout",
+			value:       "10",
+			expectError: true,
+		},
 		{
 			name:        "Unknown config key",
 			configKey:   "unknown.key",
@@ -547,20 +631,27 @@ func TestSettingsScreen_UpdateSettingValues(t *testing.T) {
 	cfg := &config.Config{
 		Defaults: config.DefaultConfig{
 			Mount: config.MountDefaults{
-				VFSCacheMode: "writes",
-				BufferSize:   "32M",
-				LogLevel:     "DEBUG",
+				VFSCacheMode:    "writes",
+				BufferSize:      "32M",
+				LogLevel:        "DEBUG",
+				ExtraFlags:      "--user-agent=test",
+				VFSCacheMaxSize: "10G",
+				VFSCacheMaxAge:  "24h",
+				DirCacheTime:    "1h",
+				AttrTimeout:     "10s",
 			},
 			Sync: config.SyncDefaults{
-				LogLevel:  "ERROR",
-				Transfers: 8,
-				Checkers:  16,
+				LogLevel:   "ERROR",
+				Transfers:  8,
+				Checkers:   16,
+				ExtraFlags: "--ca-cert=/etc/ca.pem",
 			},
 		},
 		Settings: config.Settings{
-			RcloneBinaryPath: "/custom/rclone",
-			DefaultMountDir:  "/custom/mnt",
-			Editor:           "emacs",
+			RcloneBinaryPath:    "/custom/rclone",
+			DefaultMountDir:     "/custom/mnt",
+			Editor:              "emacs",
+			AutoRefreshInterval: "1m",
 		},
 	}
 
@@ -1191,12 +1282,11 @@ func TestSettingsScreen_UpdateImportModeForm_Escape(t *testing.T) {
 	}
 }
 
-func TestSettingsScreen_ShowReplaceConfirm(t *testing.T) {
+func TestSettingsScreen_ShowImportConfirm(t *testing.T) {
 	screen := NewSettingsScreen()
 	screen.SetSize(80, 24)
 
-	// Call showReplaceConfirm
-	model, cmd := screen.showReplaceConfirm()
+	model, cmd := screen.showImportConfirm(&config.ImportPlan{Mode: config.ImportModeReplace})
 
 	// Verify state
 	if !screen.showingConfirm {
@@ -1214,12 +1304,56 @@ func TestSettingsScreen_ShowReplaceConfirm(t *testing.T) {
 	_ = cmd
 }
 
+func TestSettingsScreen_PreviewImport(t *testing.T) {
+	importPath := filepath.Join(t.TempDir(), "import.yaml")
+	content := "version: \"1.1\"\nmounts:\n  - name: gdrive\n    remote: \"gdrive:\"\n    mount_point: /mnt/gdrive\n  - name: dropbox\n    remote: \"dropbox:\"\n    mount_point: /mnt/dropbox\n"
+	if err := os.WriteFile(importPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	screen := NewSettingsScreen()
+	screen.SetSize(100, 30)
+	cfg := &config.Config{Mounts: []models.MountConfig{{ID: "a1b2c3d4", Name: "gdrive"}}}
+	screen.SetConfig(cfg)
+	screen.pendingImportPath = importPath
+	screen.importMode = "merge"
+
+	screen.previewImport()
+	if !screen.showingConfirm || screen.confirmDialog == nil {
+		t.Fatal("previewImport() should ask for confirmation")
+	}
+	view := screen.View()
+	for _, want := range []string{"Merge Configuration?", "Add 1: dropbox (mount)", "Skip 1: gdrive (mount, duplicate name)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("confirm dialog should show %q, got:\n%s", want, view)
+		}
+	}
+	if len(cfg.Mounts) != 1 {
+		t.Error("previewing must not import anything")
+	}
+}
+
+func TestSettingsScreen_PreviewImport_Error(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetConfig(&config.Config{})
+	screen.pendingImportPath = filepath.Join(t.TempDir(), "missing.yaml")
+	screen.importMode = "replace"
+
+	screen.previewImport()
+	if screen.showingConfirm {
+		t.Error("no confirmation should be shown when the file can't be read")
+	}
+	if screen.messageType != "error" || !strings.Contains(screen.message, "Import failed") {
+		t.Errorf("message = %q (%s), want an import error", screen.message, screen.messageType)
+	}
+}
+
 func TestSettingsScreen_UpdateConfirmDialog_Escape(t *testing.T) {
 	screen := NewSettingsScreen()
 	screen.SetSize(80, 24)
 
 	// Initialize confirm dialog
-	screen.showReplaceConfirm()
+	screen.showImportConfirm(&config.ImportPlan{Mode: config.ImportModeReplace})
 	screen.pendingImportPath = "/some/path.yaml"
 
 	// Press escape to cancel
@@ -1243,6 +1377,76 @@ func TestSettingsScreen_UpdateConfirmDialog_Escape(t *testing.T) {
 	}
 }
 
+func TestSettingsScreen_ResetConfirm(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetSize(80, 24)
+	screen.SetConfig(&config.Config{})
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if !screen.showingConfirm || !screen.pendingReset || screen.confirmDialog == nil {
+		t.Fatal("ctrl+d should ask before resetting")
+	}
+	if !strings.Contains(screen.View(), "Confirm Reset") {
+		t.Error("view should show the reset confirmation")
+	}
+
+	screen.updateConfirmDialog(tea.KeyMsg{Type: tea.KeyEsc})
+	if screen.showingConfirm || screen.pendingReset {
+		t.Error("esc should cancel the reset")
+	}
+}
+
+func TestSettingsScreen_ResetConfirm_NilConfig(t *testing.T) {
+	screen := NewSettingsScreen()
+
+	screen.showResetConfirm()
+	if screen.showingConfirm || screen.messageType != "error" {
+		t.Error("reset without a config should show an error")
+	}
+}
+
+func TestSettingsScreen_ExecuteReset(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := &config.Config{
+		Version:  config.CurrentVersion,
+		Mounts:   []models.MountConfig{{Name: "drive", Remote: "gdrive:", MountPoint: "/mnt/drive"}},
+		SyncJobs: []models.SyncJobConfig{{Name: "backup", Source: "/home", Destination: "b2:home"}},
+	}
+	cfg.Settings.DefaultMountDir = "/media"
+	cfg.Defaults.Mount.VFSCacheMode = "off"
+	cfg.Defaults.Sync.Transfers = 16
+
+	screen := NewSettingsScreen()
+	screen.SetSize(80, 24)
+	screen.SetConfig(cfg)
+
+	screen.executeReset()
+
+	if screen.messageType != "success" {
+		t.Fatalf("message = %q (%s), want success", screen.message, screen.messageType)
+	}
+	if cfg.Settings.DefaultMountDir != "~/mnt" || cfg.Defaults.Mount.VFSCacheMode != "full" || cfg.Defaults.Sync.Transfers != 4 {
+		t.Errorf("settings = %+v, defaults = %+v, want the built-in values", cfg.Settings, cfg.Defaults)
+	}
+	if len(cfg.Mounts) != 1 || len(cfg.SyncJobs) != 1 {
+		t.Error("mounts and sync jobs should survive the reset")
+	}
+	for _, item := range screen.settings {
+		if item.configKey == "settings.default_mount_dir" && item.Value != "~/mnt" {
+			t.Errorf("displayed default_mount_dir = %q, want ~/mnt", item.Value)
+		}
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(saved.Mounts) != 1 || saved.Defaults.Mount.VFSCacheMode != "full" {
+		t.Errorf("saved config = %+v, want the reset settings and the mount", saved)
+	}
+}
+
 func TestSettingsScreen_ExecuteImport_NilConfig(t *testing.T) {
 	screen := NewSettingsScreen()
 	screen.SetSize(80, 24)
@@ -1500,7 +1704,7 @@ func TestSettingsScreen_RenderConfirmDialog(t *testing.T) {
 	screen.SetSize(80, 24)
 
 	// Initialize confirm dialog
-	screen.showReplaceConfirm()
+	screen.showImportConfirm(&config.ImportPlan{Mode: config.ImportModeReplace})
 
 	// Render confirm dialog
 	view := screen.renderConfirmDialog()
@@ -1574,7 +1778,7 @@ func TestSettingsScreen_View_WithConfirmDialog(t *testing.T) {
 	screen.SetSize(80, 24)
 
 	// Start confirm dialog
-	screen.showReplaceConfirm()
+	screen.showImportConfirm(&config.ImportPlan{Mode: config.ImportModeReplace})
 
 	view := screen.View()
 
@@ -1691,3 +1895,260 @@ func TestSettingsScreen_EscapeFromActions(t *testing.T) {
 		t.Error("ShouldGoBack should be false when escaping from actions")
 	}
 }
+
+func TestSettingsScreen_OpenConfigDirKey(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetSize(80, 24)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	if !screen.pickingConfigDir {
+		t.Error("pickingConfigDir = false, want true")
+	}
+	if !screen.showingFilePicker {
+		t.Error("showingFilePicker = false, want true")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if screen.pickingConfigDir {
+		t.Error("pickingConfigDir should be cleared after esc")
+	}
+	if screen.form != nil {
+		t.Error("form should be cleared after esc")
+	}
+}
+
+func TestSettingsScreen_SetMessage(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetSize(80, 24)
+
+	screen.SetMessage("Using config /tmp/x", "success")
+
+	if screen.message != "Using config /tmp/x" {
+		t.Errorf("message = %q, want %q", screen.message, "Using config /tmp/x")
+	}
+	if screen.messageType != "success" {
+		t.Errorf("messageType = %q, want %q", screen.messageType, "success")
+	}
+}
+
+func TestSettingsScreen_SetAutoRefreshInterval(t *testing.T) {
+	screen := NewSettingsScreen()
+	cfg := &config.Config{}
+	screen.SetConfig(cfg)
+
+	if err := screen.setConfigValue("settings.auto_refresh_interval", "45s"); err != nil {
+		t.Fatalf("setConfigValue() error = %v", err)
+	}
+	if cfg.Settings.AutoRefreshInterval != "45s" {
+		t.Errorf("AutoRefreshInterval = %q, want %q", cfg.Settings.AutoRefreshInterval, "45s")
+	}
+
+	for _, value := range []string{"soon", "500ms"} {
+		if err := screen.setConfigValue("settings.auto_refresh_interval", value); err == nil {
+			t.Errorf("setConfigValue(%q) should fail", value)
+		}
+	}
+	if cfg.Settings.AutoRefreshInterval != "45s" {
+		t.Errorf("invalid value should not be stored, got %q", cfg.Settings.AutoRefreshInterval)
+	}
+}
+
+func TestSettingsScreen_OnOffSettings(t *testing.T) {
+	screen := NewSettingsScreen()
+	cfg := &config.Config{}
+	screen.SetConfig(cfg)
+
+	if got := screen.getConfigValue("settings.watch_config"); got != "off" {
+		t.Errorf("watch_config = %q, want off by default", got)
+	}
+	if err := screen.setConfigValue("settings.watch_config", "on"); err != nil {
+		t.Fatalf("setConfigValue() error = %v", err)
+	}
+	if !cfg.Settings.WatchConfig || screen.getConfigValue("settings.watch_config") != "on" {
+		t.Error("WatchConfig should be on")
+	}
+	if err := screen.setConfigValue("settings.watch_config", "maybe"); err == nil {
+		t.Error("setConfigValue() should reject values other than on and off")
+	}
+
+	if err := screen.setConfigValue("settings.action_log", "on"); err != nil {
+		t.Fatalf("setConfigValue() error = %v", err)
+	}
+	if !cfg.Settings.ActionLog || screen.getConfigValue("settings.action_log") != "on" {
+		t.Error("ActionLog should be on")
+	}
+}
+
+func TestSettingsScreen_BackupCount(t *testing.T) {
+	screen := NewSettingsScreen()
+	cfg := &config.Config{}
+	screen.SetConfig(cfg)
+
+	if got := screen.getConfigValue("settings.backup_count"); got != "5" {
+		t.Errorf("backup_count = %q, want the default %d", got, config.DefaultBackupCount)
+	}
+	if err := screen.setConfigValue("settings.backup_count", "10"); err != nil {
+		t.Fatalf("setConfigValue() error = %v", err)
+	}
+	if cfg.Settings.BackupCount != 10 {
+		t.Errorf("BackupCount = %d, want 10", cfg.Settings.BackupCount)
+	}
+	if err := screen.setConfigValue("settings.backup_count", "0"); err == nil {
+		t.Error("setConfigValue() should require at least one backup")
+	}
+}
+
+func TestSettingsScreen_VFSCacheLimitDefaults(t *testing.T) {
+	screen := NewSettingsScreen()
+	cfg := &config.Config{}
+	screen.SetConfig(cfg)
+
+	if err := screen.setConfigValue("defaults.mount.vfs_cache_max_size", "10G"); err != nil {
+		t.Fatalf("setConfigValue() error = %v", err)
+	}
+	if err := screen.setConfigValue("defaults.mount.vfs_cache_max_age", "24h"); err != nil {
+		t.Fatalf("setConfigValue() error = %v", err)
+	}
+	if cfg.Defaults.Mount.VFSCacheMaxSize != "10G" || cfg.Defaults.Mount.VFSCacheMaxAge != "24h" {
+		t.Errorf("defaults = %q/%q, want 10G/24h", cfg.Defaults.Mount.VFSCacheMaxSize, cfg.Defaults.Mount.VFSCacheMaxAge)
+	}
+
+	if err := screen.setConfigValue("defaults.mount.vfs_cache_max_size", "lots"); err == nil {
+		t.Error("setConfigValue() should reject an invalid size")
+	}
+	if err := screen.setConfigValue("defaults.mount.vfs_cache_max_age", "soon"); err == nil {
+		t.Error("setConfigValue() should reject an invalid duration")
+	}
+	if err := screen.setConfigValue("defaults.mount.vfs_cache_max_age", ""); err != nil || cfg.Defaults.Mount.VFSCacheMaxAge != "" {
+		t.Errorf("clearing the age limit: err = %v, value = %q", err, cfg.Defaults.Mount.VFSCacheMaxAge)
+	}
+}
+
+func TestSettingsScreen_InlineEditString(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	screen := NewSettingsScreen()
+	screen.SetSize(100, 30)
+	cfg := &config.Config{Settings: config.Settings{DefaultMountDir: "~/mnt"}}
+	screen.SetConfig(cfg)
+	screen.cursor = 7 // Default Mount Directory
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if !screen.IsInlineEditing() {
+		t.Fatal("e should start inline editing for a string setting")
+	}
+	if screen.form != nil {
+		t.Error("inline editing should not open the full form")
+	}
+
+	screen.inlineInput.SetValue("/data/mnt")
+	if !strings.Contains(screen.View(), "/data/mnt") {
+		t.Error("View should render the inline input in the row")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if screen.IsInlineEditing() {
+		t.Error("enter should commit and leave inline editing")
+	}
+	if cfg.Settings.DefaultMountDir != "/data/mnt" {
+		t.Errorf("DefaultMountDir = %q, want %q", cfg.Settings.DefaultMountDir, "/data/mnt")
+	}
+	if screen.messageType != "success" {
+		t.Errorf("messageType = %q, want success", screen.messageType)
+	}
+}
+
+func TestSettingsScreen_InlineEditCancel(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetSize(100, 30)
+	cfg := &config.Config{Settings: config.Settings{Editor: "vim"}}
+	screen.SetConfig(cfg)
+	screen.cursor = 8 // Editor
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	screen.inlineInput.SetValue("nano")
+	screen.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if screen.IsInlineEditing() {
+		t.Error("esc should cancel inline editing")
+	}
+	if screen.ShouldGoBack() {
+		t.Error("esc while inline editing should not leave the screen")
+	}
+	if cfg.Settings.Editor != "vim" || screen.settings[8].Value != "vim" {
+		t.Errorf("Editor = %q / %q, want unchanged 'vim'", cfg.Settings.Editor, screen.settings[8].Value)
+	}
+}
+
+func TestSettingsScreen_InlineEditValidationKeepsEditing(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetSize(100, 30)
+	cfg := &config.Config{Settings: config.Settings{AutoRefreshInterval: "30s"}}
+	screen.SetConfig(cfg)
+	screen.cursor = 9 // Auto-Refresh Interval
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	screen.inlineInput.SetValue("soon")
+	screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !screen.IsInlineEditing() {
+		t.Error("an invalid value should keep the inline editor open")
+	}
+	if screen.messageType != "error" {
+		t.Errorf("messageType = %q, want error", screen.messageType)
+	}
+	if cfg.Settings.AutoRefreshInterval != "30s" || screen.settings[9].Value != "30s" {
+		t.Error("an invalid value should not replace the setting")
+	}
+}
+
+func TestSettingsScreen_InlineEditNonStringOpensForm(t *testing.T) {
+	screen := NewSettingsScreen()
+	screen.SetSize(100, 30)
+	screen.SetConfig(&config.Config{})
+	screen.cursor = 4 // Default Transfers (int)
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+
+	if screen.IsInlineEditing() {
+		t.Error("int settings should not be edited inline")
+	}
+	if !screen.editing || screen.form == nil {
+		t.Error("int settings should open the full form")
+	}
+}
+
+func TestSettingsScreen_PortableExport(t *testing.T) {
+	exportPath := t.TempDir() + "/portable.yaml"
+
+	screen := NewSettingsScreen()
+	screen.SetSize(80, 24)
+	screen.SetConfig(&config.Config{
+		Version: "1.0",
+		Mounts: []models.MountConfig{
+			{Name: "test-mount", Remote: "remote:path", MountPoint: "/mnt/test"},
+		},
+	})
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+	if !screen.showingFilePicker || !screen.exportPortable {
+		t.Fatal("pressing 'X' should start a portable export")
+	}
+
+	screen.completeExport(exportPath)
+	if screen.messageType != "success" || !strings.Contains(screen.message, "Portable template exported") {
+		t.Errorf("message = %q (%s), want portable export success", screen.message, screen.messageType)
+	}
+	if screen.exportPortable {
+		t.Error("exportPortable should be reset after the export")
+	}
+
+	content, err := os.ReadFile(exportPath)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	if !strings.Contains(string(content), "portable: true") {
+		t.Errorf("export should be portable, got:\n%s", content)
+	}
+}
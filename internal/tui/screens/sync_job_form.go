@@ -36,13 +36,19 @@ type SyncJobForm struct {
 
 	// Services
 	config       *config.Config
-	generator    *systemd.Generator
+	generator    systemd.UnitGenerator
 	manager      systemd.ServiceManager
 	rcloneClient *rclone.Client
 
 	// Available remotes
 	remotes []rclone.Remote
 
+	// Manual remote entry, used when there are no remotes to pick from
+	manualRemote  bool
+	rcloneMissing bool
+	remoteNotice  string
+	remoteHint    string
+
 	// Form data - Basic Info
 	name         string
 	sourceRemote string
@@ -50,33 +56,65 @@ type SyncJobForm struct {
 	destRemote   string
 	destPath     string
 
+	// Source check, skipped when creating the job for a path that will
+	// exist later. The last result is kept so moving between fields does
+	// not list the remote again.
+	skipSourceCheck bool
+	checkedSource   string
+	sourceCheckErr  error
+
 	// Form data - Sync Options
 	direction       string
 	deleteMode      string
 	createEmptyDirs bool
 	dryRun          bool
 	trackRenames    bool
+	backupDir       string
+	suffix          string
+	modifyWindow    string
+	verifyAfter     bool
+	resyncFirstRun  bool
+	workingDir      string
+	createWorkDir   bool
 
 	// Form data - Schedule
 	scheduleType     string
 	onCalendar       string
 	onBootSec        string
+	randomizedDelay  string
 	requireACPower   bool
 	requireUnmetered bool
 
 	// Form data - Filters & Performance
 	excludePattern string
+	filters        string
 	maxTransfers   string
 	bandwidthLimit string
+	maxDuration    string
+	maxRetries     string
+	retryDelay     string
+	niceLevel      string
+	ioniceClass    int
+	ioniceLevel    string
 	logLevel       string
+	extraArgs      string
+	backendFlags   []string
+	notes          string
+	description    string
+	docURL         string
 
 	// Form data - Service Options
 	enabled        bool
 	runImmediately bool
+
+	// Form data - Mount Dependencies
+	requiresMounts []string
+	stopWithMount  bool
+	serialize      bool
 }
 
 // NewSyncJobForm creates a new sync job form.
-func NewSyncJobForm(job *models.SyncJobConfig, remotes []rclone.Remote, cfg *config.Config, gen *systemd.Generator, mgr systemd.ServiceManager, rcloneClient *rclone.Client, isEdit bool) *SyncJobForm {
+func NewSyncJobForm(job *models.SyncJobConfig, remotes []rclone.Remote, cfg *config.Config, gen systemd.UnitGenerator, mgr systemd.ServiceManager, rcloneClient *rclone.Client, isEdit bool) *SyncJobForm {
 	f := &SyncJobForm{
 		job:          job,
 		isEdit:       isEdit,
@@ -96,6 +134,9 @@ func NewSyncJobForm(job *models.SyncJobConfig, remotes []rclone.Remote, cfg *con
 	// If editing, populate with existing values
 	if job != nil {
 		f.name = job.Name
+		f.notes = job.Notes
+		f.description = job.Description
+		f.docURL = job.DocumentationURL
 
 		// Parse source remote and path
 		srcRemote, srcPath := parseRemotePath(job.Source)
@@ -122,22 +163,48 @@ func NewSyncJobForm(job *models.SyncJobConfig, remotes []rclone.Remote, cfg *con
 		}
 		f.createEmptyDirs = true // Default in generator
 		f.dryRun = job.SyncOptions.DryRun
+		f.backupDir = job.SyncOptions.BackupDir
+		f.suffix = job.SyncOptions.Suffix
+		f.modifyWindow = job.SyncOptions.ModifyWindow
+		f.maxDuration = job.SyncOptions.MaxDuration
+		if job.SyncOptions.MaxRetries != 0 {
+			f.maxRetries = strconv.Itoa(job.SyncOptions.MaxRetries)
+		}
+		f.retryDelay = job.SyncOptions.RetryDelay
+		f.workingDir = job.SyncOptions.WorkingDir
+		f.verifyAfter = job.SyncOptions.VerifyAfter
+		f.resyncFirstRun = job.SyncOptions.ResyncOnFirstRun
 
 		// Schedule
 		f.scheduleType = job.Schedule.Type
 		f.onCalendar = job.Schedule.OnCalendar
 		f.onBootSec = job.Schedule.OnBootSec
+		f.randomizedDelay = job.Schedule.RandomizedDelaySec
 		f.requireACPower = job.Schedule.RequireACPower
 		f.requireUnmetered = job.Schedule.RequireUnmetered
 
 		// Filters & Performance
 		f.excludePattern = job.SyncOptions.ExcludePattern
+		f.filters = strings.Join(job.SyncOptions.Filters, "\n")
 		f.maxTransfers = fmt.Sprintf("%d", job.SyncOptions.Transfers)
+		if job.SyncOptions.Nice != 0 {
+			f.niceLevel = strconv.Itoa(job.SyncOptions.Nice)
+		}
+		f.ioniceClass = job.SyncOptions.IONiceClass
+		if job.SyncOptions.IONicePriority != 0 {
+			f.ioniceLevel = strconv.Itoa(job.SyncOptions.IONicePriority)
+		}
 		f.bandwidthLimit = job.SyncOptions.BandwidthLimit
 		f.logLevel = job.SyncOptions.LogLevel
+		f.extraArgs = job.SyncOptions.ExtraArgs
 
 		// Service options
 		f.enabled = job.Enabled
+
+		// Mount dependencies
+		f.requiresMounts = append([]string(nil), job.RequiresMounts...)
+		f.stopWithMount = job.StopWithMount
+		f.serialize = job.SerializeWithSharedMount
 	}
 
 	// Set default values if empty
@@ -150,9 +217,6 @@ func NewSyncJobForm(job *models.SyncJobConfig, remotes []rclone.Remote, cfg *con
 	if f.logLevel == "" {
 		f.logLevel = "INFO"
 	}
-	if f.maxTransfers == "0" {
-		f.maxTransfers = "4"
-	}
 	if f.scheduleType == "" {
 		f.scheduleType = "timer"
 	}
@@ -160,6 +224,12 @@ func NewSyncJobForm(job *models.SyncJobConfig, remotes []rclone.Remote, cfg *con
 		f.onCalendar = "daily"
 	}
 
+	// Fall back to free-text remote entry when there is nothing to pick from
+	if len(remotes) == 0 {
+		f.manualRemote = true
+		f.remoteNotice, f.remoteHint, f.rcloneMissing = manualRemoteNotice(rcloneClient)
+	}
+
 	f.buildForm()
 	return f
 }
@@ -176,14 +246,10 @@ func parseRemotePath(s string) (remote, path string) {
 func (f *SyncJobForm) buildForm() {
 	homeDir, _ := os.UserHomeDir()
 
-	// Build remote options - handle empty remotes gracefully
-	remoteOptions := make([]huh.Option[string], 0)
-	if len(f.remotes) > 0 {
-		for _, r := range f.remotes {
-			remoteOptions = append(remoteOptions, huh.NewOption(r.Name+" ("+r.Type+")", r.Name))
-		}
-	} else {
-		remoteOptions = append(remoteOptions, huh.NewOption("⚠ No remotes - run 'rclone config' first", ""))
+	// Build remote options
+	remoteOptions := make([]huh.Option[string], 0, len(f.remotes))
+	for _, r := range f.remotes {
+		remoteOptions = append(remoteOptions, huh.NewOption(r.Name+" ("+r.Type+")", r.Name))
 	}
 
 	// Direction options
@@ -191,6 +257,7 @@ func (f *SyncJobForm) buildForm() {
 		huh.NewOption("Sync (mirror)", "sync"),
 		huh.NewOption("Copy", "copy"),
 		huh.NewOption("Move", "move"),
+		huh.NewOption("Bisync (two-way)", "bisync"),
 	}
 
 	// Delete mode options
@@ -215,39 +282,68 @@ func (f *SyncJobForm) buildForm() {
 		huh.NewOption("Debug", "DEBUG"),
 	}
 
-	// Build form groups
-	groups := []*huh.Group{
-		// Step 1: Basic Info
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Sync Job Name").
-				Description("A unique name for this sync job").
-				Placeholder("e.g., Photos Backup").
-				Value(&f.name).
-				Validate(f.validateName),
+	// Source remote field - a picker when remotes are known, free text otherwise
+	var remoteFields []huh.Field
+	if f.manualRemote {
+		remoteFields = append(remoteFields,
+			huh.NewNote().
+				Title("⚠ "+f.remoteNotice).
+				Description(f.remoteHint),
 
+			huh.NewInput().
+				Title("Source Remote").
+				Description("Name of the source rclone remote").
+				Placeholder("e.g., gdrive").
+				Value(&f.sourceRemote).
+				Validate(validateManualRemote),
+		)
+	} else {
+		remoteFields = append(remoteFields,
 			huh.NewSelect[string]().
 				Title("Source Remote").
 				Description("Select the source rclone remote").
 				Options(remoteOptions...).
 				Value(&f.sourceRemote),
+		)
+	}
 
-			huh.NewInput().
-				Title("Source Path").
-				Description("Path on the source remote (e.g., /Photos)").
-				Placeholder("/").
-				Value(&f.sourcePath).
-				SuggestionsFunc(f.getRemotePathSuggestions, &f.sourceRemote),
-
-			components.NewEnhancedFilePicker().
-				Title("Destination Path").
-				Description("Local directory for synced files. Use quick jump keys: ~ (home), / (root), m (mnt), M (media), r (recent), Backspace (parent).").
-				DirAllowed(true).
-				FileAllowed(false).
-				CurrentDirectory(homeDir).
-				Value(&f.destPath).
-				Validate(f.validateDestPath),
-		).Title("Step 1: Basic Info"),
+	basicFields := []huh.Field{
+		huh.NewInput().
+			Title("Sync Job Name").
+			Description("A unique name for this sync job").
+			Placeholder("e.g., Photos Backup").
+			Value(&f.name).
+			Validate(f.validateName),
+	}
+	basicFields = append(basicFields, remoteFields...)
+	basicFields = append(basicFields,
+		huh.NewInput().
+			Title("Source Path").
+			Description("Path on the source remote (e.g., /Photos)").
+			Placeholder("/").
+			Value(&f.sourcePath).
+			SuggestionsFunc(f.getRemotePathSuggestions, &f.sourceRemote),
+
+		huh.NewConfirm().
+			Title("Create Even If Source Is Missing").
+			Description("The source is listed with rclone to confirm it exists; choose Yes for a path that will exist later").
+			Value(&f.skipSourceCheck).
+			Validate(f.validateSourceExists),
+
+		components.NewEnhancedFilePicker().
+			Title("Destination Path").
+			Description("Local directory for synced files. Use quick jump keys: ~ (home), / (root), m (mnt), M (media), r (recent), Backspace (parent).").
+			DirAllowed(true).
+			FileAllowed(false).
+			CurrentDirectory(homeDir).
+			Value(&f.destPath).
+			Validate(f.validateDestPath),
+	)
+
+	// Build form groups
+	groups := []*huh.Group{
+		// Step 1: Basic Info
+		huh.NewGroup(basicFields...).Title("Step 1: Basic Info"),
 
 		// Step 2: Sync Options
 		huh.NewGroup(
@@ -277,6 +373,51 @@ func (f *SyncJobForm) buildForm() {
 				Title("Track Renames").
 				Description("Track file renames for efficient syncing").
 				Value(&f.trackRenames),
+
+			huh.NewConfirm().
+				Title("Verify After Sync").
+				Description("Run rclone check after each successful run (not available for move)").
+				Value(&f.verifyAfter).
+				Validate(f.validateVerifyAfter),
+
+			huh.NewConfirm().
+				Title("Resync On First Run").
+				Description("Bisync only: run once with --resync to establish the baseline bisync needs before its first run").
+				Value(&f.resyncFirstRun),
+
+			huh.NewInput().
+				Title("Backup Directory").
+				Description("Move overwritten and deleted files here instead of losing them (optional, must not overlap the destination)").
+				Placeholder("remote:backup or ~/sync-backups").
+				Value(&f.backupDir).
+				Validate(f.validateBackupDir),
+
+			huh.NewInput().
+				Title("Backup Suffix").
+				Description("Suffix added to backed up files (optional, e.g., .bak)").
+				Placeholder(".bak").
+				Value(&f.suffix).
+				Validate(validateBackupSuffix),
+
+			huh.NewInput().
+				Title("Modify Window").
+				Description("Treat modification times this close as equal, for FAT or SMB destinations (optional, e.g., 2s)").
+				Placeholder("2s").
+				Value(&f.modifyWindow).
+				Validate(validateModifyWindow),
+
+			huh.NewInput().
+				Title("Working Directory").
+				Description("Directory the sync runs in, e.g. for bisync state or scripts (optional)").
+				Placeholder("~/.local/state/rclone-bisync").
+				Value(&f.workingDir).
+				Validate(validateWorkingDir),
+
+			huh.NewConfirm().
+				Title("Create Working Directory If Missing").
+				Description("Choose Yes to create the working directory when the job is saved").
+				Value(&f.createWorkDir).
+				Validate(f.validateWorkingDirExists),
 		).Title("Step 2: Sync Options"),
 
 		// Step 3: Schedule
@@ -300,6 +441,13 @@ func (f *SyncJobForm) buildForm() {
 				Placeholder("5min").
 				Value(&f.onBootSec),
 
+			huh.NewInput().
+				Title("Randomized Delay").
+				Description("Start up to this much later, at random, so jobs sharing a schedule don't all run at once (optional, e.g., 30min)").
+				Placeholder("30min").
+				Value(&f.randomizedDelay).
+				Validate(func(s string) error { return systemd.ValidateTimeSpan(strings.TrimSpace(s)) }),
+
 			huh.NewConfirm().
 				Title("Require AC Power").
 				Description("Only run when connected to AC power (not on battery)").
@@ -319,27 +467,126 @@ func (f *SyncJobForm) buildForm() {
 				Placeholder("*.tmp, .git/*, node_modules/*").
 				Value(&f.excludePattern),
 
+			huh.NewText().
+				Title("Filter Rules").
+				Description("rclone filter rules, one per line, applied in order: \"- pattern\" or \"exclude pattern\" skips, \"+ pattern\" or \"include pattern\" keeps").
+				Placeholder("- *.tmp\n+ *.jpg").
+				Lines(4).
+				Value(&f.filters).
+				Validate(func(s string) error {
+					return systemd.ValidateFilters(systemd.ParseFilterLines(s))
+				}),
+
 			huh.NewInput().
 				Title("Max Transfers").
-				Description("Maximum number of parallel transfers").
+				Description("Maximum number of parallel transfers (0 uses rclone's default)").
 				Placeholder("4").
 				Value(&f.maxTransfers).
 				Validate(f.validateMaxTransfers),
 
 			huh.NewInput().
 				Title("Bandwidth Limit").
-				Description("Limit bandwidth (e.g., 10M, 1G)").
+				Description("A rate (e.g., 10M) or a timetable (e.g., 08:00,512k 19:00,10M 23:00,off); empty for unlimited").
 				Placeholder("10M").
 				Value(&f.bandwidthLimit).
 				Validate(components.ValidateBandwidthLimit),
 
+			huh.NewInput().
+				Title("Max Duration").
+				Description("Stop a run that takes longer than this (optional, e.g., 2h); it is recorded as timed out").
+				Placeholder("2h").
+				Value(&f.maxDuration).
+				Validate(func(s string) error { return systemd.ValidateMaxDuration(strings.TrimSpace(s)) }),
+
+			huh.NewInput().
+				Title("Max Retries").
+				Description("Rerun a failed sync up to this many times, e.g. after a network blip (optional)").
+				Placeholder("3").
+				Value(&f.maxRetries).
+				Validate(f.validateMaxRetries),
+
+			huh.NewInput().
+				Title("Retry Delay").
+				Description("How long to wait before each retry (optional, e.g., 5min; defaults to "+systemd.DefaultRetryDelay+")").
+				Placeholder(systemd.DefaultRetryDelay).
+				Value(&f.retryDelay).
+				Validate(func(s string) error { return systemd.ValidateTimeSpan(strings.TrimSpace(s)) }),
+
+			huh.NewInput().
+				Title("Nice Level").
+				Description("Lower the CPU priority of the sync, 1-19 (optional, higher yields more)").
+				Placeholder("10").
+				Value(&f.niceLevel).
+				Validate(f.validateNiceLevel),
+
+			huh.NewSelect[int]().
+				Title("I/O Class").
+				Description("Disk I/O scheduling class of the sync").
+				Options(
+					huh.NewOption("Default", 0),
+					huh.NewOption("Best effort", 2),
+					huh.NewOption("Idle (only when the disk is otherwise unused)", 3),
+				).
+				Value(&f.ioniceClass),
+
+			huh.NewInput().
+				Title("I/O Priority").
+				Description("Priority within the best-effort class, 1-7 (optional, higher yields more)").
+				Placeholder("7").
+				Value(&f.ioniceLevel).
+				Validate(f.validateIONiceLevel),
+
 			huh.NewSelect[string]().
 				Title("Log Level").
 				Description("Logging verbosity").
 				Options(logLevelOptions...).
 				Value(&f.logLevel),
+
+			huh.NewText().
+				Title("Extra Arguments").
+				Description("Additional rclone arguments, separated by spaces or newlines; quote values with spaces").
+				Placeholder("--option value").
+				Lines(3).
+				Value(&f.extraArgs).
+				Validate(func(s string) error {
+					if err := systemd.ValidateExtraArgs(s); err != nil {
+						return err
+					}
+					return systemd.ValidateManagedFlags(s, "sync")
+				}),
+
+			huh.NewInput().
+				Title("Description").
+				Description("Shown by systemctl status and the journal (defaults to the first line of the notes)").
+				Value(&f.description),
+
+			huh.NewInput().
+				Title("Documentation URL").
+				Description("Optional link added to the units' Documentation=, e.g. a runbook").
+				Placeholder("https://").
+				Value(&f.docURL).
+				Validate(systemd.ValidateDocumentationURL),
+
+			huh.NewText().
+				Title("Notes").
+				Description("Operational notes for this sync job (e.g., rotate creds quarterly)").
+				Value(&f.notes),
 		).Title("Step 4: Filters & Performance"),
 
+		// Backend flags, only when a remote's type has suggestions
+		huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Backend Flags").
+				Description("Common flags for the source and destination backends, added to the extra arguments").
+				OptionsFunc(func() []huh.Option[string] {
+					return backendFlagOptions(f.remotes, f.sourceRemote, f.destRemote)
+				}, []*string{&f.sourceRemote, &f.destRemote}).
+				Value(&f.backendFlags),
+		).Title("Step 4: Backend Flags").
+			WithHideFunc(func() bool {
+				return len(backendFlagOptions(f.remotes, f.sourceRemote, f.destRemote)) == 0
+			}),
+
 		// Step 5: Service Options
 		huh.NewGroup(
 			huh.NewConfirm().
@@ -354,10 +601,61 @@ func (f *SyncJobForm) buildForm() {
 		).Title("Step 5: Service Options"),
 	}
 
+	// Step 6: Mount Dependencies, only when there are mounts to depend on
+	if mountOptions := f.mountOptions(); len(mountOptions) > 0 {
+		groups = append(groups, huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Required Mounts").
+				Description("Mounts the job reads or writes through; the job starts after them").
+				Options(mountOptions...).
+				Value(&f.requiresMounts),
+
+			huh.NewConfirm().
+				Title("Stop With Mount").
+				Description("Stop the job's service and timer when a required mount stops").
+				Value(&f.stopWithMount).
+				Validate(f.validateStopWithMount),
+
+			huh.NewConfirm().
+				Title("Serialize With Shared Mount").
+				Description("Wait for other serialized jobs using the same mounts, so only one reads them at a time").
+				Value(&f.serialize).
+				Validate(f.validateSerialize),
+		).Title("Step 6: Mount Dependencies"))
+	}
+
 	f.form = huh.NewForm(groups...)
 	f.form.WithTheme(huh.ThemeBase16())
 }
 
+// mountOptions returns the configured mounts as options keyed by ID.
+func (f *SyncJobForm) mountOptions() []huh.Option[string] {
+	if f.config == nil {
+		return nil
+	}
+	options := make([]huh.Option[string], 0, len(f.config.Mounts))
+	for _, m := range f.config.Mounts {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (%s)", m.Name, m.MountPoint), m.ID))
+	}
+	return options
+}
+
+// validateStopWithMount requires a mount to bind to.
+func (f *SyncJobForm) validateStopWithMount(enabled bool) error {
+	if enabled && len(f.requiresMounts) == 0 {
+		return fmt.Errorf("select at least one required mount to stop with")
+	}
+	return nil
+}
+
+// validateSerialize requires a mount to share.
+func (f *SyncJobForm) validateSerialize(enabled bool) error {
+	if enabled && len(f.requiresMounts) == 0 {
+		return fmt.Errorf("select at least one required mount to serialize on")
+	}
+	return nil
+}
+
 // showCalendar returns true if the calendar field should be shown.
 func (f *SyncJobForm) showCalendar() bool {
 	return f.scheduleType == "timer"
@@ -413,12 +711,127 @@ func (f *SyncJobForm) validateDestPath(path string) error {
 	return nil
 }
 
+// source returns the job's source as remote:path.
+func (f *SyncJobForm) source() string {
+	return strings.TrimSuffix(strings.TrimSpace(f.sourceRemote), ":") + ":" + f.sourcePath
+}
+
+// validateSourceExists warns when the source cannot be listed, since a job
+// with a missing source silently transfers nothing. Choosing to create the
+// job anyway skips the check. The source of an edited job is only checked
+// once it changes.
+func (f *SyncJobForm) validateSourceExists(skip bool) error {
+	if skip || f.rcloneMissing || f.rcloneClient == nil || strings.TrimSpace(f.sourceRemote) == "" {
+		return nil
+	}
+	source := f.source()
+	if f.isEdit && f.job != nil && f.job.Source == source {
+		return nil
+	}
+
+	if source != f.checkedSource {
+		f.checkedSource = source
+		f.sourceCheckErr = nil
+		if result := rclone.CheckSyncSource(rootCtx, f.rcloneClient, source); !result.Passed {
+			f.sourceCheckErr = fmt.Errorf("⚠ %s. Choose Yes to create the job anyway", result.Message)
+		}
+	}
+	return f.sourceCheckErr
+}
+
+// validateBackupDir validates the optional backup directory. rclone refuses
+// a backup directory that overlaps the destination.
+func (f *SyncJobForm) validateBackupDir(dir string) error {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return nil
+	}
+
+	dest := f.destPath
+	if f.destRemote != "" {
+		dest = f.destRemote + ":" + f.destPath
+	}
+
+	backupRemote, backupPath := config.SplitLocation(dir)
+	destRemote, destPath := config.SplitLocation(dest)
+	if backupRemote == "" && !filepath.IsAbs(backupPath) {
+		return fmt.Errorf("local backup directory must be absolute or start with ~")
+	}
+	if backupRemote == destRemote && config.PathsOverlap(backupPath, destPath) {
+		return fmt.Errorf("backup directory must be outside the destination")
+	}
+	return nil
+}
+
+// validateVerifyAfter rejects verification for move jobs, whose source
+// files are gone once the job finishes.
+func (f *SyncJobForm) validateVerifyAfter(verify bool) error {
+	if verify && f.direction == "move" {
+		return fmt.Errorf("verify after sync is not available for move jobs")
+	}
+	return nil
+}
+
+// validateBackupSuffix validates the optional backup suffix.
+func validateBackupSuffix(suffix string) error {
+	if strings.ContainsAny(suffix, "/\\") {
+		return fmt.Errorf("suffix must not contain path separators")
+	}
+	return nil
+}
+
+// validateModifyWindow checks that the modify window is empty or a
+// non-negative duration such as 1s or 500ms.
+func validateModifyWindow(window string) error {
+	window = strings.TrimSpace(window)
+	if window == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(window)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q (e.g., 1s, 2s, 500ms)", window)
+	}
+	if d < 0 {
+		return fmt.Errorf("modify window must not be negative")
+	}
+	return nil
+}
+
+// validateWorkingDir checks that the optional working directory is a local
+// path that systemd can use, which means absolute or starting with ~.
+func validateWorkingDir(dir string) error {
+	dir = strings.TrimSpace(dir)
+	if dir == "" {
+		return nil
+	}
+	if !filepath.IsAbs(components.ExpandHome(dir)) {
+		return fmt.Errorf("working directory must be absolute or start with ~")
+	}
+	return nil
+}
+
+// validateWorkingDirExists asks to create a missing working directory, since
+// the service fails to start without it.
+func (f *SyncJobForm) validateWorkingDirExists(create bool) error {
+	dir := strings.TrimSpace(f.workingDir)
+	if create || dir == "" {
+		return nil
+	}
+	if info, err := os.Stat(components.ExpandHome(dir)); err != nil {
+		return fmt.Errorf("⚠ %s does not exist. Choose Yes to create it", dir)
+	} else if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	return nil
+}
+
 // validateOnCalendar validates the OnCalendar timer string.
 func (f *SyncJobForm) validateOnCalendar(calendar string) error {
 	return rclone.ValidateOnCalendar(calendar)
 }
 
-// validateMaxTransfers validates the max transfers field.
+// validateMaxTransfers validates the max transfers field. Zero is allowed
+// and leaves rclone's default.
 func (f *SyncJobForm) validateMaxTransfers(value string) error {
 	if value == "" {
 		return nil
@@ -427,12 +840,53 @@ func (f *SyncJobForm) validateMaxTransfers(value string) error {
 	if err != nil {
 		return fmt.Errorf("must be a valid number")
 	}
-	if num <= 0 {
-		return fmt.Errorf("must be greater than 0")
+	if num < 0 {
+		return fmt.Errorf("must not be negative")
 	}
 	return nil
 }
 
+// validateMaxRetries validates the optional number of retries.
+func (f *SyncJobForm) validateMaxRetries(value string) error {
+	retries, err := parseOptionalInt(value)
+	if err != nil {
+		return err
+	}
+	return systemd.ValidateRetries(&models.SyncOptions{MaxRetries: retries})
+}
+
+// validateNiceLevel validates the optional nice level.
+func (f *SyncJobForm) validateNiceLevel(value string) error {
+	nice, err := parseOptionalInt(value)
+	if err != nil {
+		return err
+	}
+	return systemd.ValidateSyncPriority(&models.SyncOptions{Nice: nice})
+}
+
+// validateIONiceLevel validates the optional I/O priority against the
+// chosen I/O class.
+func (f *SyncJobForm) validateIONiceLevel(value string) error {
+	level, err := parseOptionalInt(value)
+	if err != nil {
+		return err
+	}
+	return systemd.ValidateSyncPriority(&models.SyncOptions{IONiceClass: f.ioniceClass, IONicePriority: level})
+}
+
+// parseOptionalInt parses an optional number field, where empty is zero.
+func parseOptionalInt(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("must be a valid number")
+	}
+	return n, nil
+}
+
 // getRemotePathSuggestions returns dynamic suggestions for remote paths.
 func (f *SyncJobForm) getRemotePathSuggestions() []string {
 	staticSuggestions := []string{"/", "/Photos", "/Documents", "/Backup", "/Sync"}
@@ -493,7 +947,7 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 	}
 
 	// Build the source path
-	source := f.sourceRemote + ":" + f.sourcePath
+	source := f.source()
 
 	// Build the destination path
 	var destination string
@@ -503,6 +957,14 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 		destination = components.ExpandHome(f.destPath)
 	}
 
+	// Create the working directory if asked to
+	workingDir := strings.TrimSpace(f.workingDir)
+	if workingDir != "" && f.createWorkDir {
+		if err := os.MkdirAll(components.ExpandHome(workingDir), 0755); err != nil {
+			return SyncJobsErrorMsg{Err: fmt.Errorf("failed to create working directory: %w", err)}
+		}
+	}
+
 	// Parse max transfers
 	transfers := 4
 	if f.maxTransfers != "" {
@@ -514,6 +976,15 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 		}
 	}
 
+	// Invalid values were rejected by the fields' validation
+	nice, _ := parseOptionalInt(f.niceLevel)
+	maxRetries, _ := parseOptionalInt(f.maxRetries)
+	retryDelay := strings.TrimSpace(f.retryDelay)
+	if maxRetries == 0 {
+		retryDelay = ""
+	}
+	ioniceLevel, _ := parseOptionalInt(f.ioniceLevel)
+
 	// Determine delete mode
 	deleteAfter := false
 	deleteExtraneous := false
@@ -528,6 +999,7 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 	scheduleType := f.scheduleType
 	onCalendar := f.onCalendar
 	onBootSec := f.onBootSec
+	randomizedDelay := strings.TrimSpace(f.randomizedDelay)
 
 	switch scheduleType {
 	case "timer":
@@ -537,11 +1009,14 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 	case "manual":
 		onCalendar = ""
 		onBootSec = ""
+		randomizedDelay = ""
 	}
 
 	// Build the sync job configuration
 	job := models.SyncJobConfig{
 		Name:        f.name,
+		Notes:       strings.TrimSpace(f.notes),
+		Description: strings.TrimSpace(f.description),
 		Source:      source,
 		Destination: destination,
 		SyncOptions: models.SyncOptions{
@@ -549,19 +1024,44 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 			DeleteAfter:      deleteAfter,
 			DeleteExtraneous: deleteExtraneous,
 			DryRun:           f.dryRun,
+			VerifyAfter:      f.verifyAfter,
+			ResyncOnFirstRun: f.resyncFirstRun && f.direction == "bisync",
+			BackupDir:        strings.TrimSpace(f.backupDir),
+			Suffix:           strings.TrimSpace(f.suffix),
+			ModifyWindow:     strings.TrimSpace(f.modifyWindow),
+			WorkingDir:       workingDir,
 			ExcludePattern:   f.excludePattern,
+			Filters:          systemd.ParseFilterLines(f.filters),
 			Transfers:        transfers,
-			BandwidthLimit:   f.bandwidthLimit,
+			BandwidthLimit:   strings.Join(strings.Fields(f.bandwidthLimit), " "),
+			MaxDuration:      strings.TrimSpace(f.maxDuration),
+			MaxRetries:       maxRetries,
+			RetryDelay:       retryDelay,
+			Nice:             nice,
+			IONiceClass:      f.ioniceClass,
+			IONicePriority:   ioniceLevel,
 			LogLevel:         f.logLevel,
+			ExtraArgs:        rclone.AddFlags(systemd.NormalizeExtraArgs(f.extraArgs), f.backendFlags),
 		},
 		Schedule: models.ScheduleConfig{
-			Type:             scheduleType,
-			OnCalendar:       onCalendar,
-			OnBootSec:        onBootSec,
-			RequireACPower:   f.requireACPower,
-			RequireUnmetered: f.requireUnmetered,
+			Type:               scheduleType,
+			OnCalendar:         onCalendar,
+			OnBootSec:          onBootSec,
+			RandomizedDelaySec: randomizedDelay,
+			RequireACPower:     f.requireACPower,
+			RequireUnmetered:   f.requireUnmetered,
 		},
-		Enabled: f.enabled,
+		RequiresMounts:           f.requiresMounts,
+		StopWithMount:            f.stopWithMount,
+		SerializeWithSharedMount: f.serialize,
+		DocumentationURL:         strings.TrimSpace(f.docURL),
+		Enabled:                  f.enabled,
+	}
+
+	if f.config != nil {
+		if err := f.config.ValidateRequiredMounts(job); err != nil {
+			return SyncJobsErrorMsg{Err: err}
+		}
 	}
 
 	// Set timestamps
@@ -569,6 +1069,9 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 	if f.isEdit && f.job != nil {
 		job.ID = f.job.ID
 		job.CreatedAt = f.job.CreatedAt
+		job.Favorite = f.job.Favorite
+		job.LastRun = f.job.LastRun
+		job.RunHistory = f.job.RunHistory
 	} else {
 		job.ID = uuid.New().String()[:8]
 		job.CreatedAt = now
@@ -663,8 +1166,9 @@ func (f *SyncJobForm) submitForm() tea.Msg {
 		}
 	}
 
-	// Run immediately if requested
-	if f.runImmediately {
+	// Run immediately if requested; a draft made without rclone installed
+	// cannot run yet
+	if f.runImmediately && !f.rcloneMissing {
 		if err := f.manager.RunSyncNow(serviceName); err != nil {
 			if f.config != nil {
 				// Attempt rollback on failure; errors are ignored since we're already
@@ -869,6 +869,11 @@ func TestSyncJobForm_MaxTransfersParsing(t *testing.T) {
 			maxTransfers:  "",
 			expectedValue: 4, // Default
 		},
+		{
+			name:          "Zero leaves rclone's default",
+			maxTransfers:  "0",
+			expectedValue: 0,
+		},
 		{
 			name:          "Invalid number uses default",
 			maxTransfers:  "abc",
@@ -1040,6 +1045,36 @@ func TestSyncJobForm_SubmitFormWithRemoteDestination(t *testing.T) {
 	}
 }
 
+func TestSyncJobForm_BackendFlags(t *testing.T) {
+	form := NewSyncJobForm(nil, createTestRemotes(), nil, createSyncTestGenerator(t), createTestManager(), nil, false)
+	form.name = "Docs Backup"
+	form.sourceRemote = "gdrive"
+	form.sourcePath = "/Documents"
+	form.destRemote = "s3"
+	form.destPath = "/backup"
+	form.scheduleType = "manual"
+	form.extraArgs = "--fast-list"
+	form.backendFlags = []string{"--drive-skip-gdocs", "--s3-chunk-size=16M"}
+
+	// Suggestions cover both the source and the destination backend
+	var drive, s3 bool
+	for _, option := range backendFlagOptions(form.remotes, form.sourceRemote, form.destRemote) {
+		drive = drive || strings.HasPrefix(option.Value, "--drive-")
+		s3 = s3 || strings.HasPrefix(option.Value, "--s3-")
+	}
+	if !drive || !s3 {
+		t.Errorf("expected drive and s3 suggestions, got drive=%v s3=%v", drive, s3)
+	}
+
+	createdMsg, ok := form.submitForm().(SyncJobCreatedMsg)
+	if !ok {
+		t.Fatal("expected SyncJobCreatedMsg")
+	}
+	if got, want := createdMsg.Job.SyncOptions.ExtraArgs, "--fast-list --drive-skip-gdocs --s3-chunk-size=16M"; got != want {
+		t.Errorf("ExtraArgs = %q, want %q", got, want)
+	}
+}
+
 func TestSyncJobForm_EditPreservesAllOptions(t *testing.T) {
 	cfg := createSyncTestConfig()
 	remotes := createTestRemotes()
@@ -1058,6 +1093,7 @@ func TestSyncJobForm_EditPreservesAllOptions(t *testing.T) {
 			Transfers:        8,
 			BandwidthLimit:   "20M",
 			LogLevel:         "DEBUG",
+			ExtraArgs:        "--fast-list",
 		},
 		Schedule: models.ScheduleConfig{
 			Type:             "onboot",
@@ -1101,6 +1137,9 @@ func TestSyncJobForm_EditPreservesAllOptions(t *testing.T) {
 	if !form.requireUnmetered {
 		t.Error("requireUnmetered should be true")
 	}
+	if form.extraArgs != "--fast-list" {
+		t.Errorf("extraArgs = %q, want '--fast-list'", form.extraArgs)
+	}
 }
 
 func TestSyncJobForm_DeleteModeParsing(t *testing.T) {
@@ -1375,16 +1414,15 @@ func TestSyncJobForm_ValidateMaxTransfers(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:        "Zero is invalid",
+			name:        "Zero uses rclone's default",
 			value:       "0",
-			expectError: true,
-			errContains: "greater than 0",
+			expectError: false,
 		},
 		{
 			name:        "Negative number is invalid",
 			value:       "-1",
 			expectError: true,
-			errContains: "greater than 0",
+			errContains: "negative",
 		},
 		{
 			name:        "Non-numeric is invalid",
@@ -1476,3 +1514,365 @@ func TestSyncJobForm_ValidateOnCalendar_SystemdFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSyncJobForm_NoRemotesFallsBackToManualEntry(t *testing.T) {
+	form := NewSyncJobForm(nil, nil, createSyncTestConfig(), nil, nil, &rclone.Client{}, false)
+
+	if !form.manualRemote {
+		t.Error("manualRemote should be true when no remotes are available")
+	}
+	if !form.rcloneMissing {
+		t.Error("rcloneMissing should be true when rclone is not installed")
+	}
+	if !strings.Contains(form.remoteNotice, "rclone not found") {
+		t.Errorf("remoteNotice = %q, should contain 'rclone not found'", form.remoteNotice)
+	}
+}
+
+func TestSyncJobForm_SubmitFormManualRemote(t *testing.T) {
+	cfg := createSyncTestConfig()
+	gen := createSyncTestGenerator(t)
+	mgr := createTestManager()
+	mgr.RunSyncNowErr = os.ErrNotExist
+	form := NewSyncJobForm(nil, nil, cfg, gen, mgr, &rclone.Client{}, false)
+
+	form.name = "Draft Sync"
+	form.sourceRemote = "gdrive:"
+	form.sourcePath = "/Photos"
+	form.destPath = "/backup/photos"
+	form.scheduleType = "manual"
+	form.runImmediately = true
+
+	msg := form.submitForm()
+
+	createdMsg, ok := msg.(SyncJobCreatedMsg)
+	if !ok {
+		t.Fatalf("expected SyncJobCreatedMsg, got %T (%v)", msg, msg)
+	}
+	if createdMsg.Job.Source != "gdrive:/Photos" {
+		t.Errorf("job.Source = %q, want 'gdrive:/Photos'", createdMsg.Job.Source)
+	}
+}
+
+func TestSyncJobForm_ValidateBackupDir(t *testing.T) {
+	tests := []struct {
+		name          string
+		destRemote    string
+		destPath      string
+		backupDir     string
+		errorContains string
+	}{
+		{name: "Empty is allowed", destPath: "/data/sync", backupDir: ""},
+		{name: "Local outside destination", destPath: "/data/sync", backupDir: "/data/sync-backup"},
+		{name: "Local same as destination", destPath: "/data/sync", backupDir: "/data/sync/", errorContains: "outside the destination"},
+		{name: "Local inside destination", destPath: "/data/sync", backupDir: "/data/sync/.old", errorContains: "outside the destination"},
+		{name: "Local containing destination", destPath: "/data/sync", backupDir: "/data", errorContains: "outside the destination"},
+		{name: "Relative local path", destPath: "/data/sync", backupDir: "backups", errorContains: "absolute"},
+		{name: "Remote outside destination", destRemote: "gdrive", destPath: "Documents", backupDir: "gdrive:Backups"},
+		{name: "Remote inside destination", destRemote: "gdrive", destPath: "Documents", backupDir: "gdrive:Documents/old", errorContains: "outside the destination"},
+		{name: "Different remote", destRemote: "gdrive", destPath: "Documents", backupDir: "s3:Documents"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := &SyncJobForm{destRemote: tt.destRemote, destPath: tt.destPath}
+			err := form.validateBackupDir(tt.backupDir)
+			if tt.errorContains == "" {
+				if err != nil {
+					t.Errorf("validateBackupDir(%q) error = %v, want nil", tt.backupDir, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("validateBackupDir(%q) error = %v, want %q", tt.backupDir, err, tt.errorContains)
+			}
+		})
+	}
+}
+
+func TestValidateBackupSuffix(t *testing.T) {
+	if err := validateBackupSuffix(".bak"); err != nil {
+		t.Errorf("validateBackupSuffix(.bak) error = %v", err)
+	}
+	if err := validateBackupSuffix("old/.bak"); err == nil {
+		t.Error("validateBackupSuffix should reject path separators")
+	}
+}
+
+func TestValidateModifyWindow(t *testing.T) {
+	for _, valid := range []string{"", "1s", "2s", "500ms", " 1h "} {
+		if err := validateModifyWindow(valid); err != nil {
+			t.Errorf("validateModifyWindow(%q) error = %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{"2", "soon", "-1s"} {
+		if err := validateModifyWindow(invalid); err == nil {
+			t.Errorf("validateModifyWindow(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestSyncJobForm_PreservesModifyWindow(t *testing.T) {
+	job := &models.SyncJobConfig{
+		ID:          "abc12345",
+		Name:        "fat",
+		Source:      "gdrive:Docs",
+		Destination: "/media/usb/docs",
+		SyncOptions: models.SyncOptions{ModifyWindow: "2s"},
+	}
+
+	form := NewSyncJobForm(job, []rclone.Remote{{Name: "gdrive", Type: "drive"}}, nil, nil, nil, nil, true)
+
+	if form.modifyWindow != "2s" {
+		t.Errorf("form modifyWindow = %q, want 2s", form.modifyWindow)
+	}
+}
+
+func TestSyncJobForm_MaxDuration(t *testing.T) {
+	job := &models.SyncJobConfig{
+		ID:          "abc12345",
+		Name:        "bounded",
+		Source:      "gdrive:Docs",
+		Destination: "/backup/docs",
+		SyncOptions: models.SyncOptions{MaxDuration: "2h"},
+		Schedule:    models.ScheduleConfig{Type: "manual"},
+	}
+
+	form := NewSyncJobForm(job, []rclone.Remote{{Name: "gdrive", Type: "drive"}}, nil, createSyncTestGenerator(t), createTestManager(), nil, true)
+	if form.maxDuration != "2h" {
+		t.Fatalf("form maxDuration = %q, want 2h", form.maxDuration)
+	}
+
+	form.maxDuration = " 90m "
+	updated, ok := form.submitForm().(SyncJobUpdatedMsg)
+	if !ok {
+		t.Fatal("expected SyncJobUpdatedMsg")
+	}
+	if updated.Job.SyncOptions.MaxDuration != "90m" {
+		t.Errorf("MaxDuration = %q, want 90m", updated.Job.SyncOptions.MaxDuration)
+	}
+}
+
+func TestSyncJobForm_PreservesBackupOptions(t *testing.T) {
+	job := &models.SyncJobConfig{
+		ID:          "abc12345",
+		Name:        "safe",
+		Source:      "gdrive:Docs",
+		Destination: "/data/docs",
+		SyncOptions: models.SyncOptions{BackupDir: "/data/docs-old", Suffix: ".bak"},
+	}
+
+	form := NewSyncJobForm(job, []rclone.Remote{{Name: "gdrive", Type: "drive"}}, nil, nil, nil, nil, true)
+
+	if form.backupDir != "/data/docs-old" || form.suffix != ".bak" {
+		t.Errorf("form backup options = %q/%q, want /data/docs-old/.bak", form.backupDir, form.suffix)
+	}
+}
+
+func TestSyncJobForm_ValidateVerifyAfter(t *testing.T) {
+	form := &SyncJobForm{direction: "sync"}
+	if err := form.validateVerifyAfter(true); err != nil {
+		t.Errorf("validateVerifyAfter(true) for sync error = %v", err)
+	}
+
+	form.direction = "move"
+	if err := form.validateVerifyAfter(true); err == nil {
+		t.Error("validateVerifyAfter(true) for move should fail")
+	}
+	if err := form.validateVerifyAfter(false); err != nil {
+		t.Errorf("validateVerifyAfter(false) for move error = %v", err)
+	}
+}
+
+func TestSyncJobForm_ValidateSourceExists(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "rclone")
+	calls := filepath.Join(dir, "calls")
+	body := "#!/bin/sh\necho \"$@\" >> " + calls + "\nfor arg in \"$@\"; do\n\tcase \"$arg\" in\n\t\tgdrive:/missing) echo \"directory not found\" >&2; exit 3 ;;\n\tesac\ndone\n"
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatal(err)
+	}
+	client := rclone.NewClientWithPath(script)
+	client.SetRetryConfig(rclone.RetryConfig{MaxRetries: 0})
+
+	form := NewSyncJobForm(nil, createTestRemotes(), createSyncTestConfig(), nil, nil, client, false)
+	form.sourceRemote = "gdrive"
+
+	form.sourcePath = "/Photos"
+	if err := form.validateSourceExists(false); err != nil {
+		t.Errorf("validateSourceExists() for an existing source error = %v", err)
+	}
+
+	form.sourcePath = "/missing"
+	err := form.validateSourceExists(false)
+	if err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("validateSourceExists() for a missing source = %v, want a warning", err)
+	}
+	if err := form.validateSourceExists(false); err == nil {
+		t.Error("the warning should persist until overridden")
+	}
+	if err := form.validateSourceExists(true); err != nil {
+		t.Errorf("validateSourceExists(true) should override the warning, got %v", err)
+	}
+
+	data, _ := os.ReadFile(calls)
+	if n := strings.Count(string(data), "lsf gdrive:/missing --max-depth 1"); n != 1 {
+		t.Errorf("missing source listed %d times, want 1 (result should be reused)", n)
+	}
+}
+
+func TestSyncJobForm_ValidateSourceExists_EditUnchanged(t *testing.T) {
+	job := &models.SyncJobConfig{ID: "job1", Name: "Docs", Source: "gdrive:/missing", Destination: "/tmp/docs"}
+	client := rclone.NewClientWithPath(filepath.Join(t.TempDir(), "no-rclone"))
+
+	form := NewSyncJobForm(job, createTestRemotes(), createSyncTestConfig(), nil, nil, client, true)
+	if err := form.validateSourceExists(false); err != nil {
+		t.Errorf("an unchanged source should not be checked again, got %v", err)
+	}
+}
+
+func TestSyncJobForm_EditPreservesZeroTransfers(t *testing.T) {
+	job := &models.SyncJobConfig{ID: "job1", Name: "Docs", Source: "gdrive:/Docs", Destination: "/tmp/docs"}
+
+	form := NewSyncJobForm(job, createTestRemotes(), createSyncTestConfig(), nil, nil, nil, true)
+	if form.maxTransfers != "0" {
+		t.Errorf("maxTransfers = %q, want 0 so the job keeps rclone's default", form.maxTransfers)
+	}
+}
+
+func TestSyncJobForm_WorkingDir(t *testing.T) {
+	for _, valid := range []string{"", "/var/lib/bisync", "~/.local/state/bisync"} {
+		if err := validateWorkingDir(valid); err != nil {
+			t.Errorf("validateWorkingDir(%q) error = %v", valid, err)
+		}
+	}
+	if err := validateWorkingDir("state/bisync"); err == nil {
+		t.Error("validateWorkingDir() should reject a relative path")
+	}
+
+	missing := filepath.Join(t.TempDir(), "bisync", "state")
+	form := NewSyncJobForm(nil, createTestRemotes(), createSyncTestConfig(), createSyncTestGenerator(t), createTestManager(), nil, false)
+	form.workingDir = missing
+	if err := form.validateWorkingDirExists(false); err == nil || !strings.Contains(err.Error(), "Choose Yes to create it") {
+		t.Errorf("validateWorkingDirExists(false) error = %v, want an offer to create the directory", err)
+	}
+	if err := form.validateWorkingDirExists(true); err != nil {
+		t.Errorf("validateWorkingDirExists(true) error = %v", err)
+	}
+
+	form.name = "Bisync"
+	form.sourceRemote = "gdrive"
+	form.sourcePath = "/Docs"
+	form.destPath = t.TempDir()
+	form.scheduleType = "manual"
+	form.createWorkDir = true
+	msg, ok := form.submitForm().(SyncJobCreatedMsg)
+	if !ok {
+		t.Fatalf("expected SyncJobCreatedMsg, got %T", msg)
+	}
+	if msg.Job.SyncOptions.WorkingDir != missing {
+		t.Errorf("WorkingDir = %q, want %q", msg.Job.SyncOptions.WorkingDir, missing)
+	}
+	if info, err := os.Stat(missing); err != nil || !info.IsDir() {
+		t.Errorf("submitting should create the working directory, stat error = %v", err)
+	}
+}
+
+func TestSyncJobForm_Priority(t *testing.T) {
+	job := &models.SyncJobConfig{
+		ID: "job1", Name: "Docs", Source: "gdrive:/Docs", Destination: "/tmp/docs",
+		SyncOptions: models.SyncOptions{Nice: 10, IONiceClass: 2, IONicePriority: 7},
+	}
+	form := NewSyncJobForm(job, createTestRemotes(), createSyncTestConfig(), nil, nil, nil, true)
+	if form.niceLevel != "10" || form.ioniceClass != 2 || form.ioniceLevel != "7" {
+		t.Errorf("form priority = %q/%d/%q, want 10/2/7", form.niceLevel, form.ioniceClass, form.ioniceLevel)
+	}
+
+	if err := form.validateNiceLevel("-1"); err == nil {
+		t.Error("validateNiceLevel() should reject raising the priority")
+	}
+	if err := form.validateNiceLevel("low"); err == nil {
+		t.Error("validateNiceLevel() should reject a non-number")
+	}
+	form.ioniceClass = 3
+	if err := form.validateIONiceLevel("7"); err == nil {
+		t.Error("validateIONiceLevel() should reject a level for the idle class")
+	}
+	if err := form.validateIONiceLevel(""); err != nil {
+		t.Errorf("validateIONiceLevel(\"\") error = %v", err)
+	}
+}
+
+func TestSyncJobForm_SubmitRandomizedDelay(t *testing.T) {
+	for _, tt := range []struct {
+		scheduleType string
+		want         string
+	}{
+		{"timer", "30min"},
+		{"manual", ""},
+	} {
+		form := NewSyncJobForm(nil, createTestRemotes(), createSyncTestConfig(), createSyncTestGenerator(t), createTestManager(), nil, false)
+		form.name = "Spread " + tt.scheduleType
+		form.sourceRemote = "gdrive"
+		form.sourcePath = "/Photos"
+		form.destPath = "/backup/photos"
+		form.scheduleType = tt.scheduleType
+		form.onCalendar = "hourly"
+		form.randomizedDelay = " 30min "
+
+		created, ok := form.submitForm().(SyncJobCreatedMsg)
+		if !ok {
+			t.Fatalf("%s: expected SyncJobCreatedMsg", tt.scheduleType)
+		}
+		if got := created.Job.Schedule.RandomizedDelaySec; got != tt.want {
+			t.Errorf("%s: RandomizedDelaySec = %q, want %q", tt.scheduleType, got, tt.want)
+		}
+	}
+
+	job := createTestSyncJobs()[0]
+	job.Schedule.RandomizedDelaySec = "15min"
+	if form := NewSyncJobForm(&job, createTestRemotes(), createSyncTestConfig(), nil, nil, nil, true); form.randomizedDelay != "15min" {
+		t.Errorf("editing should load the randomized delay, got %q", form.randomizedDelay)
+	}
+}
+
+func TestSyncJobForm_SubmitRetries(t *testing.T) {
+	for _, tt := range []struct {
+		maxRetries string
+		wantDelay  string
+	}{
+		{"3", "5min"},
+		{"", ""},
+	} {
+		form := NewSyncJobForm(nil, createTestRemotes(), createSyncTestConfig(), createSyncTestGenerator(t), createTestManager(), nil, false)
+		form.name = "Retried " + tt.maxRetries
+		form.sourceRemote = "gdrive"
+		form.sourcePath = "/Photos"
+		form.destPath = "/backup/photos"
+		form.scheduleType = "manual"
+		form.maxRetries = tt.maxRetries
+		form.retryDelay = " 5min "
+
+		created, ok := form.submitForm().(SyncJobCreatedMsg)
+		if !ok {
+			t.Fatalf("retries %q: expected SyncJobCreatedMsg", tt.maxRetries)
+		}
+		if got := created.Job.SyncOptions.RetryDelay; got != tt.wantDelay {
+			t.Errorf("retries %q: RetryDelay = %q, want %q", tt.maxRetries, got, tt.wantDelay)
+		}
+	}
+
+	job := createTestSyncJobs()[0]
+	job.SyncOptions.MaxRetries = 2
+	job.SyncOptions.RetryDelay = "1min"
+	form := NewSyncJobForm(&job, createTestRemotes(), createSyncTestConfig(), nil, nil, nil, true)
+	if form.maxRetries != "2" || form.retryDelay != "1min" {
+		t.Errorf("editing should load the retries, got %q and %q", form.maxRetries, form.retryDelay)
+	}
+
+	for _, bad := range []string{"-1", "many"} {
+		if form.validateMaxRetries(bad) == nil {
+			t.Errorf("validateMaxRetries(%q) should fail", bad)
+		}
+	}
+}
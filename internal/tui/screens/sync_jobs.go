@@ -4,10 +4,12 @@ package screens
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
 	"github.com/dtg01100/rclone-mount-sync/internal/models"
@@ -25,6 +27,11 @@ const (
 	SyncJobsModeEdit
 	SyncJobsModeDelete
 	SyncJobsModeDetails
+	SyncJobsModeLogs
+	SyncJobsModeFetch
+	SyncJobsModeBulkEdit
+	SyncJobsModeTimerConfirm
+	SyncJobsModeRunConfirm
 )
 
 // SyncJobsScreen manages sync job configurations.
@@ -37,16 +44,38 @@ type SyncJobsScreen struct {
 	height   int
 	mode     SyncJobsScreenMode
 	goBack   bool
+	marked   map[string]bool // IDs of sync jobs selected for bulk edit
+	hidden   int             // Disabled sync jobs left out of the list
+	search   listSearch      // Narrows the list as a query is typed
 
 	// Sub-screens
 	form    *SyncJobForm
 	details *SyncJobDetails
+	logs    *LogViewer
 	delete  *SyncJobDeleteConfirm
+	bulk    *bulkEdit
+
+	// Confirmation before disabling the timer of a running job
+	timerConfirm    *components.ConfirmDialog
+	timerConfirmJob models.SyncJobConfig
+
+	// Confirmation before running a sync that deletes in the destination
+	runConfirm    *components.ConfirmDialog
+	runConfirmJob models.SyncJobConfig
+
+	// One-shot fetch
+	fetchForm   *huh.Form
+	fetchSource string
+	fetchDest   string
+	fetch       *fetchRun
+
+	// Dry run started from the list
+	dryRun *dryRunRun
 
 	// Services
 	config    *config.Config
 	rclone    *rclone.Client
-	generator *systemd.Generator
+	generator systemd.UnitGenerator
 	manager   systemd.ServiceManager
 
 	// Messages
@@ -65,7 +94,7 @@ func NewSyncJobsScreen() *SyncJobsScreen {
 }
 
 // SetServices sets the required services for the sync jobs screen.
-func (s *SyncJobsScreen) SetServices(cfg *config.Config, rcloneClient *rclone.Client, gen *systemd.Generator, mgr systemd.ServiceManager) {
+func (s *SyncJobsScreen) SetServices(cfg *config.Config, rcloneClient *rclone.Client, gen systemd.UnitGenerator, mgr systemd.ServiceManager) {
 	s.config = cfg
 	s.rclone = rcloneClient
 	s.generator = gen
@@ -79,6 +108,15 @@ func (s *SyncJobsScreen) SetSize(width, height int) {
 	if s.form != nil {
 		s.form.SetSize(width, height)
 	}
+	if s.logs != nil {
+		s.logs.SetSize(width, height)
+	}
+	if s.timerConfirm != nil {
+		s.timerConfirm.SetSize(width, height)
+	}
+	if s.runConfirm != nil {
+		s.runConfirm.SetSize(width, height)
+	}
 }
 
 // Init initializes the screen.
@@ -97,18 +135,30 @@ func (s *SyncJobsScreen) loadSyncJobs() tea.Msg {
 		return SyncJobsErrorMsg{Err: fmt.Errorf("failed to reload config: %w", err)}
 	}
 
-	// Load sync jobs from config
-	s.jobs = s.config.SyncJobs
+	// Load sync jobs from config, favorites first
+	s.jobs, s.hidden = s.listedSyncJobs()
 
 	// Load statuses for each sync job (only if generator and manager are available)
 	if s.generator != nil && s.manager != nil {
+		recorded := false
 		for _, job := range s.jobs {
 			serviceName := s.generator.ServiceName(job.ID, "sync") + ".service"
 			status, err := s.manager.GetDetailedStatus(serviceName)
 			if err == nil {
 				s.statuses[job.Name] = status
+				if outcome, ok := models.RunOutcomeFromStatus(status); ok && s.recordRun(job.ID, outcome) {
+					recorded = true
+				}
 			}
 		}
+
+		// Persist newly finished runs so the history outlives the journal
+		if recorded {
+			if err := s.config.Save(); err != nil {
+				return SyncJobsErrorMsg{Err: fmt.Errorf("failed to save run history: %w", err)}
+			}
+			s.jobs, s.hidden = s.listedSyncJobs()
+		}
 	}
 
 	return SyncJobsLoadedMsg{Jobs: s.jobs}
@@ -129,7 +179,11 @@ func (s *SyncJobsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Form submitted, handled by form
 		return s, nil
 	case SyncJobCreatedMsg:
-		s.jobs = append(s.jobs, msg.Job)
+		if !msg.Job.Enabled && hideDisabled(s.config) {
+			s.hidden++
+		} else {
+			s.jobs = append(s.jobs, msg.Job)
+		}
 		s.success = fmt.Sprintf("Sync job '%s' created successfully", msg.Job.Name)
 		s.mode = SyncJobsModeList
 		s.err = nil
@@ -138,7 +192,13 @@ func (s *SyncJobsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update the job in the list
 		for i, j := range s.jobs {
 			if j.ID == msg.Job.ID {
-				s.jobs[i] = msg.Job
+				if !msg.Job.Enabled && hideDisabled(s.config) {
+					s.jobs = append(s.jobs[:i], s.jobs[i+1:]...)
+					s.hidden++
+					s.clampCursor()
+				} else {
+					s.jobs[i] = msg.Job
+				}
 				break
 			}
 		}
@@ -146,6 +206,26 @@ func (s *SyncJobsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		s.mode = SyncJobsModeList
 		s.err = nil
 		return s, nil
+	case FetchProgressMsg, FetchDoneMsg:
+		return s, s.handleFetchMsg(msg)
+	case SyncDryRunOutputMsg, SyncDryRunDoneMsg:
+		return s, s.handleDryRunMsg(msg)
+	case SyncJobScheduleTestedMsg:
+		return s, s.handleScheduleTested(msg)
+	case SyncJobProgressMsg:
+		// Polling stops once the details view is closed
+		if s.details != nil {
+			return s, s.details.updateProgress(msg)
+		}
+		return s, nil
+	}
+
+	if s.mode == SyncJobsModeFetch && s.fetchForm != nil {
+		return s.updateFetchForm(msg)
+	}
+
+	if s.mode == SyncJobsModeBulkEdit && s.bulk != nil {
+		return s.updateBulkEdit(msg)
 	}
 
 	// Then handle form mode - pass remaining messages to form
@@ -164,8 +244,19 @@ func (s *SyncJobsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return s.updateList(msg)
 		case SyncJobsModeDelete:
 			return s.updateDelete(msg)
+		case SyncJobsModeTimerConfirm:
+			return s.updateTimerConfirm(msg)
+		case SyncJobsModeRunConfirm:
+			return s.updateRunConfirm(msg)
 		case SyncJobsModeDetails:
 			return s.updateDetails(msg)
+		case SyncJobsModeLogs:
+			return s.updateLogs(msg)
+		}
+
+	case LogViewerLoadedMsg:
+		if s.logs != nil {
+			s.logs, _ = s.logs.Update(msg)
 		}
 
 	case SyncJobsLoadedMsg:
@@ -198,7 +289,16 @@ func (s *SyncJobsScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // updateList handles updates when in list mode.
 func (s *SyncJobsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if s.search.editing {
+		cmd := s.search.update(msg)
+		s.applySearch()
+		return s, cmd
+	}
+
 	switch msg.String() {
+	case "/":
+		// Filter the list as a query is typed
+		return s, s.search.open()
 	case "up", "k":
 		if s.cursor > 0 {
 			s.cursor--
@@ -207,15 +307,20 @@ func (s *SyncJobsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if s.cursor < len(s.jobs)-1 {
 			s.cursor++
 		}
-	case "a", "n":
+	case SyncJobKeys.Key(ActionAdd):
 		// Add new sync job
 		return s.startCreateForm()
-	case "e":
+	case SyncJobKeys.Key(ActionEdit):
 		// Edit selected sync job
 		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
 			return s.startEditForm()
 		}
-	case "d":
+	case SyncJobKeys.Key(ActionClone):
+		// Create a new sync job from a copy of the selected one
+		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
+			return s.startCloneForm()
+		}
+	case SyncJobKeys.Key(ActionDelete):
 		// Delete selected sync job
 		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
 			s.delete = NewSyncJobDeleteConfirm(s.jobs[s.cursor])
@@ -227,30 +332,312 @@ func (s *SyncJobsScreen) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter":
 		// View details
 		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
-			s.mode = SyncJobsModeDetails
-			s.details = NewSyncJobDetails(s.jobs[s.cursor], s.manager, s.generator)
+			s.openDetails(s.jobs[s.cursor])
+			return s, s.details.watchProgress()
 		}
-	case "r":
-		// Run sync job now
+	case SyncJobKeys.Key(ActionRun):
+		// Run sync job now, asking first if it can delete files
 		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
-			return s.runSyncJobNow()
+			return s.confirmRunNow()
 		}
-	case "t":
+	case SyncJobKeys.Key(ActionDryRun):
+		// Run the job once with --dry-run and show what it would change
+		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
+			return s.startDryRun()
+		}
+	case SyncJobKeys.Key(ActionTestSchedule):
+		// Fire the installed service as its timer would, then show its logs
+		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
+			return s.testSchedule()
+		}
+	case SyncJobKeys.Key(ActionToggle):
 		// Toggle timer
 		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
 			return s.toggleTimer()
 		}
-	case "R":
+	case SyncJobKeys.Key(ActionFavorite):
+		// Pin or unpin the selected sync job
+		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
+			return s.toggleFavorite()
+		}
+	case SyncJobKeys.Key(ActionRefresh):
 		// Refresh sync job list
 		s.loading = true
 		return s, s.loadSyncJobs
+	case SyncJobKeys.Key(ActionHideDisabled):
+		// Hide or show disabled sync jobs
+		return s.toggleHideDisabled()
+	case SyncJobKeys.Key(ActionLogs):
+		// Jump straight to the selected sync job's logs
+		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
+			return s.openLogs()
+		}
+	case SyncJobKeys.Key(ActionFetch):
+		// Fetch a URL or remote path once, without saving a job
+		return s.startFetchForm()
+	case SyncJobKeys.Key(ActionMark):
+		// Mark or unmark the selected sync job for bulk edit
+		if len(s.jobs) > 0 && s.cursor < len(s.jobs) {
+			s.toggleMark()
+		}
+	case SyncJobKeys.Key(ActionBulkEdit):
+		// Change an option on all marked sync jobs
+		return s.startBulkEdit()
+	case "n":
+		// Also adds a sync job, unless n has been bound to another action
+		return s.startCreateForm()
 	case "esc":
+		// Clear the filter first, then leave
+		if s.search.query() != "" {
+			s.search.clear()
+			s.applySearch()
+			return s, nil
+		}
 		s.goBack = true
 	}
 
 	return s, nil
 }
 
+// toggleFavorite pins or unpins the selected sync job and keeps the cursor on it.
+func (s *SyncJobsScreen) toggleFavorite() (tea.Model, tea.Cmd) {
+	if s.config == nil {
+		s.err = fmt.Errorf("config not initialized")
+		return s, nil
+	}
+
+	job := s.jobs[s.cursor]
+	favorite := !job.Favorite
+
+	found := false
+	for i := range s.config.SyncJobs {
+		if s.config.SyncJobs[i].ID == job.ID {
+			s.config.SyncJobs[i].Favorite = favorite
+			found = true
+			break
+		}
+	}
+	if !found {
+		s.err = fmt.Errorf("sync job '%s' not found in config", job.Name)
+		return s, nil
+	}
+
+	if err := s.config.Save(); err != nil {
+		for i := range s.config.SyncJobs {
+			if s.config.SyncJobs[i].ID == job.ID {
+				s.config.SyncJobs[i].Favorite = !favorite
+				break
+			}
+		}
+		s.err = fmt.Errorf("failed to save config: %w", err)
+		return s, nil
+	}
+
+	s.jobs, s.hidden = s.listedSyncJobs()
+	for i, j := range s.jobs {
+		if j.ID == job.ID {
+			s.cursor = i
+			break
+		}
+	}
+
+	if favorite {
+		s.success = fmt.Sprintf("Sync job '%s' pinned to top", job.Name)
+	} else {
+		s.success = fmt.Sprintf("Sync job '%s' unpinned", job.Name)
+	}
+	s.err = nil
+	return s, nil
+}
+
+// toggleHideDisabled hides or shows disabled sync jobs, keeping the cursor
+// on the selected job when it is still listed.
+func (s *SyncJobsScreen) toggleHideDisabled() (tea.Model, tea.Cmd) {
+	if err := toggleHideDisabled(s.config); err != nil {
+		s.err = err
+		return s, nil
+	}
+
+	var selected string
+	if s.cursor < len(s.jobs) {
+		selected = s.jobs[s.cursor].ID
+	}
+	s.jobs, s.hidden = s.listedSyncJobs()
+	s.cursor = 0
+	for i, j := range s.jobs {
+		if j.ID == selected {
+			s.cursor = i
+			break
+		}
+	}
+
+	if hideDisabled(s.config) {
+		s.success = "Disabled sync jobs hidden"
+	} else {
+		s.success = "Showing disabled sync jobs"
+	}
+	s.err = nil
+	return s, nil
+}
+
+// listedSyncJobs returns the sync jobs to list, favorites first and narrowed
+// by the hide disabled setting and the search, and how many disabled ones
+// were hidden.
+func (s *SyncJobsScreen) listedSyncJobs() ([]models.SyncJobConfig, int) {
+	jobs, hidden := visibleSyncJobs(sortSyncJobsByFavorite(s.config.SyncJobs), hideDisabled(s.config))
+	return searchSyncJobs(jobs, s.search.query()), hidden
+}
+
+// applySearch relists the sync jobs after the search changed, keeping the cursor
+// within the narrowed list.
+func (s *SyncJobsScreen) applySearch() {
+	if s.config != nil {
+		s.jobs, s.hidden = s.listedSyncJobs()
+	}
+	s.clampCursor()
+}
+
+// clampCursor keeps the cursor within the list after items are removed.
+func (s *SyncJobsScreen) clampCursor() {
+	if s.cursor >= len(s.jobs) {
+		s.cursor = max(len(s.jobs)-1, 0)
+	}
+}
+
+// recordRun adds a finished run to the history of the sync job with the
+// given ID and reports whether it was new.
+func (s *SyncJobsScreen) recordRun(id string, outcome models.RunOutcome) bool {
+	for i := range s.config.SyncJobs {
+		if s.config.SyncJobs[i].ID == id {
+			return s.config.SyncJobs[i].RecordRun(outcome)
+		}
+	}
+	return false
+}
+
+// toggleMark marks or unmarks the selected sync job and moves to the next one.
+func (s *SyncJobsScreen) toggleMark() {
+	id := s.jobs[s.cursor].ID
+	if s.marked == nil {
+		s.marked = make(map[string]bool)
+	}
+	if s.marked[id] {
+		delete(s.marked, id)
+	} else {
+		s.marked[id] = true
+	}
+	if s.cursor < len(s.jobs)-1 {
+		s.cursor++
+	}
+}
+
+// startBulkEdit opens bulk edit for the marked sync jobs.
+func (s *SyncJobsScreen) startBulkEdit() (tea.Model, tea.Cmd) {
+	var ids, names []string
+	for _, j := range s.jobs {
+		if s.marked[j.ID] {
+			ids = append(ids, j.ID)
+			names = append(names, j.Name)
+		}
+	}
+	if len(ids) == 0 {
+		s.err = fmt.Errorf("no sync jobs marked - press space to mark sync jobs for bulk edit")
+		return s, nil
+	}
+
+	s.bulk = newBulkEdit("sync jobs", names, syncJobBulkFields, func(field bulkField, value string) []BulkEditResult {
+		return s.applyBulkEdit(ids, names, field, value)
+	})
+	s.mode = SyncJobsModeBulkEdit
+	s.err = nil
+	return s, s.bulk.Init()
+}
+
+// updateBulkEdit handles updates while bulk edit is open.
+func (s *SyncJobsScreen) updateBulkEdit(msg tea.Msg) (tea.Model, tea.Cmd) {
+	cmd := s.bulk.Update(msg)
+	if !s.bulk.done {
+		return s, cmd
+	}
+
+	applied := s.bulk.Applied()
+	s.bulk = nil
+	s.mode = SyncJobsModeList
+	if !applied {
+		return s, nil
+	}
+	s.marked = nil
+	s.loading = true
+	return s, s.loadSyncJobs
+}
+
+// applyBulkEdit sets field to value on the given sync jobs, saves the config
+// once and regenerates each job's service and timer.
+func (s *SyncJobsScreen) applyBulkEdit(ids, names []string, field bulkField, value string) []BulkEditResult {
+	if s.config == nil || s.generator == nil || s.manager == nil {
+		return bulkFailAll(names, fmt.Errorf("services not initialized"))
+	}
+
+	previous := make([]models.SyncJobConfig, len(s.config.SyncJobs))
+	copy(previous, s.config.SyncJobs)
+
+	now := time.Now()
+	indexes := make([]int, len(ids))
+	for n, id := range ids {
+		indexes[n] = -1
+		for i := range s.config.SyncJobs {
+			if s.config.SyncJobs[i].ID == id {
+				setSyncJobField(&s.config.SyncJobs[i], field.key, value)
+				s.config.SyncJobs[i].ModifiedAt = now
+				indexes[n] = i
+				break
+			}
+		}
+	}
+
+	if err := s.config.Save(); err != nil {
+		s.config.SyncJobs = previous
+		return bulkFailAll(names, fmt.Errorf("failed to save config: %w", err))
+	}
+
+	return regenerateUnits(names, func(n int) error {
+		if indexes[n] < 0 {
+			return fmt.Errorf("sync job not found in config")
+		}
+		_, _, err := s.generator.WriteSyncUnits(&s.config.SyncJobs[indexes[n]])
+		return err
+	}, s.manager)
+}
+
+// IsBulkEditing reports whether bulk edit is open, so typed keys reach it
+// instead of the global bindings.
+func (s *SyncJobsScreen) IsBulkEditing() bool {
+	return s.mode == SyncJobsModeBulkEdit && s.bulk != nil
+}
+
+// IsSearching reports whether the list filter is being typed, so typed keys
+// reach it instead of the global bindings.
+func (s *SyncJobsScreen) IsSearching() bool {
+	return s.mode == SyncJobsModeList && s.search.editing
+}
+
+// HasSearchFilter reports whether the list is filtered, so Esc clears the
+// filter instead of leaving the screen.
+func (s *SyncJobsScreen) HasSearchFilter() bool {
+	return s.mode == SyncJobsModeList && s.search.query() != ""
+}
+
+// sortSyncJobsByFavorite returns a copy of jobs with favorites floated to the
+// top. The existing order is kept within favorites and within the rest.
+func sortSyncJobsByFavorite(jobs []models.SyncJobConfig) []models.SyncJobConfig {
+	sorted := make([]models.SyncJobConfig, len(jobs))
+	copy(sorted, jobs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Favorite && !sorted[j].Favorite
+	})
+	return sorted
+}
+
 // updateForm handles updates when in form mode.
 func (s *SyncJobsScreen) updateForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if s.form == nil {
@@ -293,6 +680,58 @@ func (s *SyncJobsScreen) updateDelete(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return s, cmd
 }
 
+// openDetails shows the details view of a sync job.
+func (s *SyncJobsScreen) openDetails(job models.SyncJobConfig) {
+	s.mode = SyncJobsModeDetails
+	s.details = NewSyncJobDetails(job, s.manager, s.generator)
+	if s.config != nil {
+		s.details.defaultExtraArgs = s.config.Defaults.Sync.ExtraFlags
+		s.details.mountNames = make(map[string]string, len(s.config.Mounts))
+		for _, m := range s.config.Mounts {
+			s.details.mountNames[m.ID] = m.Name
+		}
+	}
+}
+
+// openLogs opens the log viewer for the selected sync job.
+func (s *SyncJobsScreen) openLogs() (tea.Model, tea.Cmd) {
+	if s.generator == nil || s.manager == nil {
+		s.err = fmt.Errorf("systemd services not initialized")
+		return s, nil
+	}
+
+	job := s.jobs[s.cursor]
+	unit := s.generator.ServiceName(job.ID, "sync") + ".service"
+	s.logs = NewLogViewer(job.Name, unit, s.manager)
+	s.logs.SetSize(s.width, s.height)
+	s.mode = SyncJobsModeLogs
+	return s, s.logs.Init()
+}
+
+// updateLogs handles updates when in logs mode.
+func (s *SyncJobsScreen) updateLogs(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if s.logs == nil {
+		s.mode = SyncJobsModeList
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	s.logs, cmd = s.logs.Update(msg)
+
+	if s.logs.IsDone() {
+		s.mode = SyncJobsModeList
+		s.logs = nil
+	}
+
+	return s, cmd
+}
+
+// IsViewingLogs reports whether the log viewer is open, so Esc can close it
+// instead of leaving the screen.
+func (s *SyncJobsScreen) IsViewingLogs() bool {
+	return s.mode == SyncJobsModeLogs && s.logs != nil
+}
+
 // updateDetails handles updates when in details mode.
 func (s *SyncJobsScreen) updateDetails(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if s.details == nil {
@@ -316,37 +755,54 @@ func (s *SyncJobsScreen) updateDetails(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // startCreateForm starts the create sync job form.
 func (s *SyncJobsScreen) startCreateForm() (tea.Model, tea.Cmd) {
+	return s.openCreateForm(nil)
+}
+
+// startCloneForm opens the create form filled in from the selected sync
+// job, under a new name.
+func (s *SyncJobsScreen) startCloneForm() (tea.Model, tea.Cmd) {
+	clone := cloneSyncJob(s.jobs[s.cursor], s.config)
+	return s.openCreateForm(&clone)
+}
+
+// openCreateForm opens the form for a new sync job, pre-filled from job if
+// it is not nil.
+func (s *SyncJobsScreen) openCreateForm(job *models.SyncJobConfig) (tea.Model, tea.Cmd) {
 	// Check if rclone client is available
 	if s.rclone == nil {
 		s.err = fmt.Errorf("rclone client not initialized - please ensure rclone is installed")
 		return s, nil
 	}
 
-	// Check if rclone is installed
-	if !s.rclone.IsInstalled() {
-		s.err = fmt.Errorf("rclone binary not found - please install rclone first")
-		return s, nil
-	}
-
-	// Get available remotes
-	remotes, err := s.rclone.ListRemotes(context.Background())
+	// Get available remotes; without rclone or any remotes the form falls
+	// back to manual remote entry so a config can still be drafted
+	remotes, err := s.availableRemotes()
 	if err != nil {
-		s.err = fmt.Errorf("failed to list remotes: %w", err)
-		return s, nil
-	}
-
-	// Check if any remotes are configured
-	if len(remotes) == 0 {
-		s.err = fmt.Errorf("no rclone remotes configured - run 'rclone config' to set up a remote")
+		s.err = err
 		return s, nil
 	}
 
-	s.form = NewSyncJobForm(nil, remotes, s.config, s.generator, s.manager, s.rclone, false)
+	s.form = NewSyncJobForm(job, remotes, s.config, s.generator, s.manager, s.rclone, false)
 	s.mode = SyncJobsModeCreate
 	s.err = nil
 	return s, s.form.Init()
 }
 
+// availableRemotes lists the configured rclone remotes for the forms.
+// A missing rclone binary is not an error: it yields no remotes so the form
+// offers manual remote entry instead.
+func (s *SyncJobsScreen) availableRemotes() ([]rclone.Remote, error) {
+	if !s.rclone.IsInstalled() {
+		return nil, nil
+	}
+
+	remotes, err := s.rclone.ListRemotes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+	return remotes, nil
+}
+
 // startEditForm starts the edit sync job form.
 func (s *SyncJobsScreen) startEditForm() (tea.Model, tea.Cmd) {
 	job := s.jobs[s.cursor]
@@ -364,22 +820,11 @@ func (s *SyncJobsScreen) startEditForm() (tea.Model, tea.Cmd) {
 		return s, nil
 	}
 
-	// Check if rclone is installed
-	if !s.rclone.IsInstalled() {
-		s.err = fmt.Errorf("rclone binary not found - please install rclone first")
-		return s, nil
-	}
-
-	// Get available remotes
-	remotes, err := s.rclone.ListRemotes(context.Background())
+	// Get available remotes; without rclone or any remotes the form falls
+	// back to manual remote entry so a config can still be drafted
+	remotes, err := s.availableRemotes()
 	if err != nil {
-		s.err = fmt.Errorf("failed to list remotes: %w", err)
-		return s, nil
-	}
-
-	// Check if any remotes are configured
-	if len(remotes) == 0 {
-		s.err = fmt.Errorf("no rclone remotes configured - run 'rclone config' to set up a remote")
+		s.err = err
 		return s, nil
 	}
 
@@ -397,10 +842,14 @@ func (s *SyncJobsScreen) runSyncJobNow() (tea.Model, tea.Cmd) {
 		return s, nil
 	}
 
-	job := s.jobs[s.cursor]
+	return s, s.runJob(s.jobs[s.cursor])
+}
+
+// runJob starts a run of the job's service.
+func (s *SyncJobsScreen) runJob(job models.SyncJobConfig) tea.Cmd {
 	serviceName := s.generator.ServiceName(job.ID, "sync") + ".service"
 
-	return s, func() tea.Msg {
+	return func() tea.Msg {
 		if err := s.manager.RunSyncNow(serviceName); err != nil {
 			return SyncJobsErrorMsg{Err: fmt.Errorf("failed to run sync job: %w", err)}
 		}
@@ -408,7 +857,106 @@ func (s *SyncJobsScreen) runSyncJobNow() (tea.Model, tea.Cmd) {
 	}
 }
 
-// toggleTimer toggles the sync job timer on/off.
+// confirmRunNow runs the selected sync job, first asking when the job is a
+// sync, which deletes destination files that are not in the source. Copy,
+// move and bisync jobs run straight away.
+func (s *SyncJobsScreen) confirmRunNow() (tea.Model, tea.Cmd) {
+	job := s.jobs[s.cursor]
+	if s.generator == nil || s.manager == nil || !deletesFromDestination(job) {
+		return s.runSyncJobNow()
+	}
+
+	s.runConfirm = newRunConfirmDialog(job)
+	s.runConfirm.SetSize(s.width, s.height)
+	s.runConfirmJob = job
+	s.mode = SyncJobsModeRunConfirm
+	return s, nil
+}
+
+// updateRunConfirm handles the confirmation before running a sync job.
+func (s *SyncJobsScreen) updateRunConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	model, _ := s.runConfirm.Update(msg)
+	if d, ok := model.(*components.ConfirmDialog); ok {
+		s.runConfirm = d
+	}
+	if !s.runConfirm.IsDone() {
+		return s, nil
+	}
+
+	confirmed := s.runConfirm.GetSelectedAction() == 1
+	job := s.runConfirmJob
+	s.runConfirm = nil
+	s.mode = SyncJobsModeList
+	if !confirmed {
+		return s, nil
+	}
+	return s, s.runJob(job)
+}
+
+// deletesFromDestination reports whether running the job can delete files in
+// its destination. Only sync does; an empty direction is a sync.
+func deletesFromDestination(job models.SyncJobConfig) bool {
+	direction := job.SyncOptions.Direction
+	return direction == "" || direction == "sync"
+}
+
+// newRunConfirmDialog asks before running a sync job, showing where it syncs
+// from and to.
+func newRunConfirmDialog(job models.SyncJobConfig) *components.ConfirmDialog {
+	return components.NewSimpleConfirmDialog(
+		"Run Sync Job",
+		fmt.Sprintf("Run '%s' now?\n\nSource:      %s\nDestination: %s\n\n"+
+			"Files in the destination that are not in the source will be deleted.",
+			job.Name, job.Source, job.Destination),
+	)
+}
+
+// testSchedule starts the selected job's installed service the way its timer
+// would, so the generated ExecStart and run conditions are exercised as
+// written. The service is a oneshot, so the start returns once the run has
+// finished and its error is the outcome of the run.
+func (s *SyncJobsScreen) testSchedule() (tea.Model, tea.Cmd) {
+	if s.generator == nil || s.manager == nil {
+		s.err = fmt.Errorf("systemd services not initialized")
+		return s, nil
+	}
+
+	job := s.jobs[s.cursor]
+	unit := s.generator.ServiceName(job.ID, "sync") + ".service"
+	s.success = fmt.Sprintf("Testing schedule of '%s' (running %s)...", job.Name, unit)
+	s.err = nil
+
+	return s, func() tea.Msg {
+		return SyncJobScheduleTestedMsg{Name: job.Name, Unit: unit, Err: s.manager.Start(unit)}
+	}
+}
+
+// handleScheduleTested reports the outcome of a schedule test and opens the
+// logs of the run.
+func (s *SyncJobsScreen) handleScheduleTested(msg SyncJobScheduleTestedMsg) tea.Cmd {
+	outcome := "succeeded"
+	if msg.Err != nil {
+		outcome = "failed"
+		s.success = ""
+		s.err = fmt.Errorf("test run of '%s' failed: %w", msg.Name, msg.Err)
+	} else {
+		s.success = fmt.Sprintf("Test run of '%s' succeeded", msg.Name)
+		s.err = nil
+	}
+
+	// Refresh statuses so the run shows up in the job's history
+	cmds := []tea.Cmd{s.loadSyncJobs}
+	if s.mode == SyncJobsModeList {
+		s.logs = NewLogViewer(fmt.Sprintf("%s (test run %s)", msg.Name, outcome), msg.Unit, s.manager)
+		s.logs.SetSize(s.width, s.height)
+		s.mode = SyncJobsModeLogs
+		cmds = append(cmds, s.logs.Init())
+	}
+	return tea.Batch(cmds...)
+}
+
+// toggleTimer toggles the sync job timer on/off. Turning off the timer while
+// the job is syncing asks first, since the running sync is left to finish.
 func (s *SyncJobsScreen) toggleTimer() (tea.Model, tea.Cmd) {
 	// Check if generator and manager are available
 	if s.generator == nil || s.manager == nil {
@@ -422,18 +970,69 @@ func (s *SyncJobsScreen) toggleTimer() (tea.Model, tea.Cmd) {
 	// Check if timer is currently active
 	isActive, _ := s.manager.IsActive(timerName)
 
-	if isActive {
-		// Stop and disable timer
-		_ = s.manager.StopTimer(timerName)
-		_ = s.manager.DisableTimer(timerName)
-	} else {
-		// Enable and start timer
+	if isActive && s.isSyncing(job) {
+		s.timerConfirm = components.NewSimpleConfirmDialog(
+			"Disable Timer",
+			fmt.Sprintf("'%s' is syncing right now.\n\nDisable the timer but leave the running sync to finish?", job.Name),
+		)
+		s.timerConfirm.SetSize(s.width, s.height)
+		s.timerConfirmJob = job
+		s.mode = SyncJobsModeTimerConfirm
+		return s, nil
+	}
+
+	return s, s.setTimer(timerName, !isActive)
+}
+
+// isSyncing reports whether the job's service is running a sync. The
+// service is a oneshot, so it is activating for the length of a run.
+func (s *SyncJobsScreen) isSyncing(job models.SyncJobConfig) bool {
+	status, err := s.manager.Status(s.generator.ServiceName(job.ID, "sync") + ".service")
+	if err != nil || status == nil {
+		return false
+	}
+	return status.State == "activating" || status.State == "active"
+}
+
+// setTimer enables and starts, or stops and disables, a timer and refreshes
+// the list. Stopping a timer does not stop a sync it already started.
+func (s *SyncJobsScreen) setTimer(timerName string, enable bool) tea.Cmd {
+	if enable {
 		_ = s.manager.EnableTimer(timerName)
 		_ = s.manager.StartTimer(timerName)
+	} else {
+		_ = s.manager.StopTimer(timerName)
+		_ = s.manager.DisableTimer(timerName)
 	}
+	return s.loadSyncJobs
+}
 
-	// Refresh status
-	return s, s.loadSyncJobs
+// updateTimerConfirm handles the confirmation before disabling the timer of
+// a running job.
+func (s *SyncJobsScreen) updateTimerConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	model, _ := s.timerConfirm.Update(msg)
+	if d, ok := model.(*components.ConfirmDialog); ok {
+		s.timerConfirm = d
+	}
+	if !s.timerConfirm.IsDone() {
+		return s, nil
+	}
+
+	confirmed := s.timerConfirm.GetSelectedAction() == 1
+	job := s.timerConfirmJob
+	s.timerConfirm = nil
+	s.mode = SyncJobsModeList
+	if !confirmed {
+		return s, nil
+	}
+
+	s.success = fmt.Sprintf("Timer for '%s' disabled; the running sync will finish", job.Name)
+	return s, s.setTimer(s.generator.ServiceName(job.ID, "sync")+".timer", false)
+}
+
+// HasUnsavedChanges returns true while a create or edit form is open.
+func (s *SyncJobsScreen) HasUnsavedChanges() bool {
+	return s.form != nil && (s.mode == SyncJobsModeCreate || s.mode == SyncJobsModeEdit)
 }
 
 // ShouldGoBack returns true if the screen should go back to the main menu.
@@ -457,10 +1056,30 @@ func (s *SyncJobsScreen) View() string {
 		if s.delete != nil {
 			return s.delete.View()
 		}
+	case SyncJobsModeTimerConfirm:
+		if s.timerConfirm != nil {
+			return s.timerConfirm.View()
+		}
+	case SyncJobsModeRunConfirm:
+		if s.runConfirm != nil {
+			return s.runConfirm.View()
+		}
 	case SyncJobsModeDetails:
 		if s.details != nil {
 			return s.details.View()
 		}
+	case SyncJobsModeLogs:
+		if s.logs != nil {
+			return s.logs.View()
+		}
+	case SyncJobsModeFetch:
+		if s.fetchForm != nil {
+			return s.renderFetchForm()
+		}
+	case SyncJobsModeBulkEdit:
+		if s.bulk != nil {
+			return s.bulk.View(s.width)
+		}
 	}
 
 	return s.renderList()
@@ -491,15 +1110,31 @@ func (s *SyncJobsScreen) renderList() string {
 		s.success = ""
 	}
 
+	if s.fetch != nil {
+		b.WriteString(s.renderFetchProgress())
+		b.WriteString("\n\n")
+	}
+
+	if search := s.search.view(); search != "" {
+		b.WriteString(search)
+		b.WriteString("\n\n")
+	}
+
 	if s.loading {
 		b.WriteString(lipgloss.NewStyle().
 			Width(s.width).
 			Align(lipgloss.Center).
 			Render("Loading sync jobs..."))
+	} else if len(s.jobs) == 0 && s.search.query() != "" {
+		b.WriteString(components.Styles.Subtitle.Render(fmt.Sprintf("No sync jobs match %q.", s.search.query())))
 	} else if len(s.jobs) == 0 {
 		// Empty state
 		emptyMsg := components.Styles.Subtitle.Render("No sync jobs configured.")
 		addHint := components.Styles.HelpText.Render("Press 'a' or 'n' to add a new sync job.")
+		if s.hidden > 0 {
+			emptyMsg = components.Styles.Subtitle.Render(fmt.Sprintf("All %d sync jobs are disabled and hidden.", s.hidden))
+			addHint = components.Styles.HelpText.Render("Press 'H' to show them or 'a' to add a new sync job.")
+		}
 
 		b.WriteString(lipgloss.NewStyle().
 			Width(s.width).
@@ -515,6 +1150,11 @@ func (s *SyncJobsScreen) renderList() string {
 		b.WriteString(s.renderJobList())
 		b.WriteString("\n")
 
+		if label := hiddenLabel(s.hidden, "sync job"); label != "" {
+			b.WriteString(components.Styles.HelpText.Render(label))
+			b.WriteString("\n")
+		}
+
 		// Selected item details
 		if s.cursor >= 0 && s.cursor < len(s.jobs) {
 			b.WriteString(s.renderJobDetails())
@@ -525,12 +1165,22 @@ func (s *SyncJobsScreen) renderList() string {
 	b.WriteString("\n")
 	helpText := components.HelpBar(s.width, []components.HelpItem{
 		{Key: "↑/↓", Desc: "navigate"},
+		{Key: "/", Desc: "filter"},
 		{Key: "R", Desc: "refresh"},
 		{Key: "a", Desc: "add"},
 		{Key: "e", Desc: "edit"},
+		{Key: "C", Desc: "clone"},
 		{Key: "d", Desc: "delete"},
 		{Key: "r", Desc: "run now"},
+		{Key: "D", Desc: "dry run"},
+		{Key: "T", Desc: "test schedule"},
 		{Key: "t", Desc: "toggle"},
+		{Key: "*", Desc: "pin"},
+		{Key: "space", Desc: "mark"},
+		{Key: "b", Desc: "bulk edit"},
+		{Key: "l", Desc: "logs"},
+		{Key: "f", Desc: "fetch once"},
+		{Key: "H", Desc: "hide disabled"},
 		{Key: "enter", Desc: "details"},
 		{Key: "esc", Desc: "back"},
 	})
@@ -564,18 +1214,23 @@ func (s *SyncJobsScreen) renderJobList() string {
 			dest = dest[:22] + "..."
 		}
 
-		sourceDest := source + " → " + dest
+		arrow := " → "
+		if job.SyncOptions.Direction == "bisync" {
+			arrow = " ⇄ "
+		}
+		sourceDest := source + arrow + dest
 		schedule := getScheduleDisplay(&job)
+		name := markedLabel(favoriteLabel(job.Name, job.Favorite), s.marked[job.ID])
 
 		if i == s.cursor {
 			line = fmt.Sprintf("▸ %-20s %-25s %-15s %s",
-				components.Styles.Selected.Render(job.Name),
+				components.Styles.Selected.Render(name),
 				components.Styles.Normal.Render(sourceDest),
 				components.Styles.Normal.Render(schedule),
 				status)
 		} else {
 			line = fmt.Sprintf("  %-20s %-25s %-15s %s",
-				components.Styles.Normal.Render(job.Name),
+				components.Styles.Normal.Render(name),
 				components.Styles.Normal.Render(sourceDest),
 				components.Styles.Normal.Render(schedule),
 				status)
@@ -586,6 +1241,10 @@ func (s *SyncJobsScreen) renderJobList() string {
 	return b.String()
 }
 
+// waitingForSharedMount is injectable for testing so status checks don't
+// read the host's process table.
+var waitingForSharedMount = systemd.WaitingForSharedMount
+
 // getJobStatus returns a formatted status string for a sync job.
 func (s *SyncJobsScreen) getJobStatus(job *models.SyncJobConfig) string {
 	status, ok := s.statuses[job.Name]
@@ -593,6 +1252,9 @@ func (s *SyncJobsScreen) getJobStatus(job *models.SyncJobConfig) string {
 		return components.StatusIndicator("unknown") + " unknown"
 	}
 
+	if job.SerializeWithSharedMount && status.ActiveState == "activating" && waitingForSharedMount(status.MainPID) {
+		return components.StatusIndicator("inactive") + " " + components.Styles.Warning.Render("waiting for shared mount")
+	}
 	if status.TimerActive {
 		return components.StatusIndicator("active") + " " + components.Styles.Success.Render("scheduled")
 	}
@@ -635,7 +1297,9 @@ func (s *SyncJobsScreen) renderJobDetails() string {
 	// Get status info
 	statusStr := "unknown"
 	if status, ok := s.statuses[job.Name]; ok {
-		if status.TimerActive {
+		if job.SerializeWithSharedMount && status.ActiveState == "activating" && waitingForSharedMount(status.MainPID) {
+			statusStr = "waiting for shared mount"
+		} else if status.TimerActive {
 			statusStr = "scheduled"
 		} else if status.ActiveState == "active" {
 			statusStr = "running"
@@ -708,6 +1372,13 @@ type SyncJobStatusMsg struct {
 	Status *models.ServiceStatus
 }
 
+// SyncJobScheduleTestedMsg is sent when a schedule test run has finished.
+type SyncJobScheduleTestedMsg struct {
+	Name string
+	Unit string
+	Err  error
+}
+
 // SyncJobRunNowMsg is sent when a sync job is run.
 type SyncJobRunNowMsg struct {
 	Name string
@@ -732,17 +1403,30 @@ type SyncJobDetails struct {
 	job       models.SyncJobConfig
 	status    *models.ServiceStatus
 	timerNext string
+	verify    *systemd.VerifyResult
 	logs      string
 	manager   systemd.ServiceManager
-	generator *systemd.Generator
+	generator systemd.UnitGenerator
 	done      bool
 	width     int
 	height    int
-	tab       int // 0: details, 1: logs
+	tab       int // 0: details, 1: logs, 2: unit preview, 3: run history
+
+	defaultExtraArgs string            // Config default flags added to every sync job
+	mountNames       map[string]string // Mount names by ID, for dependencies
+	dryRun           *dryRunOutput     // Shown on the logs tab in place of the journal
+
+	progress *models.RunningStats // Stats of the running job, nil when not running or not reported
+	polling  bool                 // Whether progress is being polled
+
+	history    []models.RunRecord // Recent runs, newest first, loaded when the history tab is shown
+	historyErr error
+
+	runConfirm *components.ConfirmDialog // Shown before running a sync job, nil otherwise
 }
 
 // NewSyncJobDetails creates a new sync job details view.
-func NewSyncJobDetails(job models.SyncJobConfig, manager systemd.ServiceManager, generator *systemd.Generator) *SyncJobDetails {
+func NewSyncJobDetails(job models.SyncJobConfig, manager systemd.ServiceManager, generator systemd.UnitGenerator) *SyncJobDetails {
 	d := &SyncJobDetails{
 		job:       job,
 		manager:   manager,
@@ -764,6 +1448,47 @@ func (d *SyncJobDetails) loadStatus() {
 			d.timerNext = status.NextRun.Format("2006-01-02 15:04:05")
 		}
 	}
+
+	if d.job.SyncOptions.VerifyAfter {
+		d.verify, _ = readVerifyResult(d.job.ID)
+	}
+}
+
+// readVerifyResult reads the last verify outcome, replaceable in tests.
+var readVerifyResult = systemd.ReadVerifyResult
+
+// verifyLabel describes the last verify outcome, e.g. "verified ✓ (2024-05-01 10:04)".
+func verifyLabel(result *systemd.VerifyResult) string {
+	if result == nil {
+		return "not run yet"
+	}
+	when := result.CheckedAt.Format("2006-01-02 15:04")
+	if result.Verified {
+		return fmt.Sprintf("verified ✓ (%s)", when)
+	}
+	return fmt.Sprintf("failed ✗ (%s)", when)
+}
+
+// runHistoryLabel renders recent run outcomes oldest first as a row of
+// glyphs, e.g. "✓✓✗✓ (3/4 ok)". Runs stopped by the max duration show as ⏱.
+func runHistoryLabel(history []models.RunOutcome) string {
+	if len(history) == 0 {
+		return "none recorded yet"
+	}
+
+	var b strings.Builder
+	ok := 0
+	for _, run := range history {
+		if run.Success {
+			b.WriteString(components.Styles.StatusActive.Render("✓"))
+			ok++
+		} else if run.TimedOut {
+			b.WriteString(components.Styles.StatusError.Render("⏱"))
+		} else {
+			b.WriteString(components.Styles.StatusError.Render("✗"))
+		}
+	}
+	return fmt.Sprintf("%s (%d/%d ok)", b.String(), ok, len(history))
 }
 
 // loadLogs loads the service logs.
@@ -777,10 +1502,49 @@ func (d *SyncJobDetails) loadLogs() {
 	}
 }
 
+// historyRuns is how many recent runs the history tab shows.
+const historyRuns = 5
+
+// loadHistory loads the recent runs of the job's service from the journal.
+func (d *SyncJobDetails) loadHistory() {
+	serviceName := d.generator.ServiceName(d.job.ID, "sync") + ".service"
+	d.history, d.historyErr = d.manager.GetRunHistory(serviceName, historyRuns)
+}
+
+// runNow runs the sync job and starts watching its progress.
+func (d *SyncJobDetails) runNow() tea.Cmd {
+	serviceName := d.generator.ServiceName(d.job.ID, "sync") + ".service"
+	_ = d.manager.RunSyncNow(serviceName)
+	d.loadStatus()
+	d.loadLogs()
+	return d.watchProgress()
+}
+
+// updateRunConfirm handles the confirmation before running the sync job.
+func (d *SyncJobDetails) updateRunConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	model, _ := d.runConfirm.Update(msg)
+	if dialog, ok := model.(*components.ConfirmDialog); ok {
+		d.runConfirm = dialog
+	}
+	if !d.runConfirm.IsDone() {
+		return d, nil
+	}
+
+	confirmed := d.runConfirm.GetSelectedAction() == 1
+	d.runConfirm = nil
+	if !confirmed {
+		return d, nil
+	}
+	return d, d.runNow()
+}
+
 // SetSize sets the size.
 func (d *SyncJobDetails) SetSize(width, height int) {
 	d.width = width
 	d.height = height
+	if d.runConfirm != nil {
+		d.runConfirm.SetSize(width, height)
+	}
 }
 
 // Init initializes the view.
@@ -792,18 +1556,31 @@ func (d *SyncJobDetails) Init() tea.Cmd {
 func (d *SyncJobDetails) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if d.runConfirm != nil {
+			return d.updateRunConfirm(msg)
+		}
 		switch msg.String() {
 		case "esc", "q":
 			d.done = true
 		case "tab":
-			d.tab = (d.tab + 1) % 2
-		case "r":
-			// Run sync job now
-			serviceName := d.generator.ServiceName(d.job.ID, "sync") + ".service"
-			_ = d.manager.RunSyncNow(serviceName)
-			d.loadStatus()
-			d.loadLogs()
-		case "t":
+			d.tab = (d.tab + 1) % 4
+			if d.tab == 3 {
+				d.loadHistory()
+			}
+		case "1", "2", "3", "4":
+			// Collapse or expand a section of the details tab
+			if title, ok := sectionKey(msg.String(), syncJobDetailSections); ok && d.tab == 0 {
+				toggleSection("sync", title)
+			}
+		case SyncJobDetailKeys.Key(ActionRun):
+			// Run sync job now, asking first if it can delete files
+			if deletesFromDestination(d.job) {
+				d.runConfirm = newRunConfirmDialog(d.job)
+				d.runConfirm.SetSize(d.width, d.height)
+				return d, nil
+			}
+			return d, d.runNow()
+		case SyncJobDetailKeys.Key(ActionToggle):
 			// Toggle timer
 			timerName := d.generator.ServiceName(d.job.ID, "sync") + ".timer"
 			isActive, _ := d.manager.IsActive(timerName)
@@ -815,23 +1592,29 @@ func (d *SyncJobDetails) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				_ = d.manager.StartTimer(timerName)
 			}
 			d.loadStatus()
-		case "e":
+		case SyncJobDetailKeys.Key(ActionEnable):
 			// Enable timer
 			timerName := d.generator.ServiceName(d.job.ID, "sync") + ".timer"
 			_ = d.manager.EnableTimer(timerName)
 			_ = d.manager.StartTimer(timerName)
 			d.loadStatus()
-		case "d":
+		case SyncJobDetailKeys.Key(ActionDisable):
 			// Disable timer
 			timerName := d.generator.ServiceName(d.job.ID, "sync") + ".timer"
 			_ = d.manager.StopTimer(timerName)
 			_ = d.manager.DisableTimer(timerName)
 			d.loadStatus()
-		case "R":
+		case SyncJobDetailKeys.Key(ActionRefresh):
 			// Refresh
 			d.loadStatus()
 			d.loadLogs()
+			if d.tab == 3 {
+				d.loadHistory()
+			}
+			return d, d.watchProgress()
 		}
+	case SyncJobProgressMsg:
+		return d, d.updateProgress(msg)
 	}
 
 	return d, nil
@@ -844,6 +1627,10 @@ func (d *SyncJobDetails) IsDone() bool {
 
 // View renders the view.
 func (d *SyncJobDetails) View() string {
+	if d.runConfirm != nil {
+		return d.runConfirm.View()
+	}
+
 	var b strings.Builder
 
 	// Title
@@ -855,7 +1642,7 @@ func (d *SyncJobDetails) View() string {
 	b.WriteString("\n\n")
 
 	// Tabs
-	tabs := []string{"Details", "Logs"}
+	tabs := []string{"Details", "Logs", "Unit", "History"}
 	var tabStrs []string
 	for i, tab := range tabs {
 		if i == d.tab {
@@ -871,21 +1658,27 @@ func (d *SyncJobDetails) View() string {
 	b.WriteString("\n\n")
 
 	// Content based on tab
-	if d.tab == 0 {
+	switch d.tab {
+	case 0:
 		b.WriteString(d.renderDetails())
-	} else {
+	case 1:
 		b.WriteString(d.renderLogs())
+	case 3:
+		b.WriteString(d.renderHistory())
+	default:
+		b.WriteString(d.renderUnit())
 	}
 
 	// Help
 	b.WriteString("\n")
 	help := components.HelpBar(d.width, []components.HelpItem{
 		{Key: "Tab", Desc: "switch tab"},
-		{Key: "r", Desc: "run now"},
-		{Key: "t", Desc: "toggle timer"},
-		{Key: "e", Desc: "enable timer"},
-		{Key: "d", Desc: "disable timer"},
-		{Key: "R", Desc: "refresh"},
+		{Key: "1-4", Desc: "collapse/expand"},
+		{Key: SyncJobDetailKeys.Key(ActionRun), Desc: "run now"},
+		{Key: SyncJobDetailKeys.Key(ActionToggle), Desc: "toggle timer"},
+		{Key: SyncJobDetailKeys.Key(ActionEnable), Desc: "enable timer"},
+		{Key: SyncJobDetailKeys.Key(ActionDisable), Desc: "disable timer"},
+		{Key: SyncJobDetailKeys.Key(ActionRefresh), Desc: "refresh"},
 		{Key: "Esc", Desc: "back"},
 	})
 	b.WriteString(help)
@@ -893,62 +1686,162 @@ func (d *SyncJobDetails) View() string {
 	return b.String()
 }
 
+// syncJobDetailSections are the collapsible sections of the details tab, in
+// the order of their number keys.
+var syncJobDetailSections = []string{"Config", "Sync Options", "Schedule", "Service Status"}
+
 // renderDetails renders the details tab.
 func (d *SyncJobDetails) renderDetails() string {
 	var b strings.Builder
 
 	// Sync job info
-	b.WriteString(fmt.Sprintf("  Name: %s\n", d.job.Name))
-	b.WriteString(fmt.Sprintf("  Source: %s\n", d.job.Source))
-	b.WriteString(fmt.Sprintf("  Destination: %s\n", d.job.Destination))
-	b.WriteString(fmt.Sprintf("  Schedule: %s\n", getScheduleDisplay(&d.job)))
+	var config strings.Builder
+	config.WriteString(fmt.Sprintf("    Name: %s\n", d.job.Name))
+	if d.job.Description != "" {
+		config.WriteString(fmt.Sprintf("    Description: %s\n", d.job.Description))
+	}
+	if d.job.DocumentationURL != "" {
+		config.WriteString(fmt.Sprintf("    Documentation: %s\n", d.job.DocumentationURL))
+	}
+	config.WriteString(fmt.Sprintf("    Source: %s\n", d.job.Source))
+	config.WriteString(fmt.Sprintf("    Destination: %s\n", d.job.Destination))
+	config.WriteString(fmt.Sprintf("    Enabled: %t\n", d.job.Enabled))
+	config.WriteString(fmt.Sprintf("    Recent Runs: %s\n", runHistoryLabel(d.job.RunHistory)))
+
+	if len(d.job.RequiresMounts) > 0 {
+		for _, id := range d.job.RequiresMounts {
+			name, ok := d.mountNames[id]
+			if !ok {
+				name = "missing mount " + id
+			}
+			unit := "rclone-mount-" + id
+			if d.generator != nil {
+				unit = d.generator.ServiceName(id, "mount")
+			}
+			config.WriteString(fmt.Sprintf("    Requires: %s (%s.service)\n", name, unit))
+		}
+		if d.job.StopWithMount {
+			config.WriteString("    Binding: BindsTo, service and timer stop when a mount stops\n")
+		} else {
+			config.WriteString("    Binding: Requires, starts after the mounts\n")
+		}
+		if d.job.SerializeWithSharedMount {
+			config.WriteString("    Serialized: runs one at a time with other jobs on these mounts\n")
+		}
+	}
+
+	// Sync options
+	var opts strings.Builder
+	if d.job.SyncOptions.Direction == "bisync" {
+		opts.WriteString("    Direction: bisync (two-way)\n")
+		if d.job.SyncOptions.ResyncOnFirstRun {
+			opts.WriteString("    Resync On First Run: true\n")
+		}
+	} else if d.job.SyncOptions.Direction != "" {
+		opts.WriteString(fmt.Sprintf("    Direction: %s\n", d.job.SyncOptions.Direction))
+	}
+	if d.job.SyncOptions.DryRun {
+		opts.WriteString("    Dry Run: true\n")
+	}
+	if len(d.job.SyncOptions.Filters) > 0 {
+		opts.WriteString("    Filters:\n")
+		for _, rule := range d.job.SyncOptions.Filters {
+			opts.WriteString(fmt.Sprintf("      %s\n", rule))
+		}
+	}
+	if d.job.SyncOptions.VerifyAfter {
+		opts.WriteString(fmt.Sprintf("    Verify After Sync: %s\n", verifyLabel(d.verify)))
+	}
+	if d.job.SyncOptions.BackupDir != "" {
+		opts.WriteString(fmt.Sprintf("    Backup Dir: %s\n", d.job.SyncOptions.BackupDir))
+	}
+	if d.job.SyncOptions.Suffix != "" {
+		opts.WriteString(fmt.Sprintf("    Backup Suffix: %s\n", d.job.SyncOptions.Suffix))
+	}
+	if d.job.SyncOptions.ModifyWindow != "" {
+		opts.WriteString(fmt.Sprintf("    Modify Window: %s\n", d.job.SyncOptions.ModifyWindow))
+	}
+	if d.job.SyncOptions.BandwidthLimit != "" {
+		opts.WriteString(fmt.Sprintf("    Bandwidth Limit: %s\n", components.FormatBandwidthLimit(d.job.SyncOptions.BandwidthLimit)))
+	}
+	if d.job.SyncOptions.Transfers > 0 {
+		opts.WriteString(fmt.Sprintf("    Max Transfers: %d\n", d.job.SyncOptions.Transfers))
+	}
+	if d.job.SyncOptions.MaxDuration != "" {
+		opts.WriteString(fmt.Sprintf("    Max Duration: %s (runs are stopped after this)\n", d.job.SyncOptions.MaxDuration))
+	}
+	if d.job.SyncOptions.MaxRetries > 0 {
+		delay := d.job.SyncOptions.RetryDelay
+		if delay == "" {
+			delay = systemd.DefaultRetryDelay
+		}
+		opts.WriteString(fmt.Sprintf("    Retries: up to %d, %s apart\n", d.job.SyncOptions.MaxRetries, delay))
+	}
+	if d.job.SyncOptions.Nice != 0 {
+		opts.WriteString(fmt.Sprintf("    Nice Level: %d\n", d.job.SyncOptions.Nice))
+	}
+	if class, ok := systemd.IOSchedulingClasses[d.job.SyncOptions.IONiceClass]; ok {
+		opts.WriteString(fmt.Sprintf("    I/O Class: %s\n", class))
+	}
+	if d.job.SyncOptions.IONicePriority != 0 {
+		opts.WriteString(fmt.Sprintf("    I/O Priority: %d\n", d.job.SyncOptions.IONicePriority))
+	}
+	if extra := systemd.MergeExtraArgs(d.defaultExtraArgs, d.job.SyncOptions.ExtraArgs); extra != "" {
+		opts.WriteString(fmt.Sprintf("    Extra Flags: %s\n", extra))
+		if managed := systemd.ManagedFlags(extra, "sync"); len(managed) > 0 {
+			opts.WriteString(fmt.Sprintf("    ⚠ Extra flags repeat managed options: %s\n", strings.Join(managed, ", ")))
+		}
+	}
+	if d.job.SyncOptions.WorkingDir != "" {
+		opts.WriteString(fmt.Sprintf("    Working Directory: %s\n", d.job.SyncOptions.WorkingDir))
+	}
 
 	// Schedule details
+	var schedule strings.Builder
+	schedule.WriteString(fmt.Sprintf("    Schedule: %s\n", getScheduleDisplay(&d.job)))
 	if d.job.Schedule.Type == "timer" && d.job.Schedule.OnCalendar != "" {
-		b.WriteString(fmt.Sprintf("  Calendar: %s\n", d.job.Schedule.OnCalendar))
+		schedule.WriteString(fmt.Sprintf("    Calendar: %s\n", d.job.Schedule.OnCalendar))
 	}
 	if d.job.Schedule.Type == "onboot" && d.job.Schedule.OnBootSec != "" {
-		b.WriteString(fmt.Sprintf("  Boot Delay: %s\n", d.job.Schedule.OnBootSec))
+		schedule.WriteString(fmt.Sprintf("    Boot Delay: %s\n", d.job.Schedule.OnBootSec))
+	}
+	if d.job.Schedule.Type != "manual" && d.job.Schedule.RandomizedDelaySec != "" {
+		schedule.WriteString(fmt.Sprintf("    Randomized Delay: up to %s\n", d.job.Schedule.RandomizedDelaySec))
 	}
-
-	b.WriteString(fmt.Sprintf("  Enabled: %t\n", d.job.Enabled))
 
 	// Status
+	var status strings.Builder
 	if d.status != nil {
-		b.WriteString("\n  Service Status:\n")
-		b.WriteString(fmt.Sprintf("    State: %s\n", d.status.ActiveState))
-		b.WriteString(fmt.Sprintf("    SubState: %s\n", d.status.SubState))
-		b.WriteString(fmt.Sprintf("    Timer Active: %t\n", d.status.TimerActive))
+		status.WriteString(fmt.Sprintf("    State: %s\n", d.status.ActiveState))
+		status.WriteString(fmt.Sprintf("    SubState: %s\n", d.status.SubState))
+		status.WriteString(d.progressLines())
+		status.WriteString(fmt.Sprintf("    Timer Active: %t\n", d.status.TimerActive))
 
 		if d.timerNext != "" {
-			b.WriteString(fmt.Sprintf("    Next Run: %s\n", d.timerNext))
+			status.WriteString(fmt.Sprintf("    Next Run: %s\n", d.timerNext))
 		}
 
 		if !d.status.LastRun.IsZero() {
-			b.WriteString(fmt.Sprintf("    Last Run: %s\n", d.status.LastRun.Format("2006-01-02 15:04:05")))
+			status.WriteString(fmt.Sprintf("    Last Run: %s\n", d.status.LastRun.Format("2006-01-02 15:04:05")))
 		}
+	} else {
+		status.WriteString("    Not available\n")
 	}
 
-	// Sync options
-	b.WriteString("\n  Sync Options:\n")
-	if d.job.SyncOptions.Direction != "" {
-		b.WriteString(fmt.Sprintf("    Direction: %s\n", d.job.SyncOptions.Direction))
-	}
-	if d.job.SyncOptions.DryRun {
-		b.WriteString("    Dry Run: true\n")
-	}
-	if d.job.SyncOptions.BandwidthLimit != "" {
-		b.WriteString(fmt.Sprintf("    Bandwidth Limit: %s\n", d.job.SyncOptions.BandwidthLimit))
-	}
-	if d.job.SyncOptions.Transfers > 0 {
-		b.WriteString(fmt.Sprintf("    Max Transfers: %d\n", d.job.SyncOptions.Transfers))
+	for i, body := range []string{config.String(), opts.String(), schedule.String(), status.String()} {
+		b.WriteString(renderSection("sync", i+1, syncJobDetailSections[i], body))
 	}
 
+	b.WriteString(renderNotes(d.job.Notes))
+
 	return b.String()
 }
 
 // renderLogs renders the logs tab.
 func (d *SyncJobDetails) renderLogs() string {
+	if d.dryRun != nil {
+		return d.renderDryRun()
+	}
 	if d.logs == "" {
 		return components.Styles.Subtitle.Render("  No logs available")
 	}
@@ -962,6 +1855,61 @@ func (d *SyncJobDetails) renderLogs() string {
 	return components.Styles.Normal.Render(strings.Join(lines, "\n"))
 }
 
+// renderUnit renders the unit preview tab: the service unit, and the timer
+// for scheduled jobs, as they would be written for the sync job.
+func (d *SyncJobDetails) renderUnit() string {
+	service, timer, err := d.generator.PreviewSync(&d.job)
+	if err != nil {
+		return components.RenderError(fmt.Sprintf("  Cannot generate units: %v", err))
+	}
+	name := d.generator.ServiceName(d.job.ID, "sync")
+	var b strings.Builder
+	b.WriteString(components.Styles.Subtitle.Render("  # "+name+".service") + "\n")
+	b.WriteString(components.Styles.Normal.Render(strings.TrimRight(service, "\n")))
+	if timer != "" {
+		b.WriteString("\n\n" + components.Styles.Subtitle.Render("  # "+name+".timer") + "\n")
+		b.WriteString(components.Styles.Normal.Render(strings.TrimRight(timer, "\n")))
+	}
+	return b.String()
+}
+
+// renderHistory renders the history tab: the most recent runs of the job's
+// service as recorded in the journal, newest first.
+func (d *SyncJobDetails) renderHistory() string {
+	if d.historyErr != nil {
+		return components.RenderError(fmt.Sprintf("  Cannot read run history: %v", d.historyErr))
+	}
+	if len(d.history) == 0 {
+		return components.Styles.Subtitle.Render("  No runs recorded in the journal")
+	}
+
+	var b strings.Builder
+	b.WriteString(components.Styles.Subtitle.Render(fmt.Sprintf("  %-20s %-10s %-5s %-12s %s", "Started", "Duration", "Exit", "Transferred", "Result")) + "\n")
+	for _, run := range d.history {
+		duration, exit, bytes := "—", "—", "—"
+		if run.Duration > 0 {
+			duration = run.Duration.Round(time.Second).String()
+		}
+		if run.ExitStatus != nil {
+			exit = fmt.Sprintf("%d", *run.ExitStatus)
+		}
+		if run.Bytes > 0 {
+			bytes = formatSize(run.Bytes)
+		}
+		result := components.Styles.StatusActive.Render(run.Result)
+		switch run.Result {
+		case "":
+			result = components.Styles.Warning.Render("running")
+		case "success":
+		default:
+			result = components.Styles.StatusError.Render(run.Result)
+		}
+		line := fmt.Sprintf("  %-20s %-10s %-5s %-12s ", run.Start.Format("2006-01-02 15:04:05"), duration, exit, bytes)
+		b.WriteString(components.Styles.Normal.Render(line) + result + "\n")
+	}
+	return b.String()
+}
+
 // SyncJobDeleteConfirm handles the delete confirmation dialog.
 type SyncJobDeleteConfirm struct {
 	job        models.SyncJobConfig
@@ -969,7 +1917,7 @@ type SyncJobDeleteConfirm struct {
 	done       bool
 	deleteType int // 0: cancel, 1: service only, 2: service and config
 	manager    systemd.ServiceManager
-	generator  *systemd.Generator
+	generator  systemd.UnitGenerator
 	config     *config.Config
 	width      int
 }
@@ -984,7 +1932,7 @@ func NewSyncJobDeleteConfirm(job models.SyncJobConfig) *SyncJobDeleteConfirm {
 }
 
 // SetServices sets the services for the delete confirmation.
-func (d *SyncJobDeleteConfirm) SetServices(mgr systemd.ServiceManager, gen *systemd.Generator, cfg *config.Config) {
+func (d *SyncJobDeleteConfirm) SetServices(mgr systemd.ServiceManager, gen systemd.UnitGenerator, cfg *config.Config) {
 	d.manager = mgr
 	d.generator = gen
 	d.config = cfg
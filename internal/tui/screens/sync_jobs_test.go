@@ -739,6 +739,27 @@ func TestSyncJobsScreen_GetJobStatus(t *testing.T) {
 	}
 }
 
+func TestSyncJobsScreen_GetJobStatusWaitingForSharedMount(t *testing.T) {
+	oldWaiting := waitingForSharedMount
+	defer func() { waitingForSharedMount = oldWaiting }()
+	waitingForSharedMount = func(pid int) bool { return pid == 42 }
+
+	screen := NewSyncJobsScreen()
+	job := &models.SyncJobConfig{Name: "TestJob", RequiresMounts: []string{"a1b2c3d4"}, SerializeWithSharedMount: true}
+	screen.statuses = map[string]*models.ServiceStatus{
+		"TestJob": {ActiveState: "activating", MainPID: 42, TimerActive: true},
+	}
+
+	if status := screen.getJobStatus(job); !strings.Contains(status, "waiting for shared mount") {
+		t.Errorf("status for queued job = %q, should contain 'waiting for shared mount'", status)
+	}
+
+	screen.statuses["TestJob"].MainPID = 7
+	if status := screen.getJobStatus(job); strings.Contains(status, "waiting") {
+		t.Errorf("status for job holding the lock = %q, should not be waiting", status)
+	}
+}
+
 // Tests for SyncJobDeleteConfirm component
 
 func TestNewSyncJobDeleteConfirm(t *testing.T) {
@@ -958,6 +979,28 @@ func TestNewSyncJobDetails(t *testing.T) {
 	}
 }
 
+func TestSyncJobDetails_ViewRandomizedDelay(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	job.Schedule = models.ScheduleConfig{Type: "timer", OnCalendar: "hourly", RandomizedDelaySec: "30min"}
+	details := NewSyncJobDetails(job, &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}, &systemd.MockGenerator{})
+	details.SetSize(100, 60)
+
+	if view := details.View(); !strings.Contains(view, "Randomized Delay: up to 30min") {
+		t.Errorf("details should show the randomized delay:\n%s", view)
+	}
+}
+
+func TestSyncJobDetails_ViewRetries(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	job.SyncOptions.MaxRetries = 3
+	details := NewSyncJobDetails(job, &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}, &systemd.MockGenerator{})
+	details.SetSize(100, 60)
+
+	if view := details.View(); !strings.Contains(view, "Retries: up to 3, 30s apart") {
+		t.Errorf("details should show the retries:\n%s", view)
+	}
+}
+
 func TestSyncJobDetails_TabSwitching(t *testing.T) {
 	job := createTestSyncJobs()[0]
 	gen := &systemd.Generator{}
@@ -975,6 +1018,18 @@ func TestSyncJobDetails_TabSwitching(t *testing.T) {
 		t.Errorf("tab after Tab = %d, want 1", details.tab)
 	}
 
+	// Press tab again to switch to the unit preview
+	details.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if details.tab != 2 {
+		t.Errorf("tab after second Tab = %d, want 2", details.tab)
+	}
+
+	// Press tab again to switch to the run history
+	details.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if details.tab != 3 {
+		t.Errorf("tab after third Tab = %d, want 3", details.tab)
+	}
+
 	// Press tab again to wrap around to Details
 	details.Update(tea.KeyMsg{Type: tea.KeyTab})
 	if details.tab != 0 {
@@ -1099,6 +1154,70 @@ func TestSyncJobDetails_ViewLogsEmpty(t *testing.T) {
 	}
 }
 
+func TestSyncJobDetails_ViewHistoryTab(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	failed := 1
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.Local)
+	mgr := &systemd.MockManager{
+		GetDetailedStatusResult: &models.ServiceStatus{},
+		GetRunHistoryResult: []models.RunRecord{
+			{Start: start.Add(2 * time.Hour)},
+			{Start: start.Add(time.Hour), Duration: 95 * time.Second, ExitStatus: &failed, Result: "exit-code"},
+			{Start: start, Result: "success", Bytes: 256 * 1024 * 1024},
+		},
+	}
+	details := NewSyncJobDetails(job, mgr, &systemd.MockGenerator{})
+	details.SetSize(120, 60)
+
+	for i := 0; i < 3; i++ {
+		details.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	if !mgr.Called("GetRunHistory", details.generator.ServiceName(job.ID, "sync")+".service") {
+		t.Fatal("switching to the history tab should read the run history")
+	}
+
+	view := details.View()
+	for _, want := range []string{"[History]", "2026-10-16 11:00:00", "running", "1m35s", "exit-code", "256.0 MB", "success"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("history tab should contain %q:\n%s", want, view)
+		}
+	}
+	if i, j := strings.Index(view, "11:00:00"), strings.Index(view, "09:00:00"); i > j {
+		t.Error("history should list the newest run first")
+	}
+}
+
+func TestSyncJobDetails_ViewHistoryEmpty(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	details := NewSyncJobDetails(job, &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}, &systemd.MockGenerator{})
+	details.width = 80
+	details.tab = 3
+	details.loadHistory()
+
+	if view := details.View(); !strings.Contains(view, "No runs recorded in the journal") {
+		t.Errorf("empty history should say so:\n%s", view)
+	}
+
+	details.historyErr = errors.New("journal unavailable")
+	if view := details.View(); !strings.Contains(view, "journal unavailable") {
+		t.Errorf("history tab should show the error:\n%s", view)
+	}
+}
+
+func TestSyncJobDetails_ViewUnitTab(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	details := NewSyncJobDetails(job, &systemd.Manager{}, &systemd.Generator{})
+	details.width = 80
+	details.tab = 2 // Unit tab
+
+	view := details.View()
+	for _, want := range []string{"# rclone-sync-e5f6g7h8.service", "# rclone-sync-e5f6g7h8.timer", "ExecStart=", "OnCalendar=daily"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("unit tab should contain %q, got:\n%s", want, view)
+		}
+	}
+}
+
 func TestSyncJobDetails_SetSize(t *testing.T) {
 	job := createTestSyncJobs()[0]
 	gen := &systemd.Generator{}
@@ -1231,7 +1350,7 @@ func TestSyncJobsScreen_EnterNoJobs(t *testing.T) {
 // Tests for add key variations - tests error handling when rclone is not installed
 
 func TestSyncJobsScreen_AddKeyVariations(t *testing.T) {
-	// Test that pressing 'a' key with rclone client that's not installed returns proper error
+	// Test that pressing 'a' key with rclone client that's not installed opens the form with manual remote entry
 	screen := NewSyncJobsScreen()
 	screen.SetSize(80, 24)
 	screen.jobs = createTestSyncJobs()
@@ -1239,20 +1358,19 @@ func TestSyncJobsScreen_AddKeyVariations(t *testing.T) {
 
 	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
 
-	// Should set error when rclone is not installed
-	if screen.err == nil {
-		t.Error("error should be set when rclone is not installed")
+	if screen.err != nil {
+		t.Errorf("unexpected error: %v", screen.err)
 	}
-	if !strings.Contains(screen.err.Error(), "rclone binary not found") {
-		t.Errorf("error = %q, should contain 'rclone binary not found'", screen.err.Error())
+	if screen.mode != SyncJobsModeCreate {
+		t.Errorf("mode = %d, want %d (SyncJobsModeCreate)", screen.mode, SyncJobsModeCreate)
 	}
-	if screen.mode != SyncJobsModeList {
-		t.Errorf("mode = %d, want %d (SyncJobsModeList)", screen.mode, SyncJobsModeList)
+	if screen.form == nil || !screen.form.manualRemote {
+		t.Error("form should fall back to manual remote entry when rclone is not installed")
 	}
 }
 
 func TestSyncJobsScreen_NewKeyWithRcloneNotInstalled(t *testing.T) {
-	// Test that pressing 'n' key with rclone client that's not installed returns proper error
+	// Test that pressing 'n' key with rclone client that's not installed opens the form with manual remote entry
 	screen := NewSyncJobsScreen()
 	screen.SetSize(80, 24)
 	screen.jobs = createTestSyncJobs()
@@ -1260,15 +1378,14 @@ func TestSyncJobsScreen_NewKeyWithRcloneNotInstalled(t *testing.T) {
 
 	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
 
-	// Should set error when rclone is not installed
-	if screen.err == nil {
-		t.Error("error should be set when rclone is not installed")
+	if screen.err != nil {
+		t.Errorf("unexpected error: %v", screen.err)
 	}
-	if !strings.Contains(screen.err.Error(), "rclone binary not found") {
-		t.Errorf("error = %q, should contain 'rclone binary not found'", screen.err.Error())
+	if screen.mode != SyncJobsModeCreate {
+		t.Errorf("mode = %d, want %d (SyncJobsModeCreate)", screen.mode, SyncJobsModeCreate)
 	}
-	if screen.mode != SyncJobsModeList {
-		t.Errorf("mode = %d, want %d (SyncJobsModeList)", screen.mode, SyncJobsModeList)
+	if screen.form == nil || !screen.form.manualRemote {
+		t.Error("form should fall back to manual remote entry when rclone is not installed")
 	}
 }
 
@@ -1865,7 +1982,7 @@ func TestSyncJobsScreen_RunSyncJobNowKey(t *testing.T) {
 	screen := NewSyncJobsScreen()
 	screen.SetSize(80, 24)
 	screen.jobs = createTestSyncJobs()
-	screen.cursor = 0
+	screen.cursor = 1 // copy job, which runs without asking
 	screen.generator = &systemd.Generator{}
 	screen.manager = &systemd.Manager{}
 
@@ -1874,6 +1991,77 @@ func TestSyncJobsScreen_RunSyncJobNowKey(t *testing.T) {
 	if cmd == nil {
 		t.Error("Update should return a command for run sync job now")
 	}
+	if screen.mode != SyncJobsModeList {
+		t.Errorf("mode = %d, want SyncJobsModeList for a copy job", screen.mode)
+	}
+}
+
+func TestSyncJobsScreen_RunSyncJobNowKey_ConfirmsSync(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	unit := (&systemd.Generator{}).ServiceName(job.ID, "sync") + ".service"
+	newScreen := func() (*SyncJobsScreen, *systemd.MockManager) {
+		mgr := &systemd.MockManager{}
+		screen := NewSyncJobsScreen()
+		screen.SetSize(80, 24)
+		screen.jobs = createTestSyncJobs()
+		screen.generator = &systemd.Generator{}
+		screen.manager = mgr
+		return screen, mgr
+	}
+
+	t.Run("sync job asks first", func(t *testing.T) {
+		screen, mgr := newScreen()
+		_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		if cmd != nil || screen.mode != SyncJobsModeRunConfirm {
+			t.Fatalf("mode = %d, want SyncJobsModeRunConfirm without running", screen.mode)
+		}
+		view := screen.View()
+		for _, want := range []string{job.Source, job.Destination, "will be deleted"} {
+			if !strings.Contains(view, want) {
+				t.Errorf("confirmation should show %q, got:\n%s", want, view)
+			}
+		}
+		if mgr.Called("RunSyncNow", "") {
+			t.Errorf("job should not run before confirming, calls = %v", mgr.Calls)
+		}
+	})
+
+	t.Run("declining does not run", func(t *testing.T) {
+		screen, mgr := newScreen()
+		screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		if cmd != nil || screen.mode != SyncJobsModeList {
+			t.Errorf("mode = %d, want SyncJobsModeList without running", screen.mode)
+		}
+		if mgr.Called("RunSyncNow", "") {
+			t.Errorf("declining should not run the job, calls = %v", mgr.Calls)
+		}
+	})
+
+	t.Run("confirming runs the job", func(t *testing.T) {
+		screen, mgr := newScreen()
+		screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+		_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		if cmd == nil || screen.mode != SyncJobsModeList {
+			t.Fatalf("mode = %d, confirming should run the job", screen.mode)
+		}
+		if msg, ok := cmd().(SyncJobRunNowMsg); !ok || msg.Name != job.Name {
+			t.Errorf("message = %#v, want SyncJobRunNowMsg for %s", msg, job.Name)
+		}
+		if !mgr.Called("RunSyncNow", unit) {
+			t.Errorf("RunSyncNow(%s) not called, calls = %v", unit, mgr.Calls)
+		}
+	})
+
+	t.Run("empty direction is a sync", func(t *testing.T) {
+		screen, _ := newScreen()
+		screen.jobs[0].SyncOptions.Direction = ""
+		screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+		if screen.mode != SyncJobsModeRunConfirm {
+			t.Errorf("mode = %d, want SyncJobsModeRunConfirm", screen.mode)
+		}
+	})
 }
 
 func TestSyncJobsScreen_RunSyncJobNowKey_NoJobs(t *testing.T) {
@@ -2141,17 +2329,23 @@ func TestSyncJobsScreen_StartCreateForm_RcloneNotInstalled(t *testing.T) {
 
 	model, cmd := screen.startCreateForm()
 
-	if screen.mode != SyncJobsModeList {
-		t.Errorf("mode = %d, want %d (SyncJobsModeList)", screen.mode, SyncJobsModeList)
+	if screen.mode != SyncJobsModeCreate {
+		t.Errorf("mode = %d, want %d (SyncJobsModeCreate)", screen.mode, SyncJobsModeCreate)
 	}
-	if screen.err == nil {
-		t.Error("error should be set when rclone is not installed")
+	if screen.err != nil {
+		t.Errorf("unexpected error: %v", screen.err)
 	}
-	if !strings.Contains(screen.err.Error(), "rclone binary not found") {
-		t.Errorf("error = %q, should contain 'rclone binary not found'", screen.err.Error())
+	if screen.form == nil {
+		t.Fatal("form should be opened when rclone is not installed")
 	}
-	if cmd != nil {
-		t.Error("startCreateForm should return nil command when rclone is not installed")
+	if !screen.form.manualRemote || !screen.form.rcloneMissing {
+		t.Error("form should fall back to manual remote entry when rclone is not installed")
+	}
+	if !strings.Contains(screen.form.remoteNotice, "rclone not found") {
+		t.Errorf("remoteNotice = %q, should contain 'rclone not found'", screen.form.remoteNotice)
+	}
+	if cmd == nil {
+		t.Error("startCreateForm should return the form init command")
 	}
 	if model == nil {
 		t.Error("startCreateForm should return a model")
@@ -2167,17 +2361,23 @@ func TestSyncJobsScreen_StartEditForm_RcloneNotInstalled(t *testing.T) {
 
 	model, cmd := screen.startEditForm()
 
-	if screen.mode != SyncJobsModeList {
-		t.Errorf("mode = %d, want %d (SyncJobsModeList)", screen.mode, SyncJobsModeList)
+	if screen.mode != SyncJobsModeEdit {
+		t.Errorf("mode = %d, want %d (SyncJobsModeEdit)", screen.mode, SyncJobsModeEdit)
 	}
-	if screen.err == nil {
-		t.Error("error should be set when rclone is not installed")
+	if screen.err != nil {
+		t.Errorf("unexpected error: %v", screen.err)
 	}
-	if !strings.Contains(screen.err.Error(), "rclone binary not found") {
-		t.Errorf("error = %q, should contain 'rclone binary not found'", screen.err.Error())
+	if screen.form == nil {
+		t.Fatal("form should be opened when rclone is not installed")
 	}
-	if cmd != nil {
-		t.Error("startEditForm should return nil command when rclone is not installed")
+	if !screen.form.manualRemote || !screen.form.rcloneMissing {
+		t.Error("form should fall back to manual remote entry when rclone is not installed")
+	}
+	if !strings.Contains(screen.form.remoteNotice, "rclone not found") {
+		t.Errorf("remoteNotice = %q, should contain 'rclone not found'", screen.form.remoteNotice)
+	}
+	if cmd == nil {
+		t.Error("startEditForm should return the form init command")
 	}
 	if model == nil {
 		t.Error("startEditForm should return a model")
@@ -2304,6 +2504,57 @@ func TestSyncJobDetails_RunNowKey(t *testing.T) {
 	}
 }
 
+func TestSyncJobDetails_RemappedKeys(t *testing.T) {
+	t.Cleanup(func() { SetKeyBindings(nil) })
+	if err := SetKeyBindings(map[string]string{ActionRun: "g", ActionDisable: "ctrl+d"}); err != nil {
+		t.Fatalf("SetKeyBindings() error = %v", err)
+	}
+
+	mgr := &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}
+	details := NewSyncJobDetails(createTestSyncJobs()[0], mgr, &systemd.Generator{})
+	details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if details.runConfirm != nil {
+		t.Error("r should no longer run the job once run is rebound")
+	}
+	details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if details.runConfirm == nil {
+		t.Fatal("the rebound key should run the job")
+	}
+	details.runConfirm = nil
+
+	details.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if !mgr.Called("DisableTimer", "") {
+		t.Errorf("ctrl+d should disable the timer, calls = %v", mgr.Calls)
+	}
+}
+
+func TestSyncJobDetails_RunNowKey_ConfirmsSync(t *testing.T) {
+	jobs := createTestSyncJobs()
+	mgr := &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}
+
+	copyJob := NewSyncJobDetails(jobs[1], mgr, &systemd.Generator{})
+	copyJob.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if copyJob.runConfirm != nil || !mgr.Called("RunSyncNow", "") {
+		t.Errorf("a copy job should run without asking, calls = %v", mgr.Calls)
+	}
+
+	mgr = &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}
+	syncJob := NewSyncJobDetails(jobs[0], mgr, &systemd.Generator{})
+	syncJob.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if syncJob.runConfirm == nil || mgr.Called("RunSyncNow", "") {
+		t.Fatalf("a sync job should ask before running, calls = %v", mgr.Calls)
+	}
+	if !strings.Contains(syncJob.View(), "will be deleted") {
+		t.Errorf("view should show the confirmation, got:\n%s", syncJob.View())
+	}
+
+	syncJob.Update(tea.KeyMsg{Type: tea.KeyRight})
+	syncJob.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if syncJob.runConfirm != nil || syncJob.done || !mgr.Called("RunSyncNow", "") {
+		t.Errorf("confirming should run the job and stay on the details, calls = %v", mgr.Calls)
+	}
+}
+
 func TestSyncJobDetails_ToggleTimerKey(t *testing.T) {
 	job := createTestSyncJobs()[0]
 	gen := &systemd.Generator{}
@@ -2472,3 +2723,464 @@ func TestSyncJobNow_ReturnsTime(t *testing.T) {
 		t.Errorf("syncJobNow() returned time %v, expected close to %v", result, now)
 	}
 }
+
+// Tests for favorites
+
+func TestSortSyncJobsByFavorite(t *testing.T) {
+	jobs := createTestSyncJobs()
+	jobs[1].Favorite = true
+
+	sorted := sortSyncJobsByFavorite(jobs)
+
+	if sorted[0].Name != "Photo Sync" {
+		t.Errorf("sorted[0].Name = %q, want 'Photo Sync'", sorted[0].Name)
+	}
+	if jobs[0].Name != "Daily Backup" {
+		t.Error("sortSyncJobsByFavorite should not reorder the input slice")
+	}
+}
+
+func TestSyncJobsScreen_ToggleFavorite(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createSyncTestConfig()
+	cfg.SyncJobs = createTestSyncJobs()
+	screen := NewSyncJobsScreen()
+	screen.SetSize(80, 24)
+	screen.SetServices(cfg, nil, nil, nil)
+	screen.jobs = sortSyncJobsByFavorite(cfg.SyncJobs)
+	screen.loading = false
+	screen.cursor = 1
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+
+	if screen.err != nil {
+		t.Fatalf("unexpected error: %v", screen.err)
+	}
+	if screen.jobs[0].Name != "Photo Sync" || !screen.jobs[0].Favorite {
+		t.Errorf("favorite job should be first, got %q", screen.jobs[0].Name)
+	}
+	if screen.cursor != 0 {
+		t.Errorf("cursor = %d, want 0 (follows the pinned job)", screen.cursor)
+	}
+	if !cfg.SyncJobs[1].Favorite {
+		t.Error("favorite flag should be persisted to config")
+	}
+	if !strings.Contains(screen.View(), "★ Photo Sync") {
+		t.Error("view should show a star next to the favorite job")
+	}
+}
+
+func TestSyncJobsScreen_HasUnsavedChanges(t *testing.T) {
+	screen := NewSyncJobsScreen()
+
+	if screen.HasUnsavedChanges() {
+		t.Error("HasUnsavedChanges() = true with no form open")
+	}
+
+	screen.mode = SyncJobsModeEdit
+	screen.form = NewSyncJobForm(nil, []rclone.Remote{{Name: "gdrive", Type: "drive"}}, nil, nil, nil, nil, false)
+	if !screen.HasUnsavedChanges() {
+		t.Error("HasUnsavedChanges() = false with edit form open")
+	}
+
+	screen.mode = SyncJobsModeList
+	if screen.HasUnsavedChanges() {
+		t.Error("HasUnsavedChanges() = true in list mode")
+	}
+}
+
+func TestSyncJobsScreen_ToggleTimerCallsManager(t *testing.T) {
+	tests := []struct {
+		name     string
+		active   bool
+		expected []string
+	}{
+		{name: "inactive timer is enabled and started", active: false, expected: []string{"EnableTimer", "StartTimer"}},
+		{name: "active timer is stopped and disabled", active: true, expected: []string{"StopTimer", "DisableTimer"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mgr := &systemd.MockManager{IsActiveResult: tt.active}
+			screen := NewSyncJobsScreen()
+			screen.jobs = createTestSyncJobs()
+			screen.generator = &systemd.MockGenerator{}
+			screen.manager = mgr
+
+			screen.toggleTimer()
+
+			timerName := "rclone-sync-" + screen.jobs[0].ID + ".timer"
+			for _, method := range tt.expected {
+				if !mgr.Called(method, timerName) {
+					t.Errorf("expected %s %s, calls = %v", method, timerName, mgr.Calls)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyLabel(t *testing.T) {
+	checked := time.Date(2024, 5, 1, 10, 4, 0, 0, time.Local)
+
+	if got := verifyLabel(nil); got != "not run yet" {
+		t.Errorf("verifyLabel(nil) = %q", got)
+	}
+	if got := verifyLabel(&systemd.VerifyResult{Verified: true, CheckedAt: checked}); got != "verified ✓ (2024-05-01 10:04)" {
+		t.Errorf("verifyLabel(verified) = %q", got)
+	}
+	if got := verifyLabel(&systemd.VerifyResult{Verified: false, CheckedAt: checked}); got != "failed ✗ (2024-05-01 10:04)" {
+		t.Errorf("verifyLabel(failed) = %q", got)
+	}
+}
+
+func TestSyncJobDetails_ShowsVerifyResult(t *testing.T) {
+	orig := readVerifyResult
+	readVerifyResult = func(id string) (*systemd.VerifyResult, error) {
+		return &systemd.VerifyResult{Verified: false, CheckedAt: time.Now()}, nil
+	}
+	defer func() { readVerifyResult = orig }()
+
+	job := createTestSyncJobs()[0]
+	job.SyncOptions.VerifyAfter = true
+	mgr := &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}
+	details := NewSyncJobDetails(job, mgr, &systemd.MockGenerator{})
+	details.SetSize(100, 40)
+
+	if view := details.View(); !strings.Contains(view, "Verify After Sync: failed ✗") {
+		t.Errorf("details should show the failed verification, got:\n%s", view)
+	}
+}
+
+func TestSyncJobsScreen_OpenLogsFromList(t *testing.T) {
+	mgr := &systemd.MockManager{GetLogsResult: "ERROR sync failed"}
+	screen := createTestSyncJobsScreen()
+	screen.SetSize(100, 40)
+	screen.generator = createTestGenerator(t)
+	screen.manager = mgr
+	screen.jobs = createTestSyncJobs()
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if screen.mode != SyncJobsModeLogs {
+		t.Fatalf("mode = %v, want logs", screen.mode)
+	}
+	screen.Update(cmd())
+
+	if !mgr.Called("GetLogs", "rclone-sync-e5f6g7h8.service") {
+		t.Errorf("logs should be fetched for the selected job, calls: %v", mgr.Calls)
+	}
+	if !strings.Contains(screen.View(), "sync failed") {
+		t.Error("View() should show the logs")
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if screen.mode != SyncJobsModeList {
+		t.Errorf("mode = %v, want list after closing logs", screen.mode)
+	}
+}
+
+func TestSyncJobDetails_ShowsNotes(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	job.Notes = "rotate creds quarterly"
+	details := &SyncJobDetails{job: job}
+
+	if got := details.renderDetails(); !strings.Contains(got, "  Notes:\n    rotate creds quarterly\n") {
+		t.Errorf("renderDetails() should show the notes, got:\n%s", got)
+	}
+}
+
+func TestSyncJobDetails_ShowsModifyWindow(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	job.SyncOptions.ModifyWindow = "2s"
+	details := &SyncJobDetails{job: job}
+
+	if got := details.renderDetails(); !strings.Contains(got, "Modify Window: 2s") {
+		t.Errorf("renderDetails() should show the modify window, got:\n%s", got)
+	}
+}
+
+func TestSyncJobDetails_ShowsMountBinding(t *testing.T) {
+	job := createTestSyncJobs()[0]
+	job.RequiresMounts = []string{"a1b2c3d4"}
+	job.StopWithMount = true
+	details := &SyncJobDetails{job: job, mountNames: map[string]string{"a1b2c3d4": "gdrive"}}
+
+	got := details.renderDetails()
+	if !strings.Contains(got, "Requires: gdrive (rclone-mount-a1b2c3d4.service)") {
+		t.Errorf("renderDetails() should list the required mount, got:\n%s", got)
+	}
+	if !strings.Contains(got, "Binding: BindsTo") {
+		t.Errorf("renderDetails() should show the binding, got:\n%s", got)
+	}
+}
+
+func TestSyncJobsScreen_HideDisabled(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := createTestConfigWithSyncJobs()
+	cfg.Settings.HideDisabled = true
+	for i := range cfg.SyncJobs {
+		cfg.SyncJobs[i].Enabled = false
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	screen := NewSyncJobsScreen()
+	screen.SetSize(100, 40)
+	screen.SetServices(cfg, nil, nil, nil)
+
+	screen.Update(screen.loadSyncJobs())
+	if len(screen.jobs) != 0 || screen.hidden != 3 {
+		t.Fatalf("got %d jobs and %d hidden, want 0 and 3", len(screen.jobs), screen.hidden)
+	}
+	if view := screen.View(); !strings.Contains(view, "All 3 sync jobs are disabled and hidden.") {
+		t.Errorf("empty state should mention the hidden jobs:\n%s", view)
+	}
+
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	if cfg.Settings.HideDisabled || len(screen.jobs) != 3 {
+		t.Errorf("disabled jobs should be shown again, got %d jobs", len(screen.jobs))
+	}
+}
+
+func TestRunHistoryLabel(t *testing.T) {
+	if got := runHistoryLabel(nil); got != "none recorded yet" {
+		t.Errorf("runHistoryLabel(nil) = %q", got)
+	}
+
+	history := []models.RunOutcome{{Success: true}, {Success: false}, {Success: true}}
+	if got := runHistoryLabel(history); !strings.Contains(got, "(2/3 ok)") || strings.Count(got, "✓") != 2 || strings.Count(got, "✗") != 1 {
+		t.Errorf("runHistoryLabel() = %q, want two ✓, one ✗ and (2/3 ok)", got)
+	}
+	if got := runHistoryLabel([]models.RunOutcome{{Success: true}, {TimedOut: true}}); strings.Count(got, "⏱") != 1 || !strings.Contains(got, "(1/2 ok)") {
+		t.Errorf("runHistoryLabel() = %q, want one ⏱ and (1/2 ok)", got)
+	}
+}
+
+func TestSyncJobsScreen_RecordsFinishedRuns(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := createTestConfigWithSyncJobs()
+	finished := time.Date(2024, 6, 2, 15, 30, 0, 0, time.UTC)
+
+	screen := NewSyncJobsScreen()
+	screen.SetServices(cfg, nil, &systemd.MockGenerator{}, &systemd.MockManager{
+		GetDetailedStatusResult: &models.ServiceStatus{ActiveState: "failed", InactiveAt: finished},
+	})
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	screen.loadSyncJobs()
+	screen.loadSyncJobs()
+
+	for _, job := range cfg.SyncJobs {
+		if len(job.RunHistory) != 1 || job.RunHistory[0].Success {
+			t.Errorf("job %q RunHistory = %+v, want one failed run", job.Name, job.RunHistory)
+		}
+	}
+}
+
+func TestSyncJobsScreen_ToggleTimer_ConfirmsWhileSyncing(t *testing.T) {
+	newScreen := func(state string) (*SyncJobsScreen, *systemd.MockManager) {
+		mgr := &systemd.MockManager{
+			IsActiveResult: true,
+			StatusResult:   &systemd.ServiceStatus{State: state},
+		}
+		screen := NewSyncJobsScreen()
+		screen.SetSize(80, 24)
+		screen.jobs = createTestSyncJobs()
+		screen.generator = &systemd.Generator{}
+		screen.manager = mgr
+		return screen, mgr
+	}
+	timerName := (&systemd.Generator{}).ServiceName(createTestSyncJobs()[0].ID, "sync") + ".timer"
+
+	t.Run("idle job is disabled at once", func(t *testing.T) {
+		screen, mgr := newScreen("inactive")
+		if _, cmd := screen.toggleTimer(); cmd == nil {
+			t.Error("toggleTimer should refresh the list")
+		}
+		if screen.mode != SyncJobsModeList || !mgr.Called("DisableTimer", timerName) {
+			t.Errorf("timer of an idle job should be disabled without asking, calls = %v", mgr.Calls)
+		}
+	})
+
+	t.Run("declining leaves the timer", func(t *testing.T) {
+		screen, mgr := newScreen("activating")
+		screen.toggleTimer()
+		if screen.mode != SyncJobsModeTimerConfirm {
+			t.Fatalf("mode = %d, want SyncJobsModeTimerConfirm", screen.mode)
+		}
+		if !strings.Contains(screen.View(), "leave the running sync to finish") {
+			t.Errorf("confirmation should explain the running sync is kept, got:\n%s", screen.View())
+		}
+
+		screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		if screen.mode != SyncJobsModeList {
+			t.Errorf("mode = %d, want SyncJobsModeList", screen.mode)
+		}
+		if mgr.Called("StopTimer", "") || mgr.Called("DisableTimer", "") {
+			t.Errorf("declining should leave the timer alone, calls = %v", mgr.Calls)
+		}
+	})
+
+	t.Run("confirming disables only the timer", func(t *testing.T) {
+		screen, mgr := newScreen("activating")
+		screen.toggleTimer()
+		screen.Update(tea.KeyMsg{Type: tea.KeyRight})
+		_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		if cmd == nil {
+			t.Error("confirming should refresh the list")
+		}
+		if !mgr.Called("StopTimer", timerName) || !mgr.Called("DisableTimer", timerName) {
+			t.Errorf("timer should be stopped and disabled, calls = %v", mgr.Calls)
+		}
+		if mgr.Called("Stop", "") {
+			t.Errorf("the running sync should not be stopped, calls = %v", mgr.Calls)
+		}
+		if !strings.Contains(screen.success, "running sync will finish") {
+			t.Errorf("success = %q", screen.success)
+		}
+	})
+}
+
+func TestSyncJobsScreen_TestSchedule(t *testing.T) {
+	unit := (&systemd.Generator{}).ServiceName(createTestSyncJobs()[0].ID, "sync") + ".service"
+
+	for _, tt := range []struct {
+		name     string
+		startErr error
+		outcome  string
+	}{
+		{"success", nil, "test run succeeded"},
+		{"failure", errors.New("exit status 1"), "test run failed"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mgr := &systemd.MockManager{StartErr: tt.startErr, GetLogsResult: "sync finished"}
+			screen := NewSyncJobsScreen()
+			screen.SetSize(80, 24)
+			screen.jobs = createTestSyncJobs()
+			screen.generator = &systemd.Generator{}
+			screen.manager = mgr
+
+			_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+			if cmd == nil {
+				t.Fatal("T should start the installed service")
+			}
+			msg, ok := cmd().(SyncJobScheduleTestedMsg)
+			if !ok {
+				t.Fatalf("expected SyncJobScheduleTestedMsg, got %T", msg)
+			}
+			if !mgr.Called("Start", unit) || mgr.Called("RunSyncNow", "") {
+				t.Errorf("test schedule should start %s directly, calls = %v", unit, mgr.Calls)
+			}
+
+			screen.Update(msg)
+			if screen.mode != SyncJobsModeLogs || screen.logs == nil {
+				t.Fatalf("mode = %d, want the logs of the run", screen.mode)
+			}
+			if screen.logs.unit != unit {
+				t.Errorf("logs unit = %q, want %q", screen.logs.unit, unit)
+			}
+			if !strings.Contains(screen.logs.title, tt.outcome) {
+				t.Errorf("logs title = %q, want it to report %q", screen.logs.title, tt.outcome)
+			}
+			if (screen.err != nil) != (tt.startErr != nil) {
+				t.Errorf("err = %v, want an error only when the run failed", screen.err)
+			}
+		})
+	}
+}
+
+func TestSyncJobDetails_CollapseSections(t *testing.T) {
+	t.Cleanup(func() { collapsedSections = map[string]bool{} })
+
+	job := createTestSyncJobs()[0]
+	job.SyncOptions.Direction = "sync"
+	mgr := &systemd.MockManager{GetDetailedStatusResult: &models.ServiceStatus{}}
+	details := NewSyncJobDetails(job, mgr, &systemd.Generator{})
+
+	if got := details.renderDetails(); !strings.Contains(got, "Direction: sync") || !strings.Contains(got, "Source:") {
+		t.Fatalf("all sections should start expanded, got:\n%s", got)
+	}
+
+	details.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	got := details.renderDetails()
+	if strings.Contains(got, "Direction: sync") || !strings.Contains(got, "[2] ▸ Sync Options (collapsed)") {
+		t.Errorf("2 should collapse Sync Options, got:\n%s", got)
+	}
+	if !strings.Contains(got, "Source:") {
+		t.Errorf("other sections should stay expanded, got:\n%s", got)
+	}
+
+	// The collapse state outlives the view for the rest of the session
+	reopened := NewSyncJobDetails(job, mgr, &systemd.Generator{})
+	if strings.Contains(reopened.renderDetails(), "Direction: sync") {
+		t.Error("a reopened details view should keep Sync Options collapsed")
+	}
+
+	reopened.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if !strings.Contains(reopened.renderDetails(), "Direction: sync") {
+		t.Error("pressing 2 again should expand Sync Options")
+	}
+}
+
+func TestSyncJobDetails_PollsProgressWhileRunning(t *testing.T) {
+	oldInterval := syncProgressInterval
+	defer func() { syncProgressInterval = oldInterval }()
+	syncProgressInterval = time.Millisecond
+
+	mgr := &systemd.MockManager{
+		GetDetailedStatusResult: &models.ServiceStatus{ActiveState: "active", SubState: "start"},
+		IsActiveResult:          true,
+		GetLogsResult: "rclone[42]: Transferred:   	  256 MiB / 1 GiB, 25%, 4 MiB/s, ETA 3m12s\n" +
+			"rclone[42]: Transferred:            3 / 12, 25%\n",
+	}
+	screen := NewSyncJobsScreen()
+	screen.SetServices(createTestConfig(), nil, &systemd.MockGenerator{}, mgr)
+	screen.jobs = createTestSyncJobs()
+	screen.loading = false
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("opening the details of a running job should start polling")
+	}
+	_, cmd = screen.Update(cmd())
+	if cmd == nil {
+		t.Error("polling should continue while the job runs")
+	}
+	view := screen.details.renderDetails()
+	if !strings.Contains(view, "Progress: 256.0 MB / 1.0 GB (25%), 4.0 MB/s, ETA 3m12s") || !strings.Contains(view, "Files: 3 / 12") {
+		t.Errorf("details should show the progress:\n%s", view)
+	}
+
+	// The run finishes
+	mgr.IsActiveResult = false
+	mgr.GetDetailedStatusResult = &models.ServiceStatus{ActiveState: "inactive", SubState: "dead"}
+	if _, cmd = screen.Update(cmd()); cmd != nil {
+		t.Error("polling should stop once the job is inactive")
+	}
+	if view := screen.details.renderDetails(); strings.Contains(view, "Progress:") {
+		t.Errorf("a finished job should show the static status:\n%s", view)
+	}
+}
+
+func TestSyncJobDetails_ProgressWithoutStats(t *testing.T) {
+	mgr := &systemd.MockManager{
+		GetDetailedStatusResult: &models.ServiceStatus{ActiveState: "active"},
+		IsActiveResult:          true,
+		GetLogsErr:              errors.New("no journal"),
+	}
+	details := NewSyncJobDetails(createTestSyncJobs()[0], mgr, &systemd.MockGenerator{})
+	if details.watchProgress() == nil {
+		t.Fatal("watchProgress() should poll a running job")
+	}
+	details.updateProgress(SyncJobProgressMsg{JobID: details.job.ID, Active: true, details: details})
+
+	if view := details.renderDetails(); !strings.Contains(view, "Progress: waiting for rclone's stats") {
+		t.Errorf("details should fall back without stats:\n%s", view)
+	}
+	if details.watchProgress() != nil {
+		t.Error("a job already polled should not be polled twice")
+	}
+}
@@ -0,0 +1,119 @@
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
+	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+)
+
+// syncProgressInterval is how often the details view polls the progress of
+// a running sync job.
+var syncProgressInterval = 2 * time.Second
+
+// fetchCoreStats asks a job's rclone remote control for its stats,
+// replaceable in tests.
+var fetchCoreStats = rclone.FetchCoreStats
+
+// SyncJobProgressMsg carries the progress of a running sync job. Stats is
+// nil when rclone has not reported any yet; Active is false once the run
+// has finished.
+type SyncJobProgressMsg struct {
+	JobID  string
+	Active bool
+	Stats  *models.RunningStats
+
+	details *SyncJobDetails // The view that polled, so a reopened view starts afresh
+}
+
+// watchProgress starts polling the progress of the job while its service
+// is running. It returns nil if the job is not running or is already being
+// polled.
+func (d *SyncJobDetails) watchProgress() tea.Cmd {
+	if d.polling || d.status == nil || d.status.ActiveState != "active" {
+		return nil
+	}
+	d.polling = true
+	return d.pollProgress()
+}
+
+// pollProgress waits syncProgressInterval, then reads the job's state and,
+// while it runs, its stats: from rclone's remote control when the job runs
+// with --rc, otherwise from the last stats rclone logged to the journal.
+func (d *SyncJobDetails) pollProgress() tea.Cmd {
+	manager, jobID, details := d.manager, d.job.ID, d
+	service := d.generator.ServiceName(jobID, "sync") + ".service"
+	rcAddr, useRC := rclone.RCAddr(systemd.MergeExtraArgs(d.defaultExtraArgs, d.job.SyncOptions.ExtraArgs))
+	ctx := rootCtx
+
+	return tea.Tick(syncProgressInterval, func(time.Time) tea.Msg {
+		active, err := manager.IsActive(service)
+		if err != nil || !active {
+			return SyncJobProgressMsg{JobID: jobID, details: details}
+		}
+		msg := SyncJobProgressMsg{JobID: jobID, Active: true, details: details}
+		if useRC {
+			if stats, err := fetchCoreStats(ctx, rcAddr); err == nil {
+				msg.Stats = stats
+				return msg
+			}
+		}
+		if logs, err := manager.GetLogs(service, 50); err == nil {
+			msg.Stats, _ = rclone.ParseStatsLog(logs)
+		}
+		return msg
+	})
+}
+
+// updateProgress records polled progress and keeps polling while the job
+// runs. Once it stops, the status is reloaded to show how the run ended.
+func (d *SyncJobDetails) updateProgress(msg SyncJobProgressMsg) tea.Cmd {
+	if msg.details != d || !d.polling {
+		return nil
+	}
+	if !msg.Active {
+		d.polling = false
+		d.progress = nil
+		d.loadStatus()
+		d.loadLogs()
+		return nil
+	}
+	if msg.Stats != nil {
+		d.progress = msg.Stats
+	}
+	return d.pollProgress()
+}
+
+// progressLines renders the progress of the running job for the service
+// status section, or "" when there is nothing to show.
+func (d *SyncJobDetails) progressLines() string {
+	if d.status == nil || d.status.ActiveState != "active" {
+		return ""
+	}
+	stats := d.progress
+	if stats == nil {
+		if d.polling {
+			return "    Progress: waiting for rclone's stats\n"
+		}
+		return ""
+	}
+
+	var line string
+	if pct := stats.Percent(); pct >= 0 {
+		line = fmt.Sprintf("%s / %s (%d%%)", formatSize(stats.Bytes), formatSize(stats.TotalBytes), pct)
+	} else {
+		line = formatSize(stats.Bytes) + " transferred"
+	}
+	line += fmt.Sprintf(", %s/s", formatSize(int64(stats.Speed)))
+	if stats.ETA > 0 {
+		line += ", ETA " + stats.ETA.Round(time.Second).String()
+	}
+	out := fmt.Sprintf("    Progress: %s\n", line)
+	if stats.TotalTransfers > 0 {
+		out += fmt.Sprintf("    Files: %d / %d\n", stats.Transfers, stats.TotalTransfers)
+	}
+	return out
+}
@@ -1,12 +1,18 @@
 package tui
 
 import (
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/dtg01100/rclone-mount-sync/internal/config"
+	"github.com/dtg01100/rclone-mount-sync/internal/models"
+	"github.com/dtg01100/rclone-mount-sync/internal/rclone"
 	"github.com/dtg01100/rclone-mount-sync/internal/systemd"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/components"
+	"github.com/dtg01100/rclone-mount-sync/internal/tui/screens"
 )
 
 func TestScreen_String(t *testing.T) {
@@ -20,6 +26,8 @@ func TestScreen_String(t *testing.T) {
 		{ScreenServices, "Service Status"},
 		{ScreenSettings, "Settings"},
 		{ScreenHelp, "Help"},
+		{ScreenBackups, "Config Backups"},
+		{ScreenRemotes, "Remotes"},
 		{Screen(999), "Unknown"},
 	}
 
@@ -529,6 +537,8 @@ func TestApp_View_AllScreens(t *testing.T) {
 		{"Services", ScreenServices},
 		{"Settings", ScreenSettings},
 		{"Help", ScreenHelp},
+		{"Backups", ScreenBackups},
+		{"Remotes", ScreenRemotes},
 	}
 
 	for _, tt := range screens {
@@ -541,6 +551,8 @@ func TestApp_View_AllScreens(t *testing.T) {
 			app.syncJobs.SetSize(80, 24)
 			app.services.SetSize(80, 24)
 			app.settings.SetSize(80, 24)
+			app.backups.SetSize(80, 24)
+			app.remotes.SetSize(80, 24)
 			app.currentScreen = tt.screen
 			if tt.screen == ScreenHelp {
 				app.showHelp = true
@@ -942,6 +954,206 @@ func TestApp_Update_MainMenuNavigation(t *testing.T) {
 	}
 }
 
+func TestApp_Update_MainMenuNavigationBackups(t *testing.T) {
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.currentScreen = ScreenMain
+	app.mainMenu.SetSize(80, 24)
+
+	app.mainMenu.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	updatedApp, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+
+	if updatedApp.(*App).currentScreen != ScreenBackups {
+		t.Errorf("main menu navigation should change screen to Backups, got %d", updatedApp.(*App).currentScreen)
+	}
+	if cmd == nil {
+		t.Error("entering the backups screen should load the backup list")
+	}
+}
+
+func TestApp_Update_BackupsScreenGoBack(t *testing.T) {
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.currentScreen = ScreenBackups
+
+	app.backups.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	updatedApp, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+
+	if updatedApp.(*App).currentScreen != ScreenMain {
+		t.Errorf("backups screen go back should return to main, got %d", updatedApp.(*App).currentScreen)
+	}
+}
+
+func TestApp_Update_MainMenuNavigationRemotes(t *testing.T) {
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.currentScreen = ScreenMain
+	app.mainMenu.SetSize(80, 24)
+
+	app.mainMenu.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	updatedApp, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+
+	if updatedApp.(*App).currentScreen != ScreenRemotes {
+		t.Errorf("main menu navigation should change screen to Remotes, got %d", updatedApp.(*App).currentScreen)
+	}
+	if cmd == nil {
+		t.Error("entering the remotes screen should load the remote list")
+	}
+}
+
+func TestApp_Update_RemotesWizardReceivesKeys(t *testing.T) {
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.currentScreen = ScreenRemotes
+	app.remotes.SetServices(rclone.NewClientWithPath("/nonexistent/rclone"))
+	app.remotes.Update(screens.RemotesLoadedMsg{})
+	app.remotes.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+	// q is typed into the name, not taken as quit or back
+	updatedApp, _ := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if updatedApp.(*App).currentScreen != ScreenRemotes || !app.remotes.IsEditing() {
+		t.Error("keys should reach the remote wizard while it is open")
+	}
+}
+
+func TestApp_Update_BackupRestoredReloadsConfig(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.config = cfg
+	app.currentScreen = ScreenMain
+
+	// Simulate the restore replacing the config file on disk
+	restored, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	restored.Settings.DefaultMountDir = "/restored/mnt"
+	if err := restored.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	app.Update(screens.BackupRestoredMsg{Name: "config.yaml.bak"})
+
+	if cfg.Settings.DefaultMountDir != "/restored/mnt" {
+		t.Errorf("DefaultMountDir = %q, want the restored value", cfg.Settings.DefaultMountDir)
+	}
+}
+
+func TestApp_SyncConfigWatcher(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	app := NewApp()
+	app.config = cfg
+	app.configPath, _ = config.ConfigPath()
+
+	if cmd := app.syncConfigWatcher(); cmd != nil || app.watcher != nil {
+		t.Fatal("no watcher should run while WatchConfig is off")
+	}
+
+	cfg.Settings.WatchConfig = true
+	if cmd := app.syncConfigWatcher(); cmd == nil || app.watcher == nil {
+		t.Fatal("turning WatchConfig on should start a watcher")
+	}
+	w := app.watcher
+	if cmd := app.syncConfigWatcher(); cmd != nil || app.watcher != w {
+		t.Error("an unchanged setting should keep the running watcher")
+	}
+
+	cfg.Settings.WatchConfig = false
+	app.syncConfigWatcher()
+	if app.watcher != nil {
+		t.Error("turning WatchConfig off should stop the watcher")
+	}
+	if _, ok := <-w.Changes(); ok {
+		t.Error("the stopped watcher should be closed")
+	}
+}
+
+func TestApp_Update_ConfigFileChanged(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	path, _ := config.ConfigPath()
+	w, err := config.NewWatcher(path, time.Hour)
+	if err != nil {
+		t.Fatalf("NewWatcher() error = %v", err)
+	}
+	defer w.Close()
+
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.config = cfg
+	app.watcher = w
+
+	// The app's own save is not an external change
+	app.Update(ConfigFileChangedMsg{watcher: w})
+	if app.configChangeConfirm != nil {
+		t.Fatal("the app's own save should not prompt")
+	}
+
+	// Simulate an external edit
+	edited, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() error = %v", err)
+	}
+	edited.Settings.DefaultMountDir = "/edited/mnt"
+	if err := edited.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	app.Update(ConfigFileChangedMsg{watcher: &config.Watcher{}})
+	if app.configChangeConfirm != nil {
+		t.Fatal("a message from a replaced watcher should be ignored")
+	}
+
+	app.Update(ConfigFileChangedMsg{watcher: w})
+	if cfg.Settings.DefaultMountDir != "/edited/mnt" {
+		t.Errorf("DefaultMountDir = %q, want the edited value", cfg.Settings.DefaultMountDir)
+	}
+	if app.configChangeConfirm == nil {
+		t.Fatal("an external change should offer to regenerate the units")
+	}
+	if !strings.Contains(app.View(), "Config Changed") {
+		t.Error("view should show the regenerate prompt")
+	}
+
+	// Choose Yes; without services the regeneration reports an error
+	app.Update(tea.KeyMsg{Type: tea.KeyRight})
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if app.configChangeConfirm != nil || cmd == nil {
+		t.Fatal("confirming should close the prompt and regenerate the units")
+	}
+	app.Update(cmd())
+	if !strings.Contains(app.notice, "Failed to regenerate units") {
+		t.Errorf("notice = %q, want the regeneration error", app.notice)
+	}
+}
+
 func TestApp_Update_MainMenuNavigationQuit(t *testing.T) {
 	app := NewApp()
 	app.width = 80
@@ -1017,6 +1229,26 @@ func TestApp_Update_SettingsScreenGoBack(t *testing.T) {
 	}
 }
 
+func TestApp_ApplyTheme(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Cleanup(func() { components.ApplyTheme(components.ThemeDark) })
+
+	app := NewApp()
+	app.config = &config.Config{Settings: config.Settings{Theme: components.ThemeLight}}
+	app.applyTheme()
+	if app.theme != components.ThemeLight || components.Styles.Error.GetForeground() != components.NewStyles(components.ThemeLight).Error.GetForeground() {
+		t.Errorf("theme = %q, want the light styles applied", app.theme)
+	}
+
+	// Changing the setting on the settings screen switches the styles
+	app.currentScreen = ScreenSettings
+	app.config.Settings.Theme = components.ThemeNone
+	app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if app.theme != components.ThemeNone {
+		t.Errorf("theme = %q after the setting changed, want none", app.theme)
+	}
+}
+
 func TestApp_RenderHelp_ScrollIndicator(t *testing.T) {
 	app := NewApp()
 	app.width = 80
@@ -1965,3 +2197,188 @@ func TestApp_cleanupSelectedOrphan_AdjustsSelectedIndex(t *testing.T) {
 	// Should not panic when adjusting selected index, should handle gracefully
 	app.cleanupSelectedOrphan()
 }
+
+func TestApp_RenderHeaderShowsConfigPath(t *testing.T) {
+	app := NewApp()
+	app.width = 120
+	app.configPath = "/tmp/alt/rclone-mount-sync/config.yaml"
+
+	header := app.renderHeader()
+
+	if !strings.Contains(header, "config.yaml") {
+		t.Errorf("Header should contain the active config path, got %q", header)
+	}
+}
+
+func TestApp_ConfigSwitchConfirmCancel(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/original")
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.pendingConfigDir = "/tmp/other"
+	app.configSwitchConfirm = components.NewSimpleConfirmDialog("Switch Config Directory", "Discard?")
+
+	updatedApp, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	a := updatedApp.(*App)
+
+	if a.configSwitchConfirm != nil {
+		t.Error("configSwitchConfirm should be cleared after esc")
+	}
+	if a.pendingConfigDir != "" {
+		t.Errorf("pendingConfigDir = %q, want empty", a.pendingConfigDir)
+	}
+	if cmd != nil {
+		t.Error("cancelling should not start a config switch")
+	}
+	if got := os.Getenv("XDG_CONFIG_HOME"); got != "/original" {
+		t.Errorf("XDG_CONFIG_HOME = %q, want %q", got, "/original")
+	}
+}
+
+func TestApp_ConfigSwitchedMsgError(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+
+	updatedApp, _ := app.Update(ConfigSwitchedMsg{Path: "/tmp/bad", Err: &testError{msg: "bad config"}})
+	a := updatedApp.(*App)
+
+	a.currentScreen = ScreenSettings
+	if !strings.Contains(a.View(), "bad config") {
+		t.Error("settings screen should show the config switch error")
+	}
+}
+
+func TestApp_LeavingScreenStopsAutoRefresh(t *testing.T) {
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.currentScreen = ScreenServices
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	if !strings.Contains(app.services.View(), "Auto-refresh: every") {
+		t.Fatal("auto-refresh should be enabled on the services screen")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.currentScreen != ScreenMain {
+		t.Fatalf("currentScreen = %v, want ScreenMain", app.currentScreen)
+	}
+	if !strings.Contains(app.services.View(), "Auto-refresh: off") {
+		t.Error("leaving the services screen should stop auto-refresh")
+	}
+}
+
+func TestApp_InlineEditReceivesGlobalKeys(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	app.currentScreen = ScreenSettings
+	app.settings.SetConfig(&config.Config{})
+
+	// Move to the Editor setting
+	for i := 0; i < 8; i++ {
+		app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if !app.settings.IsInlineEditing() {
+		t.Fatal("expected inline editing to start")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if app.currentScreen != ScreenSettings {
+		t.Error("q should be typed into the inline editor, not leave the screen")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.currentScreen != ScreenSettings {
+		t.Error("esc should cancel the inline edit, not leave the screen")
+	}
+	if app.settings.IsInlineEditing() {
+		t.Error("esc should end inline editing")
+	}
+}
+
+func TestApp_LogSearchReceivesGlobalKeys(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	app.currentScreen = ScreenServices
+	app.services.Update(screens.ServicesLoadedMsg{Services: []screens.ServiceInfo{
+		{Name: "rclone-mount-a1b2c3d4", DisplayName: "gdrive", Type: "mount", Status: "active"},
+	}})
+	app.services.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	app.services.Update(screens.ServiceLogsLoadedMsg{Logs: "INFO quota exceeded"})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	if !app.services.IsSearchingLogs() {
+		t.Fatal("expected the log search to open")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if app.currentScreen != ScreenServices {
+		t.Error("q should be typed into the log search, not leave the screen")
+	}
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.currentScreen != ScreenServices || app.services.IsSearchingLogs() {
+		t.Error("esc should cancel the log search, not leave the screen")
+	}
+}
+
+func TestApp_ListFilterReceivesGlobalKeys(t *testing.T) {
+	app := NewApp()
+	app.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	app.currentScreen = ScreenMounts
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	if !app.mounts.IsSearching() {
+		t.Fatal("expected the list filter to open")
+	}
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if app.currentScreen != ScreenMounts || !app.mounts.HasSearchFilter() {
+		t.Fatal("q should be typed into the filter, not leave the screen")
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.currentScreen != ScreenMounts || app.mounts.HasSearchFilter() {
+		t.Error("esc should clear the filter before leaving the screen")
+	}
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if app.currentScreen != ScreenMain {
+		t.Error("esc without a filter should leave the screen")
+	}
+}
+
+func TestApp_RenderStatusBarInstanceWarning(t *testing.T) {
+	app := NewApp()
+	app.width = 120
+	app.instanceWarning = "Another instance (pid 42 on nas) may be managing this config"
+
+	if bar := app.renderStatusBar(); !strings.Contains(bar, "pid 42 on nas") {
+		t.Errorf("status bar should show the instance warning, got %q", bar)
+	}
+}
+
+func TestApp_Update_EscapeClosesLogViewer(t *testing.T) {
+	app := NewApp()
+	app.width = 80
+	app.height = 24
+	app.currentScreen = ScreenMounts
+	app.mounts.SetServices(&config.Config{}, nil, systemd.NewTestGenerator(t.TempDir()), &systemd.MockManager{})
+	app.mounts.Update(screens.MountsLoadedMsg{Mounts: []models.MountConfig{{ID: "abc", Name: "Drive"}}})
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if !app.mounts.IsViewingLogs() {
+		t.Fatal("l should open the mount's logs")
+	}
+
+	updatedApp, _ := app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if updatedApp.(*App).currentScreen != ScreenMounts {
+		t.Errorf("Escape should close the log viewer, got screen %d", updatedApp.(*App).currentScreen)
+	}
+	if app.mounts.IsViewingLogs() {
+		t.Error("log viewer should be closed")
+	}
+
+	updatedApp, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if updatedApp.(*App).currentScreen != ScreenMain {
+		t.Errorf("second Escape should go to main, got screen %d", updatedApp.(*App).currentScreen)
+	}
+}